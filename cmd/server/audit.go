@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/datastore/pg"
+)
+
+// auditExportPageSize is the number of audit events read at a time by
+// audit export
+const auditExportPageSize = 100
+
+// auditLine is an audit event as written by audit export
+type auditLine struct {
+	ID         datastore.ID          `json:"id"`
+	CreatedAt  time.Time             `json:"created_at"`
+	Actor      string                `json:"actor"`
+	Method     string                `json:"method"`
+	Action     datastore.AuditAction `json:"action"`
+	Resource   string                `json:"resource"`
+	ResourceID datastore.ID          `json:"resource_id"`
+	Before     json.RawMessage       `json:"before"`
+	After      json.RawMessage       `json:"after"`
+	RequestID  string                `json:"request_id"`
+	ClientIP   string                `json:"client_ip"`
+}
+
+// runAudit implements the audit subcommand:
+//
+//	server [flags] audit export [export flags] write audit events as JSON Lines
+func runAudit(args []string) error {
+	if len(args) == 0 || args[0] != "export" {
+		return fmt.Errorf("usage: audit export [flags]")
+	}
+
+	fs := flag.NewFlagSet("audit export", flag.ExitOnError)
+	var (
+		tenant     = fs.String("tenant", "", "Slug of the tenant to export, the default tenant if empty")
+		resource   = fs.String("resource", "", "Only export events of this resource, blog or comment")
+		resourceID = fs.String("resource-id", "", "Only export events of the resource with this ID")
+		actor      = fs.String("actor", "", "Only export events of this actor")
+		since      = fs.String("since", "", "Only export events at or after this RFC 3339 time")
+		until      = fs.String("until", "", "Only export events before this RFC 3339 time")
+		output     = fs.String("output", "", "File to write the events to, stdout if empty")
+	)
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	filter := datastore.AuditFilter{
+		Resource:   *resource,
+		ResourceID: datastore.ID(*resourceID),
+		Actor:      *actor,
+	}
+	var err error
+	if filter.Since, err = parseAuditTime("since", *since); err != nil {
+		return err
+	}
+	if filter.Until, err = parseAuditTime("until", *until); err != nil {
+		return err
+	}
+
+	conn, err := pg.Open(pgOptions()...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer conn.Close()
+
+	store := pg.NewWithDB(conn)
+	ctx := context.Background()
+	if *tenant != "" {
+		t, err := store.GetTenantBySlug(ctx, *tenant)
+		if err != nil {
+			return err
+		}
+		ctx = datastore.NewTenantContext(ctx, t)
+	}
+
+	out := io.Writer(os.Stdout)
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", *output, err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	w := bufio.NewWriter(out)
+	if err := exportAuditEvents(ctx, store, filter, w); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// exportAuditEvents writes every audit event passing filter to w, one JSON
+// object per line, oldest first
+func exportAuditEvents(ctx context.Context, store datastore.Store, filter datastore.AuditFilter, w io.Writer) error {
+	enc := json.NewEncoder(w)
+	pageToken := ""
+	for {
+		events, next, err := store.ListAuditEvents(ctx, filter, auditExportPageSize, pageToken)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if err := enc.Encode(auditLine(*event)); err != nil {
+				return fmt.Errorf("failed to write audit event %s: %w", event.ID, err)
+			}
+		}
+		if next == "" {
+			return nil
+		}
+		pageToken = next
+	}
+}
+
+// parseAuditTime parses the value of a time flag of audit export, returning
+// nil if it is empty
+func parseAuditTime(name, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %w", name, err)
+	}
+	return &t, nil
+}
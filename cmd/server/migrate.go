@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/agruetz/prosigliere/db"
+	"github.com/agruetz/prosigliere/internal/datastore/pg"
+	"github.com/agruetz/prosigliere/internal/migrate"
+)
+
+// runMigrate implements the migrate subcommand:
+//
+//	server [flags] migrate [-dry-run]   apply pending migrations
+//	server [flags] migrate status       show the state of every migration
+func runMigrate(logger *log.Logger, args []string) error {
+	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "List pending migrations without applying them")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	conn, err := pg.Open(pgOptions()...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer conn.Close()
+
+	ctx := context.Background()
+
+	switch fs.Arg(0) {
+	case "status":
+		migrator, err := newMigrator(conn)
+		if err != nil {
+			return err
+		}
+		infos, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		return printStatus(infos)
+	case "":
+		if !*dryRun {
+			return applyMigrations(ctx, logger, conn)
+		}
+		migrator, err := newMigrator(conn)
+		if err != nil {
+			return err
+		}
+		pending, err := migrator.Pending(ctx)
+		if err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			logger.Println("Database schema is up to date")
+		}
+		for _, migration := range pending {
+			logger.Printf("Would apply migration V%s (%s)", migration.Version, migration.Description)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown migrate command %q", fs.Arg(0))
+	}
+}
+
+// applyMigrations applies all pending embedded migrations
+func applyMigrations(ctx context.Context, logger *log.Logger, conn *sql.DB) error {
+	migrator, err := newMigrator(conn)
+	if err != nil {
+		return err
+	}
+
+	applied, err := migrator.Migrate(ctx)
+	for _, migration := range applied {
+		logger.Printf("Applied migration V%s (%s)", migration.Version, migration.Description)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to apply migrations: %w", err)
+	}
+	if len(applied) == 0 {
+		logger.Println("Database schema is up to date")
+	}
+
+	return nil
+}
+
+// newMigrator creates a migrator for the embedded migrations
+func newMigrator(conn *sql.DB) (*migrate.Migrator, error) {
+	return migrate.New(conn, db.Migrations(), migrate.WithTable(*migrationTable))
+}
+
+// printStatus writes the migration status as a table to stdout
+func printStatus(infos []migrate.Info) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tDESCRIPTION\tSTATE\tINSTALLED ON\tEXECUTION TIME")
+	for _, info := range infos {
+		installedOn := ""
+		executionTime := ""
+		if !info.InstalledOn.IsZero() {
+			installedOn = info.InstalledOn.Format("2006-01-02 15:04:05")
+			executionTime = info.ExecutionTime.String()
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", info.Version, info.Description, info.State, installedOn, executionTime)
+	}
+	return w.Flush()
+}
@@ -2,6 +2,7 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"flag"
 	"fmt"
@@ -13,12 +14,23 @@ import (
 	"syscall"
 	"time"
 
+	"buf.build/go/protovalidate"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/agruetz/prosigliere/internal/auth"
+	"github.com/agruetz/prosigliere/internal/authz"
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/datastore/memory"
 	"github.com/agruetz/prosigliere/internal/datastore/pg"
+	"github.com/agruetz/prosigliere/internal/filter"
+	"github.com/agruetz/prosigliere/internal/gateway"
+	"github.com/agruetz/prosigliere/internal/interceptor"
+	"github.com/agruetz/prosigliere/internal/publisher"
+	"github.com/agruetz/prosigliere/internal/purger"
+	"github.com/agruetz/prosigliere/internal/ratelimit"
 	"github.com/agruetz/prosigliere/internal/service"
 	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
 )
@@ -30,6 +42,9 @@ var (
 	// HTTP/REST gateway settings
 	httpPort = flag.Int("http-port", 8080, "The HTTP server port")
 
+	// Datastore settings
+	storeType = flag.String("store", "postgres", "Datastore backend (postgres or memory)")
+
 	// Database settings
 	dbHost     = flag.String("db-host", "localhost", "Database host")
 	dbPort     = flag.Int("db-port", 5432, "Database port")
@@ -37,6 +52,46 @@ var (
 	dbPassword = flag.String("db-password", "postgres", "Database password")
 	dbName     = flag.String("db-name", "prosigliere", "Database name")
 	dbSSLMode  = flag.String("db-sslmode", "disable", "Database SSL mode")
+
+	// Migration settings
+	migrateOnStart = flag.Bool("migrate-on-start", false, "Apply pending database migrations before serving")
+	migrationTable = flag.String("migration-table", "schema_version", "Schema history table tracking applied migrations")
+
+	// Scheduled publishing settings
+	publishInterval = flag.Duration("publish-interval", time.Minute, "How often to publish scheduled blogs that are due (0 disables)")
+
+	// Trash settings
+	purgeInterval  = flag.Duration("purge-interval", time.Hour, "How often to purge blogs whose trash retention has expired (0 disables)")
+	trashRetention = flag.Duration("trash-retention", 30*24*time.Hour, "How long deleted blogs are kept in the trash before being purged")
+
+	// Comment settings
+	maxCommentDepth  = flag.Int("max-comment-depth", service.DefaultMaxCommentDepth, "How deep replies to comments may nest (0 disables replies)")
+	moderateComments = flag.Bool("moderate-comments", false, "Hold new comments for approval on blogs without their own comment policy")
+
+	// Comment filter settings
+	filterMaxLinks        = flag.Int("filter-max-links", -1, "Hold new comments with more links than this for approval (-1 disables)")
+	filterBlockedWords    = flag.String("filter-blocked-words", "", "File of words and phrases, one per line, that get new comments rejected")
+	filterDuplicateWindow = flag.Duration("filter-duplicate-window", 0, "Mark new comments repeating a comment made within this window as spam (0 disables)")
+	filterBayes           = flag.Bool("filter-bayes", false, "Judge new comments with a naive Bayes classifier trained on the moderation history")
+
+	// Authentication settings
+	authJWKS              = flag.String("auth-jwks", "", "JSON Web Key Set file with the keys bearer tokens are signed with")
+	authHMACSecretFile    = flag.String("auth-hmac-secret-file", "", "File holding the shared secret bearer tokens are signed with")
+	authIssuer            = flag.String("auth-issuer", "", "Issuer bearer tokens must name (optional)")
+	authAudience          = flag.String("auth-audience", "", "Audience bearer tokens must name (optional)")
+	authAnonymousReads    = flag.Bool("auth-anonymous-reads", true, "Allow reading blogs, comments and author profiles without a bearer token")
+	authAnonymousComments = flag.Bool("auth-anonymous-comments", true, "Allow adding comments without a bearer token")
+	authAPIKeys           = flag.Bool("auth-api-keys", false, "Accept API keys created with CreateAPIKey in the x-api-key header")
+
+	// Authorization settings
+	authzPolicy = flag.String("authz-policy", "", "YAML or JSON file with the rules deciding which roles may call which methods (requires authentication)")
+
+	// Rate limiting settings
+	rateLimits = flag.String("rate-limits", "", "YAML or JSON file with the limits on how often callers may call which methods")
+
+	// Multi-tenancy settings
+	multiTenant  = flag.Bool("multi-tenant", false, "Host a blog per tenant, named by the X-Tenant header, a subdomain of --tenant-domain or a /t/{tenant} path prefix")
+	tenantDomain = flag.String("tenant-domain", "", "Domain whose subdomains name tenants, such as blogs.example for acme.blogs.example (requires --multi-tenant)")
 )
 
 func main() {
@@ -45,35 +100,120 @@ func main() {
 	// Initialize logger
 	logger := log.New(os.Stdout, "", log.LstdFlags)
 
+	// Run the migrate subcommand instead of the server if requested
+	if flag.Arg(0) == "migrate" {
+		if err := runMigrate(logger, flag.Args()[1:]); err != nil {
+			logger.Fatalf("Migration failed: %v", err)
+		}
+		return
+	}
+
+	// Run the tenants subcommand instead of the server if requested
+	if flag.Arg(0) == "tenants" {
+		if err := runTenants(logger, flag.Args()[1:]); err != nil {
+			logger.Fatalf("Tenants command failed: %v", err)
+		}
+		return
+	}
+
+	// Run the audit subcommand instead of the server if requested
+	if flag.Arg(0) == "audit" {
+		if err := runAudit(flag.Args()[1:]); err != nil {
+			logger.Fatalf("Audit command failed: %v", err)
+		}
+		return
+	}
+	if *tenantDomain != "" && !*multiTenant {
+		logger.Fatalf("--tenant-domain requires --multi-tenant")
+	}
+
 	// Create a context that can be canceled
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	// Initialize the database connection
-	store, err := pg.New(
-		pg.WithHost(*dbHost),
-		pg.WithPort(*dbPort),
-		pg.WithUser(*dbUser),
-		pg.WithPassword(*dbPassword),
-		pg.WithDatabase(*dbName),
-		pg.WithSSLMode(*dbSSLMode),
-		pg.WithMaxOpenConns(10),
-		pg.WithMaxIdleConns(5),
-		pg.WithConnMaxLife(time.Minute*5),
-	)
+	// Initialize the datastore
+	store, err := newStore(ctx, logger)
 	if err != nil {
-		logger.Fatalf("Failed to connect to database: %v", err)
+		logger.Fatalf("Failed to initialize datastore: %v", err)
 	}
-	logger.Println("Connected to database")
 
 	// Create the blog service
-	blogService := service.NewBlogService(store)
+	opts := []service.Option{
+		service.WithMaxCommentDepth(int32(*maxCommentDepth)),
+		service.WithCommentModeration(*moderateComments),
+	}
+	commentFilter, err := newCommentFilter(logger, store)
+	if err != nil {
+		logger.Fatalf("Failed to initialize comment filters: %v", err)
+	}
+	if commentFilter != nil {
+		opts = append(opts, service.WithCommentFilter(commentFilter))
+	}
+
+	// Initialize authentication
+	verifier, err := newVerifier()
+	if err != nil {
+		logger.Fatalf("Failed to initialize authentication: %v", err)
+	}
+	var apiKeys auth.Verifier
+	if *authAPIKeys {
+		apiKeys = auth.NewAPIKeys(store)
+	}
+
+	// Initialize authorization
+	policy, err := newPolicy(verifier != nil || apiKeys != nil)
+	if err != nil {
+		logger.Fatalf("Failed to initialize authorization: %v", err)
+	}
+
+	// Hide unpublished blogs and the trash from callers who may not edit
+	// them, unless everybody may
+	if verifier != nil || apiKeys != nil {
+		opts = append(opts, service.WithAccessControl(policy))
+	}
+	blogService := service.NewBlogService(store, opts...)
+	userService := service.NewUserService(store)
+
+	// Only authenticated callers may administer the server, so it cannot be
+	// administered without authentication
+	var adminService *service.AdminService
+	if verifier != nil || apiKeys != nil {
+		adminService = service.NewAdminService(store)
+	} else {
+		logger.Println("Authentication is disabled, the admin service is not served")
+	}
+
+	// Initialize rate limiting
+	var limiter *ratelimit.Limiter
+	if *rateLimits != "" {
+		limiter, err = ratelimit.Load(*rateLimits, ratelimit.NewMemory())
+		if err != nil {
+			logger.Fatalf("Failed to initialize rate limiting: %v", err)
+		}
+	}
+
+	// The gateway proves with a key that it forwards client addresses, which
+	// only this process knows
+	gatewayKey, err := gateway.NewKey()
+	if err != nil {
+		logger.Fatalf("Failed to generate gateway key: %v", err)
+	}
 
 	// Start the gRPC server
-	go startGRPCServer(ctx, logger, blogService)
+	go startGRPCServer(ctx, logger, store, blogService, adminService, userService, verifier, apiKeys, policy, limiter, gatewayKey)
 
 	// Start the HTTP/REST gateway
-	go startHTTPServer(ctx, logger)
+	go startHTTPServer(ctx, logger, gatewayKey, adminService != nil)
+
+	// Start publishing scheduled blogs
+	if *publishInterval > 0 {
+		go startPublisher(ctx, logger, store)
+	}
+
+	// Start purging expired blogs from the trash
+	if *purgeInterval > 0 {
+		go startPurger(ctx, logger, store)
+	}
 
 	// Wait for termination signal
 	signalChan := make(chan os.Signal, 1)
@@ -88,18 +228,156 @@ func main() {
 	logger.Println("Server shutdown complete")
 }
 
-func startGRPCServer(ctx context.Context, logger *log.Logger, blogService *service.BlogService) {
+// newStore creates the datastore backend selected by the store flag
+func newStore(ctx context.Context, logger *log.Logger) (datastore.Store, error) {
+	switch *storeType {
+	case "memory":
+		logger.Println("Using in-memory datastore, data will not be persisted")
+		return memory.New(), nil
+	case "postgres":
+		db, err := pg.Open(pgOptions()...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to database: %w", err)
+		}
+		logger.Println("Connected to database")
+
+		if *migrateOnStart {
+			if err := applyMigrations(ctx, logger, db); err != nil {
+				db.Close()
+				return nil, err
+			}
+		}
+
+		return pg.NewWithDB(db), nil
+	default:
+		return nil, fmt.Errorf("unknown store type %q", *storeType)
+	}
+}
+
+// newPolicy loads the authorization policy named by the authz flags, or
+// returns nil if authorization is disabled. Policies need the principals
+// authentication provides.
+func newPolicy(authenticated bool) (*authz.Policy, error) {
+	if *authzPolicy == "" {
+		return nil, nil
+	}
+	if !authenticated {
+		return nil, fmt.Errorf("--authz-policy requires --auth-jwks, --auth-hmac-secret-file or --auth-api-keys")
+	}
+	return authz.Load(*authzPolicy)
+}
+
+// newVerifier creates the verifier of bearer tokens selected by the auth
+// flags, or nil if bearer tokens are not accepted
+func newVerifier() (auth.Verifier, error) {
+	var keys *auth.KeySet
+	var err error
+	switch {
+	case *authJWKS != "" && *authHMACSecretFile != "":
+		return nil, fmt.Errorf("--auth-jwks and --auth-hmac-secret-file are mutually exclusive")
+	case *authJWKS != "":
+		keys, err = auth.LoadJWKS(*authJWKS)
+	case *authHMACSecretFile != "":
+		var secret []byte
+		secret, err = os.ReadFile(*authHMACSecretFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read HMAC secret: %w", err)
+		}
+		keys, err = auth.NewHMACKeySet(bytes.TrimSpace(secret))
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.NewJWT(keys,
+		auth.WithIssuer(*authIssuer),
+		auth.WithAudience(*authAudience),
+	), nil
+}
+
+// anonymousMethod reports whether a method may be called without a bearer
+// token, as the auth flags allow
+func anonymousMethod(fullMethod string) bool {
+	return (*authAnonymousReads && service.IsPublicRead(fullMethod)) ||
+		(*authAnonymousComments && service.IsAddComment(fullMethod))
+}
+
+// newCommentFilter creates the comment filters enabled by the filter flags,
+// or nil if none is
+func newCommentFilter(logger *log.Logger, store datastore.Store) (*filter.Pipeline, error) {
+	// Cheap filters run first, as a rejection skips the rest
+	var classifiers []filter.Classifier
+	if *filterBlockedWords != "" {
+		words, err := filter.LoadBlockedWords(*filterBlockedWords)
+		if err != nil {
+			return nil, err
+		}
+		classifiers = append(classifiers, words)
+	}
+	if *filterMaxLinks >= 0 {
+		classifiers = append(classifiers, filter.NewLinkLimit(*filterMaxLinks))
+	}
+	if *filterDuplicateWindow > 0 {
+		classifiers = append(classifiers, filter.NewDuplicates(store, *filterDuplicateWindow))
+	}
+	if *filterBayes {
+		classifiers = append(classifiers, filter.NewBayes(store))
+	}
+	if len(classifiers) == 0 {
+		return nil, nil
+	}
+
+	names := make([]string, len(classifiers))
+	for i, classifier := range classifiers {
+		names[i] = classifier.Name()
+	}
+	logger.Printf("Filtering new comments with %v", names)
+	return filter.New(classifiers...), nil
+}
+
+// pgOptions returns the PostgreSQL connection options from the database flags
+func pgOptions() []pg.Option {
+	return []pg.Option{
+		pg.WithHost(*dbHost),
+		pg.WithPort(*dbPort),
+		pg.WithUser(*dbUser),
+		pg.WithPassword(*dbPassword),
+		pg.WithDatabase(*dbName),
+		pg.WithSSLMode(*dbSSLMode),
+		pg.WithMaxOpenConns(10),
+		pg.WithMaxIdleConns(5),
+		pg.WithConnMaxLife(time.Minute * 5),
+	}
+}
+
+func startGRPCServer(ctx context.Context, logger *log.Logger, store datastore.Store, blogService *service.BlogService, adminService *service.AdminService, userService *service.UserService, verifier, apiKeys auth.Verifier, policy *authz.Policy, limiter *ratelimit.Limiter, gatewayKey string) {
 	addr := fmt.Sprintf(":%d", *grpcPort)
 	lis, err := net.Listen("tcp", addr)
 	if err != nil {
 		logger.Fatalf("Failed to listen on %s: %v", addr, err)
 	}
 
+	// Create the request validator from the buf.validate rules in the protos
+	validator, err := protovalidate.New()
+	if err != nil {
+		logger.Fatalf("Failed to create request validator: %v", err)
+	}
+
+	interceptors := unaryInterceptors(logger, store, blogService, validator, verifier, apiKeys, policy, limiter, gatewayKey)
+
 	// Create a new gRPC server
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(interceptors...),
+	)
 
-	// Register the blog service
+	// Register the blog, admin and user services
 	blogpb.RegisterBlogsServer(grpcServer, blogService)
+	if adminService != nil {
+		blogpb.RegisterAdminServer(grpcServer, adminService)
+	}
+	blogpb.RegisterUsersServer(grpcServer, userService)
 
 	// Register reflection service on gRPC server
 	reflection.Register(grpcServer)
@@ -118,24 +396,77 @@ func startGRPCServer(ctx context.Context, logger *log.Logger, blogService *servi
 	logger.Println("gRPC server stopped")
 }
 
-func startHTTPServer(ctx context.Context, logger *log.Logger) {
+// unaryInterceptors returns the interceptors every call goes through, in the
+// order they run
+func unaryInterceptors(logger *log.Logger, store datastore.Store, blogService *service.BlogService, validator protovalidate.Validator, verifier, apiKeys auth.Verifier, policy *authz.Policy, limiter *ratelimit.Limiter, gatewayKey string) []grpc.UnaryServerInterceptor {
+	// Find out where calls come from, trusting the addresses the gateway
+	// forwards, then resolve the tenant, as everything after it acts for
+	// that tenant
+	interceptors := []grpc.UnaryServerInterceptor{interceptor.ClientIP(gatewayKey)}
+	if *multiTenant {
+		interceptors = append(interceptors, interceptor.Tenant(store))
+	}
+	// Authenticate callers before anything else looks at their requests
+	if verifier != nil || apiKeys != nil {
+		interceptors = append(interceptors, interceptor.Auth(verifier, apiKeys, anonymousMethod))
+	} else {
+		logger.Println("Authentication is disabled, every method can be called anonymously")
+	}
+	// Limit how often they call before doing any work for them
+	if limiter != nil {
+		interceptors = append(interceptors, interceptor.RateLimit(limiter))
+	}
+	// Tell the stores who is calling, for the audit events of their changes
+	interceptors = append(interceptors, interceptor.Audit())
+	// Reject malformed requests before anything looks up what they name
+	interceptors = append(interceptors,
+		interceptor.IfMatch(),
+		interceptor.Validate(validator),
+	)
+	// Then check what they may do, looking up blog owners in the service
+	if policy != nil {
+		interceptors = append(interceptors, interceptor.Authorize(policy, blogService))
+	}
+	return interceptors
+}
+
+func startHTTPServer(ctx context.Context, logger *log.Logger, gatewayKey string, admin bool) {
 	addr := fmt.Sprintf(":%d", *httpPort)
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(gateway.ServeMuxOptions()...)
 
 	// Set up a connection to the gRPC server
 	grpcAddr := fmt.Sprintf("localhost:%d", *grpcPort)
-	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(gateway.SendKey(gatewayKey)),
+	}
 
-	// Register the blog service handler
+	// Register the blog, admin and user service handlers
 	err := blogpb.RegisterBlogsHandlerFromEndpoint(ctx, mux, grpcAddr, opts)
 	if err != nil {
 		logger.Fatalf("Failed to register gateway: %v", err)
 	}
+	if admin {
+		err = blogpb.RegisterAdminHandlerFromEndpoint(ctx, mux, grpcAddr, opts)
+		if err != nil {
+			logger.Fatalf("Failed to register gateway: %v", err)
+		}
+	}
+	err = blogpb.RegisterUsersHandlerFromEndpoint(ctx, mux, grpcAddr, opts)
+	if err != nil {
+		logger.Fatalf("Failed to register gateway: %v", err)
+	}
+
+	// Resolve the tenant of requests before the mux routes them by path
+	var handler http.Handler = mux
+	if *multiTenant {
+		handler = gateway.Tenant(mux, *tenantDomain)
+	}
 
 	// Create an HTTP server
 	server := &http.Server{
 		Addr:    addr,
-		Handler: mux,
+		Handler: handler,
 	}
 
 	logger.Printf("Starting HTTP/REST gateway on %s", addr)
@@ -158,3 +489,26 @@ func startHTTPServer(ctx context.Context, logger *log.Logger) {
 	}
 	logger.Println("HTTP server stopped")
 }
+
+func startPublisher(ctx context.Context, logger *log.Logger, store datastore.Store) {
+	p := publisher.New(store,
+		publisher.WithInterval(*publishInterval),
+		publisher.WithLogger(logger),
+	)
+
+	logger.Printf("Starting scheduled blog publisher every %s", *publishInterval)
+	p.Run(ctx)
+	logger.Println("Scheduled blog publisher stopped")
+}
+
+func startPurger(ctx context.Context, logger *log.Logger, store datastore.Store) {
+	p := purger.New(store,
+		purger.WithInterval(*purgeInterval),
+		purger.WithRetention(*trashRetention),
+		purger.WithLogger(logger),
+	)
+
+	logger.Printf("Starting trash purger every %s with %s retention", *purgeInterval, *trashRetention)
+	p.Run(ctx)
+	logger.Println("Trash purger stopped")
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"io"
+	"log"
+	"testing"
+
+	"buf.build/go/protovalidate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/agruetz/prosigliere/internal/auth"
+	"github.com/agruetz/prosigliere/internal/authz"
+	"github.com/agruetz/prosigliere/internal/datastore/mocks"
+	"github.com/agruetz/prosigliere/internal/service"
+	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
+)
+
+func TestUnaryInterceptors_ValidateBeforeAuthorize(t *testing.T) {
+	validator, err := protovalidate.New()
+	require.NoError(t, err)
+	policy, err := authz.New(authz.Rule{Methods: []string{"/blog.v1.Blogs/Update"}, Roles: []string{"author"}, Owner: true})
+	require.NoError(t, err)
+
+	// The store expects no calls, as nothing may look up the owner of a
+	// malformed ID
+	store := mocks.NewStore(t)
+	blogService := service.NewBlogService(store, service.WithAccessControl(policy))
+	interceptors := unaryInterceptors(log.New(io.Discard, "", 0), store, blogService, validator, nil, nil, policy, nil, "")
+
+	ctx := auth.NewContext(context.Background(), &auth.Principal{Subject: "alice", Roles: []string{"author"}})
+	req := &blogpb.UpdateReq{Id: &blogpb.UUID{Value: "not-a-uuid"}}
+	info := &grpc.UnaryServerInfo{FullMethod: blogpb.Blogs_Update_FullMethodName}
+	handler := func(ctx context.Context, req any) (any, error) {
+		t.Fatal("handler called with a malformed request")
+		return nil, nil
+	}
+
+	// Run the interceptors like grpc.ChainUnaryInterceptor does
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		next, interceptor := handler, interceptors[i]
+		handler = func(ctx context.Context, req any) (any, error) {
+			return interceptor(ctx, req, info, next)
+		}
+	}
+
+	_, err = handler(ctx, req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
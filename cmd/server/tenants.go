@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/agruetz/prosigliere/internal/datastore/pg"
+)
+
+// runTenants implements the tenants subcommand:
+//
+//	server [flags] tenants list                 show every tenant
+//	server [flags] tenants create <slug> <name> add a tenant
+func runTenants(logger *log.Logger, args []string) error {
+	fs := flag.NewFlagSet("tenants", flag.ExitOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	conn, err := pg.Open(pgOptions()...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer conn.Close()
+
+	store := pg.NewWithDB(conn)
+	ctx := context.Background()
+
+	switch fs.Arg(0) {
+	case "list", "":
+		tenants, err := store.ListTenants(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "SLUG\tNAME\tID\tCREATED AT")
+		for _, tenant := range tenants {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", tenant.Slug, tenant.Name, tenant.ID, tenant.CreatedAt.Format("2006-01-02 15:04:05"))
+		}
+		return w.Flush()
+	case "create":
+		if fs.NArg() != 3 {
+			return fmt.Errorf("usage: tenants create <slug> <name>")
+		}
+		tenant, err := store.CreateTenant(ctx, fs.Arg(1), fs.Arg(2))
+		if err != nil {
+			return err
+		}
+		logger.Printf("Created tenant %s (%s)", tenant.Slug, tenant.ID)
+		return nil
+	default:
+		return fmt.Errorf("unknown tenants command %q", fs.Arg(0))
+	}
+}
@@ -0,0 +1,21 @@
+// Package db embeds the database migration scripts so they ship with the server binary
+package db
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// Migrations returns the migration scripts, named following the Flyway
+// convention V{version}__{description}.sql
+func Migrations() fs.FS {
+	sub, err := fs.Sub(migrations, "migrations")
+	if err != nil {
+		// The embedded directory is fixed at compile time, so this cannot happen
+		panic(err)
+	}
+	return sub
+}
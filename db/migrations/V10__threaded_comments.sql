@@ -0,0 +1,16 @@
+-- Let comments reply to other comments of the same blog
+ALTER TABLE comments ADD COLUMN parent_id UUID;
+ALTER TABLE comments ADD COLUMN depth INTEGER NOT NULL DEFAULT 0;
+
+-- Replies reference their parent together with the blog, so a reply can only
+-- belong to the blog of its parent
+ALTER TABLE comments ADD CONSTRAINT comments_id_blog_id_key UNIQUE (id, blog_id);
+ALTER TABLE comments ADD CONSTRAINT comments_parent_id_fkey
+    FOREIGN KEY (parent_id, blog_id) REFERENCES comments(id, blog_id) ON DELETE CASCADE;
+
+-- Top-level comments have depth 0, replies are nested below them
+ALTER TABLE comments ADD CONSTRAINT comments_depth_check
+    CHECK ((parent_id IS NULL AND depth = 0) OR (parent_id IS NOT NULL AND depth > 0));
+
+-- Create index for faster reply lookups
+CREATE INDEX idx_comments_parent_id ON comments(parent_id);
@@ -0,0 +1,6 @@
+-- Record when comments were last edited
+ALTER TABLE comments ADD COLUMN updated_at TIMESTAMP WITH TIME ZONE;
+
+-- Comments are read and paged through oldest first
+DROP INDEX idx_comments_blog_id;
+CREATE INDEX idx_comments_blog_id_created_at ON comments(blog_id, created_at, id);
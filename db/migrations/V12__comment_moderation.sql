@@ -0,0 +1,19 @@
+-- Create comment moderation types
+CREATE TYPE comment_state AS ENUM ('pending', 'approved', 'rejected', 'spam');
+CREATE TYPE comment_policy AS ENUM ('open', 'moderated');
+
+-- Add moderation state to comments. Existing comments were shown, so they
+-- are approved.
+ALTER TABLE comments
+    ADD COLUMN state comment_state NOT NULL DEFAULT 'approved',
+    ADD COLUMN moderation_reason TEXT NOT NULL DEFAULT '',
+    ADD COLUMN moderated_at TIMESTAMP WITH TIME ZONE;
+
+-- New comments must choose a state explicitly
+ALTER TABLE comments ALTER COLUMN state DROP DEFAULT;
+
+-- Blogs without a policy follow the server default
+ALTER TABLE blogs ADD COLUMN comment_policy comment_policy;
+
+-- Create index for the moderation queue
+CREATE INDEX idx_comments_pending_created_at ON comments(created_at, id) WHERE state = 'pending';
@@ -0,0 +1,18 @@
+-- Create comment_verdicts table recording what the comment filters made of
+-- new comments
+CREATE TABLE comment_verdicts (
+    comment_id UUID NOT NULL REFERENCES comments(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL CHECK (position >= 0),
+    classifier VARCHAR(50) NOT NULL,
+    state comment_state,
+    reason TEXT NOT NULL DEFAULT '',
+    score DOUBLE PRECISION NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (comment_id, position)
+);
+
+-- Create index for training filters on the moderation history
+CREATE INDEX idx_comments_moderated_at ON comments(moderated_at) WHERE moderated_at IS NOT NULL;
+
+-- Create index for finding recent comments across blogs
+CREATE INDEX idx_comments_created_at ON comments(created_at);
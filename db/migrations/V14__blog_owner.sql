@@ -0,0 +1,6 @@
+-- Record the subject of the principal that created each blog. Blogs created
+-- before authentication, or anonymously, have no owner.
+ALTER TABLE blogs ADD COLUMN owner VARCHAR(255);
+
+-- Create index for listing a principal's blogs
+CREATE INDEX idx_blogs_owner ON blogs(owner) WHERE owner IS NOT NULL;
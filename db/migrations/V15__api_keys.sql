@@ -0,0 +1,16 @@
+-- Create api_keys table holding the keys service-to-service clients
+-- authenticate with. Only the SHA-256 hash of each key is stored.
+CREATE TABLE api_keys (
+    id UUID PRIMARY KEY,
+    name VARCHAR(100) NOT NULL CHECK (LENGTH(name) > 0),
+    prefix VARCHAR(20) NOT NULL,
+    key_hash CHAR(64) NOT NULL UNIQUE,
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP WITH TIME ZONE,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Create index for listing keys, newest first
+CREATE INDEX idx_api_keys_created_at ON api_keys(created_at DESC, id DESC);
@@ -0,0 +1,28 @@
+-- Create users table holding the public profiles of the authors of blogs and
+-- comments
+CREATE TABLE users (
+    id UUID PRIMARY KEY,
+    display_name VARCHAR(100) NOT NULL CHECK (LENGTH(display_name) > 0),
+    bio VARCHAR(1000) NOT NULL DEFAULT '',
+    avatar_url VARCHAR(500) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create index for listing users, oldest first
+CREATE INDEX idx_users_created_at ON users(created_at, id);
+
+-- Create trigger to automatically update updated_at on users
+CREATE TRIGGER update_users_updated_at
+BEFORE UPDATE ON users
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+
+-- Reference the authors of blogs and comments. Comments by guests keep only
+-- their free-text author, and the author of existing blogs is unknown.
+ALTER TABLE blogs ADD COLUMN author_id UUID REFERENCES users(id);
+ALTER TABLE comments ADD COLUMN author_id UUID REFERENCES users(id);
+
+-- Create indexes for finding the blogs and comments of a user
+CREATE INDEX idx_blogs_author_id ON blogs(author_id) WHERE author_id IS NOT NULL;
+CREATE INDEX idx_comments_author_id ON comments(author_id) WHERE author_id IS NOT NULL;
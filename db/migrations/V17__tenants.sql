@@ -0,0 +1,63 @@
+-- Create tenants table holding the independent blogs hosted by a deployment
+CREATE TABLE tenants (
+    id UUID PRIMARY KEY,
+    slug VARCHAR(63) NOT NULL UNIQUE CHECK (slug ~ '^[a-z0-9]+(-[a-z0-9]+)*$'),
+    name VARCHAR(100) NOT NULL CHECK (LENGTH(name) > 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Everything stored before tenants existed belongs to the default tenant
+INSERT INTO tenants (id, slug, name)
+VALUES ('00000000-0000-0000-0000-000000000000', 'default', 'Default');
+
+-- Add the tenant of every row that is looked up directly. Revisions, tags of
+-- blogs and comment verdicts belong to the tenant of their blog or comment.
+-- Filling in the tenants does not change the blogs, so the triggers bumping
+-- their version and update time are disabled meanwhile.
+ALTER TABLE blogs DISABLE TRIGGER increment_blogs_version;
+ALTER TABLE blogs DISABLE TRIGGER update_blogs_updated_at;
+ALTER TABLE users DISABLE TRIGGER update_users_updated_at;
+
+ALTER TABLE blogs ADD COLUMN tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000' REFERENCES tenants(id);
+ALTER TABLE comments ADD COLUMN tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000' REFERENCES tenants(id);
+ALTER TABLE slugs ADD COLUMN tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000' REFERENCES tenants(id);
+ALTER TABLE tags ADD COLUMN tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000' REFERENCES tenants(id);
+ALTER TABLE api_keys ADD COLUMN tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000' REFERENCES tenants(id);
+ALTER TABLE users ADD COLUMN tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000' REFERENCES tenants(id);
+
+ALTER TABLE blogs ENABLE TRIGGER increment_blogs_version;
+ALTER TABLE blogs ENABLE TRIGGER update_blogs_updated_at;
+ALTER TABLE users ENABLE TRIGGER update_users_updated_at;
+
+-- New rows must name their tenant
+ALTER TABLE blogs ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE comments ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE slugs ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE tags ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE api_keys ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE users ALTER COLUMN tenant_id DROP DEFAULT;
+
+-- Slugs and tag names only need to be unique within a tenant
+ALTER TABLE blogs DROP CONSTRAINT blogs_slug_key;
+ALTER TABLE blogs ADD CONSTRAINT blogs_tenant_id_slug_key UNIQUE (tenant_id, slug);
+ALTER TABLE slugs DROP CONSTRAINT slugs_pkey;
+ALTER TABLE slugs ADD PRIMARY KEY (tenant_id, slug);
+ALTER TABLE tags DROP CONSTRAINT tags_name_key;
+ALTER TABLE tags ADD CONSTRAINT tags_tenant_id_name_key UNIQUE (tenant_id, name);
+
+-- Rows may only reference rows of their own tenant
+ALTER TABLE blogs ADD CONSTRAINT blogs_id_tenant_id_key UNIQUE (id, tenant_id);
+ALTER TABLE users ADD CONSTRAINT users_id_tenant_id_key UNIQUE (id, tenant_id);
+ALTER TABLE comments ADD CONSTRAINT comments_blog_id_tenant_id_fkey
+    FOREIGN KEY (blog_id, tenant_id) REFERENCES blogs(id, tenant_id) ON DELETE CASCADE;
+ALTER TABLE slugs ADD CONSTRAINT slugs_blog_id_tenant_id_fkey
+    FOREIGN KEY (blog_id, tenant_id) REFERENCES blogs(id, tenant_id) ON DELETE CASCADE DEFERRABLE INITIALLY DEFERRED;
+ALTER TABLE blogs ADD CONSTRAINT blogs_author_id_tenant_id_fkey
+    FOREIGN KEY (author_id, tenant_id) REFERENCES users(id, tenant_id);
+ALTER TABLE comments ADD CONSTRAINT comments_author_id_tenant_id_fkey
+    FOREIGN KEY (author_id, tenant_id) REFERENCES users(id, tenant_id);
+
+-- Create indexes for listing the blogs, users and API keys of a tenant
+CREATE INDEX idx_blogs_tenant_id ON blogs(tenant_id, id);
+CREATE INDEX idx_users_tenant_id_created_at ON users(tenant_id, created_at, id);
+CREATE INDEX idx_api_keys_tenant_id_created_at ON api_keys(tenant_id, created_at DESC, id DESC);
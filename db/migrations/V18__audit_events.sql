@@ -0,0 +1,37 @@
+-- Create audit_events table recording every change to blogs and comments.
+-- Events outlive the blogs and comments they describe, so resource_id
+-- references neither table.
+CREATE TABLE audit_events (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    actor VARCHAR(255) NOT NULL DEFAULT '',
+    method VARCHAR(255) NOT NULL DEFAULT '',
+    action VARCHAR(20) NOT NULL CHECK (action IN ('create', 'update', 'delete', 'undelete', 'purge', 'publish', 'unpublish', 'restore', 'moderate')),
+    resource VARCHAR(20) NOT NULL CHECK (resource IN ('blog', 'comment')),
+    resource_id UUID NOT NULL,
+    before JSONB,
+    after JSONB,
+    request_id VARCHAR(255) NOT NULL DEFAULT '',
+    client_ip VARCHAR(45) NOT NULL DEFAULT ''
+);
+
+-- Create indexes for listing the events of a tenant, oldest first, and for
+-- filtering them by resource and actor
+CREATE INDEX idx_audit_events_tenant_created_at ON audit_events(tenant_id, created_at, id);
+CREATE INDEX idx_audit_events_resource_id ON audit_events(resource_id, created_at);
+CREATE INDEX idx_audit_events_actor ON audit_events(tenant_id, actor, created_at);
+
+-- Create function to reject changes to audit events
+CREATE OR REPLACE FUNCTION reject_audit_event_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit events are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+-- Create trigger to keep audit events immutable once recorded
+CREATE TRIGGER audit_events_immutable
+BEFORE UPDATE OR DELETE ON audit_events
+FOR EACH ROW
+EXECUTE FUNCTION reject_audit_event_change();
@@ -0,0 +1,4 @@
+-- Record the subject of the principal that created each user, who may write
+-- as that user. Users created before authentication, or anonymously, have no
+-- owner.
+ALTER TABLE users ADD COLUMN owner VARCHAR(255);
@@ -0,0 +1,15 @@
+-- Create post status type
+CREATE TYPE post_status AS ENUM ('draft', 'scheduled', 'published', 'archived');
+
+-- Add status to blogs. Existing blogs were public, so they are published.
+ALTER TABLE blogs
+    ADD COLUMN status post_status NOT NULL DEFAULT 'published',
+    ADD COLUMN published_at TIMESTAMP WITH TIME ZONE;
+
+UPDATE blogs SET published_at = created_at;
+
+-- New blogs must choose a status explicitly
+ALTER TABLE blogs ALTER COLUMN status DROP DEFAULT;
+
+-- Create index for listing blogs by status in ID order
+CREATE INDEX idx_blogs_status_id ON blogs(status, id);
@@ -0,0 +1,11 @@
+-- Add the time a scheduled blog is published at
+ALTER TABLE blogs ADD COLUMN publish_at TIMESTAMP WITH TIME ZONE;
+
+-- Scheduled blogs without a publish time would never be published
+UPDATE blogs SET status = 'draft' WHERE status = 'scheduled';
+
+ALTER TABLE blogs ADD CONSTRAINT blogs_publish_at_check
+    CHECK ((status = 'scheduled') = (publish_at IS NOT NULL));
+
+-- Create index for finding scheduled blogs that are due
+CREATE INDEX idx_blogs_scheduled_publish_at ON blogs(publish_at) WHERE status = 'scheduled';
@@ -0,0 +1,10 @@
+-- Create revisions table holding the previous versions of blogs
+CREATE TABLE revisions (
+    blog_id UUID NOT NULL REFERENCES blogs(id) ON DELETE CASCADE,
+    number INTEGER NOT NULL CHECK (number > 0),
+    title VARCHAR(100) NOT NULL,
+    content TEXT NOT NULL,
+    editor VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (blog_id, number)
+);
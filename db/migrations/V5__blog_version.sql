@@ -0,0 +1,17 @@
+-- Add a version to blogs for optimistic concurrency control
+ALTER TABLE blogs ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+-- Create function to increment the version of a row on every update
+CREATE OR REPLACE FUNCTION increment_version_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.version = OLD.version + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Create trigger to automatically increment version on blogs
+CREATE TRIGGER increment_blogs_version
+BEFORE UPDATE ON blogs
+FOR EACH ROW
+EXECUTE FUNCTION increment_version_column();
@@ -0,0 +1,5 @@
+-- Deleted blogs are moved to the trash and purged after a retention period
+ALTER TABLE blogs ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE;
+
+-- Create index for finding trashed blogs that are due to be purged
+CREATE INDEX idx_blogs_deleted_at ON blogs(deleted_at) WHERE deleted_at IS NOT NULL;
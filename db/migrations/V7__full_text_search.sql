@@ -0,0 +1,14 @@
+-- Index the text of blogs and comments for full-text search. Titles weigh
+-- more than content when ranking matches.
+ALTER TABLE blogs ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', title), 'A') ||
+    setweight(to_tsvector('english', content), 'B')
+) STORED;
+
+ALTER TABLE comments ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    to_tsvector('english', content)
+) STORED;
+
+-- Create indexes for searching blogs and comments
+CREATE INDEX idx_blogs_search_vector ON blogs USING GIN (search_vector);
+CREATE INDEX idx_comments_search_vector ON comments USING GIN (search_vector);
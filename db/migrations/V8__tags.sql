@@ -0,0 +1,15 @@
+-- Tags group blogs by topic
+CREATE TABLE tags (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(50) NOT NULL UNIQUE CHECK (name ~ '^[a-z0-9]+(-[a-z0-9]+)*$')
+);
+
+-- Create the join table between blogs and tags
+CREATE TABLE blog_tags (
+    blog_id UUID NOT NULL REFERENCES blogs(id) ON DELETE CASCADE,
+    tag_id INTEGER NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (blog_id, tag_id)
+);
+
+-- Create index for listing the blogs with a tag
+CREATE INDEX idx_blog_tags_tag_id ON blog_tags(tag_id, blog_id);
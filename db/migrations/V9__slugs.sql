@@ -0,0 +1,44 @@
+-- Slugs address blogs by a human-readable name. A blog keeps every slug it
+-- ever had, so links using a previous slug still lead to it. The blog is
+-- checked at commit, as a slug is claimed before the blog is created.
+CREATE TABLE slugs (
+    slug VARCHAR(100) PRIMARY KEY CHECK (slug ~ '^[a-z0-9]+(-[a-z0-9]+)*$'),
+    blog_id UUID NOT NULL REFERENCES blogs(id) ON DELETE CASCADE DEFERRABLE INITIALLY DEFERRED,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create index for finding the slugs of a blog
+CREATE INDEX idx_slugs_blog_id ON slugs(blog_id);
+
+-- Add the current slug of each blog
+ALTER TABLE blogs ADD COLUMN slug VARCHAR(100);
+
+-- Generate slugs for the existing blogs from their titles. Blogs whose title
+-- gives the same slug as an older blog get a suffix from their ID. Filling
+-- in the slugs does not change the blogs, so the triggers bumping their
+-- version and update time are disabled meanwhile.
+ALTER TABLE blogs DISABLE TRIGGER increment_blogs_version;
+ALTER TABLE blogs DISABLE TRIGGER update_blogs_updated_at;
+
+UPDATE blogs SET slug = generated.slug
+FROM (
+    SELECT id,
+        CASE WHEN ROW_NUMBER() OVER (PARTITION BY base ORDER BY created_at, id) = 1 THEN base
+            ELSE base || '-' || LEFT(id::text, 8)
+        END AS slug
+    FROM (
+        SELECT id, created_at,
+            COALESCE(NULLIF(TRIM(BOTH '-' FROM LEFT(TRIM(BOTH '-' FROM REGEXP_REPLACE(LOWER(title), '[^a-z0-9]+', '-', 'g')), 90)), ''), 'post') AS base
+        FROM blogs
+    ) bases
+) generated
+WHERE blogs.id = generated.id;
+
+ALTER TABLE blogs ENABLE TRIGGER increment_blogs_version;
+ALTER TABLE blogs ENABLE TRIGGER update_blogs_updated_at;
+
+ALTER TABLE blogs ALTER COLUMN slug SET NOT NULL;
+ALTER TABLE blogs ADD CONSTRAINT blogs_slug_key UNIQUE (slug);
+
+INSERT INTO slugs (slug, blog_id, created_at)
+SELECT slug, id, created_at FROM blogs;
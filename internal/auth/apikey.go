@@ -0,0 +1,122 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+)
+
+const (
+	// apiKeyPrefix starts every API key, so that leaked keys are easy to
+	// recognize
+	apiKeyPrefix = "psk_"
+
+	// apiKeyDisplayLen is how many characters of a key are kept to tell keys
+	// apart
+	apiKeyDisplayLen = len(apiKeyPrefix) + 6
+
+	// touchInterval is how often the last use of a key is recorded at most
+	touchInterval = time.Minute
+)
+
+// APIKeySubjectPrefix starts the subject of principals authenticated by an
+// API key, which is followed by the ID of the key
+const APIKeySubjectPrefix = "apikey:"
+
+// APIKeyStore holds the API keys a verifier accepts
+type APIKeyStore interface {
+	// GetAPIKeyByHash retrieves the API key with the given hash
+	GetAPIKeyByHash(ctx context.Context, hash string) (*datastore.APIKey, error)
+
+	// TouchAPIKey records that an API key was used at the given time
+	TouchAPIKey(ctx context.Context, id datastore.ID, at time.Time) error
+}
+
+// GenerateAPIKey creates a random API key. It returns the key, the prefix
+// that is kept to tell it apart from other keys and the hash it is stored by.
+func GenerateAPIKey() (key, prefix, hash string, err error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", "", fmt.Errorf("failed to generate API key: %w", err)
+	}
+	key = apiKeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+	return key, key[:apiKeyDisplayLen], HashAPIKey(key), nil
+}
+
+// HashAPIKey returns the hex-encoded SHA-256 hash API keys are stored by.
+// Keys are random, so a fast hash protects them as well as a slow one.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// APIKeys verifies API keys by looking up their hash in a store. The
+// principal of a key is named after its ID, has its scopes as roles and
+// belongs to the tenant the key was looked up for.
+type APIKeys struct {
+	store APIKeyStore
+	now   func() time.Time
+}
+
+// NewAPIKeys creates an API key verifier for the keys of a store. Only
+// WithClock applies to it.
+func NewAPIKeys(store APIKeyStore, opts ...Option) *APIKeys {
+	cfg := defaultConfig()
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return &APIKeys{
+		store: store,
+		now:   cfg.now,
+	}
+}
+
+// Verify checks that an API key is known, not revoked and not expired, and
+// records its use at most once per minute
+func (v *APIKeys) Verify(ctx context.Context, key string) (*Principal, error) {
+	stored, err := v.store.GetAPIKeyByHash(ctx, HashAPIKey(key))
+	if errors.Is(err, datastore.ErrNotFound) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, errors.New("unknown API key"))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up API key: %w", err)
+	}
+
+	now := v.now()
+	if stored.RevokedAt != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, errors.New("API key is revoked"))
+	}
+	if !stored.Active(now) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, errors.New("API key has expired"))
+	}
+
+	if stored.LastUsedAt == nil || now.Sub(*stored.LastUsedAt) >= touchInterval {
+		if err := v.store.TouchAPIKey(ctx, stored.ID, now); err != nil {
+			return nil, fmt.Errorf("failed to record API key use: %w", err)
+		}
+	}
+
+	return &Principal{
+		Subject: APIKeySubjectPrefix + string(stored.ID),
+		Name:    stored.Name,
+		Roles:   stored.Scopes,
+		Tenant:  tenantSlug(ctx),
+	}, nil
+}
+
+// tenantSlug returns the slug of the tenant carried by ctx, empty for the
+// default tenant
+func tenantSlug(ctx context.Context) string {
+	if tenant, ok := datastore.TenantFromContext(ctx); ok && tenant.ID != datastore.DefaultTenantID {
+		return tenant.Slug
+	}
+	return ""
+}
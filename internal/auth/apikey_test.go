@@ -0,0 +1,118 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/agruetz/prosigliere/internal/auth"
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/datastore/memory"
+)
+
+// failingKeys is an API key store that is unavailable
+type failingKeys struct{}
+
+func (failingKeys) GetAPIKeyByHash(ctx context.Context, hash string) (*datastore.APIKey, error) {
+	return nil, datastore.Unavailable(errors.New("connection refused"))
+}
+
+func (failingKeys) TouchAPIKey(ctx context.Context, id datastore.ID, at time.Time) error {
+	return nil
+}
+
+func TestGenerateAPIKey(t *testing.T) {
+	key, prefix, hash, err := auth.GenerateAPIKey()
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(key, "psk_"))
+	assert.True(t, strings.HasPrefix(key, prefix))
+	assert.Len(t, prefix, 10)
+	assert.Equal(t, auth.HashAPIKey(key), hash)
+	assert.Len(t, hash, 64)
+
+	other, _, _, err := auth.GenerateAPIKey()
+	require.NoError(t, err)
+	assert.NotEqual(t, key, other)
+}
+
+func TestAPIKeys(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	clock := now
+	verifier := auth.NewAPIKeys(store, auth.WithClock(func() time.Time { return clock }))
+
+	newKey := func(expiresAt *time.Time) (string, *datastore.APIKey) {
+		key, prefix, hash, err := auth.GenerateAPIKey()
+		require.NoError(t, err)
+		stored, err := store.CreateAPIKey(ctx, "ingest", prefix, hash, []string{"author"}, expiresAt)
+		require.NoError(t, err)
+		return key, stored
+	}
+
+	// Valid keys identify a principal with their scopes as roles
+	key, stored := newKey(nil)
+	principal, err := verifier.Verify(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, &auth.Principal{Subject: "apikey:" + string(stored.ID), Name: "ingest", Roles: []string{"author"}}, principal)
+
+	// Their use is recorded, but at most once a minute
+	used, err := store.GetAPIKeyByHash(ctx, auth.HashAPIKey(key))
+	require.NoError(t, err)
+	require.NotNil(t, used.LastUsedAt)
+	assert.True(t, now.Equal(*used.LastUsedAt))
+	clock = now.Add(30 * time.Second)
+	_, err = verifier.Verify(ctx, key)
+	require.NoError(t, err)
+	used, err = store.GetAPIKeyByHash(ctx, auth.HashAPIKey(key))
+	require.NoError(t, err)
+	assert.True(t, now.Equal(*used.LastUsedAt))
+	clock = now.Add(time.Minute)
+	_, err = verifier.Verify(ctx, key)
+	require.NoError(t, err)
+	used, err = store.GetAPIKeyByHash(ctx, auth.HashAPIKey(key))
+	require.NoError(t, err)
+	assert.True(t, clock.Equal(*used.LastUsedAt))
+
+	// Unknown, revoked and expired keys are rejected
+	_, err = verifier.Verify(ctx, "psk_unknown")
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	assert.ErrorContains(t, err, "unknown API key")
+
+	require.NoError(t, store.RevokeAPIKey(ctx, stored.ID))
+	_, err = verifier.Verify(ctx, key)
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	assert.ErrorContains(t, err, "API key is revoked")
+
+	expiresAt := clock.Add(time.Hour)
+	key, _ = newKey(&expiresAt)
+	_, err = verifier.Verify(ctx, key)
+	require.NoError(t, err)
+	clock = expiresAt
+	_, err = verifier.Verify(ctx, key)
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	assert.ErrorContains(t, err, "API key has expired")
+
+	// Keys belong to the tenant they were created for
+	tenant, err := store.CreateTenant(ctx, "acme", "Acme")
+	require.NoError(t, err)
+	tenantCtx := datastore.NewTenantContext(ctx, tenant)
+	key, prefix, hash, err := auth.GenerateAPIKey()
+	require.NoError(t, err)
+	_, err = store.CreateAPIKey(tenantCtx, "ingest", prefix, hash, nil, nil)
+	require.NoError(t, err)
+	principal, err = verifier.Verify(tenantCtx, key)
+	require.NoError(t, err)
+	assert.Equal(t, "acme", principal.Tenant)
+	_, err = verifier.Verify(ctx, key)
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+
+	// Failures of the store are not invalid keys
+	_, err = auth.NewAPIKeys(failingKeys{}).Verify(ctx, key)
+	assert.ErrorIs(t, err, datastore.ErrUnavailable)
+	assert.NotErrorIs(t, err, auth.ErrInvalidToken)
+}
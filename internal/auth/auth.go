@@ -0,0 +1,61 @@
+// Package auth authenticates the callers of the services and carries who
+// they are through request contexts.
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+)
+
+// ErrInvalidToken indicates a token that is malformed, expired, not signed
+// by a trusted key or otherwise not acceptable
+var ErrInvalidToken = errors.New("invalid token")
+
+// Principal is an authenticated caller
+type Principal struct {
+	// Subject identifies the caller, such as the sub claim of a token
+	Subject string
+
+	// Name is the display name of the caller, empty if unknown
+	Name string
+
+	// Roles are the roles granted to the caller, which decide what it may
+	// call when authorization is enabled
+	Roles []string
+
+	// Tenant is the slug of the tenant the caller belongs to, empty for the
+	// default tenant. Callers may only call for their own tenant.
+	Tenant string
+}
+
+// TenantSlug returns the slug of the tenant of the principal
+func (p *Principal) TenantSlug() string {
+	if p.Tenant == "" {
+		return datastore.DefaultTenantSlug
+	}
+	return p.Tenant
+}
+
+// Verifier authenticates callers by the credentials they present
+type Verifier interface {
+	// Verify checks a bearer token and returns the caller it identifies.
+	// Tokens that are not acceptable fail with an error wrapping
+	// ErrInvalidToken.
+	Verify(ctx context.Context, token string) (*Principal, error)
+}
+
+// principalKey is the context key of the authenticated principal
+type principalKey struct{}
+
+// NewContext returns a copy of ctx carrying the principal
+func NewContext(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// FromContext returns the principal carried by ctx, if any
+func FromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
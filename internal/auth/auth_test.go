@@ -0,0 +1,29 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/agruetz/prosigliere/internal/auth"
+)
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	_, ok := auth.FromContext(ctx)
+	assert.False(t, ok)
+
+	principal := &auth.Principal{Subject: "alice"}
+	got, ok := auth.FromContext(auth.NewContext(ctx, principal))
+	assert.True(t, ok)
+	assert.Same(t, principal, got)
+
+	_, ok = auth.FromContext(auth.NewContext(ctx, nil))
+	assert.False(t, ok)
+}
+
+func TestTenantSlug(t *testing.T) {
+	assert.Equal(t, "default", (&auth.Principal{Subject: "alice"}).TenantSlug())
+	assert.Equal(t, "acme", (&auth.Principal{Subject: "alice", Tenant: "acme"}).TenantSlug())
+}
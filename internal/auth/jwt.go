@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWT verifies JSON Web Tokens signed with the keys of a KeySet. Tokens must
+// name their subject in the sub claim and be within their validity period.
+// The roles of the principal are read from the roles claim, which may be a
+// single string or a list of them.
+type JWT struct {
+	keys   *KeySet
+	parser *jwt.Parser
+}
+
+// claims are the claims of a token that make up a principal
+type claims struct {
+	jwt.RegisteredClaims
+	Name   string           `json:"name,omitempty"`
+	Roles  jwt.ClaimStrings `json:"roles,omitempty"`
+	Tenant string           `json:"tenant,omitempty"`
+}
+
+// NewJWT creates a JWT verifier trusting the keys of a KeySet
+func NewJWT(keys *KeySet, opts ...Option) *JWT {
+	cfg := defaultConfig()
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	parserOpts := []jwt.ParserOption{
+		jwt.WithValidMethods(keys.methods()),
+		jwt.WithLeeway(cfg.leeway),
+		jwt.WithTimeFunc(cfg.now),
+		jwt.WithExpirationRequired(),
+	}
+	if cfg.issuer != "" {
+		parserOpts = append(parserOpts, jwt.WithIssuer(cfg.issuer))
+	}
+	if cfg.audience != "" {
+		parserOpts = append(parserOpts, jwt.WithAudience(cfg.audience))
+	}
+
+	return &JWT{
+		keys:   keys,
+		parser: jwt.NewParser(parserOpts...),
+	}
+}
+
+// Verify checks the signature and claims of a token and returns its subject
+// as the principal, belonging to the tenant named by the tenant claim
+func (v *JWT) Verify(ctx context.Context, token string) (*Principal, error) {
+	var c claims
+	if _, err := v.parser.ParseWithClaims(token, &c, v.keys.keyFunc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if c.Subject == "" {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, errors.New("token has no subject"))
+	}
+
+	return &Principal{
+		Subject: c.Subject,
+		Name:    c.Name,
+		Roles:   c.Roles,
+		Tenant:  c.Tenant,
+	}, nil
+}
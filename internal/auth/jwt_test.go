@@ -0,0 +1,313 @@
+package auth_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/agruetz/prosigliere/internal/auth"
+)
+
+// now is the time tokens are verified at
+var now = time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+// sign signs a token with the given claims and key ID
+func sign(t *testing.T, method jwt.SigningMethod, key any, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+// validClaims returns the claims of a token valid at now
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":   "alice",
+		"name":  "Alice",
+		"roles": []string{"author", "moderator"},
+		"iss":   "https://issuer.example",
+		"aud":   "blogs",
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	}
+}
+
+// encode base64url encodes an integer for a JWK
+func encode(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+func TestJWTHMAC(t *testing.T) {
+	secret := []byte("test-secret")
+	keys, err := auth.NewHMACKeySet(secret)
+	require.NoError(t, err)
+	verifier := auth.NewJWT(keys,
+		auth.WithIssuer("https://issuer.example"),
+		auth.WithAudience("blogs"),
+		auth.WithClock(func() time.Time { return now }),
+	)
+
+	// Define test cases
+	tests := []struct {
+		name    string
+		token   func() string
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			token: func() string {
+				return sign(t, jwt.SigningMethodHS256, secret, "", validClaims())
+			},
+		},
+		{
+			name: "within leeway",
+			token: func() string {
+				claims := validClaims()
+				claims["exp"] = now.Add(-30 * time.Second).Unix()
+				return sign(t, jwt.SigningMethodHS256, secret, "", claims)
+			},
+		},
+		{
+			name: "expired",
+			token: func() string {
+				claims := validClaims()
+				claims["exp"] = now.Add(-time.Hour).Unix()
+				return sign(t, jwt.SigningMethodHS256, secret, "", claims)
+			},
+			wantErr: true,
+		},
+		{
+			name: "no expiry",
+			token: func() string {
+				claims := validClaims()
+				delete(claims, "exp")
+				return sign(t, jwt.SigningMethodHS256, secret, "", claims)
+			},
+			wantErr: true,
+		},
+		{
+			name: "not yet valid",
+			token: func() string {
+				claims := validClaims()
+				claims["nbf"] = now.Add(time.Hour).Unix()
+				return sign(t, jwt.SigningMethodHS256, secret, "", claims)
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong issuer",
+			token: func() string {
+				claims := validClaims()
+				claims["iss"] = "https://other.example"
+				return sign(t, jwt.SigningMethodHS256, secret, "", claims)
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong audience",
+			token: func() string {
+				claims := validClaims()
+				claims["aud"] = "other"
+				return sign(t, jwt.SigningMethodHS256, secret, "", claims)
+			},
+			wantErr: true,
+		},
+		{
+			name: "no subject",
+			token: func() string {
+				claims := validClaims()
+				delete(claims, "sub")
+				return sign(t, jwt.SigningMethodHS256, secret, "", claims)
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong secret",
+			token: func() string {
+				return sign(t, jwt.SigningMethodHS256, []byte("other-secret"), "", validClaims())
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsigned",
+			token: func() string {
+				return sign(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "", validClaims())
+			},
+			wantErr: true,
+		},
+		{
+			name: "malformed",
+			token: func() string {
+				return "not-a-token"
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := verifier.Verify(context.Background(), tt.token())
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, auth.ErrInvalidToken)
+				assert.Nil(t, principal)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, &auth.Principal{Subject: "alice", Name: "Alice", Roles: []string{"author", "moderator"}}, principal)
+		})
+	}
+
+	_, err = auth.NewHMACKeySet(nil)
+	assert.Error(t, err)
+}
+
+func TestJWTSingleRole(t *testing.T) {
+	secret := []byte("test-secret")
+	keys, err := auth.NewHMACKeySet(secret)
+	require.NoError(t, err)
+	verifier := auth.NewJWT(keys, auth.WithClock(func() time.Time { return now }))
+
+	claims := validClaims()
+	claims["roles"] = "editor"
+	principal, err := verifier.Verify(context.Background(), sign(t, jwt.SigningMethodHS256, secret, "", claims))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"editor"}, principal.Roles)
+}
+
+func TestJWTTenant(t *testing.T) {
+	secret := []byte("test-secret")
+	keys, err := auth.NewHMACKeySet(secret)
+	require.NoError(t, err)
+	verifier := auth.NewJWT(keys, auth.WithClock(func() time.Time { return now }))
+
+	claims := validClaims()
+	claims["tenant"] = "acme"
+	principal, err := verifier.Verify(context.Background(), sign(t, jwt.SigningMethodHS256, secret, "", claims))
+	require.NoError(t, err)
+	assert.Equal(t, "acme", principal.Tenant)
+	assert.Equal(t, "acme", principal.TenantSlug())
+}
+
+func TestJWTJWKS(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	edPublic, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	jwks, err := json.Marshal(map[string]any{
+		"keys": []map[string]string{
+			{"kty": "RSA", "kid": "rsa", "use": "sig", "n": encode(rsaKey.N), "e": encode(big.NewInt(int64(rsaKey.E)))},
+			{"kty": "EC", "kid": "ec", "crv": "P-256", "x": encode(ecKey.X), "y": encode(ecKey.Y)},
+			{"kty": "OKP", "kid": "ed", "crv": "Ed25519", "x": base64.RawURLEncoding.EncodeToString(edPublic)},
+			{"kty": "RSA", "kid": "enc", "use": "enc", "n": encode(otherKey.N), "e": encode(big.NewInt(int64(otherKey.E)))},
+		},
+	})
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, jwks, 0o600))
+
+	keys, err := auth.LoadJWKS(path)
+	require.NoError(t, err)
+	verifier := auth.NewJWT(keys, auth.WithClock(func() time.Time { return now }))
+
+	// Define test cases
+	tests := []struct {
+		name    string
+		method  jwt.SigningMethod
+		key     crypto.Signer
+		kid     string
+		wantErr bool
+	}{
+		{name: "RSA", method: jwt.SigningMethodRS256, key: rsaKey, kid: "rsa"},
+		{name: "RSA-PSS", method: jwt.SigningMethodPS256, key: rsaKey, kid: "rsa"},
+		{name: "ECDSA", method: jwt.SigningMethodES256, key: ecKey, kid: "ec"},
+		{name: "Ed25519", method: jwt.SigningMethodEdDSA, key: edKey, kid: "ed"},
+		{name: "unknown key", method: jwt.SigningMethodRS256, key: rsaKey, kid: "other", wantErr: true},
+		{name: "no key ID", method: jwt.SigningMethodRS256, key: rsaKey, wantErr: true},
+		{name: "wrong key", method: jwt.SigningMethodRS256, key: otherKey, kid: "rsa", wantErr: true},
+		{name: "encryption key", method: jwt.SigningMethodRS256, key: otherKey, kid: "enc", wantErr: true},
+		{name: "algorithm of another key type", method: jwt.SigningMethodES256, key: ecKey, kid: "rsa", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := sign(t, tt.method, tt.key, tt.kid, validClaims())
+			principal, err := verifier.Verify(context.Background(), token)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, auth.ErrInvalidToken)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "alice", principal.Subject)
+		})
+	}
+
+	// A symmetric token must not verify against a public key as a secret
+	hmacToken := sign(t, jwt.SigningMethodHS256, []byte("secret"), "rsa", validClaims())
+	_, err = verifier.Verify(context.Background(), hmacToken)
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+
+	// A set with a single key needs no key ID
+	single, err := json.Marshal(map[string]any{
+		"keys": []map[string]string{
+			{"kty": "EC", "crv": "P-256", "x": encode(ecKey.X), "y": encode(ecKey.Y)},
+		},
+	})
+	require.NoError(t, err)
+	keys, err = auth.ParseJWKS(single)
+	require.NoError(t, err)
+	principal, err := auth.NewJWT(keys, auth.WithClock(func() time.Time { return now })).
+		Verify(context.Background(), sign(t, jwt.SigningMethodES256, ecKey, "", validClaims()))
+	require.NoError(t, err)
+	assert.Equal(t, "alice", principal.Subject)
+}
+
+func TestParseJWKSErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		jwks string
+	}{
+		{name: "not JSON", jwks: "keys"},
+		{name: "no keys", jwks: `{"keys": []}`},
+		{name: "only encryption keys", jwks: `{"keys": [{"kty": "RSA", "use": "enc", "n": "AQAB", "e": "AQAB"}]}`},
+		{name: "unknown key type", jwks: `{"keys": [{"kty": "oct", "k": "c2VjcmV0"}]}`},
+		{name: "unknown curve", jwks: `{"keys": [{"kty": "EC", "crv": "P-192", "x": "AQ", "y": "AQ"}]}`},
+		{name: "point off the curve", jwks: `{"keys": [{"kty": "EC", "crv": "P-256", "x": "AQ", "y": "AQ"}]}`},
+		{name: "bad modulus", jwks: `{"keys": [{"kty": "RSA", "n": "!!", "e": "AQAB"}]}`},
+		{name: "short Ed25519 key", jwks: `{"keys": [{"kty": "OKP", "crv": "Ed25519", "x": "AQ"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := auth.ParseJWKS([]byte(tt.jwks))
+			assert.Error(t, err)
+		})
+	}
+
+	_, err := auth.LoadJWKS(filepath.Join(t.TempDir(), "missing.json"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
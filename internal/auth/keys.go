@@ -0,0 +1,208 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// hmacMethods are the signing algorithms of tokens signed with a secret
+var hmacMethods = []string{"HS256", "HS384", "HS512"}
+
+// publicKeyMethods are the signing algorithms of tokens signed with a key
+// pair
+var publicKeyMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+// KeySet holds the keys tokens may be signed with: either a shared HMAC
+// secret or the public keys of a JSON Web Key Set
+type KeySet struct {
+	secret []byte
+	keys   []publicKey
+}
+
+// publicKey is a verification key of a JSON Web Key Set
+type publicKey struct {
+	id  string
+	key crypto.PublicKey
+}
+
+// NewHMACKeySet creates a KeySet for tokens signed with a shared secret
+func NewHMACKeySet(secret []byte) (*KeySet, error) {
+	if len(secret) == 0 {
+		return nil, errors.New("HMAC secret is empty")
+	}
+	return &KeySet{secret: secret}, nil
+}
+
+// LoadJWKS creates a KeySet from a file holding a JSON Web Key Set
+func LoadJWKS(path string) (*KeySet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS: %w", err)
+	}
+	return ParseJWKS(data)
+}
+
+// jwk is a JSON Web Key as defined by RFC 7517. Only the members of RSA,
+// elliptic curve and Ed25519 public keys are read.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// ParseJWKS creates a KeySet from a JSON Web Key Set. Keys meant for
+// encryption rather than signatures are skipped.
+func ParseJWKS(data []byte) (*KeySet, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS: %w", err)
+	}
+
+	keySet := &KeySet{}
+	for i, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse JWKS key %d: %w", i, err)
+		}
+		keySet.keys = append(keySet.keys, publicKey{id: k.Kid, key: key})
+	}
+	if len(keySet.keys) == 0 {
+		return nil, errors.New("JWKS holds no signing keys")
+	}
+	return keySet, nil
+}
+
+// publicKey decodes the public key of a JSON Web Key
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeInt(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus: %w", err)
+		}
+		e, err := decodeInt(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exponent: %w", err)
+		}
+		if !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeInt(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid x coordinate: %w", err)
+		}
+		y, err := decodeInt(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid y coordinate: %w", err)
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point is not on the curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+// decodeInt decodes a base64url encoded big-endian unsigned integer
+func decodeInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, errors.New("empty value")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// methods returns the signing algorithms tokens may use with the keys
+func (k *KeySet) methods() []string {
+	if k.secret != nil {
+		return hmacMethods
+	}
+	return publicKeyMethods
+}
+
+// keyFunc returns the key to verify a token with. Tokens naming a key ID
+// are verified with that key, and tokens without one with the only key of
+// the set.
+func (k *KeySet) keyFunc(token *jwt.Token) (any, error) {
+	if k.secret != nil {
+		return k.secret, nil
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	for _, key := range k.keys {
+		if key.id != kid && (kid != "" || len(k.keys) > 1) {
+			continue
+		}
+		if !matchesKey(token.Method, key.key) {
+			return nil, fmt.Errorf("key %q does not fit algorithm %s", key.id, token.Method.Alg())
+		}
+		return key.key, nil
+	}
+	return nil, fmt.Errorf("unknown key %q", kid)
+}
+
+// matchesKey reports whether a signing method verifies with a key of the
+// given type
+func matchesKey(method jwt.SigningMethod, key crypto.PublicKey) bool {
+	switch key.(type) {
+	case *rsa.PublicKey:
+		switch method.(type) {
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+			return true
+		}
+	case *ecdsa.PublicKey:
+		_, ok := method.(*jwt.SigningMethodECDSA)
+		return ok
+	case ed25519.PublicKey:
+		_, ok := method.(*jwt.SigningMethodEd25519)
+		return ok
+	}
+	return false
+}
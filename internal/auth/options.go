@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"time"
+)
+
+// config holds the configuration for a verifier
+type config struct {
+	issuer   string
+	audience string
+	leeway   time.Duration
+	now      func() time.Time
+}
+
+// defaultConfig returns the default configuration for a verifier
+func defaultConfig() *config {
+	return &config{
+		leeway: time.Minute,
+		now:    time.Now,
+	}
+}
+
+// Option is a function that modifies config
+type Option func(*config)
+
+// WithIssuer sets the issuer tokens must name in their iss claim. Any issuer
+// is accepted by default.
+func WithIssuer(issuer string) Option {
+	return func(c *config) {
+		c.issuer = issuer
+	}
+}
+
+// WithAudience sets the audience tokens must name in their aud claim. Any
+// audience is accepted by default.
+func WithAudience(audience string) Option {
+	return func(c *config) {
+		c.audience = audience
+	}
+}
+
+// WithLeeway sets how much clock skew is tolerated when checking the times a
+// token is valid between
+func WithLeeway(leeway time.Duration) Option {
+	return func(c *config) {
+		c.leeway = leeway
+	}
+}
+
+// WithClock sets the function returning the current time, for tests
+func WithClock(now func() time.Time) Option {
+	return func(c *config) {
+		c.now = now
+	}
+}
@@ -0,0 +1,242 @@
+// Package authz decides which principals may call which methods of the
+// services, by their roles and by whether they own what the call touches.
+package authz
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/agruetz/prosigliere/internal/auth"
+)
+
+// Reason is a machine-readable cause of a denial
+type Reason string
+
+// Reasons of denials
+const (
+	// ReasonNoRule means no rule of the policy covers the method
+	ReasonNoRule Reason = "NO_RULE"
+
+	// ReasonUnauthenticated means the method needs a principal but the call
+	// has none
+	ReasonUnauthenticated Reason = "UNAUTHENTICATED"
+
+	// ReasonMissingRole means the principal has none of the roles the
+	// method needs
+	ReasonMissingRole Reason = "MISSING_ROLE"
+
+	// ReasonNotOwner means the principal has a role that may call the
+	// method, but only on what it owns
+	ReasonNotOwner Reason = "NOT_OWNER"
+)
+
+// Denial is the error of a call the policy does not allow
+type Denial struct {
+	Reason  Reason
+	Method  string
+	Message string
+}
+
+// Error implements error
+func (d *Denial) Error() string {
+	return d.Message
+}
+
+// Rule allows a set of methods to principals with any of a set of roles
+type Rule struct {
+	// Methods are full gRPC method names such as /blog.v1.Blogs/Create.
+	// A name ending in /* covers every method of a service, and * covers
+	// every method.
+	Methods []string `yaml:"methods"`
+
+	// Roles may call the methods. A rule without roles allows every
+	// authenticated principal.
+	Roles []string `yaml:"roles"`
+
+	// Owner restricts the rule to calls on what the principal owns
+	Owner bool `yaml:"owner"`
+
+	// Anyone allows everybody to call the methods, including anonymous
+	// callers
+	Anyone bool `yaml:"anyone"`
+}
+
+// Owners looks up the owner of what requests touch
+type Owners interface {
+	// Owner returns the subject of the owner of what a request touches, or
+	// an empty string if it has none
+	Owner(ctx context.Context, req any) (string, error)
+}
+
+// OwnerFunc returns the subject of the owner of what a call touches, or an
+// empty string if it has none
+type OwnerFunc func(ctx context.Context) (string, error)
+
+// Policy is a set of rules. Calls are allowed if any rule covering their
+// method allows them, and denied if none does.
+type Policy struct {
+	rules []Rule
+}
+
+// New creates a policy from rules
+func New(rules ...Rule) (*Policy, error) {
+	for i, rule := range rules {
+		if err := validateRule(rule); err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i+1, err)
+		}
+	}
+	return &Policy{rules: rules}, nil
+}
+
+// Load creates a policy from a YAML or JSON file
+func Load(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse creates a policy from a YAML or JSON document with a list of rules
+// under rules. Unknown fields are rejected so that misspelled rules do not
+// go unnoticed.
+func Parse(data []byte) (*Policy, error) {
+	var doc struct {
+		Rules []Rule `yaml:"rules"`
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&doc); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse policy: %w", err)
+	}
+	return New(doc.Rules...)
+}
+
+// validateRule checks that a rule can match something
+func validateRule(rule Rule) error {
+	if len(rule.Methods) == 0 {
+		return errors.New("no methods")
+	}
+	for _, method := range rule.Methods {
+		if method != "*" && (!strings.HasPrefix(method, "/") || strings.Count(method, "/") != 2) {
+			return fmt.Errorf("method %q is not a full gRPC method name", method)
+		}
+	}
+	if rule.Anyone && (len(rule.Roles) > 0 || rule.Owner) {
+		return errors.New("anyone cannot be combined with roles or owner")
+	}
+	for _, role := range rule.Roles {
+		if role == "" {
+			return errors.New("empty role")
+		}
+	}
+	return nil
+}
+
+// covers reports whether a rule applies to a full method name
+func (r Rule) covers(fullMethod string) bool {
+	for _, method := range r.Methods {
+		switch {
+		case method == "*", method == fullMethod:
+			return true
+		case strings.HasSuffix(method, "/*"):
+			if strings.HasPrefix(fullMethod, strings.TrimSuffix(method, "*")) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasRole reports whether the principal has a role of the rule
+func (r Rule) hasRole(principal *auth.Principal) bool {
+	if len(r.Roles) == 0 {
+		return true
+	}
+	for _, role := range principal.Roles {
+		if slices.Contains(r.Roles, role) {
+			return true
+		}
+	}
+	return false
+}
+
+// Authorize decides whether a principal, nil for anonymous callers, may call
+// a method. The owner of what the call touches is only looked up when a rule
+// depends on it. Calls that are not allowed fail with a *Denial; failures to
+// look up the owner are returned as they are.
+func (p *Policy) Authorize(ctx context.Context, fullMethod string, principal *auth.Principal, owner OwnerFunc) error {
+	var covered []Rule
+	for _, rule := range p.rules {
+		if !rule.covers(fullMethod) {
+			continue
+		}
+		if rule.Anyone {
+			return nil
+		}
+		covered = append(covered, rule)
+	}
+	if len(covered) == 0 {
+		return &Denial{
+			Reason:  ReasonNoRule,
+			Method:  fullMethod,
+			Message: fmt.Sprintf("no rule allows calling %s", fullMethod),
+		}
+	}
+	if principal == nil {
+		return &Denial{
+			Reason:  ReasonUnauthenticated,
+			Method:  fullMethod,
+			Message: fmt.Sprintf("%s requires authentication", fullMethod),
+		}
+	}
+
+	ownerOnly := false
+	for _, rule := range covered {
+		if !rule.hasRole(principal) {
+			continue
+		}
+		if !rule.Owner {
+			return nil
+		}
+		ownerOnly = true
+	}
+	if !ownerOnly {
+		return &Denial{
+			Reason:  ReasonMissingRole,
+			Method:  fullMethod,
+			Message: fmt.Sprintf("%s requires one of the roles %s", fullMethod, strings.Join(roles(covered), ", ")),
+		}
+	}
+
+	subject, err := owner(ctx)
+	if err != nil {
+		return err
+	}
+	if subject != "" && subject == principal.Subject {
+		return nil
+	}
+	return &Denial{
+		Reason:  ReasonNotOwner,
+		Method:  fullMethod,
+		Message: fmt.Sprintf("%s is only allowed on resources owned by the caller", fullMethod),
+	}
+}
+
+// roles returns the sorted, distinct roles of rules
+func roles(rules []Rule) []string {
+	var all []string
+	for _, rule := range rules {
+		all = append(all, rule.Roles...)
+	}
+	slices.Sort(all)
+	return slices.Compact(all)
+}
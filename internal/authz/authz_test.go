@@ -0,0 +1,257 @@
+package authz_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/agruetz/prosigliere/internal/auth"
+	"github.com/agruetz/prosigliere/internal/authz"
+)
+
+const testPolicy = `
+rules:
+  - methods: ["/blog.v1.Blogs/Get", "/blog.v1.Blogs/List"]
+    anyone: true
+  - methods: ["*"]
+    roles: [admin]
+  - methods: ["/blog.v1.Blogs/Create"]
+    roles: [editor, author]
+  - methods: ["/blog.v1.Blogs/Update", "/blog.v1.Blogs/Delete"]
+    roles: [editor]
+  - methods: ["/blog.v1.Blogs/Update", "/blog.v1.Blogs/Delete"]
+    roles: [author]
+    owner: true
+  - methods: ["/blog.v1.Blogs/AddComment"]
+  - methods: ["/blog.v1.Moderation/*"]
+    roles: [moderator]
+`
+
+// ownedBy returns an OwnerFunc reporting owner, counting its calls
+func ownedBy(owner string, calls *int) authz.OwnerFunc {
+	return func(ctx context.Context) (string, error) {
+		*calls++
+		return owner, nil
+	}
+}
+
+func TestPolicyAuthorize(t *testing.T) {
+	policy, err := authz.Parse([]byte(testPolicy))
+	require.NoError(t, err)
+
+	alice := &auth.Principal{Subject: "alice", Roles: []string{"author"}}
+
+	// Define test cases
+	tests := []struct {
+		name        string
+		method      string
+		principal   *auth.Principal
+		owner       string
+		reason      authz.Reason
+		ownerLookup bool
+	}{
+		{
+			name:   "anyone anonymously",
+			method: "/blog.v1.Blogs/Get",
+		},
+		{
+			name:      "anyone with a principal",
+			method:    "/blog.v1.Blogs/List",
+			principal: alice,
+		},
+		{
+			name:      "admin on any method",
+			method:    "/blog.v1.Admin/PurgeBlog",
+			principal: &auth.Principal{Subject: "root", Roles: []string{"admin"}},
+		},
+		{
+			name:      "role",
+			method:    "/blog.v1.Blogs/Create",
+			principal: alice,
+		},
+		{
+			name:      "editor on any blog",
+			method:    "/blog.v1.Blogs/Update",
+			principal: &auth.Principal{Subject: "erin", Roles: []string{"editor"}},
+			owner:     "alice",
+		},
+		{
+			name:        "author on own blog",
+			method:      "/blog.v1.Blogs/Delete",
+			principal:   alice,
+			owner:       "alice",
+			ownerLookup: true,
+		},
+		{
+			name:        "author on other blog",
+			method:      "/blog.v1.Blogs/Delete",
+			principal:   alice,
+			owner:       "bob",
+			reason:      authz.ReasonNotOwner,
+			ownerLookup: true,
+		},
+		{
+			name:        "author on blog without owner",
+			method:      "/blog.v1.Blogs/Update",
+			principal:   &auth.Principal{Subject: "", Roles: []string{"author"}},
+			reason:      authz.ReasonNotOwner,
+			ownerLookup: true,
+		},
+		{
+			name:      "any authenticated principal",
+			method:    "/blog.v1.Blogs/AddComment",
+			principal: &auth.Principal{Subject: "carol"},
+		},
+		{
+			name:      "service wildcard",
+			method:    "/blog.v1.Moderation/Approve",
+			principal: &auth.Principal{Subject: "mo", Roles: []string{"moderator"}},
+		},
+		{
+			name:      "missing role",
+			method:    "/blog.v1.Moderation/Approve",
+			principal: alice,
+			reason:    authz.ReasonMissingRole,
+		},
+		{
+			name:   "anonymous",
+			method: "/blog.v1.Blogs/Create",
+			reason: authz.ReasonUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := policy.Authorize(context.Background(), tt.method, tt.principal, ownedBy(tt.owner, &calls))
+
+			assert.Equal(t, tt.ownerLookup, calls > 0, "owner lookups")
+			if tt.reason == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var denial *authz.Denial
+			require.ErrorAs(t, err, &denial)
+			assert.Equal(t, tt.reason, denial.Reason)
+			assert.Equal(t, tt.method, denial.Method)
+		})
+	}
+}
+
+func TestPolicyAuthorizeMessages(t *testing.T) {
+	policy, err := authz.Parse([]byte(testPolicy))
+	require.NoError(t, err)
+	principal := &auth.Principal{Subject: "carol", Roles: []string{"commenter"}}
+
+	err = policy.Authorize(context.Background(), "/blog.v1.Blogs/Update", principal, nil)
+	assert.EqualError(t, err, "/blog.v1.Blogs/Update requires one of the roles admin, author, editor")
+
+	// Methods no rule covers are denied to everybody
+	policy, err = authz.New(authz.Rule{Methods: []string{"/blog.v1.Blogs/*"}, Roles: []string{"admin"}})
+	require.NoError(t, err)
+	err = policy.Authorize(context.Background(), "/blog.v1.Other/Create", &auth.Principal{Subject: "root", Roles: []string{"admin"}}, nil)
+	var denial *authz.Denial
+	require.ErrorAs(t, err, &denial)
+	assert.Equal(t, authz.ReasonNoRule, denial.Reason)
+	assert.EqualError(t, err, "no rule allows calling /blog.v1.Other/Create")
+}
+
+func TestPolicyAuthorizeOwnerError(t *testing.T) {
+	policy, err := authz.New(authz.Rule{Methods: []string{"/blog.v1.Blogs/Update"}, Roles: []string{"author"}, Owner: true})
+	require.NoError(t, err)
+	lookupErr := errors.New("database unavailable")
+
+	err = policy.Authorize(context.Background(), "/blog.v1.Blogs/Update", &auth.Principal{Subject: "alice", Roles: []string{"author"}},
+		func(ctx context.Context) (string, error) { return "", lookupErr })
+	assert.ErrorIs(t, err, lookupErr)
+}
+
+func TestParse(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name     string
+		policy   string
+		errorMsg string
+	}{
+		{
+			name:   "JSON",
+			policy: `{"rules": [{"methods": ["/blog.v1.Blogs/Get"], "anyone": true}]}`,
+		},
+		{
+			name:   "empty",
+			policy: "",
+		},
+		{
+			name:     "unknown field",
+			policy:   "rules:\n  - methods: [\"*\"]\n    role: [admin]\n",
+			errorMsg: "failed to parse policy",
+		},
+		{
+			name:     "no methods",
+			policy:   "rules:\n  - roles: [admin]\n",
+			errorMsg: "rule 1: no methods",
+		},
+		{
+			name:     "short method name",
+			policy:   "rules:\n  - methods: [Create]\n",
+			errorMsg: `rule 1: method "Create" is not a full gRPC method name`,
+		},
+		{
+			name:     "anyone with roles",
+			policy:   "rules:\n  - methods: [\"*\"]\n    anyone: true\n    roles: [admin]\n",
+			errorMsg: "rule 1: anyone cannot be combined with roles or owner",
+		},
+		{
+			name:     "empty role",
+			policy:   "rules:\n  - methods: [\"*\"]\n    roles: [\"\"]\n",
+			errorMsg: "rule 1: empty role",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := authz.Parse([]byte(tt.policy))
+			if tt.errorMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, policy)
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testPolicy), 0o600))
+
+	policy, err := authz.Load(path)
+	require.NoError(t, err)
+	assert.NoError(t, policy.Authorize(context.Background(), "/blog.v1.Blogs/Get", nil, nil))
+
+	_, err = authz.Load(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.ErrorContains(t, err, "failed to read policy")
+}
+
+func TestLoadExample(t *testing.T) {
+	policy, err := authz.Load("../../cmd/server/policy.example.yaml")
+	require.NoError(t, err)
+
+	author := &auth.Principal{Subject: "alice", Roles: []string{"author"}}
+	owner := func(ctx context.Context) (string, error) { return "bob", nil }
+	assert.NoError(t, policy.Authorize(context.Background(), "/blog.v1.Blogs/Get", nil, owner))
+	assert.NoError(t, policy.Authorize(context.Background(), "/blog.v1.Blogs/Create", author, owner))
+	assert.Error(t, policy.Authorize(context.Background(), "/blog.v1.Blogs/Update", author, owner))
+	assert.Error(t, policy.Authorize(context.Background(), "/blog.v1.Blogs/ModerateComment", author, owner))
+	assert.Error(t, policy.Authorize(context.Background(), "/blog.v1.Blogs/ListRevisions", nil, owner))
+	assert.Error(t, policy.Authorize(context.Background(), "/blog.v1.Blogs/GetRevision", author, owner))
+	assert.NoError(t, policy.Authorize(context.Background(), "/blog.v1.Blogs/DiffRevisions", &auth.Principal{Subject: "bob", Roles: []string{"author"}}, owner))
+	assert.NoError(t, policy.Authorize(context.Background(), "/blog.v1.Blogs/ListRevisions", &auth.Principal{Subject: "erin", Roles: []string{"editor"}}, owner))
+	assert.NoError(t, policy.Authorize(context.Background(), "/blog.v1.Admin/PurgeBlog", &auth.Principal{Subject: "root", Roles: []string{"admin"}}, owner))
+}
@@ -0,0 +1,188 @@
+package datastore
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// AuditAction is the kind of change an audit event records
+type AuditAction string
+
+const (
+	AuditCreate    AuditAction = "create"
+	AuditUpdate    AuditAction = "update"
+	AuditDelete    AuditAction = "delete" // moving a blog to the trash, or deleting a comment
+	AuditUndelete  AuditAction = "undelete"
+	AuditPurge     AuditAction = "purge"
+	AuditPublish   AuditAction = "publish"
+	AuditUnpublish AuditAction = "unpublish"
+	AuditRestore   AuditAction = "restore" // restoring a revision of a blog
+	AuditModerate  AuditAction = "moderate"
+)
+
+// AuditEvent records a change to a blog or comment. Stores append it in the
+// same transaction as the change and never change or remove it afterwards.
+type AuditEvent struct {
+	ID         ID              `db:"id"`
+	CreatedAt  time.Time       `db:"created_at"`
+	Actor      string          `db:"actor"`  // subject of the principal that made the change, empty if unknown
+	Method     string          `db:"method"` // full name of the RPC that made the change, empty if unknown
+	Action     AuditAction     `db:"action"`
+	Resource   string          `db:"resource"` // ResourceBlog or ResourceComment
+	ResourceID ID              `db:"resource_id"`
+	Before     json.RawMessage `db:"before"` // snapshot of the resource before the change, nil for creations
+	After      json.RawMessage `db:"after"`  // snapshot of the resource after the change, nil for removals
+	RequestID  string          `db:"request_id"`
+	ClientIP   string          `db:"client_ip"`
+}
+
+// AuditInfo describes the request making a change, which stores record in
+// its audit event
+type AuditInfo struct {
+	Actor     string
+	Method    string
+	RequestID string
+	ClientIP  string
+}
+
+// auditKey is the context key of the audit information of a call
+type auditKey struct{}
+
+// NewAuditContext returns a copy of ctx carrying the audit information of
+// the request it is made for
+func NewAuditContext(ctx context.Context, info AuditInfo) context.Context {
+	return context.WithValue(ctx, auditKey{}, info)
+}
+
+// AuditFromContext returns the audit information carried by ctx, or the
+// zero value if it carries none
+func AuditFromContext(ctx context.Context) AuditInfo {
+	info, _ := ctx.Value(auditKey{}).(AuditInfo)
+	return info
+}
+
+// AuditFilter narrows ListAuditEvents. Empty fields match every event.
+type AuditFilter struct {
+	Resource   string
+	ResourceID ID
+	Actor      string
+	Since      *time.Time // inclusive
+	Until      *time.Time // exclusive
+}
+
+// Match reports whether an event passes the filter
+func (f AuditFilter) Match(event *AuditEvent) bool {
+	return (f.Resource == "" || event.Resource == f.Resource) &&
+		(f.ResourceID == "" || event.ResourceID == f.ResourceID) &&
+		(f.Actor == "" || event.Actor == f.Actor) &&
+		(f.Since == nil || !event.CreatedAt.Before(*f.Since)) &&
+		(f.Until == nil || event.CreatedAt.Before(*f.Until))
+}
+
+// AuditPageToken returns the page token for the audit events after the
+// given one, which are ordered by creation time and ID
+func AuditPageToken(event *AuditEvent) string {
+	return createdPageToken(event.CreatedAt, event.ID)
+}
+
+// ParseAuditPageToken returns the creation time and ID of the audit event a
+// page token was created for
+func ParseAuditPageToken(token string) (time.Time, ID, error) {
+	return parseCreatedPageToken(ResourceAuditEvent, token)
+}
+
+// blogSnapshot is the audited state of a blog. Comments are audited on
+// their own.
+type blogSnapshot struct {
+	ID            ID            `json:"id"`
+	Title         string        `json:"title"`
+	Content       string        `json:"content"`
+	Status        Status        `json:"status"`
+	PublishedAt   *time.Time    `json:"published_at,omitempty"`
+	PublishAt     *time.Time    `json:"publish_at,omitempty"`
+	DeletedAt     *time.Time    `json:"deleted_at,omitempty"`
+	Tags          []string      `json:"tags"`
+	Slug          string        `json:"slug"`
+	CommentPolicy CommentPolicy `json:"comment_policy,omitempty"`
+	Owner         string        `json:"owner,omitempty"`
+	AuthorID      *ID           `json:"author_id,omitempty"`
+	Version       int64         `json:"version"`
+	CreatedAt     time.Time     `json:"created_at"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+}
+
+// BlogSnapshot returns the snapshot of a blog recorded by audit events
+func BlogSnapshot(blog *Blog) json.RawMessage {
+	tags := blog.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	return snapshot(blogSnapshot{
+		ID:            blog.ID,
+		Title:         blog.Title,
+		Content:       blog.Content,
+		Status:        blog.Status,
+		PublishedAt:   utcTime(blog.PublishedAt),
+		PublishAt:     utcTime(blog.PublishAt),
+		DeletedAt:     utcTime(blog.DeletedAt),
+		Tags:          tags,
+		Slug:          blog.Slug,
+		CommentPolicy: blog.CommentPolicy,
+		Owner:         blog.Owner,
+		AuthorID:      blog.AuthorID,
+		Version:       blog.Version,
+		CreatedAt:     blog.CreatedAt.UTC(),
+		UpdatedAt:     blog.UpdatedAt.UTC(),
+	})
+}
+
+// commentSnapshot is the audited state of a comment
+type commentSnapshot struct {
+	ID               ID           `json:"id"`
+	BlogID           ID           `json:"blog_id"`
+	ParentID         *ID          `json:"parent_id,omitempty"`
+	Content          string       `json:"content"`
+	Author           string       `json:"author"`
+	AuthorID         *ID          `json:"author_id,omitempty"`
+	State            CommentState `json:"state"`
+	ModerationReason string       `json:"moderation_reason,omitempty"`
+	ModeratedAt      *time.Time   `json:"moderated_at,omitempty"`
+	CreatedAt        time.Time    `json:"created_at"`
+	UpdatedAt        *time.Time   `json:"updated_at,omitempty"`
+}
+
+// CommentSnapshot returns the snapshot of a comment recorded by audit events
+func CommentSnapshot(comment *Comment) json.RawMessage {
+	return snapshot(commentSnapshot{
+		ID:               comment.ID,
+		BlogID:           comment.BlogID,
+		ParentID:         comment.ParentID,
+		Content:          comment.Content,
+		Author:           comment.Author,
+		AuthorID:         comment.AuthorID,
+		State:            comment.State,
+		ModerationReason: comment.ModerationReason,
+		ModeratedAt:      utcTime(comment.ModeratedAt),
+		CreatedAt:        comment.CreatedAt.UTC(),
+		UpdatedAt:        utcTime(comment.UpdatedAt),
+	})
+}
+
+// snapshot encodes a snapshot, which only holds types that always encode
+func snapshot(v any) json.RawMessage {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+// utcTime returns a copy of an optional time in UTC
+func utcTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
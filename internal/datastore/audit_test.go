@@ -0,0 +1,81 @@
+package datastore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+)
+
+func TestAuditFromContext(t *testing.T) {
+	assert.Equal(t, datastore.AuditInfo{}, datastore.AuditFromContext(context.Background()))
+
+	info := datastore.AuditInfo{Actor: "alice", Method: "/blog.v1.BlogService/CreateBlog", RequestID: "req-1", ClientIP: "203.0.113.7"}
+	assert.Equal(t, info, datastore.AuditFromContext(datastore.NewAuditContext(context.Background(), info)))
+}
+
+func TestAuditFilterMatch(t *testing.T) {
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	before, after := createdAt.Add(-time.Second), createdAt.Add(time.Second)
+	event := &datastore.AuditEvent{
+		CreatedAt:  createdAt,
+		Actor:      "alice",
+		Resource:   datastore.ResourceBlog,
+		ResourceID: "blog-1",
+	}
+
+	tests := []struct {
+		name   string
+		filter datastore.AuditFilter
+		want   bool
+	}{
+		{"empty", datastore.AuditFilter{}, true},
+		{"resource", datastore.AuditFilter{Resource: datastore.ResourceBlog}, true},
+		{"other resource", datastore.AuditFilter{Resource: datastore.ResourceComment}, false},
+		{"resource ID", datastore.AuditFilter{ResourceID: "blog-1"}, true},
+		{"other resource ID", datastore.AuditFilter{ResourceID: "blog-2"}, false},
+		{"actor", datastore.AuditFilter{Actor: "alice"}, true},
+		{"other actor", datastore.AuditFilter{Actor: "bob"}, false},
+		{"since is inclusive", datastore.AuditFilter{Since: &createdAt}, true},
+		{"since later", datastore.AuditFilter{Since: &after}, false},
+		{"until is exclusive", datastore.AuditFilter{Until: &createdAt}, false},
+		{"until later", datastore.AuditFilter{Since: &before, Until: &after}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.filter.Match(event))
+		})
+	}
+}
+
+func TestBlogSnapshot(t *testing.T) {
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+	blog := &datastore.Blog{
+		ID:        "blog-1",
+		Title:     "Title",
+		Content:   "Content",
+		Status:    datastore.StatusDraft,
+		Slug:      "title",
+		Version:   2,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+		Comments:  []datastore.Comment{{ID: "comment-1"}},
+	}
+
+	// Comments are left out, and times are recorded in UTC
+	assert.JSONEq(t, `{
+		"id": "blog-1",
+		"title": "Title",
+		"content": "Content",
+		"status": "draft",
+		"tags": [],
+		"slug": "title",
+		"version": 2,
+		"created_at": "2025-05-01T10:00:00Z",
+		"updated_at": "2025-05-01T10:00:00Z"
+	}`, string(datastore.BlogSnapshot(blog)))
+}
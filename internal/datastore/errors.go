@@ -0,0 +1,104 @@
+// Package datastore provides interfaces and implementations for data persistence
+package datastore
+
+import (
+	"errors"
+)
+
+// Sentinel errors identifying the kind of a datastore failure. Every Store
+// implementation returns errors that match one of these via errors.Is, so
+// callers can react to the failure without inspecting backend specifics.
+var (
+	// ErrNotFound indicates the requested resource does not exist
+	ErrNotFound = errors.New("not found")
+
+	// ErrConflict indicates the operation conflicts with existing data
+	ErrConflict = errors.New("conflict")
+
+	// ErrInvalid indicates the provided data was rejected by the datastore
+	ErrInvalid = errors.New("invalid")
+
+	// ErrUnavailable indicates the datastore could not be reached
+	ErrUnavailable = errors.New("unavailable")
+
+	// ErrVersionMismatch indicates the resource changed since the caller
+	// read the version it expected
+	ErrVersionMismatch = errors.New("version mismatch")
+)
+
+// Resource names used in datastore errors
+const (
+	ResourceBlog       = "blog"
+	ResourceComment    = "comment"
+	ResourceRevision   = "revision"
+	ResourceAPIKey     = "api key"
+	ResourceUser       = "user"
+	ResourceTenant     = "tenant"
+	ResourceAuditEvent = "audit event"
+)
+
+// Error describes a failed datastore operation
+type Error struct {
+	// Kind is one of the sentinel errors declared in this package
+	Kind error
+
+	// Resource is the type of resource the operation acted on
+	Resource string
+
+	// ID identifies the resource, if known
+	ID ID
+
+	// Field names the offending field for ErrInvalid errors, if known
+	Field string
+
+	// Err is the underlying cause, if any
+	Err error
+}
+
+// Error returns a human readable description of the failure
+func (e *Error) Error() string {
+	msg := e.Kind.Error()
+	if e.Resource != "" {
+		msg = e.Resource + " " + msg
+	}
+	if e.Field != "" {
+		msg += " (" + e.Field + ")"
+	}
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+// Unwrap allows errors.Is and errors.As to match both the kind and the cause
+func (e *Error) Unwrap() []error {
+	if e.Err == nil {
+		return []error{e.Kind}
+	}
+	return []error{e.Kind, e.Err}
+}
+
+// NotFound returns an ErrNotFound error for the given resource
+func NotFound(resource string, id ID) error {
+	return &Error{Kind: ErrNotFound, Resource: resource, ID: id}
+}
+
+// Conflict returns an ErrConflict error for the given resource
+func Conflict(resource string, id ID, cause error) error {
+	return &Error{Kind: ErrConflict, Resource: resource, ID: id, Err: cause}
+}
+
+// Invalid returns an ErrInvalid error for a field of the given resource
+func Invalid(resource, field string, cause error) error {
+	return &Error{Kind: ErrInvalid, Resource: resource, Field: field, Err: cause}
+}
+
+// VersionMismatch returns an ErrVersionMismatch error for the given resource
+func VersionMismatch(resource string, id ID) error {
+	return &Error{Kind: ErrVersionMismatch, Resource: resource, ID: id}
+}
+
+// Unavailable returns an ErrUnavailable error wrapping the given cause
+func Unavailable(cause error) error {
+	return &Error{Kind: ErrUnavailable, Err: cause}
+}
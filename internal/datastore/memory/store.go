@@ -0,0 +1,1731 @@
+// Package memory provides an in-memory implementation of the datastore.Store interface
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/search"
+)
+
+// space holds the data of a single tenant. Its methods implement the
+// datastore.Store methods for that tenant, ignoring the tenant carried by
+// the context.
+type space struct {
+	mu        sync.RWMutex
+	blogs     map[datastore.ID]*datastore.Blog
+	revisions map[datastore.ID][]datastore.Revision       // by blog, oldest first
+	slugs     map[string]datastore.ID                     // current and previous slugs of every blog
+	verdicts  map[datastore.ID][]datastore.CommentVerdict // by comment, in the order they were given
+	apiKeys   map[datastore.ID]*datastore.APIKey
+	keyHashes map[string]datastore.ID // API keys by the hash of the key
+	users     map[datastore.ID]*datastore.User
+	audit     []*datastore.AuditEvent // in the order they were recorded
+}
+
+// newSpace creates the empty data of a tenant
+func newSpace() *space {
+	return &space{
+		blogs:     make(map[datastore.ID]*datastore.Blog),
+		revisions: make(map[datastore.ID][]datastore.Revision),
+		slugs:     make(map[string]datastore.ID),
+		verdicts:  make(map[datastore.ID][]datastore.CommentVerdict),
+		apiKeys:   make(map[datastore.ID]*datastore.APIKey),
+		keyHashes: make(map[string]datastore.ID),
+		users:     make(map[datastore.ID]*datastore.User),
+	}
+}
+
+// Create creates a new blog entry with the given status and tags, and a slug
+// generated from the title
+func (s *space) Create(ctx context.Context, title, content string, status datastore.Status, publishAt *time.Time, tags []string, opts ...datastore.CreateOption) (datastore.ID, error) {
+	options := datastore.NewCreateOptions(opts...)
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	if err := validateStatus(status); err != nil {
+		return "", err
+	}
+	if (status == datastore.StatusScheduled) != (publishAt != nil) {
+		return "", datastore.Invalid(datastore.ResourceBlog, "publish_at", errPublishAt)
+	}
+	tags, err := datastore.NormalizeTags(tags)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	id := datastore.ID(uuid.New().String())
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.validateAuthor(datastore.ResourceBlog, options.AuthorID); err != nil {
+		return "", err
+	}
+	slug := datastore.UniqueSlug(datastore.Slugify(title), func(slug string) bool {
+		_, taken := s.slugs[slug]
+		return taken
+	})
+	blog := &datastore.Blog{
+		ID:        id,
+		Title:     title,
+		Content:   content,
+		CreatedAt: now,
+		UpdatedAt: now,
+		Version:   1,
+		Tags:      tags,
+		Slug:      slug,
+		Owner:     options.Owner,
+		AuthorID:  copyID(options.AuthorID),
+		Comments:  []datastore.Comment{},
+	}
+	setStatus(blog, status, now)
+	blog.PublishAt = copyTime(publishAt)
+	s.blogs[id] = blog
+	s.slugs[slug] = id
+	s.recordAudit(ctx, datastore.AuditCreate, datastore.ResourceBlog, id, nil, datastore.BlogSnapshot(blog))
+
+	return id, nil
+}
+
+// Get retrieves a blog by ID with its comments
+func (s *space) Get(ctx context.Context, id datastore.ID, opts ...datastore.GetOption) (*datastore.Blog, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := validateID(datastore.ResourceBlog, "id", id); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	options := datastore.NewGetOptions(opts...)
+	blog, ok := s.blogs[id]
+	if !ok || (blog.DeletedAt != nil && !options.ShowDeleted) {
+		return nil, datastore.NotFound(datastore.ResourceBlog, id)
+	}
+
+	cp := copyBlog(blog)
+	if options.SkipContent {
+		cp.Content = ""
+	}
+	if options.SkipComments {
+		cp.Comments = []datastore.Comment{}
+	}
+	if options.CommentLimit > 0 && len(cp.Comments) > int(options.CommentLimit) {
+		cp.Comments = cp.Comments[:options.CommentLimit]
+	}
+	return cp, nil
+}
+
+// GetBySlug retrieves a blog by its current or a previous slug
+func (s *space) GetBySlug(ctx context.Context, slug string, opts ...datastore.GetOption) (*datastore.Blog, error) {
+	s.mu.RLock()
+	id, ok := s.slugs[slug]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, datastore.NotFound(datastore.ResourceBlog, datastore.ID(slug))
+	}
+	return s.Get(ctx, id, opts...)
+}
+
+// Update applies a patch to an existing blog, recording the previous version
+// as a revision when the title or content changes
+func (s *space) Update(ctx context.Context, id datastore.ID, patch datastore.BlogPatch, editor string, version int64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	title, content, status, publishAt := patch.Title, patch.Content, patch.Status, patch.PublishAt
+
+	// Setting a publish time schedules the blog
+	if publishAt != nil {
+		if status != nil && *status != datastore.StatusScheduled {
+			return datastore.Invalid(datastore.ResourceBlog, "publish_at", errPublishAt)
+		}
+		scheduled := datastore.StatusScheduled
+		status = &scheduled
+	}
+	if title == nil && content == nil && status == nil && patch.Tags == nil && patch.Slug == nil && patch.CommentPolicy == nil {
+		return nil // Nothing to update
+	}
+	if status != nil {
+		if err := validateStatus(*status); err != nil {
+			return err
+		}
+	}
+	var tags []string
+	if patch.Tags != nil {
+		var err error
+		if tags, err = datastore.NormalizeTags(*patch.Tags); err != nil {
+			return err
+		}
+	}
+	if patch.Slug != nil && !datastore.ValidSlug(*patch.Slug) {
+		return datastore.Invalid(datastore.ResourceBlog, "slug", fmt.Errorf("invalid slug %q", *patch.Slug))
+	}
+	if patch.CommentPolicy != nil && !patch.CommentPolicy.Valid() {
+		return datastore.Invalid(datastore.ResourceBlog, "comment_policy", fmt.Errorf("unknown comment policy %q", *patch.CommentPolicy))
+	}
+	if err := validateID(datastore.ResourceBlog, "id", id); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	blog, ok := s.live(id)
+	if !ok {
+		return datastore.NotFound(datastore.ResourceBlog, id)
+	}
+
+	if version != 0 && blog.Version != version {
+		return datastore.VersionMismatch(datastore.ResourceBlog, id)
+	}
+
+	// Slugs stay with the blog that first used them
+	if patch.Slug != nil {
+		if owner, ok := s.slugs[*patch.Slug]; ok && owner != id {
+			return datastore.Conflict(datastore.ResourceBlog, id, fmt.Errorf("slug %q is taken", *patch.Slug))
+		}
+	}
+
+	// Mirror the publish time check constraint in PostgreSQL
+	if status != nil && *status == datastore.StatusScheduled && publishAt == nil && blog.PublishAt == nil {
+		return datastore.Invalid(datastore.ResourceBlog, "publish_at", errPublishAt)
+	}
+
+	before := datastore.BlogSnapshot(blog)
+	now := time.Now()
+	if title != nil || content != nil {
+		s.recordRevision(blog, editor, now)
+	}
+	if title != nil {
+		blog.Title = *title
+	}
+	if content != nil {
+		blog.Content = *content
+	}
+	if status != nil {
+		setStatus(blog, *status, now)
+	}
+	if publishAt != nil {
+		blog.PublishAt = copyTime(publishAt)
+	}
+	if patch.Tags != nil {
+		blog.Tags = tags
+	}
+	if patch.Slug != nil {
+		blog.Slug = *patch.Slug
+		s.slugs[blog.Slug] = id
+	}
+	if patch.CommentPolicy != nil {
+		blog.CommentPolicy = *patch.CommentPolicy
+	}
+	touch(blog, now)
+	s.recordAudit(ctx, datastore.AuditUpdate, datastore.ResourceBlog, id, before, datastore.BlogSnapshot(blog))
+
+	return nil
+}
+
+// Delete moves a blog to the trash
+func (s *space) Delete(ctx context.Context, id datastore.ID, version int64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := validateID(datastore.ResourceBlog, "id", id); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	blog, ok := s.live(id)
+	if !ok {
+		return datastore.NotFound(datastore.ResourceBlog, id)
+	}
+	if version != 0 && blog.Version != version {
+		return datastore.VersionMismatch(datastore.ResourceBlog, id)
+	}
+
+	before := datastore.BlogSnapshot(blog)
+	now := time.Now()
+	blog.DeletedAt = &now
+	touch(blog, now)
+	s.recordAudit(ctx, datastore.AuditDelete, datastore.ResourceBlog, id, before, datastore.BlogSnapshot(blog))
+
+	return nil
+}
+
+// Undelete moves a blog out of the trash
+func (s *space) Undelete(ctx context.Context, id datastore.ID) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := validateID(datastore.ResourceBlog, "id", id); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	blog, ok := s.trashed(id)
+	if !ok {
+		return datastore.NotFound(datastore.ResourceBlog, id)
+	}
+
+	before := datastore.BlogSnapshot(blog)
+	blog.DeletedAt = nil
+	touch(blog, time.Now())
+	s.recordAudit(ctx, datastore.AuditUndelete, datastore.ResourceBlog, id, before, datastore.BlogSnapshot(blog))
+
+	return nil
+}
+
+// Purge permanently deletes a blog in the trash with its comments and revisions
+func (s *space) Purge(ctx context.Context, id datastore.ID) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := validateID(datastore.ResourceBlog, "id", id); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	blog, ok := s.trashed(id)
+	if !ok {
+		return datastore.NotFound(datastore.ResourceBlog, id)
+	}
+	s.recordAudit(ctx, datastore.AuditPurge, datastore.ResourceBlog, id, datastore.BlogSnapshot(blog), nil)
+	s.purge(id)
+
+	return nil
+}
+
+// PurgeDeleted permanently deletes up to limit blogs that were moved to the
+// trash no later than before, oldest first
+func (s *space) PurgeDeleted(ctx context.Context, before time.Time, limit int32) ([]datastore.ID, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		return nil, datastore.Invalid(datastore.ResourceBlog, "limit", fmt.Errorf("must be positive, got %d", limit))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var due []*datastore.Blog
+	for _, blog := range s.blogs {
+		if blog.DeletedAt != nil && !blog.DeletedAt.After(before) {
+			due = append(due, blog)
+		}
+	}
+
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].DeletedAt.Before(*due[j].DeletedAt)
+	})
+	if len(due) > int(limit) {
+		due = due[:limit]
+	}
+
+	ids := make([]datastore.ID, 0, len(due))
+	for _, blog := range due {
+		s.recordAudit(ctx, datastore.AuditPurge, datastore.ResourceBlog, blog.ID, datastore.BlogSnapshot(blog), nil)
+		s.purge(blog.ID)
+		ids = append(ids, blog.ID)
+	}
+
+	return ids, nil
+}
+
+// List retrieves a paginated list of blog summaries matching the filter, ordered by ID
+func (s *space) List(ctx context.Context, pageSize int32, pageToken string, filter datastore.ListFilter) ([]*datastore.BlogSummary, string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, "", err
+	}
+	if pageSize <= 0 {
+		return nil, "", datastore.Invalid(datastore.ResourceBlog, "page_size", fmt.Errorf("must be positive, got %d", pageSize))
+	}
+	if pageToken != "" {
+		if err := validateID(datastore.ResourceBlog, "page_token", datastore.ID(pageToken)); err != nil {
+			return nil, "", err
+		}
+	}
+	if filter.Status != "" {
+		if err := validateStatus(filter.Status); err != nil {
+			return nil, "", err
+		}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	summaries := make([]*datastore.BlogSummary, 0, len(s.blogs))
+	for id, blog := range s.blogs {
+		if pageToken != "" && string(id) <= pageToken {
+			continue
+		}
+		if filter.Status != "" && blog.Status != filter.Status {
+			continue
+		}
+		if !matchesDeleted(blog, filter.Deleted) {
+			continue
+		}
+		if filter.Tag != "" && !slices.Contains(blog.Tags, filter.Tag) {
+			continue
+		}
+		if filter.Owner != "" && blog.Owner != filter.Owner {
+			continue
+		}
+		summaries = append(summaries, &datastore.BlogSummary{
+			ID:           blog.ID,
+			Title:        blog.Title,
+			CommentCount: approvedCount(blog),
+			Status:       blog.Status,
+			DeletedAt:    copyTime(blog.DeletedAt),
+			Slug:         blog.Slug,
+		})
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].ID < summaries[j].ID
+	})
+
+	// Handle pagination
+	var nextPageToken string
+	if len(summaries) > int(pageSize) {
+		summaries = summaries[:pageSize]
+		nextPageToken = string(summaries[len(summaries)-1].ID)
+	}
+
+	return summaries, nextPageToken, nil
+}
+
+// ListTags retrieves every tag of the blogs outside the trash with the given
+// status, along with how many blogs have it
+func (s *space) ListTags(ctx context.Context, status datastore.Status) ([]*datastore.TagCount, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if status != "" {
+		if err := validateStatus(status); err != nil {
+			return nil, err
+		}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	counts := make(map[string]int32)
+	for _, blog := range s.blogs {
+		if blog.DeletedAt != nil || (status != "" && blog.Status != status) {
+			continue
+		}
+		for _, tag := range blog.Tags {
+			counts[tag]++
+		}
+	}
+
+	tags := make([]*datastore.TagCount, 0, len(counts))
+	for name, count := range counts {
+		tags = append(tags, &datastore.TagCount{Name: name, BlogCount: count})
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].Name < tags[j].Name
+	})
+
+	return tags, nil
+}
+
+// Search retrieves a paginated list of the published blogs matching the
+// query, best matches first. Unlike PostgreSQL, words are matched exactly,
+// without stemming or stop words.
+func (s *space) Search(ctx context.Context, query datastore.SearchQuery, pageSize int32, pageToken string) ([]*datastore.SearchResult, string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, "", err
+	}
+	if pageSize <= 0 {
+		return nil, "", datastore.Invalid(datastore.ResourceBlog, "page_size", fmt.Errorf("must be positive, got %d", pageSize))
+	}
+	if len(query.Terms) == 0 {
+		return nil, "", datastore.Invalid(datastore.ResourceBlog, "query", errors.New("must contain at least one word"))
+	}
+	var afterRank float32
+	var afterID datastore.ID
+	if pageToken != "" {
+		var err error
+		afterRank, afterID, err = search.ParsePageToken(pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		if err := validateID(datastore.ResourceBlog, "page_token", afterID); err != nil {
+			return nil, "", err
+		}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var results []*datastore.SearchResult
+	for _, blog := range s.blogs {
+		if blog.Status != datastore.StatusPublished || blog.DeletedAt != nil {
+			continue
+		}
+		rank, doc, ok := searchBlog(blog, query)
+		if !ok {
+			continue
+		}
+		if pageToken != "" && (rank > afterRank || rank == afterRank && blog.ID <= afterID) {
+			continue
+		}
+		results = append(results, &datastore.SearchResult{
+			BlogSummary: datastore.BlogSummary{
+				ID:           blog.ID,
+				Title:        blog.Title,
+				CommentCount: approvedCount(blog),
+				Status:       blog.Status,
+				Slug:         blog.Slug,
+			},
+			Rank:    rank,
+			Snippet: search.Snippet(doc, query.Terms),
+		})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Rank != results[j].Rank {
+			return results[i].Rank > results[j].Rank
+		}
+		return results[i].ID < results[j].ID
+	})
+
+	// Handle pagination
+	var nextPageToken string
+	if len(results) > int(pageSize) {
+		results = results[:pageSize]
+		last := results[len(results)-1]
+		nextPageToken = search.PageToken(last.Rank, last.ID)
+	}
+
+	return results, nextPageToken, nil
+}
+
+// AddComment adds a comment to a blog, or a reply to one of its comments
+func (s *space) AddComment(ctx context.Context, blogID datastore.ID, parentID *datastore.ID, content, author string, maxDepth int32, state datastore.CommentState, opts ...datastore.CommentOption) (*datastore.Comment, error) {
+	options := datastore.NewCommentOptions(opts...)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if !state.Valid() {
+		return nil, datastore.Invalid(datastore.ResourceComment, "state", fmt.Errorf("unknown comment state %q", state))
+	}
+	for _, verdict := range options.Verdicts {
+		if verdict.State != "" && !verdict.State.Valid() {
+			return nil, datastore.Invalid(datastore.ResourceComment, "state", fmt.Errorf("unknown comment state %q", verdict.State))
+		}
+	}
+	if err := validateID(datastore.ResourceBlog, "id", blogID); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	blog, ok := s.live(blogID)
+	if !ok {
+		return nil, datastore.NotFound(datastore.ResourceBlog, blogID)
+	}
+
+	// Replies go one level below their parent, which must be an approved
+	// comment on the same blog
+	var depth int32
+	if parentID != nil {
+		if err := validateID(datastore.ResourceComment, "parent_id", *parentID); err != nil {
+			return nil, err
+		}
+		idx := commentIndex(blog, *parentID)
+		if idx < 0 || blog.Comments[idx].State != datastore.CommentStateApproved {
+			return nil, datastore.NotFound(datastore.ResourceComment, *parentID)
+		}
+		depth = blog.Comments[idx].Depth + 1
+		if depth > maxDepth {
+			return nil, datastore.Invalid(datastore.ResourceComment, "parent_id", fmt.Errorf("replies nest at most %d deep", maxDepth))
+		}
+		parent := *parentID
+		parentID = &parent
+	}
+	if err := s.validateAuthor(datastore.ResourceComment, options.AuthorID); err != nil {
+		return nil, err
+	}
+
+	comment := datastore.Comment{
+		ID:        datastore.ID(uuid.New().String()),
+		BlogID:    blogID,
+		ParentID:  parentID,
+		Depth:     depth,
+		Content:   content,
+		Author:    author,
+		CreatedAt: time.Now(),
+		State:     state,
+		AuthorID:  copyID(options.AuthorID),
+	}
+	blog.Comments = append(blog.Comments, comment)
+	for _, verdict := range options.Verdicts {
+		verdict.CommentID = comment.ID
+		verdict.CreatedAt = comment.CreatedAt
+		s.verdicts[comment.ID] = append(s.verdicts[comment.ID], verdict)
+	}
+	s.recordAudit(ctx, datastore.AuditCreate, datastore.ResourceComment, comment.ID, nil, datastore.CommentSnapshot(&comment))
+
+	cp := copyComment(comment)
+	return &cp, nil
+}
+
+// GetComment retrieves an approved comment of a blog
+func (s *space) GetComment(ctx context.Context, blogID, id datastore.ID) (*datastore.Comment, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := validateID(datastore.ResourceComment, "id", id); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	blog, ok := s.live(blogID)
+	if !ok {
+		return nil, datastore.NotFound(datastore.ResourceComment, id)
+	}
+	idx := commentIndex(blog, id)
+	if idx < 0 || blog.Comments[idx].State != datastore.CommentStateApproved {
+		return nil, datastore.NotFound(datastore.ResourceComment, id)
+	}
+
+	cp := copyComment(blog.Comments[idx])
+	return &cp, nil
+}
+
+// UpdateComment replaces the content of a comment of a blog
+func (s *space) UpdateComment(ctx context.Context, blogID, id datastore.ID, content string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := validateID(datastore.ResourceComment, "id", id); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	blog, ok := s.live(blogID)
+	if !ok {
+		return datastore.NotFound(datastore.ResourceComment, id)
+	}
+	idx := commentIndex(blog, id)
+	if idx < 0 {
+		return datastore.NotFound(datastore.ResourceComment, id)
+	}
+
+	before := datastore.CommentSnapshot(&blog.Comments[idx])
+	now := time.Now()
+	blog.Comments[idx].Content = content
+	blog.Comments[idx].UpdatedAt = &now
+	s.recordAudit(ctx, datastore.AuditUpdate, datastore.ResourceComment, id, before, datastore.CommentSnapshot(&blog.Comments[idx]))
+	return nil
+}
+
+// DeleteComment deletes a comment of a blog along with its replies
+func (s *space) DeleteComment(ctx context.Context, blogID, id datastore.ID) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := validateID(datastore.ResourceComment, "id", id); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	blog, ok := s.live(blogID)
+	if !ok {
+		return datastore.NotFound(datastore.ResourceComment, id)
+	}
+	idx := commentIndex(blog, id)
+	if idx < 0 {
+		return datastore.NotFound(datastore.ResourceComment, id)
+	}
+	s.recordAudit(ctx, datastore.AuditDelete, datastore.ResourceComment, id, datastore.CommentSnapshot(&blog.Comments[idx]), nil)
+
+	// Replies come after their parent, so one pass finds the whole thread
+	deleted := map[datastore.ID]bool{id: true}
+	blog.Comments = slices.DeleteFunc(blog.Comments, func(c datastore.Comment) bool {
+		if c.ParentID != nil && deleted[*c.ParentID] {
+			deleted[c.ID] = true
+		}
+		return deleted[c.ID]
+	})
+	for id := range deleted {
+		delete(s.verdicts, id)
+	}
+	return nil
+}
+
+// ListComments retrieves a paginated list of the approved comments of a
+// blog, oldest first
+func (s *space) ListComments(ctx context.Context, blogID datastore.ID, pageSize int32, pageToken string) ([]*datastore.Comment, string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, "", err
+	}
+	if pageSize <= 0 {
+		return nil, "", datastore.Invalid(datastore.ResourceComment, "page_size", fmt.Errorf("must be positive, got %d", pageSize))
+	}
+
+	// The page token is the creation time and ID of the last comment on the
+	// previous page
+	var afterTime time.Time
+	var afterID datastore.ID
+	if pageToken != "" {
+		var err error
+		afterTime, afterID, err = datastore.ParseCommentPageToken(pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	if err := validateID(datastore.ResourceBlog, "id", blogID); err != nil {
+		return nil, "", err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	blog, ok := s.live(blogID)
+	if !ok {
+		return nil, "", datastore.NotFound(datastore.ResourceBlog, blogID)
+	}
+
+	var comments []*datastore.Comment
+	for _, comment := range blog.Comments {
+		if comment.State != datastore.CommentStateApproved {
+			continue
+		}
+		if pageToken != "" && !commentAfter(comment, afterTime, afterID) {
+			continue
+		}
+		cp := copyComment(comment)
+		comments = append(comments, &cp)
+	}
+
+	// Handle pagination
+	var nextPageToken string
+	if len(comments) > int(pageSize) {
+		comments = comments[:pageSize]
+		nextPageToken = datastore.CommentPageToken(comments[len(comments)-1])
+	}
+
+	return comments, nextPageToken, nil
+}
+
+// ListPendingComments retrieves a paginated list of the pending comments of
+// the blogs outside the trash, or of a single blog, oldest first
+func (s *space) ListPendingComments(ctx context.Context, blogID *datastore.ID, pageSize int32, pageToken string) ([]*datastore.Comment, string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, "", err
+	}
+	if pageSize <= 0 {
+		return nil, "", datastore.Invalid(datastore.ResourceComment, "page_size", fmt.Errorf("must be positive, got %d", pageSize))
+	}
+
+	// The page token is the creation time and ID of the last comment on the
+	// previous page
+	var afterTime time.Time
+	var afterID datastore.ID
+	if pageToken != "" {
+		var err error
+		afterTime, afterID, err = datastore.ParseCommentPageToken(pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	if blogID != nil {
+		if err := validateID(datastore.ResourceBlog, "id", *blogID); err != nil {
+			return nil, "", err
+		}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	blogs := make([]*datastore.Blog, 0, len(s.blogs))
+	if blogID != nil {
+		blog, ok := s.live(*blogID)
+		if !ok {
+			return nil, "", datastore.NotFound(datastore.ResourceBlog, *blogID)
+		}
+		blogs = append(blogs, blog)
+	} else {
+		for _, blog := range s.blogs {
+			if blog.DeletedAt == nil {
+				blogs = append(blogs, blog)
+			}
+		}
+	}
+
+	var comments []*datastore.Comment
+	for _, blog := range blogs {
+		for _, comment := range blog.Comments {
+			if comment.State != datastore.CommentStatePending {
+				continue
+			}
+			if pageToken != "" && !commentAfter(comment, afterTime, afterID) {
+				continue
+			}
+			cp := copyComment(comment)
+			comments = append(comments, &cp)
+		}
+	}
+
+	// Comments of different blogs interleave, so they are sorted like the
+	// PostgreSQL store sorts them
+	sort.Slice(comments, func(i, j int) bool {
+		return commentAfter(*comments[j], comments[i].CreatedAt, comments[i].ID)
+	})
+
+	// Handle pagination
+	var nextPageToken string
+	if len(comments) > int(pageSize) {
+		comments = comments[:pageSize]
+		nextPageToken = datastore.CommentPageToken(comments[len(comments)-1])
+	}
+
+	return comments, nextPageToken, nil
+}
+
+// ModerateComment sets the state of a comment of a blog, recording the
+// reason and the time
+func (s *space) ModerateComment(ctx context.Context, blogID, id datastore.ID, state datastore.CommentState, reason string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if !state.Valid() {
+		return datastore.Invalid(datastore.ResourceComment, "state", fmt.Errorf("unknown comment state %q", state))
+	}
+	if err := validateID(datastore.ResourceComment, "id", id); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	blog, ok := s.live(blogID)
+	if !ok {
+		return datastore.NotFound(datastore.ResourceComment, id)
+	}
+	idx := commentIndex(blog, id)
+	if idx < 0 {
+		return datastore.NotFound(datastore.ResourceComment, id)
+	}
+
+	before := datastore.CommentSnapshot(&blog.Comments[idx])
+	now := time.Now()
+	blog.Comments[idx].State = state
+	blog.Comments[idx].ModerationReason = reason
+	blog.Comments[idx].ModeratedAt = &now
+	s.recordAudit(ctx, datastore.AuditModerate, datastore.ResourceComment, id, before, datastore.CommentSnapshot(&blog.Comments[idx]))
+	return nil
+}
+
+// ListRecentComments retrieves up to limit comments of the blogs outside the
+// trash created at or after since, whatever their state, newest first
+func (s *space) ListRecentComments(ctx context.Context, since time.Time, limit int32) ([]*datastore.Comment, error) {
+	return s.selectComments(ctx, limit, func(c datastore.Comment) bool {
+		return !c.CreatedAt.Before(since)
+	}, func(c datastore.Comment) time.Time {
+		return c.CreatedAt
+	})
+}
+
+// ListModeratedComments retrieves up to limit comments of the blogs outside
+// the trash that a moderator settled, most recently moderated first
+func (s *space) ListModeratedComments(ctx context.Context, limit int32) ([]*datastore.Comment, error) {
+	return s.selectComments(ctx, limit, func(c datastore.Comment) bool {
+		return c.ModeratedAt != nil
+	}, func(c datastore.Comment) time.Time {
+		return *c.ModeratedAt
+	})
+}
+
+// ListCommentVerdicts retrieves the verdicts recorded for a comment of a
+// blog, whatever its state, in the order they were given
+func (s *space) ListCommentVerdicts(ctx context.Context, blogID, id datastore.ID) ([]*datastore.CommentVerdict, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := validateID(datastore.ResourceComment, "id", id); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	blog, ok := s.live(blogID)
+	if !ok || commentIndex(blog, id) < 0 {
+		return nil, datastore.NotFound(datastore.ResourceComment, id)
+	}
+
+	verdicts := make([]*datastore.CommentVerdict, len(s.verdicts[id]))
+	for i, verdict := range s.verdicts[id] {
+		verdicts[i] = &verdict
+	}
+	return verdicts, nil
+}
+
+// Publish publishes a blog, recording the publish time if it was not already
+// published
+func (s *space) Publish(ctx context.Context, id datastore.ID) error {
+	return s.transition(ctx, id, datastore.StatusPublished, datastore.AuditPublish)
+}
+
+// Unpublish moves a blog back to draft
+func (s *space) Unpublish(ctx context.Context, id datastore.ID) error {
+	return s.transition(ctx, id, datastore.StatusDraft, datastore.AuditUnpublish)
+}
+
+// transition moves a single blog to the given status, recording it as the
+// given audit action
+func (s *space) transition(ctx context.Context, id datastore.ID, status datastore.Status, action datastore.AuditAction) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := validateID(datastore.ResourceBlog, "id", id); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	blog, ok := s.live(id)
+	if !ok {
+		return datastore.NotFound(datastore.ResourceBlog, id)
+	}
+
+	before := datastore.BlogSnapshot(blog)
+	now := time.Now()
+	setStatus(blog, status, now)
+	touch(blog, now)
+	s.recordAudit(ctx, action, datastore.ResourceBlog, id, before, datastore.BlogSnapshot(blog))
+
+	return nil
+}
+
+// PublishScheduled publishes up to limit scheduled blogs that are due, in
+// publish time order
+func (s *space) PublishScheduled(ctx context.Context, now time.Time, limit int32) ([]datastore.ID, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		return nil, datastore.Invalid(datastore.ResourceBlog, "limit", fmt.Errorf("must be positive, got %d", limit))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var due []*datastore.Blog
+	for _, blog := range s.blogs {
+		if blog.Status == datastore.StatusScheduled && blog.DeletedAt == nil && !blog.PublishAt.After(now) {
+			due = append(due, blog)
+		}
+	}
+
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].PublishAt.Before(*due[j].PublishAt)
+	})
+	if len(due) > int(limit) {
+		due = due[:limit]
+	}
+
+	publishedAt := time.Now()
+	ids := make([]datastore.ID, 0, len(due))
+	for _, blog := range due {
+		before := datastore.BlogSnapshot(blog)
+		setStatus(blog, datastore.StatusPublished, publishedAt)
+		touch(blog, publishedAt)
+		s.recordAudit(ctx, datastore.AuditPublish, datastore.ResourceBlog, blog.ID, before, datastore.BlogSnapshot(blog))
+		ids = append(ids, blog.ID)
+	}
+
+	return ids, nil
+}
+
+// ListRevisions retrieves a paginated list of the revisions of a blog, newest first
+func (s *space) ListRevisions(ctx context.Context, blogID datastore.ID, pageSize int32, pageToken string) ([]*datastore.Revision, string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, "", err
+	}
+	if pageSize <= 0 {
+		return nil, "", datastore.Invalid(datastore.ResourceRevision, "page_size", fmt.Errorf("must be positive, got %d", pageSize))
+	}
+
+	// The page token is the number of the last revision on the previous page
+	before := int64(math.MaxInt32)
+	if pageToken != "" {
+		n, err := strconv.ParseInt(pageToken, 10, 32)
+		if err != nil || n <= 0 {
+			return nil, "", datastore.Invalid(datastore.ResourceRevision, "page_token", fmt.Errorf("invalid page token %q", pageToken))
+		}
+		before = n
+	}
+	if err := validateID(datastore.ResourceBlog, "id", blogID); err != nil {
+		return nil, "", err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.live(blogID); !ok {
+		return nil, "", datastore.NotFound(datastore.ResourceBlog, blogID)
+	}
+
+	stored := s.revisions[blogID]
+	revisions := make([]*datastore.Revision, 0, len(stored))
+	for i := len(stored) - 1; i >= 0; i-- {
+		if int64(stored[i].Number) >= before {
+			continue
+		}
+		revision := stored[i]
+		revisions = append(revisions, &revision)
+	}
+
+	// Handle pagination
+	var nextPageToken string
+	if len(revisions) > int(pageSize) {
+		revisions = revisions[:pageSize]
+		nextPageToken = strconv.Itoa(int(revisions[len(revisions)-1].Number))
+	}
+
+	return revisions, nextPageToken, nil
+}
+
+// GetRevision retrieves a single revision of a blog
+func (s *space) GetRevision(ctx context.Context, blogID datastore.ID, number int32) (*datastore.Revision, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := validateID(datastore.ResourceBlog, "id", blogID); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.live(blogID); !ok {
+		return nil, datastore.NotFound(datastore.ResourceRevision, datastore.RevisionID(blogID, number))
+	}
+	revision, ok := s.revision(blogID, number)
+	if !ok {
+		return nil, datastore.NotFound(datastore.ResourceRevision, datastore.RevisionID(blogID, number))
+	}
+
+	return &revision, nil
+}
+
+// RestoreRevision sets the title and content of a blog back to those of a
+// revision, recording the replaced version as a new revision
+func (s *space) RestoreRevision(ctx context.Context, blogID datastore.ID, number int32, editor string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := validateID(datastore.ResourceBlog, "id", blogID); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	blog, ok := s.live(blogID)
+	if !ok {
+		return datastore.NotFound(datastore.ResourceBlog, blogID)
+	}
+	revision, ok := s.revision(blogID, number)
+	if !ok {
+		return datastore.NotFound(datastore.ResourceRevision, datastore.RevisionID(blogID, number))
+	}
+
+	before := datastore.BlogSnapshot(blog)
+	now := time.Now()
+	s.recordRevision(blog, editor, now)
+	blog.Title = revision.Title
+	blog.Content = revision.Content
+	touch(blog, now)
+	s.recordAudit(ctx, datastore.AuditRestore, datastore.ResourceBlog, blogID, before, datastore.BlogSnapshot(blog))
+
+	return nil
+}
+
+// CreateAPIKey stores a new API key by the hash of the key and returns it
+func (s *space) CreateAPIKey(ctx context.Context, name, prefix, hash string, scopes []string, expiresAt *time.Time) (*datastore.APIKey, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if name == "" {
+		return nil, datastore.Invalid(datastore.ResourceAPIKey, "name", errors.New("must not be empty"))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := datastore.ID(uuid.New().String())
+	if _, taken := s.keyHashes[hash]; taken {
+		return nil, datastore.Conflict(datastore.ResourceAPIKey, id, errors.New("key hash already in use"))
+	}
+	key := &datastore.APIKey{
+		ID:        id,
+		Name:      name,
+		Prefix:    prefix,
+		Hash:      hash,
+		Scopes:    append([]string{}, scopes...),
+		CreatedAt: time.Now(),
+		ExpiresAt: copyTime(expiresAt),
+	}
+	s.apiKeys[id] = key
+	s.keyHashes[hash] = id
+
+	return copyAPIKey(key), nil
+}
+
+// ListAPIKeys retrieves the API keys, newest first, leaving out revoked keys
+// unless asked for
+func (s *space) ListAPIKeys(ctx context.Context, showRevoked bool) ([]*datastore.APIKey, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	keys := []*datastore.APIKey{}
+	for _, key := range s.apiKeys {
+		if key.RevokedAt == nil || showRevoked {
+			keys = append(keys, copyAPIKey(key))
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if !keys[i].CreatedAt.Equal(keys[j].CreatedAt) {
+			return keys[i].CreatedAt.After(keys[j].CreatedAt)
+		}
+		return keys[i].ID > keys[j].ID
+	})
+	return keys, nil
+}
+
+// GetAPIKeyByHash retrieves the API key with the given hash, whether or not
+// it is still active
+func (s *space) GetAPIKeyByHash(ctx context.Context, hash string) (*datastore.APIKey, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	id, ok := s.keyHashes[hash]
+	if !ok {
+		return nil, datastore.NotFound(datastore.ResourceAPIKey, "")
+	}
+	return copyAPIKey(s.apiKeys[id]), nil
+}
+
+// TouchAPIKey records that an API key was used at the given time
+func (s *space) TouchAPIKey(ctx context.Context, id datastore.ID, at time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.apiKeys[id]
+	if !ok {
+		return datastore.NotFound(datastore.ResourceAPIKey, id)
+	}
+	if key.LastUsedAt == nil || at.After(*key.LastUsedAt) {
+		key.LastUsedAt = &at
+	}
+	return nil
+}
+
+// RevokeAPIKey revokes an API key, keeping the time it was first revoked
+func (s *space) RevokeAPIKey(ctx context.Context, id datastore.ID) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := validateID(datastore.ResourceAPIKey, "id", id); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.apiKeys[id]
+	if !ok {
+		return datastore.NotFound(datastore.ResourceAPIKey, id)
+	}
+	if key.RevokedAt == nil {
+		now := time.Now()
+		key.RevokedAt = &now
+	}
+	return nil
+}
+
+// CreateUser creates a user and returns it
+func (s *space) CreateUser(ctx context.Context, displayName, bio, avatarURL string, opts ...datastore.UserOption) (*datastore.User, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if displayName == "" {
+		return nil, datastore.Invalid(datastore.ResourceUser, "display_name", errors.New("must not be empty"))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	user := &datastore.User{
+		ID:          datastore.ID(uuid.New().String()),
+		DisplayName: displayName,
+		Bio:         bio,
+		AvatarURL:   avatarURL,
+		Owner:       datastore.NewUserOptions(opts...).Owner,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	s.users[user.ID] = user
+
+	cp := *user
+	return &cp, nil
+}
+
+// GetUser retrieves a user by ID
+func (s *space) GetUser(ctx context.Context, id datastore.ID) (*datastore.User, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := validateID(datastore.ResourceUser, "id", id); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	user, ok := s.users[id]
+	if !ok {
+		return nil, datastore.NotFound(datastore.ResourceUser, id)
+	}
+	cp := *user
+	return &cp, nil
+}
+
+// GetUsers retrieves the users with the given IDs, leaving out IDs no user
+// has
+func (s *space) GetUsers(ctx context.Context, ids []datastore.ID) ([]*datastore.User, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		if err := validateID(datastore.ResourceUser, "id", id); err != nil {
+			return nil, err
+		}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	users := []*datastore.User{}
+	seen := make(map[datastore.ID]bool, len(ids))
+	for _, id := range ids {
+		user, ok := s.users[id]
+		if !ok || seen[id] {
+			continue
+		}
+		seen[id] = true
+		cp := *user
+		users = append(users, &cp)
+	}
+	return users, nil
+}
+
+// UpdateUser applies a patch to an existing user
+func (s *space) UpdateUser(ctx context.Context, id datastore.ID, patch datastore.UserPatch) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := validateID(datastore.ResourceUser, "id", id); err != nil {
+		return err
+	}
+	if patch.DisplayName != nil && *patch.DisplayName == "" {
+		return datastore.Invalid(datastore.ResourceUser, "display_name", errors.New("must not be empty"))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.users[id]
+	if !ok {
+		return datastore.NotFound(datastore.ResourceUser, id)
+	}
+	if patch.DisplayName != nil {
+		user.DisplayName = *patch.DisplayName
+	}
+	if patch.Bio != nil {
+		user.Bio = *patch.Bio
+	}
+	if patch.AvatarURL != nil {
+		user.AvatarURL = *patch.AvatarURL
+	}
+	user.UpdatedAt = time.Now()
+	return nil
+}
+
+// ListUsers retrieves a paginated list of users, oldest first
+func (s *space) ListUsers(ctx context.Context, pageSize int32, pageToken string) ([]*datastore.User, string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, "", err
+	}
+	if pageSize <= 0 {
+		return nil, "", datastore.Invalid(datastore.ResourceUser, "page_size", fmt.Errorf("must be positive, got %d", pageSize))
+	}
+
+	// The page token is the creation time and ID of the last user on the
+	// previous page
+	var afterTime time.Time
+	var afterID datastore.ID
+	if pageToken != "" {
+		var err error
+		afterTime, afterID, err = datastore.ParseUserPageToken(pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var users []*datastore.User
+	for _, user := range s.users {
+		if pageToken != "" && !createdAfter(user.CreatedAt, user.ID, afterTime, afterID) {
+			continue
+		}
+		cp := *user
+		users = append(users, &cp)
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return createdAfter(users[j].CreatedAt, users[j].ID, users[i].CreatedAt, users[i].ID)
+	})
+
+	// Handle pagination
+	var nextPageToken string
+	if len(users) > int(pageSize) {
+		users = users[:pageSize]
+		nextPageToken = datastore.UserPageToken(users[len(users)-1])
+	}
+
+	return users, nextPageToken, nil
+}
+
+// ListAuditEvents retrieves a paginated list of the audit events matching
+// the filter, oldest first
+func (s *space) ListAuditEvents(ctx context.Context, filter datastore.AuditFilter, pageSize int32, pageToken string) ([]*datastore.AuditEvent, string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, "", err
+	}
+	if pageSize <= 0 {
+		return nil, "", datastore.Invalid(datastore.ResourceAuditEvent, "page_size", fmt.Errorf("must be positive, got %d", pageSize))
+	}
+
+	// The page token is the creation time and ID of the last event on the
+	// previous page
+	var afterTime time.Time
+	var afterID datastore.ID
+	if pageToken != "" {
+		var err error
+		afterTime, afterID, err = datastore.ParseAuditPageToken(pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var events []*datastore.AuditEvent
+	for _, event := range s.audit {
+		if !filter.Match(event) {
+			continue
+		}
+		if pageToken != "" && !createdAfter(event.CreatedAt, event.ID, afterTime, afterID) {
+			continue
+		}
+		cp := *event
+		events = append(events, &cp)
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return createdAfter(events[j].CreatedAt, events[j].ID, events[i].CreatedAt, events[i].ID)
+	})
+
+	// Handle pagination
+	var nextPageToken string
+	if len(events) > int(pageSize) {
+		events = events[:pageSize]
+		nextPageToken = datastore.AuditPageToken(events[len(events)-1])
+	}
+
+	return events, nextPageToken, nil
+}
+
+// recordAudit appends an audit event for a change made for the request the
+// context carries the audit information of. Callers hold the write lock, so
+// the event is recorded along with the change. Snapshots are never changed,
+// so events share them with their copies.
+func (s *space) recordAudit(ctx context.Context, action datastore.AuditAction, resource string, id datastore.ID, before, after json.RawMessage) {
+	info := datastore.AuditFromContext(ctx)
+	s.audit = append(s.audit, &datastore.AuditEvent{
+		ID:         datastore.ID(uuid.New().String()),
+		CreatedAt:  time.Now(),
+		Actor:      info.Actor,
+		Method:     info.Method,
+		Action:     action,
+		Resource:   resource,
+		ResourceID: id,
+		Before:     before,
+		After:      after,
+		RequestID:  info.RequestID,
+		ClientIP:   info.ClientIP,
+	})
+}
+
+// validateAuthor checks that the author of a blog or comment is a user,
+// mirroring the foreign keys in PostgreSQL
+func (s *space) validateAuthor(resource string, id *datastore.ID) error {
+	if id == nil {
+		return nil
+	}
+	if err := validateID(resource, "author_id", *id); err != nil {
+		return err
+	}
+	if _, ok := s.users[*id]; !ok {
+		return datastore.NotFound(datastore.ResourceUser, *id)
+	}
+	return nil
+}
+
+// live looks up a blog that is not in the trash
+func (s *space) live(id datastore.ID) (*datastore.Blog, bool) {
+	blog, ok := s.blogs[id]
+	if !ok || blog.DeletedAt != nil {
+		return nil, false
+	}
+	return blog, true
+}
+
+// trashed looks up a blog that is in the trash
+func (s *space) trashed(id datastore.ID) (*datastore.Blog, bool) {
+	blog, ok := s.blogs[id]
+	if !ok || blog.DeletedAt == nil {
+		return nil, false
+	}
+	return blog, true
+}
+
+// purge removes a blog for good. Comments are stored with their blog, so
+// they go with it.
+func (s *space) purge(id datastore.ID) {
+	if blog, ok := s.blogs[id]; ok {
+		for _, comment := range blog.Comments {
+			delete(s.verdicts, comment.ID)
+		}
+	}
+	delete(s.blogs, id)
+	delete(s.revisions, id)
+	for slug, owner := range s.slugs {
+		if owner == id {
+			delete(s.slugs, slug)
+		}
+	}
+}
+
+// matchesDeleted reports whether a blog passes a deleted filter
+func matchesDeleted(blog *datastore.Blog, filter datastore.DeletedFilter) bool {
+	switch filter {
+	case datastore.IncludeDeleted:
+		return true
+	case datastore.OnlyDeleted:
+		return blog.DeletedAt != nil
+	default:
+		return blog.DeletedAt == nil
+	}
+}
+
+// Search rank weights of titles, content and comments, matching the ts_rank
+// defaults for the weights the PostgreSQL store gives them
+const (
+	titleWeight   = 1.0
+	contentWeight = 0.4
+	commentWeight = 0.1
+)
+
+// searchBlog returns the rank of the best match of a blog or, if enabled,
+// one of its comments, along with the text the snippet is taken from
+func searchBlog(blog *datastore.Blog, query datastore.SearchQuery) (float32, string, bool) {
+	title := search.Tokenize(blog.Title)
+	tokens := append(title, search.Tokenize(blog.Content)...)
+	rank, ok := searchRank(tokens, query.Terms, func(i int) float32 {
+		if i < len(title) {
+			return titleWeight
+		}
+		return contentWeight
+	})
+	doc := blog.Content
+
+	if query.IncludeComments {
+		for _, comment := range blog.Comments {
+			if comment.State != datastore.CommentStateApproved {
+				continue
+			}
+			commentRank, commentOK := searchRank(search.Tokenize(comment.Content), query.Terms, func(int) float32 {
+				return commentWeight
+			})
+			if commentOK && (!ok || commentRank > rank) {
+				rank, doc, ok = commentRank, comment.Content, true
+			}
+		}
+	}
+
+	return rank, doc, ok
+}
+
+// searchRank sums the weights of the tokens where a term matches, and
+// reports whether every term does
+func searchRank(tokens []search.Token, terms []datastore.SearchTerm, weight func(i int) float32) (float32, bool) {
+	var rank float32
+	for _, term := range terms {
+		matches := search.Match(tokens, term)
+		if len(matches) == 0 {
+			return 0, false
+		}
+		for _, i := range matches {
+			rank += weight(i)
+		}
+	}
+	return rank, true
+}
+
+// revision looks up a revision of a blog. Revisions are numbered from 1
+// without gaps, so the number is also the position in the history.
+func (s *space) revision(blogID datastore.ID, number int32) (datastore.Revision, bool) {
+	revisions := s.revisions[blogID]
+	if number <= 0 || int(number) > len(revisions) {
+		return datastore.Revision{}, false
+	}
+	return revisions[number-1], true
+}
+
+// recordRevision saves the current title and content of a blog as its next revision
+func (s *space) recordRevision(blog *datastore.Blog, editor string, now time.Time) {
+	s.revisions[blog.ID] = append(s.revisions[blog.ID], datastore.Revision{
+		BlogID:    blog.ID,
+		Number:    int32(len(s.revisions[blog.ID]) + 1),
+		Title:     blog.Title,
+		Content:   blog.Content,
+		Editor:    editor,
+		CreatedAt: now,
+	})
+}
+
+// touch records a change to a blog, mirroring the triggers maintaining the
+// update time and version in PostgreSQL
+func touch(blog *datastore.Blog, now time.Time) {
+	blog.UpdatedAt = now
+	blog.Version++
+}
+
+// setStatus changes the status of a blog, recording the publish time when it
+// becomes published and dropping the scheduled publish time once it is no
+// longer scheduled
+func setStatus(blog *datastore.Blog, status datastore.Status, now time.Time) {
+	if status == datastore.StatusPublished && blog.Status != datastore.StatusPublished {
+		blog.PublishedAt = &now
+	}
+	if status != datastore.StatusScheduled {
+		blog.PublishAt = nil
+	}
+	blog.Status = status
+}
+
+// errPublishAt explains when a blog may have a publish time
+var errPublishAt = errors.New("only scheduled blogs have a publish time, and they must have one")
+
+// validateStatus rejects unknown statuses, mirroring the post_status enum in PostgreSQL
+func validateStatus(status datastore.Status) error {
+	if !status.Valid() {
+		return datastore.Invalid(datastore.ResourceBlog, "status", fmt.Errorf("unknown status %q", status))
+	}
+	return nil
+}
+
+// validateID rejects IDs that are not UUIDs, mirroring the uuid column type in PostgreSQL
+func validateID(resource, field string, id datastore.ID) error {
+	if _, err := uuid.Parse(string(id)); err != nil {
+		return datastore.Invalid(resource, field, err)
+	}
+	return nil
+}
+
+// copyBlog returns a deep copy of a blog with its approved comments so
+// callers cannot mutate stored state
+func copyBlog(blog *datastore.Blog) *datastore.Blog {
+	cp := *blog
+	cp.PublishedAt = copyTime(blog.PublishedAt)
+	cp.PublishAt = copyTime(blog.PublishAt)
+	cp.DeletedAt = copyTime(blog.DeletedAt)
+	cp.AuthorID = copyID(blog.AuthorID)
+	cp.Tags = slices.Clone(blog.Tags)
+	cp.CommentCount = approvedCount(blog)
+	cp.Comments = make([]datastore.Comment, 0, cp.CommentCount)
+	for _, comment := range blog.Comments {
+		if comment.State == datastore.CommentStateApproved {
+			cp.Comments = append(cp.Comments, copyComment(comment))
+		}
+	}
+	return &cp
+}
+
+// approvedCount returns the number of approved comments of a blog
+func approvedCount(blog *datastore.Blog) int32 {
+	var count int32
+	for _, comment := range blog.Comments {
+		if comment.State == datastore.CommentStateApproved {
+			count++
+		}
+	}
+	return count
+}
+
+// selectComments returns copies of up to limit comments of the blogs outside
+// the trash that match, ordered by the time returned by at and then by ID,
+// latest first
+func (s *space) selectComments(ctx context.Context, limit int32, match func(datastore.Comment) bool, at func(datastore.Comment) time.Time) ([]*datastore.Comment, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		return nil, datastore.Invalid(datastore.ResourceComment, "limit", fmt.Errorf("must be positive, got %d", limit))
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	comments := []*datastore.Comment{}
+	for _, blog := range s.blogs {
+		if blog.DeletedAt != nil {
+			continue
+		}
+		for _, comment := range blog.Comments {
+			if match(comment) {
+				cp := copyComment(comment)
+				comments = append(comments, &cp)
+			}
+		}
+	}
+
+	sort.Slice(comments, func(i, j int) bool {
+		ti, tj := at(*comments[i]), at(*comments[j])
+		if !ti.Equal(tj) {
+			return ti.After(tj)
+		}
+		return comments[i].ID > comments[j].ID
+	})
+	if len(comments) > int(limit) {
+		comments = comments[:limit]
+	}
+	return comments, nil
+}
+
+// copyComment returns a deep copy of a comment
+func copyComment(comment datastore.Comment) datastore.Comment {
+	cp := comment
+	if comment.ParentID != nil {
+		parent := *comment.ParentID
+		cp.ParentID = &parent
+	}
+	cp.UpdatedAt = copyTime(comment.UpdatedAt)
+	cp.ModeratedAt = copyTime(comment.ModeratedAt)
+	cp.AuthorID = copyID(comment.AuthorID)
+	return cp
+}
+
+// commentIndex returns the index of a comment of a blog, or -1 if the blog
+// has no such comment
+func commentIndex(blog *datastore.Blog, id datastore.ID) int {
+	return slices.IndexFunc(blog.Comments, func(c datastore.Comment) bool {
+		return c.ID == id
+	})
+}
+
+// commentAfter reports whether a comment comes after the comment created at
+// createdAt with the given ID, ordering comments by creation time and ID
+func commentAfter(comment datastore.Comment, createdAt time.Time, id datastore.ID) bool {
+	return createdAfter(comment.CreatedAt, comment.ID, createdAt, id)
+}
+
+// createdAfter reports whether the row created at createdAt with the given
+// ID comes after the one created at afterTime with ID afterID, ordering rows
+// by creation time and ID
+func createdAfter(createdAt time.Time, id datastore.ID, afterTime time.Time, afterID datastore.ID) bool {
+	if !createdAt.Equal(afterTime) {
+		return createdAt.After(afterTime)
+	}
+	return id > afterID
+}
+
+// copyAPIKey returns a deep copy of an API key
+func copyAPIKey(key *datastore.APIKey) *datastore.APIKey {
+	cp := *key
+	cp.Scopes = append([]string{}, key.Scopes...)
+	cp.ExpiresAt = copyTime(key.ExpiresAt)
+	cp.LastUsedAt = copyTime(key.LastUsedAt)
+	cp.RevokedAt = copyTime(key.RevokedAt)
+	return &cp
+}
+
+// copyTime returns a copy of an optional time
+func copyTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	cp := *t
+	return &cp
+}
+
+// copyID returns a copy of an optional ID
+func copyID(id *datastore.ID) *datastore.ID {
+	if id == nil {
+		return nil
+	}
+	cp := *id
+	return &cp
+}
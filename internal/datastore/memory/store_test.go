@@ -0,0 +1,51 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/datastore/memory"
+	"github.com/agruetz/prosigliere/internal/datastore/storetest"
+)
+
+func TestConformance(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) datastore.Store {
+		return memory.New()
+	})
+}
+
+func TestGetReturnsCopy(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+
+	id, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusPublished, nil, []string{"test"})
+	require.NoError(t, err)
+	comment, err := store.AddComment(ctx, id, nil, "Test Comment", "Test Author", 1, datastore.CommentStateApproved)
+	require.NoError(t, err)
+	_, err = store.AddComment(ctx, id, &comment.ID, "Test Reply", "Test Author", 1, datastore.CommentStateApproved)
+	require.NoError(t, err)
+
+	blog, err := store.Get(ctx, id)
+	require.NoError(t, err)
+
+	// Mutating the returned blog must not affect the stored one
+	blog.Title = "Mutated"
+	blog.Comments[0].Content = "Mutated"
+	*blog.Comments[1].ParentID = "mutated"
+	blog.Tags[0] = "mutated"
+	publishedAt := *blog.PublishedAt
+	*blog.PublishedAt = publishedAt.Add(time.Hour)
+
+	blog, err = store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "Test Title", blog.Title)
+	assert.Equal(t, "Test Comment", blog.Comments[0].Content)
+	assert.Equal(t, comment.ID, *blog.Comments[1].ParentID)
+	assert.Equal(t, []string{"test"}, blog.Tags)
+	assert.True(t, blog.PublishedAt.Equal(publishedAt))
+}
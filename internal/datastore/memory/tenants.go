@@ -0,0 +1,394 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+)
+
+// Store implements the datastore.Store interface in memory. It is safe for
+// concurrent use and mirrors the behavior of the PostgreSQL store, which
+// makes it suitable for local development, demos and tests. The data of
+// every tenant is kept apart, and a tenant gets its data on first use.
+type Store struct {
+	mu      sync.RWMutex
+	tenants map[string]*datastore.Tenant // by slug
+	spaces  map[datastore.ID]*space      // data of every tenant, by tenant ID
+}
+
+var _ datastore.Store = (*Store)(nil)
+
+// New creates a new, empty in-memory store with only the default tenant
+func New() *Store {
+	return &Store{
+		tenants: map[string]*datastore.Tenant{
+			datastore.DefaultTenantSlug: {
+				ID:        datastore.DefaultTenantID,
+				Slug:      datastore.DefaultTenantSlug,
+				Name:      "Default",
+				CreatedAt: time.Now(),
+			},
+		},
+		spaces: map[datastore.ID]*space{
+			datastore.DefaultTenantID: newSpace(),
+		},
+	}
+}
+
+// Create creates a new blog entry with the given status and tags, and a slug
+// generated from the title
+func (s *Store) Create(ctx context.Context, title, content string, status datastore.Status, publishAt *time.Time, tags []string, opts ...datastore.CreateOption) (datastore.ID, error) {
+	return s.space(ctx).Create(ctx, title, content, status, publishAt, tags, opts...)
+}
+
+// Get retrieves a blog by ID with its comments
+func (s *Store) Get(ctx context.Context, id datastore.ID, opts ...datastore.GetOption) (*datastore.Blog, error) {
+	return s.space(ctx).Get(ctx, id, opts...)
+}
+
+// GetBySlug retrieves a blog by its current or a previous slug
+func (s *Store) GetBySlug(ctx context.Context, slug string, opts ...datastore.GetOption) (*datastore.Blog, error) {
+	return s.space(ctx).GetBySlug(ctx, slug, opts...)
+}
+
+// Update applies a patch to an existing blog, recording the previous version
+// as a revision when the title or content changes
+func (s *Store) Update(ctx context.Context, id datastore.ID, patch datastore.BlogPatch, editor string, version int64) error {
+	return s.space(ctx).Update(ctx, id, patch, editor, version)
+}
+
+// Delete moves a blog to the trash
+func (s *Store) Delete(ctx context.Context, id datastore.ID, version int64) error {
+	return s.space(ctx).Delete(ctx, id, version)
+}
+
+// Undelete moves a blog out of the trash
+func (s *Store) Undelete(ctx context.Context, id datastore.ID) error {
+	return s.space(ctx).Undelete(ctx, id)
+}
+
+// Purge permanently deletes a blog in the trash with its comments and revisions
+func (s *Store) Purge(ctx context.Context, id datastore.ID) error {
+	return s.space(ctx).Purge(ctx, id)
+}
+
+// List retrieves a paginated list of blog summaries matching the filter, ordered by ID
+func (s *Store) List(ctx context.Context, pageSize int32, pageToken string, filter datastore.ListFilter) ([]*datastore.BlogSummary, string, error) {
+	return s.space(ctx).List(ctx, pageSize, pageToken, filter)
+}
+
+// ListTags retrieves every tag of the blogs outside the trash with the given
+// status, along with how many blogs have it
+func (s *Store) ListTags(ctx context.Context, status datastore.Status) ([]*datastore.TagCount, error) {
+	return s.space(ctx).ListTags(ctx, status)
+}
+
+// Search retrieves a paginated list of the published blogs matching the
+// query, best matches first. Unlike PostgreSQL, words are matched exactly,
+// without stemming or stop words.
+func (s *Store) Search(ctx context.Context, query datastore.SearchQuery, pageSize int32, pageToken string) ([]*datastore.SearchResult, string, error) {
+	return s.space(ctx).Search(ctx, query, pageSize, pageToken)
+}
+
+// AddComment adds a comment to a blog, or a reply to one of its comments
+func (s *Store) AddComment(ctx context.Context, blogID datastore.ID, parentID *datastore.ID, content, author string, maxDepth int32, state datastore.CommentState, opts ...datastore.CommentOption) (*datastore.Comment, error) {
+	return s.space(ctx).AddComment(ctx, blogID, parentID, content, author, maxDepth, state, opts...)
+}
+
+// GetComment retrieves an approved comment of a blog
+func (s *Store) GetComment(ctx context.Context, blogID, id datastore.ID) (*datastore.Comment, error) {
+	return s.space(ctx).GetComment(ctx, blogID, id)
+}
+
+// UpdateComment replaces the content of a comment of a blog
+func (s *Store) UpdateComment(ctx context.Context, blogID, id datastore.ID, content string) error {
+	return s.space(ctx).UpdateComment(ctx, blogID, id, content)
+}
+
+// DeleteComment deletes a comment of a blog along with its replies
+func (s *Store) DeleteComment(ctx context.Context, blogID, id datastore.ID) error {
+	return s.space(ctx).DeleteComment(ctx, blogID, id)
+}
+
+// ListComments retrieves a paginated list of the approved comments of a
+// blog, oldest first
+func (s *Store) ListComments(ctx context.Context, blogID datastore.ID, pageSize int32, pageToken string) ([]*datastore.Comment, string, error) {
+	return s.space(ctx).ListComments(ctx, blogID, pageSize, pageToken)
+}
+
+// ListPendingComments retrieves a paginated list of the pending comments of
+// the blogs outside the trash, or of a single blog, oldest first
+func (s *Store) ListPendingComments(ctx context.Context, blogID *datastore.ID, pageSize int32, pageToken string) ([]*datastore.Comment, string, error) {
+	return s.space(ctx).ListPendingComments(ctx, blogID, pageSize, pageToken)
+}
+
+// ModerateComment sets the state of a comment of a blog, recording the
+// reason and the time
+func (s *Store) ModerateComment(ctx context.Context, blogID, id datastore.ID, state datastore.CommentState, reason string) error {
+	return s.space(ctx).ModerateComment(ctx, blogID, id, state, reason)
+}
+
+// ListRecentComments retrieves up to limit comments of the blogs outside the
+// trash created at or after since, whatever their state, newest first
+func (s *Store) ListRecentComments(ctx context.Context, since time.Time, limit int32) ([]*datastore.Comment, error) {
+	return s.space(ctx).ListRecentComments(ctx, since, limit)
+}
+
+// ListModeratedComments retrieves up to limit comments of the blogs outside
+// the trash that a moderator settled, most recently moderated first
+func (s *Store) ListModeratedComments(ctx context.Context, limit int32) ([]*datastore.Comment, error) {
+	return s.space(ctx).ListModeratedComments(ctx, limit)
+}
+
+// ListCommentVerdicts retrieves the verdicts recorded for a comment of a
+// blog, whatever its state, in the order they were given
+func (s *Store) ListCommentVerdicts(ctx context.Context, blogID, id datastore.ID) ([]*datastore.CommentVerdict, error) {
+	return s.space(ctx).ListCommentVerdicts(ctx, blogID, id)
+}
+
+// Publish publishes a blog, recording the publish time if it was not already
+// published
+func (s *Store) Publish(ctx context.Context, id datastore.ID) error {
+	return s.space(ctx).Publish(ctx, id)
+}
+
+// Unpublish moves a blog back to draft
+func (s *Store) Unpublish(ctx context.Context, id datastore.ID) error {
+	return s.space(ctx).Unpublish(ctx, id)
+}
+
+// ListRevisions retrieves a paginated list of the revisions of a blog, newest first
+func (s *Store) ListRevisions(ctx context.Context, blogID datastore.ID, pageSize int32, pageToken string) ([]*datastore.Revision, string, error) {
+	return s.space(ctx).ListRevisions(ctx, blogID, pageSize, pageToken)
+}
+
+// GetRevision retrieves a single revision of a blog
+func (s *Store) GetRevision(ctx context.Context, blogID datastore.ID, number int32) (*datastore.Revision, error) {
+	return s.space(ctx).GetRevision(ctx, blogID, number)
+}
+
+// RestoreRevision sets the title and content of a blog back to those of a
+// revision, recording the replaced version as a new revision
+func (s *Store) RestoreRevision(ctx context.Context, blogID datastore.ID, number int32, editor string) error {
+	return s.space(ctx).RestoreRevision(ctx, blogID, number, editor)
+}
+
+// CreateAPIKey stores a new API key by the hash of the key and returns it
+func (s *Store) CreateAPIKey(ctx context.Context, name, prefix, hash string, scopes []string, expiresAt *time.Time) (*datastore.APIKey, error) {
+	return s.space(ctx).CreateAPIKey(ctx, name, prefix, hash, scopes, expiresAt)
+}
+
+// ListAPIKeys retrieves the API keys, newest first, leaving out revoked keys
+// unless asked for
+func (s *Store) ListAPIKeys(ctx context.Context, showRevoked bool) ([]*datastore.APIKey, error) {
+	return s.space(ctx).ListAPIKeys(ctx, showRevoked)
+}
+
+// GetAPIKeyByHash retrieves the API key with the given hash, whether or not
+// it is still active
+func (s *Store) GetAPIKeyByHash(ctx context.Context, hash string) (*datastore.APIKey, error) {
+	return s.space(ctx).GetAPIKeyByHash(ctx, hash)
+}
+
+// TouchAPIKey records that an API key was used at the given time
+func (s *Store) TouchAPIKey(ctx context.Context, id datastore.ID, at time.Time) error {
+	return s.space(ctx).TouchAPIKey(ctx, id, at)
+}
+
+// RevokeAPIKey revokes an API key, keeping the time it was first revoked
+func (s *Store) RevokeAPIKey(ctx context.Context, id datastore.ID) error {
+	return s.space(ctx).RevokeAPIKey(ctx, id)
+}
+
+// CreateUser creates a user and returns it
+func (s *Store) CreateUser(ctx context.Context, displayName, bio, avatarURL string, opts ...datastore.UserOption) (*datastore.User, error) {
+	return s.space(ctx).CreateUser(ctx, displayName, bio, avatarURL, opts...)
+}
+
+// GetUser retrieves a user by ID
+func (s *Store) GetUser(ctx context.Context, id datastore.ID) (*datastore.User, error) {
+	return s.space(ctx).GetUser(ctx, id)
+}
+
+// GetUsers retrieves the users with the given IDs, leaving out IDs no user
+// has
+func (s *Store) GetUsers(ctx context.Context, ids []datastore.ID) ([]*datastore.User, error) {
+	return s.space(ctx).GetUsers(ctx, ids)
+}
+
+// UpdateUser applies a patch to an existing user
+func (s *Store) UpdateUser(ctx context.Context, id datastore.ID, patch datastore.UserPatch) error {
+	return s.space(ctx).UpdateUser(ctx, id, patch)
+}
+
+// ListUsers retrieves a paginated list of users, oldest first
+func (s *Store) ListUsers(ctx context.Context, pageSize int32, pageToken string) ([]*datastore.User, string, error) {
+	return s.space(ctx).ListUsers(ctx, pageSize, pageToken)
+}
+
+// ListAuditEvents retrieves a paginated list of the audit events matching
+// the filter, oldest first
+func (s *Store) ListAuditEvents(ctx context.Context, filter datastore.AuditFilter, pageSize int32, pageToken string) ([]*datastore.AuditEvent, string, error) {
+	return s.space(ctx).ListAuditEvents(ctx, filter, pageSize, pageToken)
+}
+
+// PurgeDeleted permanently deletes up to limit blogs of any tenant that were
+// moved to the trash no later than before, oldest first within each tenant
+func (s *Store) PurgeDeleted(ctx context.Context, before time.Time, limit int32) ([]datastore.ID, error) {
+	if limit <= 0 {
+		return nil, datastore.Invalid(datastore.ResourceBlog, "limit", fmt.Errorf("must be positive, got %d", limit))
+	}
+
+	ids := []datastore.ID{}
+	for _, sp := range s.allSpaces() {
+		if len(ids) == int(limit) {
+			break
+		}
+		purged, err := sp.PurgeDeleted(ctx, before, limit-int32(len(ids)))
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, purged...)
+	}
+	return ids, nil
+}
+
+// PublishScheduled publishes up to limit scheduled blogs of any tenant that
+// are due, in publish time order within each tenant
+func (s *Store) PublishScheduled(ctx context.Context, now time.Time, limit int32) ([]datastore.ID, error) {
+	if limit <= 0 {
+		return nil, datastore.Invalid(datastore.ResourceBlog, "limit", fmt.Errorf("must be positive, got %d", limit))
+	}
+
+	ids := []datastore.ID{}
+	for _, sp := range s.allSpaces() {
+		if len(ids) == int(limit) {
+			break
+		}
+		published, err := sp.PublishScheduled(ctx, now, limit-int32(len(ids)))
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, published...)
+	}
+	return ids, nil
+}
+
+// CreateTenant creates a tenant with the given slug and name and returns it
+func (s *Store) CreateTenant(ctx context.Context, slug, name string) (*datastore.Tenant, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if !datastore.ValidTenantSlug(slug) {
+		return nil, datastore.Invalid(datastore.ResourceTenant, "slug", fmt.Errorf("invalid slug %q", slug))
+	}
+	if name == "" {
+		return nil, datastore.Invalid(datastore.ResourceTenant, "name", errors.New("must not be empty"))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, taken := s.tenants[slug]; taken {
+		return nil, datastore.Conflict(datastore.ResourceTenant, datastore.ID(slug), fmt.Errorf("slug %q is taken", slug))
+	}
+	tenant := &datastore.Tenant{
+		ID:        datastore.ID(uuid.New().String()),
+		Slug:      slug,
+		Name:      name,
+		CreatedAt: time.Now(),
+	}
+	s.tenants[slug] = tenant
+	if _, ok := s.spaces[tenant.ID]; !ok {
+		s.spaces[tenant.ID] = newSpace()
+	}
+
+	cp := *tenant
+	return &cp, nil
+}
+
+// GetTenantBySlug retrieves a tenant by its slug
+func (s *Store) GetTenantBySlug(ctx context.Context, slug string) (*datastore.Tenant, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tenant, ok := s.tenants[slug]
+	if !ok {
+		return nil, datastore.NotFound(datastore.ResourceTenant, datastore.ID(slug))
+	}
+	cp := *tenant
+	return &cp, nil
+}
+
+// ListTenants retrieves every tenant, sorted by slug
+func (s *Store) ListTenants(ctx context.Context) ([]*datastore.Tenant, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tenants := make([]*datastore.Tenant, 0, len(s.tenants))
+	for _, tenant := range s.tenants {
+		cp := *tenant
+		tenants = append(tenants, &cp)
+	}
+	sort.Slice(tenants, func(i, j int) bool {
+		return tenants[i].Slug < tenants[j].Slug
+	})
+	return tenants, nil
+}
+
+// space returns the data of the tenant of ctx, creating it on first use
+func (s *Store) space(ctx context.Context) *space {
+	id := datastore.TenantID(ctx)
+
+	s.mu.RLock()
+	sp, ok := s.spaces[id]
+	s.mu.RUnlock()
+	if ok {
+		return sp
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if sp, ok := s.spaces[id]; ok {
+		return sp
+	}
+	sp = newSpace()
+	s.spaces[id] = sp
+	return sp
+}
+
+// allSpaces returns the data of every tenant, ordered by tenant ID
+func (s *Store) allSpaces() []*space {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ids := make([]datastore.ID, 0, len(s.spaces))
+	for id := range s.spaces {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	spaces := make([]*space, len(ids))
+	for i, id := range ids {
+		spaces[i] = s.spaces[id]
+	}
+	return spaces
+}
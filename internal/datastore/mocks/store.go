@@ -7,6 +7,8 @@ import (
 
 	datastore "github.com/agruetz/prosigliere/internal/datastore"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // Store is an autogenerated mock type for the Store type
@@ -14,27 +16,36 @@ type Store struct {
 	mock.Mock
 }
 
-// AddComment provides a mock function with given fields: ctx, blogID, content, author
-func (_m *Store) AddComment(ctx context.Context, blogID datastore.ID, content string, author string) (datastore.ID, error) {
-	ret := _m.Called(ctx, blogID, content, author)
+// AddComment provides a mock function with given fields: ctx, blogID, parentID, content, author, maxDepth, state, opts
+func (_m *Store) AddComment(ctx context.Context, blogID datastore.ID, parentID *datastore.ID, content string, author string, maxDepth int32, state datastore.CommentState, opts ...datastore.CommentOption) (*datastore.Comment, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, blogID, parentID, content, author, maxDepth, state)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AddComment")
 	}
 
-	var r0 datastore.ID
+	var r0 *datastore.Comment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, string, string) (datastore.ID, error)); ok {
-		return rf(ctx, blogID, content, author)
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, *datastore.ID, string, string, int32, datastore.CommentState, ...datastore.CommentOption) (*datastore.Comment, error)); ok {
+		return rf(ctx, blogID, parentID, content, author, maxDepth, state, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, string, string) datastore.ID); ok {
-		r0 = rf(ctx, blogID, content, author)
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, *datastore.ID, string, string, int32, datastore.CommentState, ...datastore.CommentOption) *datastore.Comment); ok {
+		r0 = rf(ctx, blogID, parentID, content, author, maxDepth, state, opts...)
 	} else {
-		r0 = ret.Get(0).(datastore.ID)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datastore.Comment)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, datastore.ID, string, string) error); ok {
-		r1 = rf(ctx, blogID, content, author)
+	if rf, ok := ret.Get(1).(func(context.Context, datastore.ID, *datastore.ID, string, string, int32, datastore.CommentState, ...datastore.CommentOption) error); ok {
+		r1 = rf(ctx, blogID, parentID, content, author, maxDepth, state, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -42,9 +53,16 @@ func (_m *Store) AddComment(ctx context.Context, blogID datastore.ID, content st
 	return r0, r1
 }
 
-// Create provides a mock function with given fields: ctx, title, content
-func (_m *Store) Create(ctx context.Context, title string, content string) (datastore.ID, error) {
-	ret := _m.Called(ctx, title, content)
+// Create provides a mock function with given fields: ctx, title, content, status, publishAt, tags, opts
+func (_m *Store) Create(ctx context.Context, title string, content string, status datastore.Status, publishAt *time.Time, tags []string, opts ...datastore.CreateOption) (datastore.ID, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, title, content, status, publishAt, tags)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
@@ -52,17 +70,77 @@ func (_m *Store) Create(ctx context.Context, title string, content string) (data
 
 	var r0 datastore.ID
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (datastore.ID, error)); ok {
-		return rf(ctx, title, content)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, datastore.Status, *time.Time, []string, ...datastore.CreateOption) (datastore.ID, error)); ok {
+		return rf(ctx, title, content, status, publishAt, tags, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) datastore.ID); ok {
-		r0 = rf(ctx, title, content)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, datastore.Status, *time.Time, []string, ...datastore.CreateOption) datastore.ID); ok {
+		r0 = rf(ctx, title, content, status, publishAt, tags, opts...)
 	} else {
 		r0 = ret.Get(0).(datastore.ID)
 	}
 
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, datastore.Status, *time.Time, []string, ...datastore.CreateOption) error); ok {
+		r1 = rf(ctx, title, content, status, publishAt, tags, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateAPIKey provides a mock function with given fields: ctx, name, prefix, hash, scopes, expiresAt
+func (_m *Store) CreateAPIKey(ctx context.Context, name string, prefix string, hash string, scopes []string, expiresAt *time.Time) (*datastore.APIKey, error) {
+	ret := _m.Called(ctx, name, prefix, hash, scopes, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAPIKey")
+	}
+
+	var r0 *datastore.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, []string, *time.Time) (*datastore.APIKey, error)); ok {
+		return rf(ctx, name, prefix, hash, scopes, expiresAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, []string, *time.Time) *datastore.APIKey); ok {
+		r0 = rf(ctx, name, prefix, hash, scopes, expiresAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datastore.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, []string, *time.Time) error); ok {
+		r1 = rf(ctx, name, prefix, hash, scopes, expiresAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateTenant provides a mock function with given fields: ctx, slug, name
+func (_m *Store) CreateTenant(ctx context.Context, slug string, name string) (*datastore.Tenant, error) {
+	ret := _m.Called(ctx, slug, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTenant")
+	}
+
+	var r0 *datastore.Tenant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*datastore.Tenant, error)); ok {
+		return rf(ctx, slug, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *datastore.Tenant); ok {
+		r0 = rf(ctx, slug, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datastore.Tenant)
+		}
+	}
+
 	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, title, content)
+		r1 = rf(ctx, slug, name)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -70,17 +148,54 @@ func (_m *Store) Create(ctx context.Context, title string, content string) (data
 	return r0, r1
 }
 
-// Delete provides a mock function with given fields: ctx, id
-func (_m *Store) Delete(ctx context.Context, id datastore.ID) error {
-	ret := _m.Called(ctx, id)
+// CreateUser provides a mock function with given fields: ctx, displayName, bio, avatarURL, opts
+func (_m *Store) CreateUser(ctx context.Context, displayName string, bio string, avatarURL string, opts ...datastore.UserOption) (*datastore.User, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, displayName, bio, avatarURL)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUser")
+	}
+
+	var r0 *datastore.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, ...datastore.UserOption) (*datastore.User, error)); ok {
+		return rf(ctx, displayName, bio, avatarURL, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, ...datastore.UserOption) *datastore.User); ok {
+		r0 = rf(ctx, displayName, bio, avatarURL, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datastore.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, ...datastore.UserOption) error); ok {
+		r1 = rf(ctx, displayName, bio, avatarURL, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, id, version
+func (_m *Store) Delete(ctx context.Context, id datastore.ID, version int64) error {
+	ret := _m.Called(ctx, id, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, int64) error); ok {
+		r0 = rf(ctx, id, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -88,9 +203,34 @@ func (_m *Store) Delete(ctx context.Context, id datastore.ID) error {
 	return r0
 }
 
-// Get provides a mock function with given fields: ctx, id
-func (_m *Store) Get(ctx context.Context, id datastore.ID) (*datastore.Blog, error) {
-	ret := _m.Called(ctx, id)
+// DeleteComment provides a mock function with given fields: ctx, blogID, id
+func (_m *Store) DeleteComment(ctx context.Context, blogID datastore.ID, id datastore.ID) error {
+	ret := _m.Called(ctx, blogID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, datastore.ID) error); ok {
+		r0 = rf(ctx, blogID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, id, opts
+func (_m *Store) Get(ctx context.Context, id datastore.ID, opts ...datastore.GetOption) (*datastore.Blog, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Get")
@@ -98,14 +238,201 @@ func (_m *Store) Get(ctx context.Context, id datastore.ID) (*datastore.Blog, err
 
 	var r0 *datastore.Blog
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID) (*datastore.Blog, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, ...datastore.GetOption) (*datastore.Blog, error)); ok {
+		return rf(ctx, id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, ...datastore.GetOption) *datastore.Blog); ok {
+		r0 = rf(ctx, id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datastore.Blog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, datastore.ID, ...datastore.GetOption) error); ok {
+		r1 = rf(ctx, id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAPIKeyByHash provides a mock function with given fields: ctx, hash
+func (_m *Store) GetAPIKeyByHash(ctx context.Context, hash string) (*datastore.APIKey, error) {
+	ret := _m.Called(ctx, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAPIKeyByHash")
+	}
+
+	var r0 *datastore.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*datastore.APIKey, error)); ok {
+		return rf(ctx, hash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *datastore.APIKey); ok {
+		r0 = rf(ctx, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datastore.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBySlug provides a mock function with given fields: ctx, slug, opts
+func (_m *Store) GetBySlug(ctx context.Context, slug string, opts ...datastore.GetOption) (*datastore.Blog, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, slug)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBySlug")
+	}
+
+	var r0 *datastore.Blog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...datastore.GetOption) (*datastore.Blog, error)); ok {
+		return rf(ctx, slug, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...datastore.GetOption) *datastore.Blog); ok {
+		r0 = rf(ctx, slug, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datastore.Blog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...datastore.GetOption) error); ok {
+		r1 = rf(ctx, slug, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetComment provides a mock function with given fields: ctx, blogID, id
+func (_m *Store) GetComment(ctx context.Context, blogID datastore.ID, id datastore.ID) (*datastore.Comment, error) {
+	ret := _m.Called(ctx, blogID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetComment")
+	}
+
+	var r0 *datastore.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, datastore.ID) (*datastore.Comment, error)); ok {
+		return rf(ctx, blogID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, datastore.ID) *datastore.Comment); ok {
+		r0 = rf(ctx, blogID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datastore.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, datastore.ID, datastore.ID) error); ok {
+		r1 = rf(ctx, blogID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRevision provides a mock function with given fields: ctx, blogID, number
+func (_m *Store) GetRevision(ctx context.Context, blogID datastore.ID, number int32) (*datastore.Revision, error) {
+	ret := _m.Called(ctx, blogID, number)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevision")
+	}
+
+	var r0 *datastore.Revision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, int32) (*datastore.Revision, error)); ok {
+		return rf(ctx, blogID, number)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, int32) *datastore.Revision); ok {
+		r0 = rf(ctx, blogID, number)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datastore.Revision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, datastore.ID, int32) error); ok {
+		r1 = rf(ctx, blogID, number)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTenantBySlug provides a mock function with given fields: ctx, slug
+func (_m *Store) GetTenantBySlug(ctx context.Context, slug string) (*datastore.Tenant, error) {
+	ret := _m.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenantBySlug")
+	}
+
+	var r0 *datastore.Tenant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*datastore.Tenant, error)); ok {
+		return rf(ctx, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *datastore.Tenant); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datastore.Tenant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUser provides a mock function with given fields: ctx, id
+func (_m *Store) GetUser(ctx context.Context, id datastore.ID) (*datastore.User, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 *datastore.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID) (*datastore.User, error)); ok {
 		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID) *datastore.Blog); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID) *datastore.User); ok {
 		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datastore.Blog)
+			r0 = ret.Get(0).(*datastore.User)
 		}
 	}
 
@@ -118,9 +445,39 @@ func (_m *Store) Get(ctx context.Context, id datastore.ID) (*datastore.Blog, err
 	return r0, r1
 }
 
-// List provides a mock function with given fields: ctx, pageSize, pageToken
-func (_m *Store) List(ctx context.Context, pageSize int32, pageToken string) ([]*datastore.BlogSummary, string, error) {
-	ret := _m.Called(ctx, pageSize, pageToken)
+// GetUsers provides a mock function with given fields: ctx, ids
+func (_m *Store) GetUsers(ctx context.Context, ids []datastore.ID) ([]*datastore.User, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsers")
+	}
+
+	var r0 []*datastore.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []datastore.ID) ([]*datastore.User, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []datastore.ID) []*datastore.User); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datastore.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []datastore.ID) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, pageSize, pageToken, filter
+func (_m *Store) List(ctx context.Context, pageSize int32, pageToken string, filter datastore.ListFilter) ([]*datastore.BlogSummary, string, error) {
+	ret := _m.Called(ctx, pageSize, pageToken, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for List")
@@ -129,25 +486,25 @@ func (_m *Store) List(ctx context.Context, pageSize int32, pageToken string) ([]
 	var r0 []*datastore.BlogSummary
 	var r1 string
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32, string) ([]*datastore.BlogSummary, string, error)); ok {
-		return rf(ctx, pageSize, pageToken)
+	if rf, ok := ret.Get(0).(func(context.Context, int32, string, datastore.ListFilter) ([]*datastore.BlogSummary, string, error)); ok {
+		return rf(ctx, pageSize, pageToken, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32, string) []*datastore.BlogSummary); ok {
-		r0 = rf(ctx, pageSize, pageToken)
+	if rf, ok := ret.Get(0).(func(context.Context, int32, string, datastore.ListFilter) []*datastore.BlogSummary); ok {
+		r0 = rf(ctx, pageSize, pageToken, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*datastore.BlogSummary)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32, string) string); ok {
-		r1 = rf(ctx, pageSize, pageToken)
+	if rf, ok := ret.Get(1).(func(context.Context, int32, string, datastore.ListFilter) string); ok {
+		r1 = rf(ctx, pageSize, pageToken, filter)
 	} else {
 		r1 = ret.Get(1).(string)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, int32, string) error); ok {
-		r2 = rf(ctx, pageSize, pageToken)
+	if rf, ok := ret.Get(2).(func(context.Context, int32, string, datastore.ListFilter) error); ok {
+		r2 = rf(ctx, pageSize, pageToken, filter)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -155,17 +512,659 @@ func (_m *Store) List(ctx context.Context, pageSize int32, pageToken string) ([]
 	return r0, r1, r2
 }
 
-// Update provides a mock function with given fields: ctx, id, title, content
-func (_m *Store) Update(ctx context.Context, id datastore.ID, title *string, content *string) error {
-	ret := _m.Called(ctx, id, title, content)
+// ListAPIKeys provides a mock function with given fields: ctx, showRevoked
+func (_m *Store) ListAPIKeys(ctx context.Context, showRevoked bool) ([]*datastore.APIKey, error) {
+	ret := _m.Called(ctx, showRevoked)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for ListAPIKeys")
+	}
+
+	var r0 []*datastore.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) ([]*datastore.APIKey, error)); ok {
+		return rf(ctx, showRevoked)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool) []*datastore.APIKey); ok {
+		r0 = rf(ctx, showRevoked)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datastore.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = rf(ctx, showRevoked)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAuditEvents provides a mock function with given fields: ctx, filter, pageSize, pageToken
+func (_m *Store) ListAuditEvents(ctx context.Context, filter datastore.AuditFilter, pageSize int32, pageToken string) ([]*datastore.AuditEvent, string, error) {
+	ret := _m.Called(ctx, filter, pageSize, pageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuditEvents")
+	}
+
+	var r0 []*datastore.AuditEvent
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.AuditFilter, int32, string) ([]*datastore.AuditEvent, string, error)); ok {
+		return rf(ctx, filter, pageSize, pageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.AuditFilter, int32, string) []*datastore.AuditEvent); ok {
+		r0 = rf(ctx, filter, pageSize, pageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datastore.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, datastore.AuditFilter, int32, string) string); ok {
+		r1 = rf(ctx, filter, pageSize, pageToken)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, datastore.AuditFilter, int32, string) error); ok {
+		r2 = rf(ctx, filter, pageSize, pageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListCommentVerdicts provides a mock function with given fields: ctx, blogID, id
+func (_m *Store) ListCommentVerdicts(ctx context.Context, blogID datastore.ID, id datastore.ID) ([]*datastore.CommentVerdict, error) {
+	ret := _m.Called(ctx, blogID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCommentVerdicts")
+	}
+
+	var r0 []*datastore.CommentVerdict
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, datastore.ID) ([]*datastore.CommentVerdict, error)); ok {
+		return rf(ctx, blogID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, datastore.ID) []*datastore.CommentVerdict); ok {
+		r0 = rf(ctx, blogID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datastore.CommentVerdict)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, datastore.ID, datastore.ID) error); ok {
+		r1 = rf(ctx, blogID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListComments provides a mock function with given fields: ctx, blogID, pageSize, pageToken
+func (_m *Store) ListComments(ctx context.Context, blogID datastore.ID, pageSize int32, pageToken string) ([]*datastore.Comment, string, error) {
+	ret := _m.Called(ctx, blogID, pageSize, pageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListComments")
+	}
+
+	var r0 []*datastore.Comment
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, int32, string) ([]*datastore.Comment, string, error)); ok {
+		return rf(ctx, blogID, pageSize, pageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, int32, string) []*datastore.Comment); ok {
+		r0 = rf(ctx, blogID, pageSize, pageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datastore.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, datastore.ID, int32, string) string); ok {
+		r1 = rf(ctx, blogID, pageSize, pageToken)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, datastore.ID, int32, string) error); ok {
+		r2 = rf(ctx, blogID, pageSize, pageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListModeratedComments provides a mock function with given fields: ctx, limit
+func (_m *Store) ListModeratedComments(ctx context.Context, limit int32) ([]*datastore.Comment, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListModeratedComments")
+	}
+
+	var r0 []*datastore.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]*datastore.Comment, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []*datastore.Comment); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datastore.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPendingComments provides a mock function with given fields: ctx, blogID, pageSize, pageToken
+func (_m *Store) ListPendingComments(ctx context.Context, blogID *datastore.ID, pageSize int32, pageToken string) ([]*datastore.Comment, string, error) {
+	ret := _m.Called(ctx, blogID, pageSize, pageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPendingComments")
+	}
+
+	var r0 []*datastore.Comment
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datastore.ID, int32, string) ([]*datastore.Comment, string, error)); ok {
+		return rf(ctx, blogID, pageSize, pageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datastore.ID, int32, string) []*datastore.Comment); ok {
+		r0 = rf(ctx, blogID, pageSize, pageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datastore.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datastore.ID, int32, string) string); ok {
+		r1 = rf(ctx, blogID, pageSize, pageToken)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *datastore.ID, int32, string) error); ok {
+		r2 = rf(ctx, blogID, pageSize, pageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListRecentComments provides a mock function with given fields: ctx, since, limit
+func (_m *Store) ListRecentComments(ctx context.Context, since time.Time, limit int32) ([]*datastore.Comment, error) {
+	ret := _m.Called(ctx, since, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRecentComments")
+	}
+
+	var r0 []*datastore.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int32) ([]*datastore.Comment, error)); ok {
+		return rf(ctx, since, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int32) []*datastore.Comment); ok {
+		r0 = rf(ctx, since, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datastore.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int32) error); ok {
+		r1 = rf(ctx, since, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRevisions provides a mock function with given fields: ctx, blogID, pageSize, pageToken
+func (_m *Store) ListRevisions(ctx context.Context, blogID datastore.ID, pageSize int32, pageToken string) ([]*datastore.Revision, string, error) {
+	ret := _m.Called(ctx, blogID, pageSize, pageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRevisions")
+	}
+
+	var r0 []*datastore.Revision
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, int32, string) ([]*datastore.Revision, string, error)); ok {
+		return rf(ctx, blogID, pageSize, pageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, int32, string) []*datastore.Revision); ok {
+		r0 = rf(ctx, blogID, pageSize, pageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datastore.Revision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, datastore.ID, int32, string) string); ok {
+		r1 = rf(ctx, blogID, pageSize, pageToken)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, datastore.ID, int32, string) error); ok {
+		r2 = rf(ctx, blogID, pageSize, pageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListTags provides a mock function with given fields: ctx, status
+func (_m *Store) ListTags(ctx context.Context, status datastore.Status) ([]*datastore.TagCount, error) {
+	ret := _m.Called(ctx, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTags")
+	}
+
+	var r0 []*datastore.TagCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.Status) ([]*datastore.TagCount, error)); ok {
+		return rf(ctx, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.Status) []*datastore.TagCount); ok {
+		r0 = rf(ctx, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datastore.TagCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, datastore.Status) error); ok {
+		r1 = rf(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTenants provides a mock function with given fields: ctx
+func (_m *Store) ListTenants(ctx context.Context) ([]*datastore.Tenant, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTenants")
+	}
+
+	var r0 []*datastore.Tenant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*datastore.Tenant, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*datastore.Tenant); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datastore.Tenant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUsers provides a mock function with given fields: ctx, pageSize, pageToken
+func (_m *Store) ListUsers(ctx context.Context, pageSize int32, pageToken string) ([]*datastore.User, string, error) {
+	ret := _m.Called(ctx, pageSize, pageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUsers")
+	}
+
+	var r0 []*datastore.User
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, string) ([]*datastore.User, string, error)); ok {
+		return rf(ctx, pageSize, pageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, string) []*datastore.User); ok {
+		r0 = rf(ctx, pageSize, pageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datastore.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, string) string); ok {
+		r1 = rf(ctx, pageSize, pageToken)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int32, string) error); ok {
+		r2 = rf(ctx, pageSize, pageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ModerateComment provides a mock function with given fields: ctx, blogID, id, state, reason
+func (_m *Store) ModerateComment(ctx context.Context, blogID datastore.ID, id datastore.ID, state datastore.CommentState, reason string) error {
+	ret := _m.Called(ctx, blogID, id, state, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ModerateComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, datastore.ID, datastore.CommentState, string) error); ok {
+		r0 = rf(ctx, blogID, id, state, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Publish provides a mock function with given fields: ctx, id
+func (_m *Store) Publish(ctx context.Context, id datastore.ID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PublishScheduled provides a mock function with given fields: ctx, now, limit
+func (_m *Store) PublishScheduled(ctx context.Context, now time.Time, limit int32) ([]datastore.ID, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishScheduled")
+	}
+
+	var r0 []datastore.ID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int32) ([]datastore.ID, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int32) []datastore.ID); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datastore.ID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int32) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Purge provides a mock function with given fields: ctx, id
+func (_m *Store) Purge(ctx context.Context, id datastore.ID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PurgeDeleted provides a mock function with given fields: ctx, before, limit
+func (_m *Store) PurgeDeleted(ctx context.Context, before time.Time, limit int32) ([]datastore.ID, error) {
+	ret := _m.Called(ctx, before, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeleted")
+	}
+
+	var r0 []datastore.ID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int32) ([]datastore.ID, error)); ok {
+		return rf(ctx, before, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int32) []datastore.ID); ok {
+		r0 = rf(ctx, before, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datastore.ID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int32) error); ok {
+		r1 = rf(ctx, before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreRevision provides a mock function with given fields: ctx, blogID, number, editor
+func (_m *Store) RestoreRevision(ctx context.Context, blogID datastore.ID, number int32, editor string) error {
+	ret := _m.Called(ctx, blogID, number, editor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreRevision")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, int32, string) error); ok {
+		r0 = rf(ctx, blogID, number, editor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeAPIKey provides a mock function with given fields: ctx, id
+func (_m *Store) RevokeAPIKey(ctx context.Context, id datastore.ID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAPIKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Search provides a mock function with given fields: ctx, query, pageSize, pageToken
+func (_m *Store) Search(ctx context.Context, query datastore.SearchQuery, pageSize int32, pageToken string) ([]*datastore.SearchResult, string, error) {
+	ret := _m.Called(ctx, query, pageSize, pageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []*datastore.SearchResult
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.SearchQuery, int32, string) ([]*datastore.SearchResult, string, error)); ok {
+		return rf(ctx, query, pageSize, pageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.SearchQuery, int32, string) []*datastore.SearchResult); ok {
+		r0 = rf(ctx, query, pageSize, pageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datastore.SearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, datastore.SearchQuery, int32, string) string); ok {
+		r1 = rf(ctx, query, pageSize, pageToken)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, datastore.SearchQuery, int32, string) error); ok {
+		r2 = rf(ctx, query, pageSize, pageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// TouchAPIKey provides a mock function with given fields: ctx, id, at
+func (_m *Store) TouchAPIKey(ctx context.Context, id datastore.ID, at time.Time) error {
+	ret := _m.Called(ctx, id, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchAPIKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, time.Time) error); ok {
+		r0 = rf(ctx, id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Undelete provides a mock function with given fields: ctx, id
+func (_m *Store) Undelete(ctx context.Context, id datastore.ID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Undelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Unpublish provides a mock function with given fields: ctx, id
+func (_m *Store) Unpublish(ctx context.Context, id datastore.ID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unpublish")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, id, patch, editor, version
+func (_m *Store) Update(ctx context.Context, id datastore.ID, patch datastore.BlogPatch, editor string, version int64) error {
+	ret := _m.Called(ctx, id, patch, editor, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, datastore.BlogPatch, string, int64) error); ok {
+		r0 = rf(ctx, id, patch, editor, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateComment provides a mock function with given fields: ctx, blogID, id, content
+func (_m *Store) UpdateComment(ctx context.Context, blogID datastore.ID, id datastore.ID, content string) error {
+	ret := _m.Called(ctx, blogID, id, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, datastore.ID, string) error); ok {
+		r0 = rf(ctx, blogID, id, content)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateUser provides a mock function with given fields: ctx, id, patch
+func (_m *Store) UpdateUser(ctx context.Context, id datastore.ID, patch datastore.UserPatch) error {
+	ret := _m.Called(ctx, id, patch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUser")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, *string, *string) error); ok {
-		r0 = rf(ctx, id, title, content)
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.ID, datastore.UserPatch) error); ok {
+		r0 = rf(ctx, id, patch)
 	} else {
 		r0 = ret.Error(0)
 	}
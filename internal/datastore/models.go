@@ -2,34 +2,536 @@
 package datastore
 
 import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
 	"time"
 )
 
 // ID represents a UUID used as an identifier
 type ID string
 
+// Status represents the lifecycle status of a blog
+type Status string
+
+// Blog statuses, matching the post_status enum in the database
+const (
+	StatusDraft     Status = "draft"
+	StatusScheduled Status = "scheduled"
+	StatusPublished Status = "published"
+	StatusArchived  Status = "archived"
+)
+
+// Valid reports whether s is a known status
+func (s Status) Valid() bool {
+	switch s {
+	case StatusDraft, StatusScheduled, StatusPublished, StatusArchived:
+		return true
+	}
+	return false
+}
+
 // Blog represents a blog entry in the database
 type Blog struct {
-	ID        ID        `db:"id"`
-	Title     string    `db:"title"`
-	Content   string    `db:"content"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
-	Comments  []Comment
+	ID            ID            `db:"id"`
+	Title         string        `db:"title"`
+	Content       string        `db:"content"`
+	CreatedAt     time.Time     `db:"created_at"`
+	UpdatedAt     time.Time     `db:"updated_at"`
+	Status        Status        `db:"status"`
+	PublishedAt   *time.Time    `db:"published_at"` // nil if the blog was never published
+	PublishAt     *time.Time    `db:"publish_at"`   // only set while the blog is scheduled
+	Version       int64         `db:"version"`      // incremented by every change to the blog
+	DeletedAt     *time.Time    `db:"deleted_at"`   // only set while the blog is in the trash
+	Tags          []string      // sorted by name
+	Slug          string        `db:"slug"`           // current slug, previous slugs stay in use as aliases
+	CommentCount  int32         `db:"comment_count"`  // all approved comments, even if fewer were read
+	CommentPolicy CommentPolicy `db:"comment_policy"` // empty to follow the server default
+	Owner         string        `db:"owner"`          // subject of the principal that created the blog, empty if unknown
+	AuthorID      *ID           `db:"author_id"`      // user who wrote the blog, nil if not given
+	Comments      []Comment
+}
+
+// wordsPattern matches lower case words joined by dashes, as used by tags
+// and slugs
+var wordsPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// MaxTagLen is the maximum length of a tag name
+const MaxTagLen = 50
+
+// ValidTag reports whether name is a valid tag name
+func ValidTag(name string) bool {
+	return len(name) <= MaxTagLen && wordsPattern.MatchString(name)
+}
+
+// NormalizeTags validates tag names and returns them sorted without
+// duplicates, as stores keep them
+func NormalizeTags(tags []string) ([]string, error) {
+	for _, tag := range tags {
+		if !ValidTag(tag) {
+			return nil, Invalid(ResourceBlog, "tags", fmt.Errorf("invalid tag %q", tag))
+		}
+	}
+	normalized := slices.Clone(tags)
+	slices.Sort(normalized)
+	return slices.Compact(normalized), nil
+}
+
+// TagCount is a tag with the number of blogs that have it
+type TagCount struct {
+	Name      string `db:"name"`
+	BlogCount int32  `db:"blog_count"`
+}
+
+// MaxSlugLen is the maximum length of a slug
+const MaxSlugLen = 100
+
+// maxSlugBaseLen leaves room for the suffix that makes a generated slug unique
+const maxSlugBaseLen = MaxSlugLen - 10
+
+// DefaultSlug is the slug of blogs whose title has no letters or digits
+const DefaultSlug = "post"
+
+// ValidSlug reports whether slug is a valid slug
+func ValidSlug(slug string) bool {
+	return len(slug) <= MaxSlugLen && wordsPattern.MatchString(slug)
+}
+
+// Slugify turns a title into a slug by lower casing it and joining its words
+// of letters and digits with dashes. Long titles are cut at a word boundary.
+func Slugify(title string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		if ('a' <= r && r <= 'z') || ('0' <= r && r <= '9') {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+		} else {
+			dash = true
+		}
+	}
+
+	slug := b.String()
+	if len(slug) > maxSlugBaseLen {
+		// Cut at the last dash that keeps the slug short enough, which may
+		// directly follow the limit
+		if i := strings.LastIndexByte(slug[:maxSlugBaseLen+1], '-'); i > 0 {
+			slug = slug[:i]
+		} else {
+			slug = slug[:maxSlugBaseLen]
+		}
+	}
+	if slug == "" {
+		return DefaultSlug
+	}
+	return slug
+}
+
+// UniqueSlug returns base if it is not taken, or else base with the lowest
+// numeric suffix from 2 up that is not taken
+func UniqueSlug(base string, taken func(slug string) bool) string {
+	slug := base
+	for n := 2; taken(slug); n++ {
+		slug = fmt.Sprintf("%s-%d", base, n)
+	}
+	return slug
+}
+
+// CommentState represents the moderation state of a comment
+type CommentState string
+
+// Comment states, matching the comment_state enum in the database
+const (
+	CommentStatePending  CommentState = "pending"
+	CommentStateApproved CommentState = "approved"
+	CommentStateRejected CommentState = "rejected"
+	CommentStateSpam     CommentState = "spam"
+)
+
+// Valid reports whether s is a known comment state
+func (s CommentState) Valid() bool {
+	switch s {
+	case CommentStatePending, CommentStateApproved, CommentStateRejected, CommentStateSpam:
+		return true
+	}
+	return false
+}
+
+// CommentPolicy represents whether new comments on a blog need approval
+type CommentPolicy string
+
+// Comment policies, matching the comment_policy enum in the database. The
+// empty policy follows the server default.
+const (
+	CommentPolicyDefault   CommentPolicy = ""
+	CommentPolicyOpen      CommentPolicy = "open"
+	CommentPolicyModerated CommentPolicy = "moderated"
+)
+
+// Valid reports whether p is a known comment policy
+func (p CommentPolicy) Valid() bool {
+	switch p {
+	case CommentPolicyDefault, CommentPolicyOpen, CommentPolicyModerated:
+		return true
+	}
+	return false
 }
 
 // Comment represents a comment in the database
 type Comment struct {
-	ID        ID        `db:"id"`
+	ID               ID           `db:"id"`
+	BlogID           ID           `db:"blog_id"`
+	ParentID         *ID          `db:"parent_id"` // comment replied to, nil for top-level comments
+	Depth            int32        `db:"depth"`     // 0 for top-level comments, one more than the parent for replies
+	Content          string       `db:"content"`
+	Author           string       `db:"author"`
+	CreatedAt        time.Time    `db:"created_at"`
+	UpdatedAt        *time.Time   `db:"updated_at"` // nil if the comment was never edited
+	State            CommentState `db:"state"`
+	ModerationReason string       `db:"moderation_reason"`
+	ModeratedAt      *time.Time   `db:"moderated_at"` // nil if the comment was never moderated
+	AuthorID         *ID          `db:"author_id"`    // user who wrote the comment, nil for guests
+}
+
+// User is the profile of an author of blogs and comments
+type User struct {
+	ID          ID        `db:"id"`
+	DisplayName string    `db:"display_name"`
+	Bio         string    `db:"bio"`
+	AvatarURL   string    `db:"avatar_url"`
+	Owner       string    `db:"owner"` // subject of the principal that created the user, empty if unknown
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+// UserPatch describes the changes UpdateUser makes to a user. Nil fields are
+// left unchanged, so the zero value changes nothing.
+type UserPatch struct {
+	DisplayName *string
+	Bio         *string
+	AvatarURL   *string
+}
+
+// CommentVerdict records what a comment filter made of a new comment
+type CommentVerdict struct {
+	CommentID  ID           `db:"comment_id"`
+	Classifier string       `db:"classifier"` // name of the filter that gave the verdict
+	State      CommentState `db:"state"`      // state the filter asked for, empty if it had no objection
+	Reason     string       `db:"reason"`
+	Score      float64      `db:"score"` // confidence of the filter, its meaning depends on the filter
+	CreatedAt  time.Time    `db:"created_at"`
+}
+
+// APIKey is a key clients authenticate with. Only a hash of the key itself
+// is stored.
+type APIKey struct {
+	ID         ID         `db:"id"`
+	Name       string     `db:"name"`
+	Prefix     string     `db:"prefix"`   // first characters of the key, to tell keys apart
+	Hash       string     `db:"key_hash"` // hex-encoded SHA-256 hash of the key
+	Scopes     []string   `db:"scopes"`
+	CreatedAt  time.Time  `db:"created_at"`
+	ExpiresAt  *time.Time `db:"expires_at"`   // nil if the key does not expire
+	LastUsedAt *time.Time `db:"last_used_at"` // nil if the key was never used
+	RevokedAt  *time.Time `db:"revoked_at"`   // set once the key is revoked
+}
+
+// Active reports whether an API key is neither revoked nor expired at now
+func (k *APIKey) Active(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
+
+// CommentPageToken returns the page token for the comments after the given
+// one, which are ordered by creation time and ID
+func CommentPageToken(comment *Comment) string {
+	return createdPageToken(comment.CreatedAt, comment.ID)
+}
+
+// ParseCommentPageToken returns the creation time and ID of the comment a
+// page token was created for
+func ParseCommentPageToken(token string) (time.Time, ID, error) {
+	return parseCreatedPageToken(ResourceComment, token)
+}
+
+// createdPageToken returns the page token for the rows after the one with
+// the given creation time and ID
+func createdPageToken(createdAt time.Time, id ID) string {
+	return createdAt.UTC().Format(time.RFC3339Nano) + "/" + string(id)
+}
+
+// parseCreatedPageToken returns the creation time and ID a page token of a
+// resource was created for
+func parseCreatedPageToken(resource, token string) (time.Time, ID, error) {
+	createdText, id, ok := strings.Cut(token, "/")
+	if !ok || id == "" {
+		return time.Time{}, "", Invalid(resource, "page_token", fmt.Errorf("invalid page token %q", token))
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, createdText)
+	if err != nil {
+		return time.Time{}, "", Invalid(resource, "page_token", fmt.Errorf("invalid page token %q", token))
+	}
+	return createdAt, ID(id), nil
+}
+
+// Revision represents a previous version of a blog, recorded when an update
+// replaced its title or content
+type Revision struct {
 	BlogID    ID        `db:"blog_id"`
+	Number    int32     `db:"number"`
+	Title     string    `db:"title"`
 	Content   string    `db:"content"`
-	Author    string    `db:"author"`
-	CreatedAt time.Time `db:"created_at"`
+	Editor    string    `db:"editor"`
+	CreatedAt time.Time `db:"created_at"` // when the update replaced this version
+}
+
+// RevisionID identifies a revision of a blog in errors
+func RevisionID(blogID ID, number int32) ID {
+	return ID(fmt.Sprintf("%s/revisions/%d", blogID, number))
 }
 
 // BlogSummary represents a summary of a blog entry
 type BlogSummary struct {
-	ID           ID     `db:"id"`
-	Title        string `db:"title"`
-	CommentCount int32  `db:"comment_count"`
+	ID           ID         `db:"id"`
+	Title        string     `db:"title"`
+	CommentCount int32      `db:"comment_count"`
+	Status       Status     `db:"status"`
+	DeletedAt    *time.Time `db:"deleted_at"` // only set while the blog is in the trash
+	Slug         string     `db:"slug"`
+}
+
+// DeletedFilter selects blogs by whether they are in the trash
+type DeletedFilter int
+
+// Deleted filters, the zero value hides trashed blogs
+const (
+	ExcludeDeleted DeletedFilter = iota
+	IncludeDeleted
+	OnlyDeleted
+)
+
+// ListFilter restricts the blogs returned by List. The zero value matches
+// every blog that is not in the trash.
+type ListFilter struct {
+	// Status only matches blogs with this status, if set
+	Status Status
+
+	// Deleted selects blogs by whether they are in the trash
+	Deleted DeletedFilter
+
+	// Tag only matches blogs with this tag, if set
+	Tag string
+
+	// Owner only matches blogs owned by the principal with this subject, if
+	// set
+	Owner string
+}
+
+// SearchTerm is a word or phrase a blog must contain to match a search
+type SearchTerm struct {
+	Words  []string // lower case words that follow each other in the text
+	Prefix bool     // the last word only needs to start a word of the text
+}
+
+// SearchQuery describes a full-text search of the published blogs that are
+// not in the trash. A blog matches if its title and content contain every
+// term, or if one of its comments does and IncludeComments is set.
+type SearchQuery struct {
+	Terms           []SearchTerm
+	IncludeComments bool
+}
+
+// Snippet highlights, wrapped around the matched words of a search snippet
+const (
+	SnippetStart = "<b>"
+	SnippetStop  = "</b>"
+)
+
+// SearchResult is a blog matching a search
+type SearchResult struct {
+	BlogSummary
+	Rank    float32 // relevance of the match, higher is better
+	Snippet string  // excerpt of the matched text with highlighted matches
+}
+
+// BlogPatch describes the changes Update makes to a blog. Nil fields are left
+// unchanged, so the zero value changes nothing.
+type BlogPatch struct {
+	Title         *string
+	Content       *string
+	Status        *Status
+	PublishAt     *time.Time     // schedules the blog
+	Tags          *[]string      // replaces every tag of the blog
+	Slug          *string        // keeps the previous slug as an alias
+	CommentPolicy *CommentPolicy // the default policy resets the blog to the server default
+}
+
+// CreateOption changes how Create creates a blog
+type CreateOption func(*CreateOptions)
+
+// CreateOptions holds the settings of a Create call
+type CreateOptions struct {
+	// Owner is the subject of the principal creating the blog
+	Owner string
+
+	// AuthorID is the user who wrote the blog, if any
+	AuthorID *ID
+}
+
+// WithOwner records the subject of the principal creating the blog as its
+// owner
+func WithOwner(owner string) CreateOption {
+	return func(o *CreateOptions) {
+		o.Owner = owner
+	}
+}
+
+// WithAuthor records the user who wrote the blog, who must exist
+func WithAuthor(id ID) CreateOption {
+	return func(o *CreateOptions) {
+		o.AuthorID = &id
+	}
+}
+
+// NewCreateOptions applies opts to the zero CreateOptions
+func NewCreateOptions(opts ...CreateOption) CreateOptions {
+	var o CreateOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// CommentOption changes how AddComment adds a comment
+type CommentOption func(*CommentOptions)
+
+// CommentOptions holds the settings of an AddComment call
+type CommentOptions struct {
+	// AuthorID is the user who wrote the comment, nil for guests
+	AuthorID *ID
+
+	// Verdicts are the verdicts of the comment filters on the comment
+	Verdicts []CommentVerdict
+}
+
+// WithCommentAuthor records the user who wrote the comment, who must exist
+func WithCommentAuthor(id ID) CommentOption {
+	return func(o *CommentOptions) {
+		o.AuthorID = &id
+	}
+}
+
+// WithCommentVerdicts records the verdicts of the comment filters on the
+// comment along with it, in the order they were given
+func WithCommentVerdicts(verdicts []CommentVerdict) CommentOption {
+	return func(o *CommentOptions) {
+		o.Verdicts = verdicts
+	}
+}
+
+// NewCommentOptions applies opts to the zero CommentOptions
+func NewCommentOptions(opts ...CommentOption) CommentOptions {
+	var o CommentOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// UserOption changes how CreateUser creates a user
+type UserOption func(*UserOptions)
+
+// UserOptions holds the settings of a CreateUser call
+type UserOptions struct {
+	// Owner is the subject of the principal creating the user
+	Owner string
+}
+
+// WithUserOwner records the subject of the principal creating the user as
+// its owner
+func WithUserOwner(owner string) UserOption {
+	return func(o *UserOptions) {
+		o.Owner = owner
+	}
+}
+
+// NewUserOptions applies opts to the zero UserOptions
+func NewUserOptions(opts ...UserOption) UserOptions {
+	var o UserOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// UserPageToken returns the page token for the users after the given one,
+// which are ordered by creation time and ID
+func UserPageToken(user *User) string {
+	return createdPageToken(user.CreatedAt, user.ID)
+}
+
+// ParseUserPageToken returns the creation time and ID of the user a page
+// token was created for
+func ParseUserPageToken(token string) (time.Time, ID, error) {
+	return parseCreatedPageToken(ResourceUser, token)
+}
+
+// GetOption changes what Get reads
+type GetOption func(*GetOptions)
+
+// GetOptions holds the settings of a Get call. The zero value reads the whole
+// blog.
+type GetOptions struct {
+	// SkipContent leaves the content of the blog empty
+	SkipContent bool
+
+	// SkipComments leaves the comments of the blog empty
+	SkipComments bool
+
+	// CommentLimit reads at most this many comments, oldest first, if
+	// positive
+	CommentLimit int32
+
+	// ShowDeleted also finds the blog if it is in the trash
+	ShowDeleted bool
+}
+
+// WithoutContent skips reading the content of the blog
+func WithoutContent() GetOption {
+	return func(o *GetOptions) {
+		o.SkipContent = true
+	}
+}
+
+// WithoutComments skips reading the comments of the blog
+func WithoutComments() GetOption {
+	return func(o *GetOptions) {
+		o.SkipComments = true
+	}
+}
+
+// WithCommentLimit reads at most limit comments, oldest first
+func WithCommentLimit(limit int32) GetOption {
+	return func(o *GetOptions) {
+		o.CommentLimit = limit
+	}
+}
+
+// WithDeleted also finds the blog if it is in the trash
+func WithDeleted() GetOption {
+	return func(o *GetOptions) {
+		o.ShowDeleted = true
+	}
+}
+
+// NewGetOptions applies opts to the zero GetOptions
+func NewGetOptions(opts ...GetOption) GetOptions {
+	var o GetOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
 }
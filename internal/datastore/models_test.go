@@ -0,0 +1,67 @@
+package datastore_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+)
+
+func TestSlugify(t *testing.T) {
+	long := strings.Repeat("word ", 30)
+
+	tests := []struct {
+		name     string
+		title    string
+		expected string
+	}{
+		{
+			name:     "words",
+			title:    "Growing Tomatoes in Pots",
+			expected: "growing-tomatoes-in-pots",
+		},
+		{
+			name:     "punctuation and spaces",
+			title:    "  Hello, World! (Part 2)  ",
+			expected: "hello-world-part-2",
+		},
+		{
+			name:     "underscores and dashes",
+			title:    "snake_case -- and - dashes",
+			expected: "snake-case-and-dashes",
+		},
+		{
+			name:     "no letters or digits",
+			title:    "!?",
+			expected: datastore.DefaultSlug,
+		},
+		{
+			name:     "long title cut at a word boundary",
+			title:    long,
+			expected: strings.TrimSuffix(strings.Repeat("word-", 18), "-"),
+		},
+		{
+			name:     "long word cut",
+			title:    strings.Repeat("a", 120),
+			expected: strings.Repeat("a", 90),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slug := datastore.Slugify(tt.title)
+			assert.Equal(t, tt.expected, slug)
+			assert.True(t, datastore.ValidSlug(slug))
+		})
+	}
+}
+
+func TestUniqueSlug(t *testing.T) {
+	taken := map[string]bool{"hello": true, "hello-2": true, "hello-4": true}
+	isTaken := func(slug string) bool { return taken[slug] }
+
+	assert.Equal(t, "world", datastore.UniqueSlug("world", isTaken))
+	assert.Equal(t, "hello-3", datastore.UniqueSlug("hello", isTaken))
+}
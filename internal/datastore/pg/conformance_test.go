@@ -0,0 +1,46 @@
+package pg_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/agruetz/prosigliere/db"
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/datastore/pg"
+	"github.com/agruetz/prosigliere/internal/datastore/storetest"
+	"github.com/agruetz/prosigliere/internal/migrate"
+)
+
+// TestConformance runs the shared datastore suite against a real PostgreSQL
+// database. It is skipped unless PROSIGLIERE_TEST_DATABASE_URL points at a
+// database, which is migrated first, and whose tables are truncated and whose
+// tenants other than the default one are deleted before every test. Run it
+// with make test-conformance.
+func TestConformance(t *testing.T) {
+	dsn := os.Getenv("PROSIGLIERE_TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("PROSIGLIERE_TEST_DATABASE_URL not set")
+	}
+
+	conn, err := sql.Open("postgres", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	require.NoError(t, conn.Ping())
+
+	migrator, err := migrate.New(conn, db.Migrations())
+	require.NoError(t, err)
+	_, err = migrator.Migrate(context.Background())
+	require.NoError(t, err)
+
+	storetest.Run(t, func(t *testing.T) datastore.Store {
+		_, err := conn.Exec("TRUNCATE blogs, comments, comment_verdicts, revisions, tags, blog_tags, slugs, api_keys, users, audit_events")
+		require.NoError(t, err)
+		_, err = conn.Exec("DELETE FROM tenants WHERE id <> $1", string(datastore.DefaultTenantID))
+		require.NoError(t, err)
+		return pg.NewWithDB(conn)
+	})
+}
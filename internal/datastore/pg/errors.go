@@ -0,0 +1,78 @@
+// Package pg provides a PostgreSQL implementation of the datastore.Store interface
+package pg
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/lib/pq"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+)
+
+// translateError converts a database error into one of the datastore error
+// kinds. Errors that cannot be classified are returned unchanged.
+func translateError(resource string, id datastore.ID, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var dsErr *datastore.Error
+	if errors.As(err, &dsErr) {
+		return err
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	if errors.Is(err, driver.ErrBadConn) {
+		return datastore.Unavailable(err)
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return datastore.Unavailable(err)
+	}
+
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+
+	switch pqErr.Code.Class() {
+	case "08", // connection exception
+		"53", // insufficient resources
+		"57": // operator intervention
+		return datastore.Unavailable(err)
+	}
+
+	switch pqErr.Code.Name() {
+	case "unique_violation", "exclusion_violation":
+		return datastore.Conflict(resource, id, err)
+	case "foreign_key_violation":
+		return datastore.NotFound(resource, id)
+	case "check_violation", "not_null_violation":
+		return datastore.Invalid(resource, constraintField(pqErr), err)
+	case "string_data_right_truncation", "invalid_text_representation":
+		return datastore.Invalid(resource, pqErr.Column, err)
+	}
+
+	return err
+}
+
+// constraintField derives the column name from a constraint error. Postgres
+// names implicit column constraints "<table>_<column>_<suffix>".
+func constraintField(pqErr *pq.Error) string {
+	if pqErr.Column != "" {
+		return pqErr.Column
+	}
+	name := strings.TrimPrefix(pqErr.Constraint, pqErr.Table+"_")
+	if idx := strings.LastIndex(name, "_"); idx > 0 {
+		return name[:idx]
+	}
+	return name
+}
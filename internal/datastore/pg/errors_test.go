@@ -0,0 +1,157 @@
+package pg_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/datastore/pg"
+)
+
+func TestErrorKinds(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name          string
+		call          func(store *pg.Store) error
+		mockSetup     func(mock sqlmock.Sqlmock)
+		expectedKind  error
+		expectedField string
+	}{
+		{
+			name: "get missing blog",
+			call: func(store *pg.Store) error {
+				_, err := store.Get(context.Background(), "missing-id")
+				return err
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id, title, content, created_at, updated_at, status, published_at, publish_at, version, deleted_at, slug, ARRAY\\(SELECT t.name .+\\) AS tags, \\(SELECT COUNT\\(\\*\\) FROM comments c WHERE c.blog_id = blogs.id AND c.state = 'approved'\\) AS comment_count, comment_policy, COALESCE\\(owner, ''\\) AS owner, author_id FROM blogs").
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedKind: datastore.ErrNotFound,
+		},
+		{
+			name: "delete missing blog",
+			call: func(store *pg.Store) error {
+				return store.Delete(context.Background(), "missing-id", 0)
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "missing-id")
+				mock.ExpectExec("UPDATE blogs SET deleted_at = NOW()").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectedKind: datastore.ErrNotFound,
+		},
+		{
+			name: "connection failure",
+			call: func(store *pg.Store) error {
+				_, err := store.Get(context.Background(), "test-id")
+				return err
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id, title, content, created_at, updated_at, status, published_at, publish_at, version, deleted_at, slug, ARRAY\\(SELECT t.name .+\\) AS tags, \\(SELECT COUNT\\(\\*\\) FROM comments c WHERE c.blog_id = blogs.id AND c.state = 'approved'\\) AS comment_count, comment_policy, COALESCE\\(owner, ''\\) AS owner, author_id FROM blogs").
+					WillReturnError(&pq.Error{Code: "08006"})
+			},
+			expectedKind: datastore.ErrUnavailable,
+		},
+		{
+			name: "network failure",
+			call: func(store *pg.Store) error {
+				return store.Delete(context.Background(), "test-id", 0)
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec("UPDATE blogs SET deleted_at = NOW()").
+					WillReturnError(&net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")})
+				mock.ExpectRollback()
+			},
+			expectedKind: datastore.ErrUnavailable,
+		},
+		{
+			name: "check constraint violation",
+			call: func(store *pg.Store) error {
+				_, err := store.Create(context.Background(), "Bad <title>", "Test Content", datastore.StatusPublished, nil, nil)
+				return err
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectClaimNewSlug(mock, "bad-title", nil, "bad-title")
+				mock.ExpectExec("INSERT INTO blogs").
+					WillReturnError(&pq.Error{Code: "23514", Table: "blogs", Constraint: "blogs_title_check"})
+				mock.ExpectRollback()
+			},
+			expectedKind:  datastore.ErrInvalid,
+			expectedField: "title",
+		},
+		{
+			name: "unique violation",
+			call: func(store *pg.Store) error {
+				_, err := store.Create(context.Background(), "Test Title", "Test Content", datastore.StatusPublished, nil, nil)
+				return err
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectClaimNewSlug(mock, "test-title", nil, "test-title")
+				mock.ExpectExec("INSERT INTO blogs").
+					WillReturnError(&pq.Error{Code: "23505", Table: "blogs", Constraint: "blogs_pkey"})
+				mock.ExpectRollback()
+			},
+			expectedKind: datastore.ErrConflict,
+		},
+		{
+			name: "comment on concurrently deleted blog",
+			call: func(store *pg.Store) error {
+				_, err := store.AddComment(context.Background(), "test-blog-id", nil, "Test Comment", "Test Author", 0, datastore.CommentStateApproved)
+				return err
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT 1 FROM blogs WHERE id = ?").
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(1))
+				mock.ExpectBegin()
+				mock.ExpectQuery("INSERT INTO comments").
+					WillReturnError(&pq.Error{Code: "23503", Table: "comments", Constraint: "comments_blog_id_fkey"})
+				mock.ExpectRollback()
+			},
+			expectedKind: datastore.ErrNotFound,
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			err = tc.call(store)
+
+			// Assert expectations
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tc.expectedKind), "unexpected error kind: %v", err)
+			if tc.expectedField != "" {
+				var dsErr *datastore.Error
+				require.True(t, errors.As(err, &dsErr))
+				assert.Equal(t, tc.expectedField, dsErr.Field)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -14,6 +14,18 @@ type Option func(*config)
 
 // New creates a new PostgreSQL store with the provided options
 func New(opts ...Option) (*Store, error) {
+	db, err := Open(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Open opens and verifies a PostgreSQL connection pool with the provided
+// options. It is used by New and by tools that need the raw connection,
+// such as the schema migrator.
+func Open(opts ...Option) (*sql.DB, error) {
 	// Start with default options
 	cfg := defaultConfig()
 
@@ -45,7 +57,7 @@ func New(opts ...Option) (*Store, error) {
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
-	return &Store{db: db}, nil
+	return db, nil
 }
 
 // NewWithDB creates a new PostgreSQL store with the provided database connection
@@ -4,96 +4,161 @@ package pg
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/search"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
-// Create creates a new blog entry
-func (s *Store) Create(ctx context.Context, title, content string) (datastore.ID, error) {
+// Create creates a new blog entry with the given status and tags
+func (s *Store) Create(ctx context.Context, title, content string, status datastore.Status, publishAt *time.Time, tags []string, opts ...datastore.CreateOption) (datastore.ID, error) {
+	options := datastore.NewCreateOptions(opts...)
+	if err := validateStatus(status); err != nil {
+		return "", err
+	}
+	if (status == datastore.StatusScheduled) != (publishAt != nil) {
+		return "", datastore.Invalid(datastore.ResourceBlog, "publish_at", errPublishAt)
+	}
+	tags, err := datastore.NormalizeTags(tags)
+	if err != nil {
+		return "", err
+	}
+
 	id := uuid.New().String()
 	query := `
-		INSERT INTO blogs (id, title, content)
-		VALUES ($1, $2, $3)
+		INSERT INTO blogs (id, title, content, status, published_at, publish_at, slug, owner, author_id, tenant_id)
+		VALUES ($1, $2, $3, $4::post_status, CASE WHEN $4::post_status = 'published' THEN NOW() END, $5, $6, NULLIF($7, ''), $8, $9)
 	`
-	_, err := s.db.ExecContext(ctx, query, id, title, content)
+	err = s.inTx(ctx, func(tx *sql.Tx) error {
+		if err := checkAuthor(ctx, tx, options.AuthorID); err != nil {
+			return err
+		}
+		slug, err := claimNewSlug(ctx, tx, datastore.ID(id), datastore.Slugify(title))
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, query, id, title, content, string(status), publishAt, slug, options.Owner, options.AuthorID, tenantID(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to create blog: %w", translateError(datastore.ResourceBlog, "", err))
+		}
+		if len(tags) > 0 {
+			if err := setTags(ctx, tx, datastore.ID(id), tags); err != nil {
+				return err
+			}
+		}
+		after, err := snapshotBlog(ctx, tx, datastore.ID(id))
+		if err != nil {
+			return err
+		}
+		return recordAudit(ctx, tx, datastore.AuditCreate, datastore.ResourceBlog, datastore.ID(id), nil, after)
+	})
 	if err != nil {
-		return "", fmt.Errorf("failed to create blog: %w", err)
+		return "", err
 	}
 	return datastore.ID(id), nil
 }
 
-// Get retrieves a blog by ID with its comments
-func (s *Store) Get(ctx context.Context, id datastore.ID) (*datastore.Blog, error) {
-	// First get the blog
+// Get retrieves a blog by ID with its approved comments
+func (s *Store) Get(ctx context.Context, id datastore.ID, opts ...datastore.GetOption) (*datastore.Blog, error) {
+	options := datastore.NewGetOptions(opts...)
+
+	// First get the blog, leaving out the content if it is not wanted
+	contentColumn := "content"
+	if options.SkipContent {
+		contentColumn = "'' AS content"
+	}
 	query := `
-		SELECT id, title, content, created_at, updated_at
+		SELECT ` + blogColumns(contentColumn) + `
 		FROM blogs
-		WHERE id = $1
+		WHERE id = $1 AND tenant_id = $2
 	`
-	var blog datastore.Blog
-	var createdAt, updatedAt time.Time
-
-	err := s.db.QueryRowContext(ctx, query, string(id)).Scan(
-		&blog.ID, &blog.Title, &blog.Content, &createdAt, &updatedAt,
-	)
-
+	if !options.ShowDeleted {
+		query += ` AND deleted_at IS NULL`
+	}
+	blog, err := scanBlog(s.db.QueryRowContext(ctx, query, string(id), tenantID(ctx)))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, fmt.Errorf("blog not found: %w", err)
+			return nil, datastore.NotFound(datastore.ResourceBlog, id)
 		}
-		return nil, fmt.Errorf("failed to get blog: %w", err)
+		return nil, fmt.Errorf("failed to get blog: %w", translateError(datastore.ResourceBlog, id, err))
 	}
 
-	blog.CreatedAt = createdAt
-	blog.UpdatedAt = updatedAt
+	// Initialize the Comments slice
+	blog.Comments = []datastore.Comment{}
+	if options.SkipComments {
+		return blog, nil
+	}
 
-	// Now fetch the comments for this blog
+	// Now fetch the approved comments for this blog
 	commentsQuery := `
-		SELECT id, blog_id, content, author, created_at
+		SELECT ` + commentColumns + `
 		FROM comments
-		WHERE blog_id = $1
-		ORDER BY created_at
+		WHERE blog_id = $1 AND tenant_id = $2 AND state = 'approved'
+		ORDER BY created_at, id
 	`
+	args := []interface{}{string(id), tenantID(ctx)}
+	if options.CommentLimit > 0 {
+		commentsQuery += ` LIMIT $3`
+		args = append(args, options.CommentLimit)
+	}
 
-	rows, err := s.db.QueryContext(ctx, commentsQuery, string(id))
+	rows, err := s.db.QueryContext(ctx, commentsQuery, args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch comments: %w", err)
+		return nil, fmt.Errorf("failed to fetch comments: %w", translateError(datastore.ResourceComment, "", err))
 	}
 	defer rows.Close()
 
-	// Initialize the Comments slice
-	blog.Comments = []datastore.Comment{}
-
 	// Iterate through the comments and add them to the blog
 	for rows.Next() {
-		var comment datastore.Comment
-		var commentCreatedAt time.Time
-
-		err = rows.Scan(
-			&comment.ID, &comment.BlogID, &comment.Content, &comment.Author, &commentCreatedAt,
-		)
+		comment, err := scanComment(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan comment: %w", err)
 		}
-
-		comment.CreatedAt = commentCreatedAt
-		blog.Comments = append(blog.Comments, comment)
+		blog.Comments = append(blog.Comments, *comment)
 	}
 
 	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating comments: %w", err)
+		return nil, fmt.Errorf("error iterating comments: %w", translateError(datastore.ResourceComment, "", err))
 	}
 
-	return &blog, nil
+	return blog, nil
+}
+
+// GetBySlug retrieves a blog by its current or a previous slug
+func (s *Store) GetBySlug(ctx context.Context, slug string, opts ...datastore.GetOption) (*datastore.Blog, error) {
+	var id datastore.ID
+	err := s.db.QueryRowContext(ctx, `SELECT blog_id FROM slugs WHERE slug = $1 AND tenant_id = $2`, slug, tenantID(ctx)).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, datastore.NotFound(datastore.ResourceBlog, datastore.ID(slug))
+		}
+		return nil, fmt.Errorf("failed to get blog: %w", translateError(datastore.ResourceBlog, datastore.ID(slug), err))
+	}
+	return s.Get(ctx, id, opts...)
 }
 
-// Update updates an existing blog
-func (s *Store) Update(ctx context.Context, id datastore.ID, title, content *string) error {
+// Update applies a patch to an existing blog, recording the previous version
+// as a revision when the title or content changes
+func (s *Store) Update(ctx context.Context, id datastore.ID, patch datastore.BlogPatch, editor string, version int64) error {
+	title, content, status, publishAt, tags, slug, commentPolicy := patch.Title, patch.Content, patch.Status, patch.PublishAt, patch.Tags, patch.Slug, patch.CommentPolicy
+
+	// Setting a publish time schedules the blog
+	if publishAt != nil {
+		if status != nil && *status != datastore.StatusScheduled {
+			return datastore.Invalid(datastore.ResourceBlog, "publish_at", errPublishAt)
+		}
+		scheduled := datastore.StatusScheduled
+		status = &scheduled
+	}
+
 	// Build the query dynamically based on which fields are provided
 	query := "UPDATE blogs SET"
 	args := []interface{}{}
@@ -112,73 +177,315 @@ func (s *Store) Update(ctx context.Context, id datastore.ID, title, content *str
 		paramCount++
 	}
 
+	if status != nil {
+		if err := validateStatus(*status); err != nil {
+			return err
+		}
+		// The right-hand side sees the old status, so the publish time is only
+		// recorded when the blog becomes published
+		updateParts = append(updateParts, fmt.Sprintf(
+			" status = $%[1]d::post_status, published_at = CASE WHEN $%[1]d::post_status = 'published' AND status <> 'published' THEN NOW() ELSE published_at END",
+			paramCount,
+		))
+		args = append(args, string(*status))
+		paramCount++
+	}
+
+	if publishAt != nil {
+		updateParts = append(updateParts, fmt.Sprintf(" publish_at = $%d", paramCount))
+		args = append(args, *publishAt)
+		paramCount++
+	} else if status != nil && *status != datastore.StatusScheduled {
+		updateParts = append(updateParts, " publish_at = NULL")
+	}
+
+	if slug != nil {
+		if !datastore.ValidSlug(*slug) {
+			return datastore.Invalid(datastore.ResourceBlog, "slug", fmt.Errorf("invalid slug %q", *slug))
+		}
+		updateParts = append(updateParts, fmt.Sprintf(" slug = $%d", paramCount))
+		args = append(args, *slug)
+		paramCount++
+	}
+
+	if commentPolicy != nil {
+		if !commentPolicy.Valid() {
+			return datastore.Invalid(datastore.ResourceBlog, "comment_policy", fmt.Errorf("unknown comment policy %q", *commentPolicy))
+		}
+		// The default policy is stored as NULL
+		updateParts = append(updateParts, fmt.Sprintf(" comment_policy = NULLIF($%d, '')::comment_policy", paramCount))
+		args = append(args, string(*commentPolicy))
+		paramCount++
+	}
+
+	if tags != nil {
+		normalized, err := datastore.NormalizeTags(*tags)
+		if err != nil {
+			return err
+		}
+		tags = &normalized
+
+		// Tags live in their own table, but changing them still changes the
+		// blog and its version
+		if len(updateParts) == 0 {
+			updateParts = append(updateParts, " updated_at = NOW()")
+		}
+	}
+
 	if len(updateParts) == 0 {
 		return nil // Nothing to update
 	}
 
 	query += strings.Join(updateParts, ",")
 
-	// Add WHERE clause
-	query += fmt.Sprintf(" WHERE id = $%d", paramCount)
-	args = append(args, string(id))
+	// Add WHERE clause, leaving blogs in the trash alone
+	query += fmt.Sprintf(" WHERE id = $%d AND tenant_id = $%d AND deleted_at IS NULL", paramCount, paramCount+1)
+	args = append(args, string(id), tenantID(ctx))
+	paramCount += 2
 
-	result, err := s.db.ExecContext(ctx, query, args...)
-	if err != nil {
-		return fmt.Errorf("failed to update blog: %w", err)
+	// Only update the version the caller expects
+	if version != 0 {
+		query += fmt.Sprintf(" AND version = $%d", paramCount)
+		args = append(args, version)
 	}
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
-	}
+	// The blog is locked while reading it before the change, so the audit
+	// event records exactly what the update replaced
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		before, err := snapshotBlog(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if title != nil || content != nil {
+			if _, err := recordRevision(ctx, tx, id, editor); err != nil {
+				return err
+			}
+		}
+		if slug != nil {
+			claimed, err := claimSlug(ctx, tx, id, *slug)
+			if err != nil {
+				return err
+			}
+			if !claimed {
+				return datastore.Conflict(datastore.ResourceBlog, id, fmt.Errorf("slug %q is taken", *slug))
+			}
+		}
+		if err := updateBlog(ctx, tx, id, version, query, args...); err != nil {
+			return err
+		}
+		if tags != nil {
+			if err := setTags(ctx, tx, id, *tags); err != nil {
+				return err
+			}
+		}
+		after, err := snapshotBlog(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		return recordAudit(ctx, tx, datastore.AuditUpdate, datastore.ResourceBlog, id, before, after)
+	})
+}
 
-	if rowsAffected == 0 {
-		return fmt.Errorf("blog not found")
+// Delete moves a blog to the trash
+func (s *Store) Delete(ctx context.Context, id datastore.ID, version int64) error {
+	query := `UPDATE blogs SET deleted_at = NOW() WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+	args := []interface{}{string(id), tenantID(ctx)}
+	if version != 0 {
+		query += ` AND version = $3`
+		args = append(args, version)
 	}
 
-	return nil
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		before, err := snapshotBlog(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		result, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("failed to delete blog: %w", translateError(datastore.ResourceBlog, id, err))
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+
+		if rowsAffected == 0 {
+			return missingOrChanged(ctx, tx, id, version)
+		}
+
+		after, err := snapshotBlog(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		return recordAudit(ctx, tx, datastore.AuditDelete, datastore.ResourceBlog, id, before, after)
+	})
 }
 
-// Delete deletes a blog and its comments
-func (s *Store) Delete(ctx context.Context, id datastore.ID) error {
-	// Comments will be deleted automatically due to ON DELETE CASCADE
-	query := `DELETE FROM blogs WHERE id = $1`
-	result, err := s.db.ExecContext(ctx, query, string(id))
-	if err != nil {
-		return fmt.Errorf("failed to delete blog: %w", err)
-	}
+// Undelete moves a blog out of the trash
+func (s *Store) Undelete(ctx context.Context, id datastore.ID) error {
+	query := `UPDATE blogs SET deleted_at = NULL WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NOT NULL`
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		before, err := snapshotBlog(ctx, tx, id)
+		if err != nil {
+			return err
+		}
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+		result, err := tx.ExecContext(ctx, query, string(id), tenantID(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to undelete blog: %w", translateError(datastore.ResourceBlog, id, err))
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+
+		if rowsAffected == 0 {
+			return datastore.NotFound(datastore.ResourceBlog, id)
+		}
+
+		after, err := snapshotBlog(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		return recordAudit(ctx, tx, datastore.AuditUndelete, datastore.ResourceBlog, id, before, after)
+	})
+}
+
+// Purge permanently deletes a blog in the trash with its comments and revisions
+func (s *Store) Purge(ctx context.Context, id datastore.ID) error {
+	// Comments and revisions will be deleted automatically due to ON DELETE CASCADE
+	query := `DELETE FROM blogs WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NOT NULL`
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		before, err := snapshotBlog(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		result, err := tx.ExecContext(ctx, query, string(id), tenantID(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to purge blog: %w", translateError(datastore.ResourceBlog, id, err))
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+
+		if rowsAffected == 0 {
+			return datastore.NotFound(datastore.ResourceBlog, id)
+		}
+
+		return recordAudit(ctx, tx, datastore.AuditPurge, datastore.ResourceBlog, id, before, nil)
+	})
+}
+
+// PurgeDeleted permanently deletes up to limit blogs of any tenant that were
+// moved to the trash no later than before. Like PublishScheduled, due blogs
+// are locked with SKIP LOCKED so concurrent purgers pick different blogs.
+func (s *Store) PurgeDeleted(ctx context.Context, before time.Time, limit int32) ([]datastore.ID, error) {
+	if limit <= 0 {
+		return nil, datastore.Invalid(datastore.ResourceBlog, "limit", fmt.Errorf("must be positive, got %d", limit))
 	}
 
-	if rowsAffected == 0 {
-		return fmt.Errorf("blog not found")
+	query := `
+		SELECT ` + blogColumns("content") + `, tenant_id
+		FROM blogs
+		WHERE deleted_at <= $1
+		ORDER BY deleted_at
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	`
+	var ids []datastore.ID
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		due, err := queryTenantBlogs(ctx, tx, "failed to find deleted blogs", query, before, limit)
+		if err != nil {
+			return err
+		}
+
+		if len(due) == 0 {
+			return nil
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM blogs WHERE id = ANY($1::uuid[])`, pq.Array(tenantBlogIDs(due))); err != nil {
+			return fmt.Errorf("failed to purge deleted blogs: %w", translateError(datastore.ResourceBlog, "", err))
+		}
+
+		for _, blog := range due {
+			if err := insertAudit(ctx, tx, blog.tenantID, datastore.AuditPurge, datastore.ResourceBlog, blog.ID, datastore.BlogSnapshot(blog.Blog), nil); err != nil {
+				return err
+			}
+			ids = append(ids, blog.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return ids, nil
 }
 
-// List retrieves a paginated list of blog summaries
-func (s *Store) List(ctx context.Context, pageSize int32, pageToken string) ([]*datastore.BlogSummary, string, error) {
+// List retrieves a paginated list of blog summaries matching the filter
+func (s *Store) List(ctx context.Context, pageSize int32, pageToken string, filter datastore.ListFilter) ([]*datastore.BlogSummary, string, error) {
+	if pageSize <= 0 {
+		return nil, "", datastore.Invalid(datastore.ResourceBlog, "page_size", fmt.Errorf("must be positive, got %d", pageSize))
+	}
+
 	query := `
-		SELECT b.id, b.title, COUNT(c.id) as comment_count
+		SELECT b.id, b.title, b.status, COUNT(c.id) as comment_count, b.deleted_at, b.slug
 		FROM blogs b
-		LEFT JOIN comments c ON b.id = c.blog_id
+		LEFT JOIN comments c ON b.id = c.blog_id AND c.state = 'approved'
 	`
-	args := []interface{}{}
-	paramCount := 1
+	args := []interface{}{tenantID(ctx)}
+	paramCount := 2
+	conditions := []string{"b.tenant_id = $1"}
+
+	switch filter.Deleted {
+	case datastore.IncludeDeleted:
+	case datastore.OnlyDeleted:
+		conditions = append(conditions, "b.deleted_at IS NOT NULL")
+	default:
+		conditions = append(conditions, "b.deleted_at IS NULL")
+	}
+
+	if filter.Status != "" {
+		if err := validateStatus(filter.Status); err != nil {
+			return nil, "", err
+		}
+		conditions = append(conditions, fmt.Sprintf("b.status = $%d::post_status", paramCount))
+		args = append(args, string(filter.Status))
+		paramCount++
+	}
+
+	if filter.Tag != "" {
+		conditions = append(conditions, fmt.Sprintf(
+			"b.id IN (SELECT bt.blog_id FROM blog_tags bt JOIN tags t ON t.id = bt.tag_id WHERE t.name = $%d)",
+			paramCount,
+		))
+		args = append(args, filter.Tag)
+		paramCount++
+	}
+
+	if filter.Owner != "" {
+		conditions = append(conditions, fmt.Sprintf("b.owner = $%d", paramCount))
+		args = append(args, filter.Owner)
+		paramCount++
+	}
 
 	// Add pagination if pageToken is provided
 	if pageToken != "" {
-		query += fmt.Sprintf(" WHERE b.id > $%d", paramCount)
+		conditions = append(conditions, fmt.Sprintf("b.id > $%d", paramCount))
 		args = append(args, pageToken)
 		paramCount++
 	}
 
+	query += " WHERE " + strings.Join(conditions, " AND ")
+
 	query += `
-		GROUP BY b.id, b.title
+		GROUP BY b.id, b.title, b.status, b.deleted_at, b.slug
 		ORDER BY b.id
 		LIMIT $` + fmt.Sprintf("%d", paramCount)
 
@@ -186,58 +493,1767 @@ func (s *Store) List(ctx context.Context, pageSize int32, pageToken string) ([]*
 
 	rows, err := s.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, "", fmt.Errorf("failed to list blogs: %w", err)
+		return nil, "", fmt.Errorf("failed to list blogs: %w", translateError(datastore.ResourceBlog, "", err))
 	}
 	defer rows.Close()
 
 	var summaries []*datastore.BlogSummary
 	for rows.Next() {
 		var summary datastore.BlogSummary
-		err := rows.Scan(&summary.ID, &summary.Title, &summary.CommentCount)
+		var deletedAt sql.NullTime
+		err := rows.Scan(&summary.ID, &summary.Title, &summary.Status, &summary.CommentCount, &deletedAt, &summary.Slug)
 		if err != nil {
 			return nil, "", fmt.Errorf("failed to scan blog summary: %w", err)
 		}
+		if deletedAt.Valid {
+			summary.DeletedAt = &deletedAt.Time
+		}
 		summaries = append(summaries, &summary)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, "", fmt.Errorf("error iterating blog summaries: %w", err)
+		return nil, "", fmt.Errorf("error iterating blog summaries: %w", translateError(datastore.ResourceBlog, "", err))
 	}
 
 	// Handle pagination
 	var nextPageToken string
 	if len(summaries) > int(pageSize) {
-		// We fetched one extra result, so there are more pages
-		nextPageToken = string(summaries[len(summaries)-1].ID)
+		// We fetched one extra result, so there are more pages. The next page
+		// starts after the last result we return, not after the extra one.
 		summaries = summaries[:len(summaries)-1] // Remove the extra result
+		nextPageToken = string(summaries[len(summaries)-1].ID)
 	}
 
 	return summaries, nextPageToken, nil
 }
 
-// AddComment adds a comment to a blog
-func (s *Store) AddComment(ctx context.Context, blogID datastore.ID, content, author string) (datastore.ID, error) {
+// ListTags retrieves every tag of the blogs outside the trash with the given
+// status, along with how many blogs have it
+func (s *Store) ListTags(ctx context.Context, status datastore.Status) ([]*datastore.TagCount, error) {
+	query := `
+		SELECT t.name, COUNT(*) AS blog_count
+		FROM tags t
+		JOIN blog_tags bt ON bt.tag_id = t.id
+		JOIN blogs b ON b.id = bt.blog_id
+		WHERE b.tenant_id = $1 AND b.deleted_at IS NULL
+	`
+	args := []interface{}{tenantID(ctx)}
+	if status != "" {
+		if err := validateStatus(status); err != nil {
+			return nil, err
+		}
+		query += ` AND b.status = $2::post_status`
+		args = append(args, string(status))
+	}
+	query += `
+		GROUP BY t.name
+		ORDER BY t.name
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", translateError(datastore.ResourceBlog, "", err))
+	}
+	defer rows.Close()
+
+	tags := []*datastore.TagCount{}
+	for rows.Next() {
+		var tag datastore.TagCount
+		if err := rows.Scan(&tag.Name, &tag.BlogCount); err != nil {
+			return nil, fmt.Errorf("failed to scan tag: %w", err)
+		}
+		tags = append(tags, &tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tags: %w", translateError(datastore.ResourceBlog, "", err))
+	}
+
+	return tags, nil
+}
+
+// Search retrieves a paginated list of the published blogs matching the
+// query, best matches first. Blogs are ranked by their best match, and the
+// snippet is taken from the content or comment of that match.
+func (s *Store) Search(ctx context.Context, query datastore.SearchQuery, pageSize int32, pageToken string) ([]*datastore.SearchResult, string, error) {
+	if pageSize <= 0 {
+		return nil, "", datastore.Invalid(datastore.ResourceBlog, "page_size", fmt.Errorf("must be positive, got %d", pageSize))
+	}
+	if len(query.Terms) == 0 {
+		return nil, "", datastore.Invalid(datastore.ResourceBlog, "query", errors.New("must contain at least one word"))
+	}
+
+	hits := `
+		SELECT b.id, ts_rank(b.search_vector, q.query) AS rank, b.content AS doc
+		FROM blogs b, q
+		WHERE b.search_vector @@ q.query AND b.tenant_id = $3 AND b.status = 'published' AND b.deleted_at IS NULL`
+	if query.IncludeComments {
+		hits += `
+		UNION ALL
+		SELECT b.id, ts_rank(c.search_vector, q.query) AS rank, c.content AS doc
+		FROM comments c JOIN blogs b ON b.id = c.blog_id, q
+		WHERE c.search_vector @@ q.query AND c.state = 'approved' AND b.tenant_id = $3 AND b.status = 'published' AND b.deleted_at IS NULL`
+	}
+
+	sqlQuery := `
+		WITH q AS (
+			SELECT to_tsquery('english', $1) AS query
+		), hits AS (` + hits + `
+		), best AS (
+			SELECT DISTINCT ON (id) id, rank, doc FROM hits ORDER BY id, rank DESC
+		)
+		SELECT b.id, b.title, b.status,
+			(SELECT COUNT(*) FROM comments c WHERE c.blog_id = b.id AND c.state = 'approved') AS comment_count,
+			best.rank, ts_headline('english', best.doc, q.query, $2) AS snippet, b.slug
+		FROM best JOIN blogs b ON b.id = best.id, q
+	`
+	args := []interface{}{tsQuery(query.Terms), headlineOptions, tenantID(ctx)}
+	paramCount := 4
+
+	// The page token is the rank and ID of the last result on the previous page
+	if pageToken != "" {
+		rank, id, err := search.ParsePageToken(pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		if _, err := uuid.Parse(string(id)); err != nil {
+			return nil, "", datastore.Invalid(datastore.ResourceBlog, "page_token", fmt.Errorf("invalid page token %q", pageToken))
+		}
+		sqlQuery += fmt.Sprintf(" WHERE best.rank < $%d::real OR (best.rank = $%d::real AND best.id > $%d)", paramCount, paramCount, paramCount+1)
+		args = append(args, rank, string(id))
+		paramCount += 2
+	}
+
+	sqlQuery += `
+		ORDER BY best.rank DESC, best.id
+		LIMIT $` + fmt.Sprintf("%d", paramCount)
+
+	args = append(args, pageSize+1) // Fetch one extra to determine if there are more results
+
+	rows, err := s.db.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to search blogs: %w", translateError(datastore.ResourceBlog, "", err))
+	}
+	defer rows.Close()
+
+	var results []*datastore.SearchResult
+	for rows.Next() {
+		var result datastore.SearchResult
+		err := rows.Scan(&result.ID, &result.Title, &result.Status, &result.CommentCount, &result.Rank, &result.Snippet, &result.Slug)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to scan search result: %w", err)
+		}
+		results = append(results, &result)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("error iterating search results: %w", translateError(datastore.ResourceBlog, "", err))
+	}
+
+	// Handle pagination
+	var nextPageToken string
+	if len(results) > int(pageSize) {
+		results = results[:len(results)-1] // Remove the extra result
+		last := results[len(results)-1]
+		nextPageToken = search.PageToken(last.Rank, last.ID)
+	}
+
+	return results, nextPageToken, nil
+}
+
+// AddComment adds a comment to a blog, or a reply to one of its approved
+// comments
+func (s *Store) AddComment(ctx context.Context, blogID datastore.ID, parentID *datastore.ID, content, author string, maxDepth int32, state datastore.CommentState, opts ...datastore.CommentOption) (*datastore.Comment, error) {
+	options := datastore.NewCommentOptions(opts...)
+	if !state.Valid() {
+		return nil, datastore.Invalid(datastore.ResourceComment, "state", fmt.Errorf("unknown comment state %q", state))
+	}
+	for _, verdict := range options.Verdicts {
+		if verdict.State != "" && !verdict.State.Valid() {
+			return nil, datastore.Invalid(datastore.ResourceComment, "state", fmt.Errorf("unknown comment state %q", verdict.State))
+		}
+	}
+
 	// First check if the blog exists
-	checkQuery := `SELECT 1 FROM blogs WHERE id = $1`
+	checkQuery := `SELECT 1 FROM blogs WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
 	var exists int
-	err := s.db.QueryRowContext(ctx, checkQuery, string(blogID)).Scan(&exists)
+	err := s.db.QueryRowContext(ctx, checkQuery, string(blogID), tenantID(ctx)).Scan(&exists)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return "", fmt.Errorf("blog not found")
+			return nil, datastore.NotFound(datastore.ResourceBlog, blogID)
+		}
+		return nil, fmt.Errorf("failed to check blog existence: %w", translateError(datastore.ResourceBlog, blogID, err))
+	}
+
+	// Replies go one level below their parent, which must be an approved
+	// comment on the same blog
+	var parent interface{}
+	var depth int32
+	if parentID != nil {
+		parentQuery := `SELECT depth FROM comments WHERE id = $1 AND blog_id = $2 AND tenant_id = $3 AND state = 'approved'`
+		var parentDepth int32
+		err = s.db.QueryRowContext(ctx, parentQuery, string(*parentID), string(blogID), tenantID(ctx)).Scan(&parentDepth)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, datastore.NotFound(datastore.ResourceComment, *parentID)
+			}
+			return nil, fmt.Errorf("failed to get parent comment: %w", translateError(datastore.ResourceComment, *parentID, err))
+		}
+		depth = parentDepth + 1
+		if depth > maxDepth {
+			return nil, datastore.Invalid(datastore.ResourceComment, "parent_id", fmt.Errorf("replies nest at most %d deep", maxDepth))
 		}
-		return "", fmt.Errorf("failed to check blog existence: %w", err)
+		parent = string(*parentID)
+	}
+	if err := checkAuthor(ctx, s.db, options.AuthorID); err != nil {
+		return nil, err
 	}
 
 	// Insert the comment
-	id := uuid.New().String()
+	comment := &datastore.Comment{
+		ID:       datastore.ID(uuid.New().String()),
+		BlogID:   blogID,
+		ParentID: parentID,
+		Depth:    depth,
+		Content:  content,
+		Author:   author,
+		AuthorID: options.AuthorID,
+		State:    state,
+	}
 	query := `
-		INSERT INTO comments (id, blog_id, content, author)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO comments (id, blog_id, parent_id, depth, content, author, state, author_id, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING created_at
 	`
-	_, err = s.db.ExecContext(ctx, query, id, string(blogID), content, author)
+	err = s.inTx(ctx, func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx, query, string(comment.ID), string(blogID), parent, depth, content, author, string(state), options.AuthorID, tenantID(ctx)).Scan(&comment.CreatedAt)
+		if err != nil {
+			err = translateError(datastore.ResourceComment, "", err)
+			if errors.Is(err, datastore.ErrNotFound) {
+				// The blog was deleted between the existence check and the insert
+				return datastore.NotFound(datastore.ResourceBlog, blogID)
+			}
+			return fmt.Errorf("failed to add comment: %w", err)
+		}
+		if err := insertVerdicts(ctx, tx, comment.ID, options.Verdicts); err != nil {
+			return err
+		}
+		return recordAudit(ctx, tx, datastore.AuditCreate, datastore.ResourceComment, comment.ID, nil, datastore.CommentSnapshot(comment))
+	})
 	if err != nil {
-		return "", fmt.Errorf("failed to add comment: %w", err)
+		return nil, err
 	}
 
-	return datastore.ID(id), nil
+	return comment, nil
+}
+
+// insertVerdicts records the verdicts of the comment filters on a new
+// comment, in the order they were given
+func insertVerdicts(ctx context.Context, tx *sql.Tx, id datastore.ID, verdicts []datastore.CommentVerdict) error {
+	query := `
+		INSERT INTO comment_verdicts (comment_id, position, classifier, state, reason, score)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	for i, verdict := range verdicts {
+		var state interface{}
+		if verdict.State != "" {
+			state = string(verdict.State)
+		}
+		_, err := tx.ExecContext(ctx, query, string(id), i, verdict.Classifier, state, verdict.Reason, verdict.Score)
+		if err != nil {
+			return fmt.Errorf("failed to add comment verdict: %w", translateError(datastore.ResourceComment, id, err))
+		}
+	}
+	return nil
+}
+
+// GetComment retrieves an approved comment of a blog
+func (s *Store) GetComment(ctx context.Context, blogID, id datastore.ID) (*datastore.Comment, error) {
+	// Comments of blogs in the trash are hidden along with their blog
+	query := `
+		SELECT ` + commentColumns + `
+		FROM comments
+		WHERE id = $1 AND blog_id = $2 AND tenant_id = $3 AND state = 'approved'
+			AND EXISTS (SELECT 1 FROM blogs b WHERE b.id = comments.blog_id AND b.deleted_at IS NULL)
+	`
+	comment, err := scanComment(s.db.QueryRowContext(ctx, query, string(id), string(blogID), tenantID(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, datastore.NotFound(datastore.ResourceComment, id)
+		}
+		return nil, fmt.Errorf("failed to get comment: %w", translateError(datastore.ResourceComment, id, err))
+	}
+
+	return comment, nil
+}
+
+// UpdateComment replaces the content of a comment of a blog
+func (s *Store) UpdateComment(ctx context.Context, blogID, id datastore.ID, content string) error {
+	query := `
+		UPDATE comments
+		SET content = $1, updated_at = NOW()
+		WHERE id = $2 AND blog_id = $3 AND tenant_id = $4
+			AND EXISTS (SELECT 1 FROM blogs b WHERE b.id = comments.blog_id AND b.deleted_at IS NULL)
+	`
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		before, err := snapshotComment(ctx, tx, blogID, id)
+		if err != nil {
+			return err
+		}
+
+		result, err := tx.ExecContext(ctx, query, content, string(id), string(blogID), tenantID(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to update comment: %w", translateError(datastore.ResourceComment, id, err))
+		}
+		if err := commentAffected(result, id); err != nil {
+			return err
+		}
+
+		after, err := snapshotComment(ctx, tx, blogID, id)
+		if err != nil {
+			return err
+		}
+		return recordAudit(ctx, tx, datastore.AuditUpdate, datastore.ResourceComment, id, before, after)
+	})
+}
+
+// DeleteComment deletes a comment of a blog, which deletes its replies by
+// cascade. The audit event records the deleted comment, whose replies go
+// with it.
+func (s *Store) DeleteComment(ctx context.Context, blogID, id datastore.ID) error {
+	query := `
+		DELETE FROM comments
+		WHERE id = $1 AND blog_id = $2 AND tenant_id = $3
+			AND EXISTS (SELECT 1 FROM blogs b WHERE b.id = comments.blog_id AND b.deleted_at IS NULL)
+	`
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		before, err := snapshotComment(ctx, tx, blogID, id)
+		if err != nil {
+			return err
+		}
+
+		result, err := tx.ExecContext(ctx, query, string(id), string(blogID), tenantID(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to delete comment: %w", translateError(datastore.ResourceComment, id, err))
+		}
+		if err := commentAffected(result, id); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, tx, datastore.AuditDelete, datastore.ResourceComment, id, before, nil)
+	})
+}
+
+// ListComments retrieves a paginated list of the approved comments of a
+// blog, oldest first
+func (s *Store) ListComments(ctx context.Context, blogID datastore.ID, pageSize int32, pageToken string) ([]*datastore.Comment, string, error) {
+	if pageSize <= 0 {
+		return nil, "", datastore.Invalid(datastore.ResourceComment, "page_size", fmt.Errorf("must be positive, got %d", pageSize))
+	}
+
+	// Check if the blog exists, as a blog without comments lists none
+	checkQuery := `SELECT 1 FROM blogs WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+	var exists int
+	err := s.db.QueryRowContext(ctx, checkQuery, string(blogID), tenantID(ctx)).Scan(&exists)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, "", datastore.NotFound(datastore.ResourceBlog, blogID)
+		}
+		return nil, "", fmt.Errorf("failed to check blog existence: %w", translateError(datastore.ResourceBlog, blogID, err))
+	}
+
+	query := `
+		SELECT ` + commentColumns + `
+		FROM comments
+		WHERE blog_id = $1 AND tenant_id = $2 AND state = 'approved'
+	`
+	args := []interface{}{string(blogID), tenantID(ctx)}
+
+	// The page token is the creation time and ID of the last comment on the
+	// previous page
+	if pageToken != "" {
+		createdAt, lastID, err := datastore.ParseCommentPageToken(pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		query += ` AND (created_at, id) > ($3, $4)`
+		args = append(args, createdAt, string(lastID))
+	}
+
+	query += fmt.Sprintf(` ORDER BY created_at, id LIMIT $%d`, len(args)+1)
+	args = append(args, pageSize+1)
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list comments: %w", translateError(datastore.ResourceComment, "", err))
+	}
+	defer rows.Close()
+
+	var comments []*datastore.Comment
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to scan comment: %w", err)
+		}
+		comments = append(comments, comment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("error iterating comments: %w", translateError(datastore.ResourceComment, "", err))
+	}
+
+	// Handle pagination
+	var nextPageToken string
+	if len(comments) > int(pageSize) {
+		comments = comments[:len(comments)-1] // Remove the extra result
+		nextPageToken = datastore.CommentPageToken(comments[len(comments)-1])
+	}
+
+	return comments, nextPageToken, nil
+}
+
+// ListPendingComments retrieves a paginated list of the pending comments of
+// the blogs outside the trash, or of a single blog, oldest first
+func (s *Store) ListPendingComments(ctx context.Context, blogID *datastore.ID, pageSize int32, pageToken string) ([]*datastore.Comment, string, error) {
+	if pageSize <= 0 {
+		return nil, "", datastore.Invalid(datastore.ResourceComment, "page_size", fmt.Errorf("must be positive, got %d", pageSize))
+	}
+
+	query := `
+		SELECT ` + commentColumns + `
+		FROM comments
+		WHERE tenant_id = $1 AND state = 'pending'
+			AND EXISTS (SELECT 1 FROM blogs b WHERE b.id = comments.blog_id AND b.deleted_at IS NULL)
+	`
+	args := []interface{}{tenantID(ctx)}
+	paramCount := 2
+
+	if blogID != nil {
+		// Check if the blog exists, as a blog without pending comments lists none
+		checkQuery := `SELECT 1 FROM blogs WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+		var exists int
+		err := s.db.QueryRowContext(ctx, checkQuery, string(*blogID), tenantID(ctx)).Scan(&exists)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, "", datastore.NotFound(datastore.ResourceBlog, *blogID)
+			}
+			return nil, "", fmt.Errorf("failed to check blog existence: %w", translateError(datastore.ResourceBlog, *blogID, err))
+		}
+
+		query += fmt.Sprintf(` AND blog_id = $%d`, paramCount)
+		args = append(args, string(*blogID))
+		paramCount++
+	}
+
+	// The page token is the creation time and ID of the last comment on the
+	// previous page
+	if pageToken != "" {
+		createdAt, lastID, err := datastore.ParseCommentPageToken(pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		query += fmt.Sprintf(` AND (created_at, id) > ($%d, $%d)`, paramCount, paramCount+1)
+		args = append(args, createdAt, string(lastID))
+		paramCount += 2
+	}
+
+	query += fmt.Sprintf(` ORDER BY created_at, id LIMIT $%d`, paramCount)
+	args = append(args, pageSize+1)
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list pending comments: %w", translateError(datastore.ResourceComment, "", err))
+	}
+	defer rows.Close()
+
+	var comments []*datastore.Comment
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to scan comment: %w", err)
+		}
+		comments = append(comments, comment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("error iterating comments: %w", translateError(datastore.ResourceComment, "", err))
+	}
+
+	// Handle pagination
+	var nextPageToken string
+	if len(comments) > int(pageSize) {
+		comments = comments[:len(comments)-1] // Remove the extra result
+		nextPageToken = datastore.CommentPageToken(comments[len(comments)-1])
+	}
+
+	return comments, nextPageToken, nil
+}
+
+// ModerateComment sets the state of a comment of a blog, recording the
+// reason and the time
+func (s *Store) ModerateComment(ctx context.Context, blogID, id datastore.ID, state datastore.CommentState, reason string) error {
+	if !state.Valid() {
+		return datastore.Invalid(datastore.ResourceComment, "state", fmt.Errorf("unknown comment state %q", state))
+	}
+
+	query := `
+		UPDATE comments
+		SET state = $1, moderation_reason = $2, moderated_at = NOW()
+		WHERE id = $3 AND blog_id = $4 AND tenant_id = $5
+			AND EXISTS (SELECT 1 FROM blogs b WHERE b.id = comments.blog_id AND b.deleted_at IS NULL)
+	`
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		before, err := snapshotComment(ctx, tx, blogID, id)
+		if err != nil {
+			return err
+		}
+
+		result, err := tx.ExecContext(ctx, query, string(state), reason, string(id), string(blogID), tenantID(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to moderate comment: %w", translateError(datastore.ResourceComment, id, err))
+		}
+		if err := commentAffected(result, id); err != nil {
+			return err
+		}
+
+		after, err := snapshotComment(ctx, tx, blogID, id)
+		if err != nil {
+			return err
+		}
+		return recordAudit(ctx, tx, datastore.AuditModerate, datastore.ResourceComment, id, before, after)
+	})
+}
+
+// ListRecentComments retrieves up to limit comments of the blogs outside the
+// trash created at or after since, whatever their state, newest first
+func (s *Store) ListRecentComments(ctx context.Context, since time.Time, limit int32) ([]*datastore.Comment, error) {
+	if limit <= 0 {
+		return nil, datastore.Invalid(datastore.ResourceComment, "limit", fmt.Errorf("must be positive, got %d", limit))
+	}
+	query := `
+		SELECT ` + commentColumns + `
+		FROM comments
+		WHERE tenant_id = $1 AND created_at >= $2
+			AND EXISTS (SELECT 1 FROM blogs b WHERE b.id = comments.blog_id AND b.deleted_at IS NULL)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $3
+	`
+	return s.queryComments(ctx, "failed to list recent comments", query, tenantID(ctx), since, limit)
+}
+
+// ListModeratedComments retrieves up to limit comments of the blogs outside
+// the trash that a moderator settled, most recently moderated first
+func (s *Store) ListModeratedComments(ctx context.Context, limit int32) ([]*datastore.Comment, error) {
+	if limit <= 0 {
+		return nil, datastore.Invalid(datastore.ResourceComment, "limit", fmt.Errorf("must be positive, got %d", limit))
+	}
+	query := `
+		SELECT ` + commentColumns + `
+		FROM comments
+		WHERE tenant_id = $1 AND moderated_at IS NOT NULL
+			AND EXISTS (SELECT 1 FROM blogs b WHERE b.id = comments.blog_id AND b.deleted_at IS NULL)
+		ORDER BY moderated_at DESC, id DESC
+		LIMIT $2
+	`
+	return s.queryComments(ctx, "failed to list moderated comments", query, tenantID(ctx), limit)
+}
+
+// ListCommentVerdicts retrieves the verdicts recorded for a comment of a
+// blog, whatever its state, in the order they were given
+func (s *Store) ListCommentVerdicts(ctx context.Context, blogID, id datastore.ID) ([]*datastore.CommentVerdict, error) {
+	// Check if the comment exists, as a comment without verdicts lists none
+	checkQuery := `
+		SELECT 1 FROM comments
+		WHERE id = $1 AND blog_id = $2 AND tenant_id = $3
+			AND EXISTS (SELECT 1 FROM blogs b WHERE b.id = comments.blog_id AND b.deleted_at IS NULL)
+	`
+	var exists int
+	err := s.db.QueryRowContext(ctx, checkQuery, string(id), string(blogID), tenantID(ctx)).Scan(&exists)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, datastore.NotFound(datastore.ResourceComment, id)
+		}
+		return nil, fmt.Errorf("failed to check comment existence: %w", translateError(datastore.ResourceComment, id, err))
+	}
+
+	query := `
+		SELECT comment_id, classifier, state, reason, score, created_at
+		FROM comment_verdicts
+		WHERE comment_id = $1
+		ORDER BY position
+	`
+	rows, err := s.db.QueryContext(ctx, query, string(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list comment verdicts: %w", translateError(datastore.ResourceComment, id, err))
+	}
+	defer rows.Close()
+
+	verdicts := []*datastore.CommentVerdict{}
+	for rows.Next() {
+		var verdict datastore.CommentVerdict
+		var state sql.NullString
+		if err := rows.Scan(&verdict.CommentID, &verdict.Classifier, &state, &verdict.Reason, &verdict.Score, &verdict.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan comment verdict: %w", err)
+		}
+		verdict.State = datastore.CommentState(state.String)
+		verdicts = append(verdicts, &verdict)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating comment verdicts: %w", translateError(datastore.ResourceComment, id, err))
+	}
+
+	return verdicts, nil
+}
+
+// Publish publishes a blog, recording the publish time if it was not already
+// published
+func (s *Store) Publish(ctx context.Context, id datastore.ID) error {
+	query := `
+		UPDATE blogs
+		SET status = 'published',
+			published_at = CASE WHEN status <> 'published' THEN NOW() ELSE published_at END,
+			publish_at = NULL
+		WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+	`
+	return s.setStatus(ctx, id, datastore.AuditPublish, query, "failed to publish blog")
+}
+
+// Unpublish moves a blog back to draft
+func (s *Store) Unpublish(ctx context.Context, id datastore.ID) error {
+	query := `UPDATE blogs SET status = 'draft', publish_at = NULL WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+	return s.setStatus(ctx, id, datastore.AuditUnpublish, query, "failed to unpublish blog")
+}
+
+// PublishScheduled publishes up to limit scheduled blogs of any tenant that
+// are due. Due blogs are locked with SKIP LOCKED, so concurrent publishers on
+// other replicas pick different blogs instead of waiting for each other.
+func (s *Store) PublishScheduled(ctx context.Context, now time.Time, limit int32) ([]datastore.ID, error) {
+	if limit <= 0 {
+		return nil, datastore.Invalid(datastore.ResourceBlog, "limit", fmt.Errorf("must be positive, got %d", limit))
+	}
+
+	query := `
+		SELECT ` + blogColumns("content") + `, tenant_id
+		FROM blogs
+		WHERE status = 'scheduled' AND publish_at <= $1 AND deleted_at IS NULL
+		ORDER BY publish_at
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	`
+	var ids []datastore.ID
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		due, err := queryTenantBlogs(ctx, tx, "failed to find scheduled blogs", query, now, limit)
+		if err != nil {
+			return err
+		}
+		if len(due) == 0 {
+			return nil
+		}
+
+		// The published blogs are read back for the audit events
+		query := `
+			UPDATE blogs
+			SET status = 'published', published_at = NOW(), publish_at = NULL
+			WHERE id = ANY($1::uuid[])
+			RETURNING ` + blogColumns("content") + `, tenant_id
+		`
+		published, err := queryTenantBlogs(ctx, tx, "failed to publish scheduled blogs", query, pq.Array(tenantBlogIDs(due)))
+		if err != nil {
+			return err
+		}
+		after := make(map[datastore.ID]*datastore.Blog, len(published))
+		for _, blog := range published {
+			after[blog.ID] = blog.Blog
+		}
+
+		for _, blog := range due {
+			if err := insertAudit(ctx, tx, blog.tenantID, datastore.AuditPublish, datastore.ResourceBlog, blog.ID, datastore.BlogSnapshot(blog.Blog), datastore.BlogSnapshot(after[blog.ID])); err != nil {
+				return err
+			}
+			ids = append(ids, blog.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// ListRevisions retrieves a paginated list of the revisions of a blog, newest first
+func (s *Store) ListRevisions(ctx context.Context, blogID datastore.ID, pageSize int32, pageToken string) ([]*datastore.Revision, string, error) {
+	if pageSize <= 0 {
+		return nil, "", datastore.Invalid(datastore.ResourceRevision, "page_size", fmt.Errorf("must be positive, got %d", pageSize))
+	}
+
+	// The page token is the number of the last revision on the previous page
+	before := int64(math.MaxInt32)
+	if pageToken != "" {
+		n, err := strconv.ParseInt(pageToken, 10, 32)
+		if err != nil || n <= 0 {
+			return nil, "", datastore.Invalid(datastore.ResourceRevision, "page_token", fmt.Errorf("invalid page token %q", pageToken))
+		}
+		before = n
+	}
+
+	// Check if the blog exists, as a blog without revisions lists none
+	checkQuery := `SELECT 1 FROM blogs WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+	var exists int
+	err := s.db.QueryRowContext(ctx, checkQuery, string(blogID), tenantID(ctx)).Scan(&exists)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, "", datastore.NotFound(datastore.ResourceBlog, blogID)
+		}
+		return nil, "", fmt.Errorf("failed to check blog existence: %w", translateError(datastore.ResourceBlog, blogID, err))
+	}
+
+	query := `
+		SELECT blog_id, number, title, content, editor, created_at
+		FROM revisions
+		WHERE blog_id = $1 AND number < $2
+		ORDER BY number DESC
+		LIMIT $3
+	`
+	rows, err := s.db.QueryContext(ctx, query, string(blogID), before, pageSize+1)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list revisions: %w", translateError(datastore.ResourceRevision, "", err))
+	}
+	defer rows.Close()
+
+	var revisions []*datastore.Revision
+	for rows.Next() {
+		var revision datastore.Revision
+		err := rows.Scan(
+			&revision.BlogID, &revision.Number, &revision.Title, &revision.Content, &revision.Editor, &revision.CreatedAt,
+		)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to scan revision: %w", err)
+		}
+		revisions = append(revisions, &revision)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("error iterating revisions: %w", translateError(datastore.ResourceRevision, "", err))
+	}
+
+	// Handle pagination
+	var nextPageToken string
+	if len(revisions) > int(pageSize) {
+		revisions = revisions[:len(revisions)-1] // Remove the extra result
+		nextPageToken = strconv.Itoa(int(revisions[len(revisions)-1].Number))
+	}
+
+	return revisions, nextPageToken, nil
+}
+
+// GetRevision retrieves a single revision of a blog
+func (s *Store) GetRevision(ctx context.Context, blogID datastore.ID, number int32) (*datastore.Revision, error) {
+	// Revisions of blogs in the trash are hidden along with their blog
+	query := `
+		SELECT blog_id, number, title, content, editor, created_at
+		FROM revisions
+		WHERE blog_id = $1 AND number = $2
+			AND EXISTS (SELECT 1 FROM blogs WHERE id = $1 AND tenant_id = $3 AND deleted_at IS NULL)
+	`
+	var revision datastore.Revision
+	err := s.db.QueryRowContext(ctx, query, string(blogID), number, tenantID(ctx)).Scan(
+		&revision.BlogID, &revision.Number, &revision.Title, &revision.Content, &revision.Editor, &revision.CreatedAt,
+	)
+	if err != nil {
+		id := datastore.RevisionID(blogID, number)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, datastore.NotFound(datastore.ResourceRevision, id)
+		}
+		return nil, fmt.Errorf("failed to get revision: %w", translateError(datastore.ResourceRevision, id, err))
+	}
+
+	return &revision, nil
+}
+
+// RestoreRevision sets the title and content of a blog back to those of a
+// revision, recording the replaced version as a new revision
+func (s *Store) RestoreRevision(ctx context.Context, blogID datastore.ID, number int32, editor string) error {
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		before, err := snapshotBlog(ctx, tx, blogID)
+		if err != nil {
+			return err
+		}
+		recorded, err := recordRevision(ctx, tx, blogID, editor)
+		if err != nil {
+			return err
+		}
+
+		// The revision just recorded is the current version, not one to restore
+		if number >= recorded {
+			return datastore.NotFound(datastore.ResourceRevision, datastore.RevisionID(blogID, number))
+		}
+
+		query := `
+			UPDATE blogs b
+			SET title = r.title, content = r.content
+			FROM revisions r
+			WHERE b.id = $1 AND b.tenant_id = $3 AND r.blog_id = b.id AND r.number = $2
+		`
+		result, err := tx.ExecContext(ctx, query, string(blogID), number, tenantID(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to restore revision: %w", translateError(datastore.ResourceBlog, blogID, err))
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+
+		// The blog is locked, so a missing row means a missing revision
+		if rowsAffected == 0 {
+			return datastore.NotFound(datastore.ResourceRevision, datastore.RevisionID(blogID, number))
+		}
+
+		after, err := snapshotBlog(ctx, tx, blogID)
+		if err != nil {
+			return err
+		}
+		return recordAudit(ctx, tx, datastore.AuditRestore, datastore.ResourceBlog, blogID, before, after)
+	})
+}
+
+// CreateAPIKey stores a new API key by the hash of the key and returns it
+func (s *Store) CreateAPIKey(ctx context.Context, name, prefix, hash string, scopes []string, expiresAt *time.Time) (*datastore.APIKey, error) {
+	if scopes == nil {
+		scopes = []string{}
+	}
+	id := datastore.ID(uuid.New().String())
+	query := `
+		INSERT INTO api_keys (id, name, prefix, key_hash, scopes, expires_at, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING ` + apiKeyColumns
+	key, err := scanAPIKey(s.db.QueryRowContext(ctx, query, string(id), name, prefix, hash, pq.Array(scopes), expiresAt, tenantID(ctx)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create api key: %w", translateError(datastore.ResourceAPIKey, id, err))
+	}
+	return key, nil
+}
+
+// ListAPIKeys retrieves the API keys, newest first, leaving out revoked keys
+// unless asked for
+func (s *Store) ListAPIKeys(ctx context.Context, showRevoked bool) ([]*datastore.APIKey, error) {
+	query := `
+		SELECT ` + apiKeyColumns + `
+		FROM api_keys
+		WHERE tenant_id = $1 AND ($2 OR revoked_at IS NULL)
+		ORDER BY created_at DESC, id DESC
+	`
+	rows, err := s.db.QueryContext(ctx, query, tenantID(ctx), showRevoked)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api keys: %w", translateError(datastore.ResourceAPIKey, "", err))
+	}
+	defer rows.Close()
+
+	keys := []*datastore.APIKey{}
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan api key: %w", err)
+		}
+		keys = append(keys, key)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating api keys: %w", translateError(datastore.ResourceAPIKey, "", err))
+	}
+
+	return keys, nil
+}
+
+// GetAPIKeyByHash retrieves the API key with the given hash, whether or not
+// it is still active
+func (s *Store) GetAPIKeyByHash(ctx context.Context, hash string) (*datastore.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE key_hash = $1 AND tenant_id = $2`
+	key, err := scanAPIKey(s.db.QueryRowContext(ctx, query, hash, tenantID(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, datastore.NotFound(datastore.ResourceAPIKey, "")
+		}
+		return nil, fmt.Errorf("failed to get api key: %w", translateError(datastore.ResourceAPIKey, "", err))
+	}
+	return key, nil
+}
+
+// TouchAPIKey records that an API key was used at the given time
+func (s *Store) TouchAPIKey(ctx context.Context, id datastore.ID, at time.Time) error {
+	query := `UPDATE api_keys SET last_used_at = GREATEST(last_used_at, $2) WHERE id = $1 AND tenant_id = $3`
+	return s.execAPIKey(ctx, "failed to touch api key", id, query, string(id), at, tenantID(ctx))
+}
+
+// RevokeAPIKey revokes an API key, keeping the time it was first revoked
+func (s *Store) RevokeAPIKey(ctx context.Context, id datastore.ID) error {
+	query := `UPDATE api_keys SET revoked_at = COALESCE(revoked_at, NOW()) WHERE id = $1 AND tenant_id = $2`
+	return s.execAPIKey(ctx, "failed to revoke api key", id, query, string(id), tenantID(ctx))
+}
+
+// execAPIKey runs a statement updating the API key id, which is not found if
+// no row was affected
+func (s *Store) execAPIKey(ctx context.Context, msg string, id datastore.ID, query string, args ...interface{}) error {
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%s: %w", msg, translateError(datastore.ResourceAPIKey, id, err))
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return datastore.NotFound(datastore.ResourceAPIKey, id)
+	}
+	return nil
+}
+
+// apiKeyColumns are the columns read by scanAPIKey
+const apiKeyColumns = `id, name, prefix, key_hash, scopes, created_at, expires_at, last_used_at, revoked_at`
+
+// scanAPIKey reads an API key selected with apiKeyColumns
+func scanAPIKey(row scanner) (*datastore.APIKey, error) {
+	var key datastore.APIKey
+	var expiresAt, lastUsedAt, revokedAt sql.NullTime
+	err := row.Scan(
+		&key.ID, &key.Name, &key.Prefix, &key.Hash, pq.Array(&key.Scopes), &key.CreatedAt, &expiresAt, &lastUsedAt, &revokedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if key.Scopes == nil {
+		key.Scopes = []string{}
+	}
+	if expiresAt.Valid {
+		key.ExpiresAt = &expiresAt.Time
+	}
+	if lastUsedAt.Valid {
+		key.LastUsedAt = &lastUsedAt.Time
+	}
+	if revokedAt.Valid {
+		key.RevokedAt = &revokedAt.Time
+	}
+	return &key, nil
+}
+
+// CreateUser creates a user with the given profile and returns it
+func (s *Store) CreateUser(ctx context.Context, displayName, bio, avatarURL string, opts ...datastore.UserOption) (*datastore.User, error) {
+	options := datastore.NewUserOptions(opts...)
+	id := datastore.ID(uuid.New().String())
+	query := `
+		INSERT INTO users (id, display_name, bio, avatar_url, owner, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + userColumns
+	user, err := scanUser(s.db.QueryRowContext(ctx, query, string(id), displayName, bio, avatarURL, options.Owner, tenantID(ctx)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", translateError(datastore.ResourceUser, id, err))
+	}
+	return user, nil
+}
+
+// GetUser retrieves a user by ID
+func (s *Store) GetUser(ctx context.Context, id datastore.ID) (*datastore.User, error) {
+	query := `SELECT ` + userColumns + ` FROM users WHERE id = $1 AND tenant_id = $2`
+	user, err := scanUser(s.db.QueryRowContext(ctx, query, string(id), tenantID(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, datastore.NotFound(datastore.ResourceUser, id)
+		}
+		return nil, fmt.Errorf("failed to get user: %w", translateError(datastore.ResourceUser, id, err))
+	}
+	return user, nil
+}
+
+// GetUsers retrieves the users with the given IDs in no particular order,
+// leaving out the ones that do not exist
+func (s *Store) GetUsers(ctx context.Context, ids []datastore.ID) ([]*datastore.User, error) {
+	users := []*datastore.User{}
+	if len(ids) == 0 {
+		return users, nil
+	}
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = string(id)
+	}
+
+	query := `SELECT ` + userColumns + ` FROM users WHERE id = ANY($1::uuid[]) AND tenant_id = $2`
+	rows, err := s.db.QueryContext(ctx, query, pq.Array(values), tenantID(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", translateError(datastore.ResourceUser, "", err))
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan user: %w", err)
+		}
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating users: %w", translateError(datastore.ResourceUser, "", err))
+	}
+
+	return users, nil
+}
+
+// UpdateUser updates the fields of a user set in the patch
+func (s *Store) UpdateUser(ctx context.Context, id datastore.ID, patch datastore.UserPatch) error {
+	query := `
+		UPDATE users
+		SET display_name = COALESCE($2, display_name),
+			bio = COALESCE($3, bio),
+			avatar_url = COALESCE($4, avatar_url)
+		WHERE id = $1 AND tenant_id = $5
+	`
+	result, err := s.db.ExecContext(ctx, query, string(id), patch.DisplayName, patch.Bio, patch.AvatarURL, tenantID(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to update user: %w", translateError(datastore.ResourceUser, id, err))
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return datastore.NotFound(datastore.ResourceUser, id)
+	}
+	return nil
+}
+
+// ListUsers retrieves a paginated list of users, oldest first
+func (s *Store) ListUsers(ctx context.Context, pageSize int32, pageToken string) ([]*datastore.User, string, error) {
+	if pageSize <= 0 {
+		return nil, "", datastore.Invalid(datastore.ResourceUser, "page_size", fmt.Errorf("must be positive, got %d", pageSize))
+	}
+
+	query := `SELECT ` + userColumns + ` FROM users WHERE tenant_id = $1`
+	args := []interface{}{tenantID(ctx)}
+
+	// The page token is the creation time and ID of the last user on the
+	// previous page
+	if pageToken != "" {
+		createdAt, lastID, err := datastore.ParseUserPageToken(pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		query += ` AND (created_at, id) > ($2, $3)`
+		args = append(args, createdAt, string(lastID))
+	}
+
+	query += fmt.Sprintf(` ORDER BY created_at, id LIMIT $%d`, len(args)+1)
+	args = append(args, pageSize+1)
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list users: %w", translateError(datastore.ResourceUser, "", err))
+	}
+	defer rows.Close()
+
+	users := []*datastore.User{}
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to scan user: %w", err)
+		}
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("error iterating users: %w", translateError(datastore.ResourceUser, "", err))
+	}
+
+	// Handle pagination
+	var nextPageToken string
+	if len(users) > int(pageSize) {
+		users = users[:len(users)-1] // Remove the extra result
+		nextPageToken = datastore.UserPageToken(users[len(users)-1])
+	}
+
+	return users, nextPageToken, nil
+}
+
+// userColumns are the columns read by scanUser
+const userColumns = `id, display_name, bio, avatar_url, COALESCE(owner, '') AS owner, created_at, updated_at`
+
+// scanUser reads a user selected with userColumns
+func scanUser(row scanner) (*datastore.User, error) {
+	var user datastore.User
+	err := row.Scan(&user.ID, &user.DisplayName, &user.Bio, &user.AvatarURL, &user.Owner, &user.CreatedAt, &user.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// CreateTenant creates a tenant with the given slug and name and returns it
+func (s *Store) CreateTenant(ctx context.Context, slug, name string) (*datastore.Tenant, error) {
+	if !datastore.ValidTenantSlug(slug) {
+		return nil, datastore.Invalid(datastore.ResourceTenant, "slug", fmt.Errorf("invalid slug %q", slug))
+	}
+	id := datastore.ID(uuid.New().String())
+	query := `
+		INSERT INTO tenants (id, slug, name)
+		VALUES ($1, $2, $3)
+		RETURNING ` + tenantColumns
+	tenant, err := scanTenant(s.db.QueryRowContext(ctx, query, string(id), slug, name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tenant: %w", translateError(datastore.ResourceTenant, id, err))
+	}
+	return tenant, nil
+}
+
+// GetTenantBySlug retrieves a tenant by its slug
+func (s *Store) GetTenantBySlug(ctx context.Context, slug string) (*datastore.Tenant, error) {
+	query := `SELECT ` + tenantColumns + ` FROM tenants WHERE slug = $1`
+	tenant, err := scanTenant(s.db.QueryRowContext(ctx, query, slug))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, datastore.NotFound(datastore.ResourceTenant, datastore.ID(slug))
+		}
+		return nil, fmt.Errorf("failed to get tenant: %w", translateError(datastore.ResourceTenant, datastore.ID(slug), err))
+	}
+	return tenant, nil
+}
+
+// ListTenants retrieves every tenant, sorted by slug
+func (s *Store) ListTenants(ctx context.Context) ([]*datastore.Tenant, error) {
+	query := `SELECT ` + tenantColumns + ` FROM tenants ORDER BY slug`
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tenants: %w", translateError(datastore.ResourceTenant, "", err))
+	}
+	defer rows.Close()
+
+	tenants := []*datastore.Tenant{}
+	for rows.Next() {
+		tenant, err := scanTenant(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan tenant: %w", err)
+		}
+		tenants = append(tenants, tenant)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tenants: %w", translateError(datastore.ResourceTenant, "", err))
+	}
+
+	return tenants, nil
+}
+
+// tenantColumns are the columns read by scanTenant
+const tenantColumns = `id, slug, name, created_at`
+
+// scanTenant reads a tenant selected with tenantColumns
+func scanTenant(row scanner) (*datastore.Tenant, error) {
+	var tenant datastore.Tenant
+	if err := row.Scan(&tenant.ID, &tenant.Slug, &tenant.Name, &tenant.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &tenant, nil
+}
+
+// ListAuditEvents retrieves a paginated list of the audit events matching
+// the filter, oldest first
+func (s *Store) ListAuditEvents(ctx context.Context, filter datastore.AuditFilter, pageSize int32, pageToken string) ([]*datastore.AuditEvent, string, error) {
+	if pageSize <= 0 {
+		return nil, "", datastore.Invalid(datastore.ResourceAuditEvent, "page_size", fmt.Errorf("must be positive, got %d", pageSize))
+	}
+
+	args := []interface{}{tenantID(ctx)}
+	paramCount := 2
+	conditions := []string{"tenant_id = $1"}
+
+	if filter.Resource != "" {
+		conditions = append(conditions, fmt.Sprintf("resource = $%d", paramCount))
+		args = append(args, filter.Resource)
+		paramCount++
+	}
+	if filter.ResourceID != "" {
+		conditions = append(conditions, fmt.Sprintf("resource_id = $%d", paramCount))
+		args = append(args, string(filter.ResourceID))
+		paramCount++
+	}
+	if filter.Actor != "" {
+		conditions = append(conditions, fmt.Sprintf("actor = $%d", paramCount))
+		args = append(args, filter.Actor)
+		paramCount++
+	}
+	if filter.Since != nil {
+		conditions = append(conditions, fmt.Sprintf("created_at >= $%d", paramCount))
+		args = append(args, *filter.Since)
+		paramCount++
+	}
+	if filter.Until != nil {
+		conditions = append(conditions, fmt.Sprintf("created_at < $%d", paramCount))
+		args = append(args, *filter.Until)
+		paramCount++
+	}
+
+	// The page token is the creation time and ID of the last event on the
+	// previous page
+	if pageToken != "" {
+		createdAt, lastID, err := datastore.ParseAuditPageToken(pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		conditions = append(conditions, fmt.Sprintf("(created_at, id) > ($%d, $%d)", paramCount, paramCount+1))
+		args = append(args, createdAt, string(lastID))
+		paramCount += 2
+	}
+
+	query := `SELECT ` + auditEventColumns + ` FROM audit_events WHERE ` + strings.Join(conditions, " AND ")
+	query += fmt.Sprintf(` ORDER BY created_at, id LIMIT $%d`, paramCount)
+	args = append(args, pageSize+1)
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list audit events: %w", translateError(datastore.ResourceAuditEvent, "", err))
+	}
+	defer rows.Close()
+
+	events := []*datastore.AuditEvent{}
+	for rows.Next() {
+		event, err := scanAuditEvent(rows)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to scan audit event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("error iterating audit events: %w", translateError(datastore.ResourceAuditEvent, "", err))
+	}
+
+	// Handle pagination
+	var nextPageToken string
+	if len(events) > int(pageSize) {
+		events = events[:len(events)-1] // Remove the extra result
+		nextPageToken = datastore.AuditPageToken(events[len(events)-1])
+	}
+
+	return events, nextPageToken, nil
+}
+
+// auditEventColumns are the columns read by scanAuditEvent
+const auditEventColumns = `id, created_at, actor, method, action, resource, resource_id, before, after, request_id, client_ip`
+
+// scanAuditEvent reads an audit event selected with auditEventColumns
+func scanAuditEvent(row scanner) (*datastore.AuditEvent, error) {
+	var event datastore.AuditEvent
+	var before, after []byte
+	err := row.Scan(
+		&event.ID, &event.CreatedAt, &event.Actor, &event.Method, &event.Action, &event.Resource, &event.ResourceID,
+		&before, &after, &event.RequestID, &event.ClientIP,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if before != nil {
+		event.Before = before
+	}
+	if after != nil {
+		event.After = after
+	}
+	return &event, nil
+}
+
+// checkAuthor checks that the user an author ID refers to exists, if it is
+// set. Foreign key violations would otherwise be reported against the blog
+// or comment being written.
+func checkAuthor(ctx context.Context, db execer, authorID *datastore.ID) error {
+	if authorID == nil {
+		return nil
+	}
+	var exists int
+	err := db.QueryRowContext(ctx, `SELECT 1 FROM users WHERE id = $1 AND tenant_id = $2`, string(*authorID), tenantID(ctx)).Scan(&exists)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return datastore.NotFound(datastore.ResourceUser, *authorID)
+		}
+		return fmt.Errorf("failed to check author existence: %w", translateError(datastore.ResourceUser, *authorID, err))
+	}
+	return nil
+}
+
+// tenantID returns the ID of the tenant a call is made for, which every
+// statement on tenant data is limited to
+func tenantID(ctx context.Context) string {
+	return string(datastore.TenantID(ctx))
+}
+
+// nullID returns the ID read from a nullable column, or nil if it is NULL
+func nullID(value sql.NullString) *datastore.ID {
+	if !value.Valid {
+		return nil
+	}
+	id := datastore.ID(value.String)
+	return &id
+}
+
+// recordRevision locks a blog and saves its current title and content as
+// its next revision, returning the revision number. The lock serializes
+// revisions of the same blog, so the next number is free until the
+// transaction ends.
+func recordRevision(ctx context.Context, tx *sql.Tx, id datastore.ID, editor string) (int32, error) {
+	var title, content string
+	query := `SELECT title, content FROM blogs WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL FOR UPDATE`
+	err := tx.QueryRowContext(ctx, query, string(id), tenantID(ctx)).Scan(&title, &content)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, datastore.NotFound(datastore.ResourceBlog, id)
+		}
+		return 0, fmt.Errorf("failed to lock blog: %w", translateError(datastore.ResourceBlog, id, err))
+	}
+
+	query = `
+		INSERT INTO revisions (blog_id, number, title, content, editor)
+		SELECT $1, COALESCE(MAX(number), 0) + 1, $2, $3, $4
+		FROM revisions
+		WHERE blog_id = $1
+		RETURNING number
+	`
+	var number int32
+	err = tx.QueryRowContext(ctx, query, string(id), title, content, editor).Scan(&number)
+	if err != nil {
+		return 0, fmt.Errorf("failed to record revision: %w", translateError(datastore.ResourceRevision, "", err))
+	}
+
+	return number, nil
+}
+
+// blogColumns returns the columns read by scanBlog, reading the content
+// with the given column expression
+func blogColumns(contentColumn string) string {
+	return `id, title, ` + contentColumn + `, created_at, updated_at, status, published_at, publish_at, version, deleted_at, slug,
+			ARRAY(SELECT t.name FROM blog_tags bt JOIN tags t ON t.id = bt.tag_id WHERE bt.blog_id = blogs.id ORDER BY t.name) AS tags,
+			(SELECT COUNT(*) FROM comments c WHERE c.blog_id = blogs.id AND c.state = 'approved') AS comment_count,
+			comment_policy, COALESCE(owner, '') AS owner, author_id`
+}
+
+// scanBlog reads a blog selected with blogColumns, without its comments
+func scanBlog(row scanner) (*datastore.Blog, error) {
+	var blog datastore.Blog
+	var publishedAt, publishAt, deletedAt sql.NullTime
+	var commentPolicy, authorID sql.NullString
+	err := row.Scan(
+		&blog.ID, &blog.Title, &blog.Content, &blog.CreatedAt, &blog.UpdatedAt, &blog.Status, &publishedAt, &publishAt, &blog.Version, &deletedAt,
+		&blog.Slug, pq.Array(&blog.Tags), &blog.CommentCount, &commentPolicy, &blog.Owner, &authorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if publishedAt.Valid {
+		blog.PublishedAt = &publishedAt.Time
+	}
+	if publishAt.Valid {
+		blog.PublishAt = &publishAt.Time
+	}
+	if deletedAt.Valid {
+		blog.DeletedAt = &deletedAt.Time
+	}
+	blog.CommentPolicy = datastore.CommentPolicy(commentPolicy.String)
+	blog.AuthorID = nullID(authorID)
+	return &blog, nil
+}
+
+// snapshotBlog locks a blog, whether or not it is in the trash, and returns
+// its audit snapshot
+func snapshotBlog(ctx context.Context, tx *sql.Tx, id datastore.ID) (json.RawMessage, error) {
+	query := `SELECT ` + blogColumns("content") + ` FROM blogs WHERE id = $1 AND tenant_id = $2 FOR UPDATE`
+	blog, err := scanBlog(tx.QueryRowContext(ctx, query, string(id), tenantID(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, datastore.NotFound(datastore.ResourceBlog, id)
+		}
+		return nil, fmt.Errorf("failed to read blog: %w", translateError(datastore.ResourceBlog, id, err))
+	}
+	return datastore.BlogSnapshot(blog), nil
+}
+
+// snapshotComment locks a comment of a blog, whatever its state, and
+// returns its audit snapshot
+func snapshotComment(ctx context.Context, tx *sql.Tx, blogID, id datastore.ID) (json.RawMessage, error) {
+	query := `SELECT ` + commentColumns + ` FROM comments WHERE id = $1 AND blog_id = $2 AND tenant_id = $3 FOR UPDATE`
+	comment, err := scanComment(tx.QueryRowContext(ctx, query, string(id), string(blogID), tenantID(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, datastore.NotFound(datastore.ResourceComment, id)
+		}
+		return nil, fmt.Errorf("failed to read comment: %w", translateError(datastore.ResourceComment, id, err))
+	}
+	return datastore.CommentSnapshot(comment), nil
+}
+
+// tenantBlog is a blog read along with its tenant by statements acting on
+// the blogs of every tenant
+type tenantBlog struct {
+	*datastore.Blog
+	tenantID string
+}
+
+// queryTenantBlogs runs a query selecting blogColumns followed by tenant_id
+// and reads the blogs it returns
+func queryTenantBlogs(ctx context.Context, tx *sql.Tx, msg, query string, args ...interface{}) ([]tenantBlog, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", msg, translateError(datastore.ResourceBlog, "", err))
+	}
+	defer rows.Close()
+
+	var blogs []tenantBlog
+	for rows.Next() {
+		var tenant string
+		blog, err := scanBlog(tenantScanner{rows, &tenant})
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan blog: %w", err)
+		}
+		blogs = append(blogs, tenantBlog{blog, tenant})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating blogs: %w", translateError(datastore.ResourceBlog, "", err))
+	}
+
+	return blogs, nil
+}
+
+// tenantBlogIDs returns the IDs of blogs as query arguments
+func tenantBlogIDs(blogs []tenantBlog) []string {
+	ids := make([]string, len(blogs))
+	for i, blog := range blogs {
+		ids[i] = string(blog.ID)
+	}
+	return ids
+}
+
+// tenantScanner reads the tenant ID selected after the columns read by
+// another scanner
+type tenantScanner struct {
+	row    scanner
+	tenant *string
+}
+
+// Scan reads the columns of the row followed by the tenant ID
+func (s tenantScanner) Scan(dest ...interface{}) error {
+	return s.row.Scan(append(dest, s.tenant)...)
+}
+
+// recordAudit records an audit event for a change made within a
+// transaction, for the request the context carries the audit information of
+func recordAudit(ctx context.Context, tx *sql.Tx, action datastore.AuditAction, resource string, id datastore.ID, before, after json.RawMessage) error {
+	return insertAudit(ctx, tx, tenantID(ctx), action, resource, id, before, after)
+}
+
+// insertAudit records an audit event of a tenant. Changes made for every
+// tenant at once name the tenant of each changed blog.
+func insertAudit(ctx context.Context, tx *sql.Tx, tenant string, action datastore.AuditAction, resource string, id datastore.ID, before, after json.RawMessage) error {
+	info := datastore.AuditFromContext(ctx)
+	query := `
+		INSERT INTO audit_events (id, tenant_id, actor, method, action, resource, resource_id, before, after, request_id, client_ip)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+	_, err := tx.ExecContext(ctx, query, uuid.New().String(), tenant, info.Actor, info.Method, string(action), resource, string(id),
+		jsonValue(before), jsonValue(after), info.RequestID, info.ClientIP)
+	if err != nil {
+		return fmt.Errorf("failed to record audit event: %w", translateError(datastore.ResourceAuditEvent, "", err))
+	}
+	return nil
+}
+
+// jsonValue returns the value of a nullable JSON column
+func jsonValue(data json.RawMessage) interface{} {
+	if data == nil {
+		return nil
+	}
+	return string(data)
+}
+
+// commentColumns are the columns read by scanComment
+const commentColumns = `id, blog_id, parent_id, depth, content, author, created_at, updated_at, state, moderation_reason, moderated_at, author_id`
+
+// scanner reads the columns of a single row
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanComment reads a comment selected with commentColumns
+func scanComment(row scanner) (*datastore.Comment, error) {
+	var comment datastore.Comment
+	var parentID, authorID sql.NullString
+	var updatedAt, moderatedAt sql.NullTime
+	err := row.Scan(
+		&comment.ID, &comment.BlogID, &parentID, &comment.Depth, &comment.Content, &comment.Author, &comment.CreatedAt, &updatedAt,
+		&comment.State, &comment.ModerationReason, &moderatedAt, &authorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if parentID.Valid {
+		parent := datastore.ID(parentID.String)
+		comment.ParentID = &parent
+	}
+	if updatedAt.Valid {
+		comment.UpdatedAt = &updatedAt.Time
+	}
+	if moderatedAt.Valid {
+		comment.ModeratedAt = &moderatedAt.Time
+	}
+	comment.AuthorID = nullID(authorID)
+	return &comment, nil
+}
+
+// queryComments runs a query selecting commentColumns and reads the
+// comments it returns
+func (s *Store) queryComments(ctx context.Context, msg, query string, args ...interface{}) ([]*datastore.Comment, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", msg, translateError(datastore.ResourceComment, "", err))
+	}
+	defer rows.Close()
+
+	comments := []*datastore.Comment{}
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan comment: %w", err)
+		}
+		comments = append(comments, comment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating comments: %w", translateError(datastore.ResourceComment, "", err))
+	}
+
+	return comments, nil
+}
+
+// commentAffected reports a statement for a single comment that affected
+// no rows as the comment not being found
+func commentAffected(result sql.Result, id datastore.ID) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return datastore.NotFound(datastore.ResourceComment, id)
+	}
+
+	return nil
+}
+
+// execer runs statements on a database or within a transaction
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// updateBlog runs an update query for a single blog, which is limited to
+// the expected version if it is non-zero
+func updateBlog(ctx context.Context, db execer, id datastore.ID, version int64, query string, args ...interface{}) error {
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update blog: %w", translateError(datastore.ResourceBlog, id, err))
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return missingOrChanged(ctx, db, id, version)
+	}
+
+	return nil
+}
+
+// missingOrChanged explains why a statement limited to a version of a blog
+// affected no rows. The blog is either missing or at another version.
+func missingOrChanged(ctx context.Context, db execer, id datastore.ID, version int64) error {
+	if version == 0 {
+		return datastore.NotFound(datastore.ResourceBlog, id)
+	}
+
+	checkQuery := `SELECT 1 FROM blogs WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+	var exists int
+	err := db.QueryRowContext(ctx, checkQuery, string(id), tenantID(ctx)).Scan(&exists)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return datastore.NotFound(datastore.ResourceBlog, id)
+		}
+		return fmt.Errorf("failed to check blog existence: %w", translateError(datastore.ResourceBlog, id, err))
+	}
+
+	return datastore.VersionMismatch(datastore.ResourceBlog, id)
+}
+
+// inTx runs fn in a transaction, committing it if fn succeeds
+func (s *Store) inTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", translateError("", "", err))
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", translateError("", "", err))
+	}
+
+	return nil
+}
+
+// setStatus runs a status change query for a single blog, recording it as
+// the given audit action
+func (s *Store) setStatus(ctx context.Context, id datastore.ID, action datastore.AuditAction, query, msg string) error {
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		before, err := snapshotBlog(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		result, err := tx.ExecContext(ctx, query, string(id), tenantID(ctx))
+		if err != nil {
+			return fmt.Errorf("%s: %w", msg, translateError(datastore.ResourceBlog, id, err))
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+
+		if rowsAffected == 0 {
+			return datastore.NotFound(datastore.ResourceBlog, id)
+		}
+
+		after, err := snapshotBlog(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		return recordAudit(ctx, tx, action, datastore.ResourceBlog, id, before, after)
+	})
+}
+
+// errPublishAt explains when a blog may have a publish time
+var errPublishAt = errors.New("only scheduled blogs have a publish time, and they must have one")
+
+// setTags replaces the tags of a blog, creating the tags that the tenant of
+// the blog does not have yet
+func setTags(ctx context.Context, db execer, id datastore.ID, tags []string) error {
+	_, err := db.ExecContext(ctx, `DELETE FROM blog_tags WHERE blog_id = $1`, string(id))
+	if err != nil {
+		return fmt.Errorf("failed to clear tags: %w", translateError(datastore.ResourceBlog, id, err))
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+
+	// Rows inserted by a statement are not visible to itself, so the tags are
+	// created before linking them
+	query := `INSERT INTO tags (tenant_id, name) SELECT $1, unnest($2::text[]) ON CONFLICT (tenant_id, name) DO NOTHING`
+	if _, err := db.ExecContext(ctx, query, tenantID(ctx), pq.Array(tags)); err != nil {
+		return fmt.Errorf("failed to create tags: %w", translateError(datastore.ResourceBlog, id, err))
+	}
+
+	query = `INSERT INTO blog_tags (blog_id, tag_id) SELECT $1, id FROM tags WHERE tenant_id = $2 AND name = ANY($3::text[])`
+	if _, err := db.ExecContext(ctx, query, string(id), tenantID(ctx), pq.Array(tags)); err != nil {
+		return fmt.Errorf("failed to set tags: %w", translateError(datastore.ResourceBlog, id, err))
+	}
+	return nil
+}
+
+// maxSlugClaims bounds how often Create looks for a free slug when
+// concurrent creates keep claiming the slug it picked
+const maxSlugClaims = 5
+
+// claimNewSlug claims the first free slug derived from base for a new blog
+func claimNewSlug(ctx context.Context, tx *sql.Tx, id datastore.ID, base string) (string, error) {
+	for range maxSlugClaims {
+		rows, err := tx.QueryContext(ctx, `SELECT slug FROM slugs WHERE tenant_id = $1 AND (slug = $2 OR slug LIKE $3)`, tenantID(ctx), base, base+"-%")
+		if err != nil {
+			return "", fmt.Errorf("failed to find slugs: %w", translateError(datastore.ResourceBlog, id, err))
+		}
+		taken := map[string]bool{}
+		for rows.Next() {
+			var slug string
+			if err := rows.Scan(&slug); err != nil {
+				rows.Close()
+				return "", fmt.Errorf("failed to scan slug: %w", err)
+			}
+			taken[slug] = true
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return "", fmt.Errorf("error iterating slugs: %w", translateError(datastore.ResourceBlog, id, err))
+		}
+
+		slug := datastore.UniqueSlug(base, func(slug string) bool { return taken[slug] })
+		claimed, err := claimSlug(ctx, tx, id, slug)
+		if err != nil {
+			return "", err
+		}
+		if claimed {
+			return slug, nil
+		}
+	}
+	return "", datastore.Conflict(datastore.ResourceBlog, id, fmt.Errorf("no free slug for %q", base))
+}
+
+// claimSlug reserves a slug for a blog, and reports false if another blog of
+// its tenant already has it. The no-op update on conflict returns the row when the
+// blog had the slug before.
+func claimSlug(ctx context.Context, tx *sql.Tx, id datastore.ID, slug string) (bool, error) {
+	query := `
+		INSERT INTO slugs (slug, blog_id, tenant_id) VALUES ($1, $2, $3)
+		ON CONFLICT (tenant_id, slug) DO UPDATE SET slug = EXCLUDED.slug WHERE slugs.blog_id = EXCLUDED.blog_id
+		RETURNING blog_id
+	`
+	var owner datastore.ID
+	err := tx.QueryRowContext(ctx, query, slug, string(id), tenantID(ctx)).Scan(&owner)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to claim slug: %w", translateError(datastore.ResourceBlog, id, err))
+	}
+	return true, nil
+}
+
+// headlineOptions configures the snippets of search results
+var headlineOptions = fmt.Sprintf("StartSel=%s, StopSel=%s", datastore.SnippetStart, datastore.SnippetStop)
+
+// tsQuery renders search terms as a tsquery matching every term. Words only
+// contain letters and digits, so they need no escaping.
+func tsQuery(terms []datastore.SearchTerm) string {
+	parts := make([]string, len(terms))
+	for i, term := range terms {
+		words := make([]string, len(term.Words))
+		for j, word := range term.Words {
+			words[j] = "'" + word + "'"
+		}
+		if term.Prefix {
+			words[len(words)-1] += ":*"
+		}
+		parts[i] = "(" + strings.Join(words, " <-> ") + ")"
+	}
+	return strings.Join(parts, " & ")
+}
+
+// validateStatus rejects unknown statuses before they reach the post_status enum
+func validateStatus(status datastore.Status) error {
+	if !status.Valid() {
+		return datastore.Invalid(datastore.ResourceBlog, "status", fmt.Errorf("unknown status %q", status))
+	}
+	return nil
 }
@@ -4,10 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"math"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -16,11 +18,17 @@ import (
 )
 
 func TestCreate(t *testing.T) {
+	testPublishAt := time.Now().Add(time.Hour)
+
 	// Define test cases
 	tests := []struct {
 		name        string
 		title       string
 		content     string
+		status      datastore.Status
+		publishAt   *time.Time
+		tags        []string
+		opts        []datastore.CreateOption
 		mockSetup   func(mock sqlmock.Sqlmock)
 		expectError bool
 		errorMsg    string
@@ -29,21 +37,250 @@ func TestCreate(t *testing.T) {
 			name:    "successful creation",
 			title:   "Test Title",
 			content: "Test Content",
+			status:  datastore.StatusPublished,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectClaimNewSlug(mock, "test-title", nil, "test-title")
+				mock.ExpectExec("INSERT INTO blogs").
+					WithArgs(sqlmock.AnyArg(), "Test Title", "Test Content", "published", nil, "test-title", "", nil, tenantID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				expectSnapshotBlog(mock, sqlmock.AnyArg())
+				expectRecordAudit(mock, datastore.AuditCreate, datastore.ResourceBlog, sqlmock.AnyArg())
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name:    "successful creation with owner",
+			title:   "Test Title",
+			content: "Test Content",
+			status:  datastore.StatusPublished,
+			opts:    []datastore.CreateOption{datastore.WithOwner("alice")},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectClaimNewSlug(mock, "test-title", nil, "test-title")
+				mock.ExpectExec("INSERT INTO blogs").
+					WithArgs(sqlmock.AnyArg(), "Test Title", "Test Content", "published", nil, "test-title", "alice", nil, tenantID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				expectSnapshotBlog(mock, sqlmock.AnyArg())
+				expectRecordAudit(mock, datastore.AuditCreate, datastore.ResourceBlog, sqlmock.AnyArg())
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name:    "successful creation with author",
+			title:   "Test Title",
+			content: "Test Content",
+			status:  datastore.StatusPublished,
+			opts:    []datastore.CreateOption{datastore.WithAuthor("test-user-id")},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT 1 FROM users WHERE id = \$1 AND tenant_id = \$2`).
+					WithArgs("test-user-id", tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"?column?"}).AddRow(1))
+				expectClaimNewSlug(mock, "test-title", nil, "test-title")
+				mock.ExpectExec("INSERT INTO blogs").
+					WithArgs(sqlmock.AnyArg(), "Test Title", "Test Content", "published", nil, "test-title", "", "test-user-id", tenantID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				expectSnapshotBlog(mock, sqlmock.AnyArg())
+				expectRecordAudit(mock, datastore.AuditCreate, datastore.ResourceBlog, sqlmock.AnyArg())
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name:    "author not found",
+			title:   "Test Title",
+			content: "Test Content",
+			status:  datastore.StatusPublished,
+			opts:    []datastore.CreateOption{datastore.WithAuthor("test-user-id")},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT 1 FROM users WHERE id = \$1 AND tenant_id = \$2`).
+					WithArgs("test-user-id", tenantID).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "user not found",
+		},
+		{
+			name:    "successful draft creation",
+			title:   "Test Title",
+			content: "Test Content",
+			status:  datastore.StatusDraft,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectClaimNewSlug(mock, "test-title", nil, "test-title")
+				mock.ExpectExec("INSERT INTO blogs").
+					WithArgs(sqlmock.AnyArg(), "Test Title", "Test Content", "draft", nil, "test-title", "", nil, tenantID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				expectSnapshotBlog(mock, sqlmock.AnyArg())
+				expectRecordAudit(mock, datastore.AuditCreate, datastore.ResourceBlog, sqlmock.AnyArg())
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name:      "successful scheduled creation",
+			title:     "Test Title",
+			content:   "Test Content",
+			status:    datastore.StatusScheduled,
+			publishAt: &testPublishAt,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectClaimNewSlug(mock, "test-title", nil, "test-title")
+				mock.ExpectExec("INSERT INTO blogs").
+					WithArgs(sqlmock.AnyArg(), "Test Title", "Test Content", "scheduled", testPublishAt, "test-title", "", nil, tenantID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				expectSnapshotBlog(mock, sqlmock.AnyArg())
+				expectRecordAudit(mock, datastore.AuditCreate, datastore.ResourceBlog, sqlmock.AnyArg())
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name:    "successful creation with tags",
+			title:   "Test Title",
+			content: "Test Content",
+			status:  datastore.StatusPublished,
+			tags:    []string{"news", "go", "news"},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectClaimNewSlug(mock, "test-title", nil, "test-title")
+				mock.ExpectExec("INSERT INTO blogs").
+					WithArgs(sqlmock.AnyArg(), "Test Title", "Test Content", "published", nil, "test-title", "", nil, tenantID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(`DELETE FROM blog_tags WHERE blog_id = \$1`).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`INSERT INTO tags \(tenant_id, name\) SELECT \$1, unnest\(\$2::text\[\]\) ON CONFLICT \(tenant_id, name\) DO NOTHING`).
+					WithArgs(tenantID, `{"go","news"}`).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec(`INSERT INTO blog_tags \(blog_id, tag_id\) SELECT \$1, id FROM tags WHERE tenant_id = \$2 AND name = ANY\(\$3::text\[\]\)`).
+					WithArgs(sqlmock.AnyArg(), tenantID, `{"go","news"}`).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				expectSnapshotBlog(mock, sqlmock.AnyArg())
+				expectRecordAudit(mock, datastore.AuditCreate, datastore.ResourceBlog, sqlmock.AnyArg())
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name:    "slug suffixed after taken slugs",
+			title:   "Test Title",
+			content: "Test Content",
+			status:  datastore.StatusPublished,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectClaimNewSlug(mock, "test-title", []string{"test-title", "test-title-2", "test-title-again"}, "test-title-3")
+				mock.ExpectExec("INSERT INTO blogs").
+					WithArgs(sqlmock.AnyArg(), "Test Title", "Test Content", "published", nil, "test-title-3", "", nil, tenantID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				expectSnapshotBlog(mock, sqlmock.AnyArg())
+				expectRecordAudit(mock, datastore.AuditCreate, datastore.ResourceBlog, sqlmock.AnyArg())
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name:    "slug claimed concurrently",
+			title:   "Test Title",
+			content: "Test Content",
+			status:  datastore.StatusPublished,
 			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT slug FROM slugs").
+					WillReturnRows(sqlmock.NewRows([]string{"slug"}))
+				mock.ExpectQuery("INSERT INTO slugs").
+					WithArgs("test-title", sqlmock.AnyArg(), tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"blog_id"}))
+				expectClaimNewSlug(mock, "test-title", []string{"test-title"}, "test-title-2")
 				mock.ExpectExec("INSERT INTO blogs").
-					WithArgs(sqlmock.AnyArg(), "Test Title", "Test Content").
+					WithArgs(sqlmock.AnyArg(), "Test Title", "Test Content", "published", nil, "test-title-2", "", nil, tenantID).
 					WillReturnResult(sqlmock.NewResult(1, 1))
+				expectSnapshotBlog(mock, sqlmock.AnyArg())
+				expectRecordAudit(mock, datastore.AuditCreate, datastore.ResourceBlog, sqlmock.AnyArg())
+				mock.ExpectCommit()
 			},
 			expectError: false,
 		},
+		{
+			name:    "slug error",
+			title:   "Test Title",
+			content: "Test Content",
+			status:  datastore.StatusPublished,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT slug FROM slugs").
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "failed to find slugs",
+		},
+		{
+			name:        "invalid tag",
+			title:       "Test Title",
+			content:     "Test Content",
+			status:      datastore.StatusPublished,
+			tags:        []string{"Not A Tag"},
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "blog invalid (tags)",
+		},
+		{
+			name:    "tag error",
+			title:   "Test Title",
+			content: "Test Content",
+			status:  datastore.StatusPublished,
+			tags:    []string{"go"},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectClaimNewSlug(mock, "test-title", nil, "test-title")
+				mock.ExpectExec("INSERT INTO blogs").
+					WithArgs(sqlmock.AnyArg(), "Test Title", "Test Content", "published", nil, "test-title", "", nil, tenantID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("DELETE FROM blog_tags").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("INSERT INTO tags").
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "failed to create tags",
+		},
+		{
+			name:        "scheduled without publish time",
+			title:       "Test Title",
+			content:     "Test Content",
+			status:      datastore.StatusScheduled,
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "blog invalid (publish_at)",
+		},
+		{
+			name:        "unknown status",
+			title:       "Test Title",
+			content:     "Test Content",
+			status:      datastore.Status("unknown"),
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "blog invalid (status)",
+		},
 		{
 			name:    "database error",
 			title:   "Test Title",
 			content: "Test Content",
+			status:  datastore.StatusPublished,
 			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectClaimNewSlug(mock, "test-title", nil, "test-title")
 				mock.ExpectExec("INSERT INTO blogs").
-					WithArgs(sqlmock.AnyArg(), "Test Title", "Test Content").
+					WithArgs(sqlmock.AnyArg(), "Test Title", "Test Content", "published", nil, "test-title", "", nil, tenantID).
 					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
 			},
 			expectError: true,
 			errorMsg:    "failed to create blog",
@@ -65,7 +302,7 @@ func TestCreate(t *testing.T) {
 			tc.mockSetup(mock)
 
 			// Call the method
-			id, err := store.Create(context.Background(), tc.title, tc.content)
+			id, err := store.Create(context.Background(), tc.title, tc.content, tc.status, tc.publishAt, tc.tags, tc.opts...)
 
 			// Assert expectations
 			if tc.expectError {
@@ -85,6 +322,7 @@ func TestGet(t *testing.T) {
 	tests := []struct {
 		name        string
 		id          datastore.ID
+		opts        []datastore.GetOption
 		mockSetup   func(mock sqlmock.Sqlmock)
 		expectError bool
 		errorMsg    string
@@ -101,11 +339,11 @@ func TestGet(t *testing.T) {
 				testUpdatedAt := time.Now()
 
 				// Blog rows
-				blogRows := sqlmock.NewRows([]string{"id", "title", "content", "created_at", "updated_at"}).
-					AddRow(testID, testTitle, testContent, testCreatedAt, testUpdatedAt)
+				blogRows := sqlmock.NewRows(blogColumns).
+					AddRow(testID, testTitle, testContent, testCreatedAt, testUpdatedAt, "published", testCreatedAt, nil, 3, nil, "test-title", "{gardening,tomatoes}", 2, nil, "", nil)
 
-				mock.ExpectQuery(`SELECT id, title, content, created_at, updated_at FROM blogs WHERE id = \$1`).
-					WithArgs(string(testID)).
+				mock.ExpectQuery(`SELECT id, title, content, created_at, updated_at, status, published_at, publish_at, version, deleted_at, slug, ARRAY\(SELECT t.name .+\) AS tags, \(SELECT COUNT\(\*\) FROM comments c WHERE c.blog_id = blogs.id AND c.state = 'approved'\) AS comment_count, comment_policy, COALESCE\(owner, ''\) AS owner, author_id FROM blogs WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL`).
+					WithArgs(string(testID), tenantID).
 					WillReturnRows(blogRows)
 
 				// Comment rows
@@ -118,19 +356,24 @@ func TestGet(t *testing.T) {
 				commentCreatedAt1 := time.Now()
 				commentCreatedAt2 := time.Now().Add(time.Hour)
 
-				commentRows := sqlmock.NewRows([]string{"id", "blog_id", "content", "author", "created_at"}).
-					AddRow(commentID1, testID, commentContent1, commentAuthor1, commentCreatedAt1).
-					AddRow(commentID2, testID, commentContent2, commentAuthor2, commentCreatedAt2)
+				commentRows := sqlmock.NewRows(commentColumns).
+					AddRow(commentID1, testID, nil, 0, commentContent1, commentAuthor1, commentCreatedAt1, nil, "approved", "", nil, nil).
+					AddRow(commentID2, testID, commentID1, 1, commentContent2, commentAuthor2, commentCreatedAt2, nil, "approved", "", nil, nil)
 
-				mock.ExpectQuery(`SELECT id, blog_id, content, author, created_at FROM comments WHERE blog_id = \$1 ORDER BY created_at`).
-					WithArgs(string(testID)).
+				mock.ExpectQuery(`SELECT id, blog_id, parent_id, depth, content, author, created_at, updated_at, state, moderation_reason, moderated_at, author_id FROM comments WHERE blog_id = \$1 AND tenant_id = \$2 AND state = 'approved' ORDER BY created_at, id`).
+					WithArgs(string(testID), tenantID).
 					WillReturnRows(commentRows)
 			},
 			expectError: false,
 			expected: &datastore.Blog{
-				ID:      datastore.ID("test-id"),
-				Title:   "Test Title",
-				Content: "Test Content",
+				ID:           datastore.ID("test-id"),
+				Title:        "Test Title",
+				Content:      "Test Content",
+				Status:       datastore.StatusPublished,
+				Version:      3,
+				Tags:         []string{"gardening", "tomatoes"},
+				Slug:         "test-title",
+				CommentCount: 2,
 				Comments: []datastore.Comment{
 					{
 						ID:      datastore.ID("comment-id-1"),
@@ -139,10 +382,12 @@ func TestGet(t *testing.T) {
 						Author:  "Author 1",
 					},
 					{
-						ID:      datastore.ID("comment-id-2"),
-						BlogID:  datastore.ID("test-id"),
-						Content: "Comment 2",
-						Author:  "Author 2",
+						ID:       datastore.ID("comment-id-2"),
+						BlogID:   datastore.ID("test-id"),
+						ParentID: idPtr("comment-id-1"),
+						Depth:    1,
+						Content:  "Comment 2",
+						Author:   "Author 2",
 					},
 				},
 				// CreatedAt and UpdatedAt will be set by the database
@@ -159,18 +404,18 @@ func TestGet(t *testing.T) {
 				testUpdatedAt := time.Now()
 
 				// Blog rows
-				blogRows := sqlmock.NewRows([]string{"id", "title", "content", "created_at", "updated_at"}).
-					AddRow(testID, testTitle, testContent, testCreatedAt, testUpdatedAt)
+				blogRows := sqlmock.NewRows(blogColumns).
+					AddRow(testID, testTitle, testContent, testCreatedAt, testUpdatedAt, "draft", nil, nil, 1, nil, "test-title", "{}", 0, nil, "alice", nil)
 
-				mock.ExpectQuery(`SELECT id, title, content, created_at, updated_at FROM blogs WHERE id = \$1`).
-					WithArgs(string(testID)).
+				mock.ExpectQuery(`SELECT id, title, content, created_at, updated_at, status, published_at, publish_at, version, deleted_at, slug, ARRAY\(SELECT t.name .+\) AS tags, \(SELECT COUNT\(\*\) FROM comments c WHERE c.blog_id = blogs.id AND c.state = 'approved'\) AS comment_count, comment_policy, COALESCE\(owner, ''\) AS owner, author_id FROM blogs WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL`).
+					WithArgs(string(testID), tenantID).
 					WillReturnRows(blogRows)
 
 				// Empty comment rows
-				commentRows := sqlmock.NewRows([]string{"id", "blog_id", "content", "author", "created_at"})
+				commentRows := sqlmock.NewRows(commentColumns)
 
-				mock.ExpectQuery(`SELECT id, blog_id, content, author, created_at FROM comments WHERE blog_id = \$1 ORDER BY created_at`).
-					WithArgs(string(testID)).
+				mock.ExpectQuery(`SELECT id, blog_id, parent_id, depth, content, author, created_at, updated_at, state, moderation_reason, moderated_at, author_id FROM comments WHERE blog_id = \$1 AND tenant_id = \$2 AND state = 'approved' ORDER BY created_at, id`).
+					WithArgs(string(testID), tenantID).
 					WillReturnRows(commentRows)
 			},
 			expectError: false,
@@ -178,16 +423,86 @@ func TestGet(t *testing.T) {
 				ID:       datastore.ID("test-id-no-comments"),
 				Title:    "Test Title No Comments",
 				Content:  "Test Content No Comments",
+				Status:   datastore.StatusDraft,
+				Version:  1,
+				Slug:     "test-title",
+				Owner:    "alice",
 				Comments: []datastore.Comment{},
 				// CreatedAt and UpdatedAt will be set by the database
 			},
 		},
+		{
+			name: "successful retrieval skipping content and comments",
+			id:   datastore.ID("test-id"),
+			opts: []datastore.GetOption{datastore.WithoutContent(), datastore.WithoutComments()},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				testCreatedAt := time.Now()
+
+				// Blog rows without content, and no comment query at all
+				blogRows := sqlmock.NewRows(blogColumns).
+					AddRow("test-id", "Test Title", "", testCreatedAt, testCreatedAt, "published", testCreatedAt, nil, 2, nil, "test-title", "{}", 0, nil, "", nil)
+
+				mock.ExpectQuery(`SELECT id, title, '' AS content, created_at, updated_at, status, published_at, publish_at, version, deleted_at, slug, ARRAY\(SELECT t.name .+\) AS tags, \(SELECT COUNT\(\*\) FROM comments c WHERE c.blog_id = blogs.id AND c.state = 'approved'\) AS comment_count, comment_policy, COALESCE\(owner, ''\) AS owner, author_id FROM blogs WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL`).
+					WithArgs("test-id", tenantID).
+					WillReturnRows(blogRows)
+			},
+			expectError: false,
+			expected: &datastore.Blog{
+				ID:       datastore.ID("test-id"),
+				Title:    "Test Title",
+				Status:   datastore.StatusPublished,
+				Version:  2,
+				Slug:     "test-title",
+				Comments: []datastore.Comment{},
+			},
+		},
+		{
+			name: "successful retrieval with comment limit",
+			id:   datastore.ID("test-id"),
+			opts: []datastore.GetOption{datastore.WithCommentLimit(1)},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				testCreatedAt := time.Now()
+
+				// The count covers all comments, even those past the limit
+				blogRows := sqlmock.NewRows(blogColumns).
+					AddRow("test-id", "Test Title", "Test Content", testCreatedAt, testCreatedAt, "draft", nil, nil, 1, nil, "test-title", "{}", 2, nil, "", nil)
+
+				mock.ExpectQuery(`SELECT id, title, content, .* AS comment_count, comment_policy, COALESCE\(owner, ''\) AS owner, author_id FROM blogs WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL`).
+					WithArgs("test-id", tenantID).
+					WillReturnRows(blogRows)
+
+				commentRows := sqlmock.NewRows(commentColumns).
+					AddRow("comment-id-1", "test-id", nil, 0, "Comment 1", "Author 1", testCreatedAt, testCreatedAt, "approved", "", nil, nil)
+
+				mock.ExpectQuery(`SELECT id, blog_id, parent_id, depth, content, author, created_at, updated_at, state, moderation_reason, moderated_at, author_id FROM comments WHERE blog_id = \$1 AND tenant_id = \$2 AND state = 'approved' ORDER BY created_at, id LIMIT \$3`).
+					WithArgs("test-id", tenantID, int32(1)).
+					WillReturnRows(commentRows)
+			},
+			expectError: false,
+			expected: &datastore.Blog{
+				ID:           datastore.ID("test-id"),
+				Title:        "Test Title",
+				Content:      "Test Content",
+				Status:       datastore.StatusDraft,
+				Version:      1,
+				Slug:         "test-title",
+				CommentCount: 2,
+				Comments: []datastore.Comment{
+					{
+						ID:      datastore.ID("comment-id-1"),
+						BlogID:  datastore.ID("test-id"),
+						Content: "Comment 1",
+						Author:  "Author 1",
+					},
+				},
+			},
+		},
 		{
 			name: "blog not found",
 			id:   datastore.ID("non-existent-id"),
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, title, content, created_at, updated_at FROM blogs WHERE id = ?").
-					WithArgs("non-existent-id").
+				mock.ExpectQuery("SELECT id, title, content, created_at, updated_at, status, published_at, publish_at, version, deleted_at, slug, ARRAY\\(SELECT t.name .+\\) AS tags, \\(SELECT COUNT\\(\\*\\) FROM comments c WHERE c.blog_id = blogs.id AND c.state = 'approved'\\) AS comment_count, comment_policy, COALESCE\\(owner, ''\\) AS owner, author_id FROM blogs WHERE id = ?").
+					WithArgs("non-existent-id", tenantID).
 					WillReturnError(sql.ErrNoRows)
 			},
 			expectError: true,
@@ -197,8 +512,8 @@ func TestGet(t *testing.T) {
 			name: "database error",
 			id:   datastore.ID("test-id"),
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, title, content, created_at, updated_at FROM blogs WHERE id = ?").
-					WithArgs("test-id").
+				mock.ExpectQuery("SELECT id, title, content, created_at, updated_at, status, published_at, publish_at, version, deleted_at, slug, ARRAY\\(SELECT t.name .+\\) AS tags, \\(SELECT COUNT\\(\\*\\) FROM comments c WHERE c.blog_id = blogs.id AND c.state = 'approved'\\) AS comment_count, comment_policy, COALESCE\\(owner, ''\\) AS owner, author_id FROM blogs WHERE id = ?").
+					WithArgs("test-id", tenantID).
 					WillReturnError(errors.New("database error"))
 			},
 			expectError: true,
@@ -215,16 +530,16 @@ func TestGet(t *testing.T) {
 				testUpdatedAt := time.Now()
 
 				// Blog rows
-				blogRows := sqlmock.NewRows([]string{"id", "title", "content", "created_at", "updated_at"}).
-					AddRow(testID, testTitle, testContent, testCreatedAt, testUpdatedAt)
+				blogRows := sqlmock.NewRows(blogColumns).
+					AddRow(testID, testTitle, testContent, testCreatedAt, testUpdatedAt, "published", testCreatedAt, nil, 3, nil, "test-title", "{gardening,tomatoes}", 2, nil, "", nil)
 
-				mock.ExpectQuery(`SELECT id, title, content, created_at, updated_at FROM blogs WHERE id = \$1`).
-					WithArgs(string(testID)).
+				mock.ExpectQuery(`SELECT id, title, content, created_at, updated_at, status, published_at, publish_at, version, deleted_at, slug, ARRAY\(SELECT t.name .+\) AS tags, \(SELECT COUNT\(\*\) FROM comments c WHERE c.blog_id = blogs.id AND c.state = 'approved'\) AS comment_count, comment_policy, COALESCE\(owner, ''\) AS owner, author_id FROM blogs WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL`).
+					WithArgs(string(testID), tenantID).
 					WillReturnRows(blogRows)
 
 				// Error when fetching comments
-				mock.ExpectQuery(`SELECT id, blog_id, content, author, created_at FROM comments WHERE blog_id = \$1 ORDER BY created_at`).
-					WithArgs(string(testID)).
+				mock.ExpectQuery(`SELECT id, blog_id, parent_id, depth, content, author, created_at, updated_at, state, moderation_reason, moderated_at, author_id FROM comments WHERE blog_id = \$1 AND tenant_id = \$2 AND state = 'approved' ORDER BY created_at, id`).
+					WithArgs(string(testID), tenantID).
 					WillReturnError(errors.New("failed to fetch comments"))
 			},
 			expectError: true,
@@ -247,7 +562,7 @@ func TestGet(t *testing.T) {
 			tc.mockSetup(mock)
 
 			// Call the method
-			blog, err := store.Get(context.Background(), tc.id)
+			blog, err := store.Get(context.Background(), tc.id, tc.opts...)
 
 			// Assert expectations
 			if tc.expectError {
@@ -259,12 +574,21 @@ func TestGet(t *testing.T) {
 				assert.Equal(t, tc.expected.ID, blog.ID)
 				assert.Equal(t, tc.expected.Title, blog.Title)
 				assert.Equal(t, tc.expected.Content, blog.Content)
+				assert.Equal(t, tc.expected.Status, blog.Status)
+				// Only published blogs have a publish time
+				assert.Equal(t, tc.expected.Status == datastore.StatusPublished, blog.PublishedAt != nil)
+				assert.ElementsMatch(t, tc.expected.Tags, blog.Tags)
+				assert.Equal(t, tc.expected.Slug, blog.Slug)
+				assert.Equal(t, tc.expected.CommentCount, blog.CommentCount)
+				assert.Equal(t, tc.expected.Owner, blog.Owner)
 
 				// Verify comments
 				assert.Equal(t, len(tc.expected.Comments), len(blog.Comments))
 				for i, expectedComment := range tc.expected.Comments {
 					assert.Equal(t, expectedComment.ID, blog.Comments[i].ID)
 					assert.Equal(t, expectedComment.BlogID, blog.Comments[i].BlogID)
+					assert.Equal(t, expectedComment.ParentID, blog.Comments[i].ParentID)
+					assert.Equal(t, expectedComment.Depth, blog.Comments[i].Depth)
 					assert.Equal(t, expectedComment.Content, blog.Comments[i].Content)
 					assert.Equal(t, expectedComment.Author, blog.Comments[i].Author)
 					// Note: We don't check CreatedAt as it's set by the database and might not match exactly
@@ -275,81 +599,52 @@ func TestGet(t *testing.T) {
 	}
 }
 
-func TestUpdate(t *testing.T) {
+func TestGetBySlug(t *testing.T) {
 	// Define test cases
-	testTitle := "Updated Title"
-	testContent := "Updated Content"
-
 	tests := []struct {
 		name        string
-		id          datastore.ID
-		title       *string
-		content     *string
+		slug        string
 		mockSetup   func(mock sqlmock.Sqlmock)
 		expectError bool
 		errorMsg    string
 	}{
 		{
-			name:    "successful update with both fields",
-			id:      datastore.ID("test-id"),
-			title:   &testTitle,
-			content: &testContent,
-			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("UPDATE blogs SET").
-					WithArgs(testTitle, testContent, string(datastore.ID("test-id"))).
-					WillReturnResult(sqlmock.NewResult(0, 1))
-			},
-			expectError: false,
-		},
-		{
-			name:    "successful update with title only",
-			id:      datastore.ID("test-id"),
-			title:   &testTitle,
-			content: nil,
-			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("UPDATE blogs SET").
-					WithArgs(testTitle, string(datastore.ID("test-id"))).
-					WillReturnResult(sqlmock.NewResult(0, 1))
-			},
-			expectError: false,
-		},
-		{
-			name:    "successful update with content only",
-			id:      datastore.ID("test-id"),
-			title:   nil,
-			content: &testContent,
+			name: "successful retrieval",
+			slug: "old-title",
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("UPDATE blogs SET").
-					WithArgs(testContent, string(datastore.ID("test-id"))).
-					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`SELECT blog_id FROM slugs WHERE slug = \$1 AND tenant_id = \$2`).
+					WithArgs("old-title", tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"blog_id"}).AddRow("test-id"))
+
+				blogRows := sqlmock.NewRows(blogColumns).
+					AddRow("test-id", "Test Title", "", time.Now(), time.Now(), "published", time.Now(), nil, 2, nil, "test-title", "{}", 0, nil, "", nil)
+				mock.ExpectQuery(`SELECT id, title, '' AS content, .* FROM blogs WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL`).
+					WithArgs("test-id", tenantID).
+					WillReturnRows(blogRows)
 			},
 			expectError: false,
 		},
 		{
-			name:    "blog not found",
-			id:      datastore.ID("non-existent-id"),
-			title:   &testTitle,
-			content: &testContent,
+			name: "slug not found",
+			slug: "missing",
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("UPDATE blogs SET").
-					WithArgs(testTitle, testContent, string(datastore.ID("non-existent-id"))).
-					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`SELECT blog_id FROM slugs WHERE slug = \$1 AND tenant_id = \$2`).
+					WithArgs("missing", tenantID).
+					WillReturnError(sql.ErrNoRows)
 			},
 			expectError: true,
 			errorMsg:    "blog not found",
 		},
 		{
-			name:    "database error",
-			id:      datastore.ID("test-id"),
-			title:   &testTitle,
-			content: &testContent,
+			name: "database error",
+			slug: "old-title",
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("UPDATE blogs SET").
-					WithArgs(testTitle, testContent, string(datastore.ID("test-id"))).
+				mock.ExpectQuery(`SELECT blog_id FROM slugs WHERE slug = \$1 AND tenant_id = \$2`).
+					WithArgs("old-title", tenantID).
 					WillReturnError(errors.New("database error"))
 			},
 			expectError: true,
-			errorMsg:    "failed to update blog",
+			errorMsg:    "failed to get blog",
 		},
 	}
 
@@ -368,66 +663,412 @@ func TestUpdate(t *testing.T) {
 			tc.mockSetup(mock)
 
 			// Call the method
-			err = store.Update(context.Background(), tc.id, tc.title, tc.content)
+			blog, err := store.GetBySlug(context.Background(), tc.slug, datastore.WithoutContent(), datastore.WithoutComments())
 
 			// Assert expectations
 			if tc.expectError {
 				require.Error(t, err)
 				assert.Contains(t, err.Error(), tc.errorMsg)
+				assert.Nil(t, blog)
 			} else {
 				require.NoError(t, err)
+				assert.Equal(t, datastore.ID("test-id"), blog.ID)
+				assert.Equal(t, "test-title", blog.Slug)
 			}
 			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestDelete(t *testing.T) {
+func TestUpdate(t *testing.T) {
 	// Define test cases
+	testTitle := "Updated Title"
+	testContent := "Updated Content"
+	testStatus := datastore.StatusArchived
+	testPublishAt := time.Now().Add(time.Hour)
+	unknownStatus := datastore.Status("unknown")
+	testTags := []string{"news", "go"}
+	noTags := []string{}
+	testSlug := "new-slug"
+	invalidSlug := "New Slug"
+	moderated := datastore.CommentPolicyModerated
+	defaultPolicy := datastore.CommentPolicyDefault
+	unknownPolicy := datastore.CommentPolicy("unknown")
+
 	tests := []struct {
-		name        string
-		id          datastore.ID
-		mockSetup   func(mock sqlmock.Sqlmock)
-		expectError bool
-		errorMsg    string
+		name          string
+		id            datastore.ID
+		title         *string
+		content       *string
+		status        *datastore.Status
+		publishAt     *time.Time
+		tags          *[]string
+		slug          *string
+		commentPolicy *datastore.CommentPolicy
+		editor        string
+		version       int64
+		mockSetup     func(mock sqlmock.Sqlmock)
+		expectError   bool
+		errorMsg      string
 	}{
 		{
-			name: "successful deletion",
-			id:   datastore.ID("test-id"),
+			name:    "successful update with both fields",
+			id:      datastore.ID("test-id"),
+			title:   &testTitle,
+			content: &testContent,
+			editor:  "alice",
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("DELETE FROM blogs WHERE id = ?").
-					WithArgs(string(datastore.ID("test-id"))).
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordRevision(mock, "test-id", "alice", 1)
+				mock.ExpectExec("UPDATE blogs SET").
+					WithArgs(testTitle, testContent, string(datastore.ID("test-id")), tenantID).
 					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordAudit(mock, datastore.AuditUpdate, datastore.ResourceBlog, "test-id")
+				mock.ExpectCommit()
 			},
 			expectError: false,
 		},
 		{
-			name: "blog not found",
-			id:   datastore.ID("non-existent-id"),
+			name:    "successful update with title only",
+			id:      datastore.ID("test-id"),
+			title:   &testTitle,
+			content: nil,
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("DELETE FROM blogs WHERE id = ?").
-					WithArgs(string(datastore.ID("non-existent-id"))).
-					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordRevision(mock, "test-id", "", 2)
+				mock.ExpectExec("UPDATE blogs SET").
+					WithArgs(testTitle, string(datastore.ID("test-id")), tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordAudit(mock, datastore.AuditUpdate, datastore.ResourceBlog, "test-id")
+				mock.ExpectCommit()
 			},
-			expectError: true,
-			errorMsg:    "blog not found",
+			expectError: false,
 		},
 		{
-			name: "database error",
+			name:    "successful update with content only",
+			id:      datastore.ID("test-id"),
+			title:   nil,
+			content: &testContent,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordRevision(mock, "test-id", "", 1)
+				mock.ExpectExec("UPDATE blogs SET").
+					WithArgs(testContent, string(datastore.ID("test-id")), tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordAudit(mock, datastore.AuditUpdate, datastore.ResourceBlog, "test-id")
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name:   "successful update with status only",
+			id:     datastore.ID("test-id"),
+			status: &testStatus,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec(`UPDATE blogs SET status = \$1::post_status, published_at = CASE .*, publish_at = NULL WHERE id = \$2 AND tenant_id = \$3`).
+					WithArgs("archived", string(datastore.ID("test-id")), tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordAudit(mock, datastore.AuditUpdate, datastore.ResourceBlog, "test-id")
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name:      "successful update with publish time",
+			id:        datastore.ID("test-id"),
+			publishAt: &testPublishAt,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec(`UPDATE blogs SET status = \$1::post_status, published_at = CASE .*, publish_at = \$2 WHERE id = \$3 AND tenant_id = \$4`).
+					WithArgs("scheduled", testPublishAt, string(datastore.ID("test-id")), tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordAudit(mock, datastore.AuditUpdate, datastore.ResourceBlog, "test-id")
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name: "successful update with tags only",
 			id:   datastore.ID("test-id"),
+			tags: &testTags,
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("DELETE FROM blogs WHERE id = ?").
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec(`UPDATE blogs SET updated_at = NOW\(\) WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL`).
+					WithArgs(string(datastore.ID("test-id")), tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`DELETE FROM blog_tags WHERE blog_id = \$1`).
 					WithArgs(string(datastore.ID("test-id"))).
-					WillReturnError(errors.New("database error"))
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("INSERT INTO tags").
+					WithArgs(tenantID, `{"go","news"}`).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec("INSERT INTO blog_tags").
+					WithArgs(string(datastore.ID("test-id")), tenantID, `{"go","news"}`).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordAudit(mock, datastore.AuditUpdate, datastore.ResourceBlog, "test-id")
+				mock.ExpectCommit()
 			},
-			expectError: true,
-			errorMsg:    "failed to delete blog",
+			expectError: false,
 		},
-	}
-
-	// Run test cases
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
+		{
+			name: "successful update clearing tags",
+			id:   datastore.ID("test-id"),
+			tags: &noTags,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec("UPDATE blogs SET updated_at = NOW").
+					WithArgs(string(datastore.ID("test-id")), tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`DELETE FROM blog_tags WHERE blog_id = \$1`).
+					WithArgs(string(datastore.ID("test-id"))).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordAudit(mock, datastore.AuditUpdate, datastore.ResourceBlog, "test-id")
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name: "successful update with slug",
+			id:   datastore.ID("test-id"),
+			slug: &testSlug,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectQuery(`INSERT INTO slugs \(slug, blog_id, tenant_id\) VALUES \(\$1, \$2, \$3\) ON CONFLICT \(tenant_id, slug\) DO UPDATE .* RETURNING blog_id`).
+					WithArgs(testSlug, string(datastore.ID("test-id")), tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"blog_id"}).AddRow("test-id"))
+				mock.ExpectExec(`UPDATE blogs SET slug = \$1 WHERE id = \$2 AND tenant_id = \$3 AND deleted_at IS NULL`).
+					WithArgs(testSlug, string(datastore.ID("test-id")), tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordAudit(mock, datastore.AuditUpdate, datastore.ResourceBlog, "test-id")
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name: "slug of another blog",
+			id:   datastore.ID("test-id"),
+			slug: &testSlug,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectQuery("INSERT INTO slugs").
+					WithArgs(testSlug, string(datastore.ID("test-id")), tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"blog_id"}))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    `blog conflict: slug "new-slug" is taken`,
+		},
+		{
+			name:        "invalid slug",
+			id:          datastore.ID("test-id"),
+			slug:        &invalidSlug,
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "blog invalid (slug)",
+		},
+		{
+			name:          "successful update with comment policy",
+			id:            datastore.ID("test-id"),
+			commentPolicy: &moderated,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec(`UPDATE blogs SET comment_policy = NULLIF\(\$1, ''\)::comment_policy WHERE id = \$2 AND tenant_id = \$3 AND deleted_at IS NULL`).
+					WithArgs("moderated", string(datastore.ID("test-id")), tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordAudit(mock, datastore.AuditUpdate, datastore.ResourceBlog, "test-id")
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name:          "successful update resetting comment policy",
+			id:            datastore.ID("test-id"),
+			commentPolicy: &defaultPolicy,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec(`UPDATE blogs SET comment_policy = NULLIF\(\$1, ''\)::comment_policy`).
+					WithArgs("", string(datastore.ID("test-id")), tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordAudit(mock, datastore.AuditUpdate, datastore.ResourceBlog, "test-id")
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name:          "unknown comment policy",
+			id:            datastore.ID("test-id"),
+			commentPolicy: &unknownPolicy,
+			mockSetup:     func(mock sqlmock.Sqlmock) {},
+			expectError:   true,
+			errorMsg:      "blog invalid (comment_policy)",
+		},
+		{
+			name:        "publish time with other status",
+			id:          datastore.ID("test-id"),
+			status:      &testStatus,
+			publishAt:   &testPublishAt,
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "blog invalid (publish_at)",
+		},
+		{
+			name:        "unknown status",
+			id:          datastore.ID("test-id"),
+			status:      &unknownStatus,
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "blog invalid (status)",
+		},
+		{
+			name:    "successful update with version",
+			id:      datastore.ID("test-id"),
+			status:  &testStatus,
+			version: 3,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec(`UPDATE blogs SET status = .* WHERE id = \$2 AND tenant_id = \$3 AND deleted_at IS NULL AND version = \$4`).
+					WithArgs("archived", string(datastore.ID("test-id")), tenantID, int64(3)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordAudit(mock, datastore.AuditUpdate, datastore.ResourceBlog, "test-id")
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name:    "stale version",
+			id:      datastore.ID("test-id"),
+			title:   &testTitle,
+			version: 2,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordRevision(mock, "test-id", "", 1)
+				mock.ExpectExec(`UPDATE blogs SET title = \$1 WHERE id = \$2 AND tenant_id = \$3 AND deleted_at IS NULL AND version = \$4`).
+					WithArgs(testTitle, string(datastore.ID("test-id")), tenantID, int64(2)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`SELECT 1 FROM blogs WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL`).
+					WithArgs(string(datastore.ID("test-id")), tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"?column?"}).AddRow(1))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "blog version mismatch",
+		},
+		{
+			name:    "blog not found with version",
+			id:      datastore.ID("non-existent-id"),
+			status:  &testStatus,
+			version: 2,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "non-existent-id")
+				mock.ExpectExec("UPDATE blogs SET").
+					WithArgs("archived", string(datastore.ID("non-existent-id")), tenantID, int64(2)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`SELECT 1 FROM blogs WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL`).
+					WithArgs(string(datastore.ID("non-existent-id")), tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"?column?"}))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "blog not found",
+		},
+		{
+			name:    "blog not found",
+			id:      datastore.ID("non-existent-id"),
+			title:   &testTitle,
+			content: &testContent,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT id, title, content, (.+) FROM blogs WHERE id = \$1 AND tenant_id = \$2 FOR UPDATE`).
+					WithArgs(string(datastore.ID("non-existent-id")), tenantID).
+					WillReturnRows(sqlmock.NewRows(blogColumns))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "blog not found",
+		},
+		{
+			name:    "database error",
+			id:      datastore.ID("test-id"),
+			title:   &testTitle,
+			content: &testContent,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordRevision(mock, "test-id", "", 1)
+				mock.ExpectExec("UPDATE blogs SET").
+					WithArgs(testTitle, testContent, string(datastore.ID("test-id")), tenantID).
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "failed to update blog",
+		},
+		{
+			name:  "revision error",
+			id:    datastore.ID("test-id"),
+			title: &testTitle,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectQuery(`SELECT title, content FROM blogs WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL FOR UPDATE`).
+					WithArgs(string(datastore.ID("test-id")), tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"title", "content"}).AddRow("Old Title", "Old Content"))
+				mock.ExpectQuery("INSERT INTO revisions").
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "failed to record revision",
+		},
+		{
+			name:   "audit error",
+			id:     datastore.ID("test-id"),
+			status: &testStatus,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec("UPDATE blogs SET").
+					WithArgs("archived", string(datastore.ID("test-id")), tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec("INSERT INTO audit_events").
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "failed to record audit event",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
 			// Create a new mock database
 			db, mock, err := sqlmock.New()
 			require.NoError(t, err)
@@ -440,7 +1081,7 @@ func TestDelete(t *testing.T) {
 			tc.mockSetup(mock)
 
 			// Call the method
-			err = store.Delete(context.Background(), tc.id)
+			err = store.Update(context.Background(), tc.id, datastore.BlogPatch{Title: tc.title, Content: tc.content, Status: tc.status, PublishAt: tc.publishAt, Tags: tc.tags, Slug: tc.slug, CommentPolicy: tc.commentPolicy}, tc.editor, tc.version)
 
 			// Assert expectations
 			if tc.expectError {
@@ -454,88 +1095,91 @@ func TestDelete(t *testing.T) {
 	}
 }
 
-func TestList(t *testing.T) {
+func TestDelete(t *testing.T) {
 	// Define test cases
 	tests := []struct {
-		name          string
-		pageSize      int32
-		pageToken     string
-		mockSetup     func(mock sqlmock.Sqlmock)
-		expectError   bool
-		errorMsg      string
-		expectedBlogs []*datastore.BlogSummary
-		nextPageToken string
+		name        string
+		id          datastore.ID
+		version     int64
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorMsg    string
 	}{
 		{
-			name:      "successful list without pagination",
-			pageSize:  10,
-			pageToken: "",
+			name: "successful deletion",
+			id:   datastore.ID("test-id"),
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				testID1 := datastore.ID("test-id-1")
-				testID2 := datastore.ID("test-id-2")
-				testTitle1 := "Test Title 1"
-				testTitle2 := "Test Title 2"
-				commentCount1 := int32(5)
-				commentCount2 := int32(10)
-
-				rows := sqlmock.NewRows([]string{"id", "title", "comment_count"}).
-					AddRow(testID1, testTitle1, commentCount1).
-					AddRow(testID2, testTitle2, commentCount2)
-
-				mock.ExpectQuery("SELECT b.id, b.title, COUNT\\(c.id\\) as comment_count FROM blogs b LEFT JOIN comments c ON b.id = c.blog_id").
-					WillReturnRows(rows)
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec(`UPDATE blogs SET deleted_at = NOW\(\) WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL`).
+					WithArgs(string(datastore.ID("test-id")), tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordAudit(mock, datastore.AuditDelete, datastore.ResourceBlog, "test-id")
+				mock.ExpectCommit()
 			},
 			expectError: false,
-			expectedBlogs: []*datastore.BlogSummary{
-				{
-					ID:           datastore.ID("test-id-1"),
-					Title:        "Test Title 1",
-					CommentCount: 5,
-				},
-				{
-					ID:           datastore.ID("test-id-2"),
-					Title:        "Test Title 2",
-					CommentCount: 10,
-				},
+		},
+		{
+			name: "blog not found",
+			id:   datastore.ID("non-existent-id"),
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT id, title, content, (.+) FROM blogs WHERE id = \$1 AND tenant_id = \$2 FOR UPDATE`).
+					WithArgs(string(datastore.ID("non-existent-id")), tenantID).
+					WillReturnRows(sqlmock.NewRows(blogColumns))
+				mock.ExpectRollback()
 			},
-			nextPageToken: "",
+			expectError: true,
+			errorMsg:    "blog not found",
 		},
 		{
-			name:      "successful list with pagination",
-			pageSize:  1,
-			pageToken: "test-id-1",
+			name:    "successful deletion with version",
+			id:      datastore.ID("test-id"),
+			version: 4,
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				testID2 := datastore.ID("test-id-2")
-				testTitle2 := "Test Title 2"
-				commentCount2 := int32(10)
-
-				rows := sqlmock.NewRows([]string{"id", "title", "comment_count"}).
-					AddRow(testID2, testTitle2, commentCount2)
-
-				mock.ExpectQuery("SELECT b.id, b.title, COUNT\\(c.id\\) as comment_count FROM blogs b LEFT JOIN comments c ON b.id = c.blog_id WHERE b.id > \\$1 GROUP BY b.id, b.title ORDER BY b.id LIMIT \\$2").
-					WithArgs("test-id-1", int32(2)). // pageSize + 1 = 1 + 1 = 2
-					WillReturnRows(rows)
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec(`UPDATE blogs SET deleted_at = NOW\(\) WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL AND version = \$3`).
+					WithArgs(string(datastore.ID("test-id")), tenantID, int64(4)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordAudit(mock, datastore.AuditDelete, datastore.ResourceBlog, "test-id")
+				mock.ExpectCommit()
 			},
 			expectError: false,
-			expectedBlogs: []*datastore.BlogSummary{
-				{
-					ID:           datastore.ID("test-id-2"),
-					Title:        "Test Title 2",
-					CommentCount: 10,
-				},
+		},
+		{
+			name:    "stale version",
+			id:      datastore.ID("test-id"),
+			version: 3,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec(`UPDATE blogs SET deleted_at = NOW\(\) WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL AND version = \$3`).
+					WithArgs(string(datastore.ID("test-id")), tenantID, int64(3)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`SELECT 1 FROM blogs WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL`).
+					WithArgs(string(datastore.ID("test-id")), tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"?column?"}).AddRow(1))
+				mock.ExpectRollback()
 			},
-			nextPageToken: "",
+			expectError: true,
+			errorMsg:    "blog version mismatch",
 		},
 		{
-			name:      "database error",
-			pageSize:  10,
-			pageToken: "",
+			name: "database error",
+			id:   datastore.ID("test-id"),
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT b.id, b.title, COUNT\\(c.id\\) as comment_count FROM blogs b LEFT JOIN comments c ON b.id = c.blog_id").
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec(`UPDATE blogs SET deleted_at = NOW\(\) WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL`).
+					WithArgs(string(datastore.ID("test-id")), tenantID).
 					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
 			},
 			expectError: true,
-			errorMsg:    "failed to list blogs",
+			errorMsg:    "failed to delete blog",
 		},
 	}
 
@@ -554,104 +1198,82 @@ func TestList(t *testing.T) {
 			tc.mockSetup(mock)
 
 			// Call the method
-			summaries, nextPageToken, err := store.List(context.Background(), tc.pageSize, tc.pageToken)
+			err = store.Delete(context.Background(), tc.id, tc.version)
 
 			// Assert expectations
 			if tc.expectError {
 				require.Error(t, err)
 				assert.Contains(t, err.Error(), tc.errorMsg)
-				assert.Nil(t, summaries)
-				assert.Empty(t, nextPageToken)
 			} else {
 				require.NoError(t, err)
-				assert.Equal(t, len(tc.expectedBlogs), len(summaries))
-				for i, expected := range tc.expectedBlogs {
-					assert.Equal(t, expected.ID, summaries[i].ID)
-					assert.Equal(t, expected.Title, summaries[i].Title)
-					assert.Equal(t, expected.CommentCount, summaries[i].CommentCount)
-				}
-				assert.Equal(t, tc.nextPageToken, nextPageToken)
 			}
 			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestAddComment(t *testing.T) {
+func TestUndelete(t *testing.T) {
 	// Define test cases
 	tests := []struct {
 		name        string
-		blogID      datastore.ID
-		content     string
-		author      string
+		id          datastore.ID
 		mockSetup   func(mock sqlmock.Sqlmock)
 		expectError bool
 		errorMsg    string
 	}{
 		{
-			name:    "successful comment addition",
-			blogID:  datastore.ID("test-blog-id"),
-			content: "Test Comment",
-			author:  "Test Author",
+			name: "successful undelete",
+			id:   datastore.ID("test-id"),
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				// Set up expectations for checking if blog exists
-				rows := sqlmock.NewRows([]string{"exists"}).AddRow(1)
-				mock.ExpectQuery("SELECT 1 FROM blogs WHERE id = ?").
-					WithArgs(string(datastore.ID("test-blog-id"))).
-					WillReturnRows(rows)
-
-				// Set up expectations for inserting comment
-				mock.ExpectExec("INSERT INTO comments").
-					WithArgs(sqlmock.AnyArg(), string(datastore.ID("test-blog-id")), "Test Comment", "Test Author").
-					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec(`UPDATE blogs SET deleted_at = NULL WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NOT NULL`).
+					WithArgs("test-id", tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordAudit(mock, datastore.AuditUndelete, datastore.ResourceBlog, "test-id")
+				mock.ExpectCommit()
 			},
 			expectError: false,
 		},
 		{
-			name:    "blog not found",
-			blogID:  datastore.ID("non-existent-blog-id"),
-			content: "Test Comment",
-			author:  "Test Author",
+			name: "blog not in trash",
+			id:   datastore.ID("test-id"),
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT 1 FROM blogs WHERE id = ?").
-					WithArgs(string(datastore.ID("non-existent-blog-id"))).
-					WillReturnError(sql.ErrNoRows)
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec("UPDATE blogs SET deleted_at = NULL").
+					WithArgs("test-id", tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
 			},
 			expectError: true,
 			errorMsg:    "blog not found",
 		},
 		{
-			name:    "database error on check",
-			blogID:  datastore.ID("test-blog-id"),
-			content: "Test Comment",
-			author:  "Test Author",
+			name: "database error",
+			id:   datastore.ID("test-id"),
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT 1 FROM blogs WHERE id = ?").
-					WithArgs(string(datastore.ID("test-blog-id"))).
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec("UPDATE blogs SET deleted_at = NULL").
+					WithArgs("test-id", tenantID).
 					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
 			},
 			expectError: true,
-			errorMsg:    "failed to check blog existence",
+			errorMsg:    "failed to undelete blog",
 		},
 		{
-			name:    "database error on insert",
-			blogID:  datastore.ID("test-blog-id"),
-			content: "Test Comment",
-			author:  "Test Author",
+			name: "missing blog",
+			id:   datastore.ID("test-id"),
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				// Set up expectations for checking if blog exists
-				rows := sqlmock.NewRows([]string{"exists"}).AddRow(1)
-				mock.ExpectQuery("SELECT 1 FROM blogs WHERE id = ?").
-					WithArgs(string(datastore.ID("test-blog-id"))).
-					WillReturnRows(rows)
-
-				// Set up expectations for inserting comment with error
-				mock.ExpectExec("INSERT INTO comments").
-					WithArgs(sqlmock.AnyArg(), string(datastore.ID("test-blog-id")), "Test Comment", "Test Author").
-					WillReturnError(errors.New("database error"))
+				mock.ExpectBegin()
+				expectSnapshotMissingBlog(mock, "test-id")
+				mock.ExpectRollback()
 			},
 			expectError: true,
-			errorMsg:    "failed to add comment",
+			errorMsg:    "blog not found",
 		},
 	}
 
@@ -670,18 +1292,3308 @@ func TestAddComment(t *testing.T) {
 			tc.mockSetup(mock)
 
 			// Call the method
-			commentID, err := store.AddComment(context.Background(), tc.blogID, tc.content, tc.author)
+			err = store.Undelete(context.Background(), tc.id)
 
 			// Assert expectations
 			if tc.expectError {
 				require.Error(t, err)
 				assert.Contains(t, err.Error(), tc.errorMsg)
-				assert.Empty(t, commentID)
 			} else {
 				require.NoError(t, err)
-				assert.NotEmpty(t, commentID)
 			}
 			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
+
+func TestPurge(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name        string
+		id          datastore.ID
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name: "successful purge",
+			id:   datastore.ID("test-id"),
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec(`DELETE FROM blogs WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NOT NULL`).
+					WithArgs("test-id", tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectRecordAudit(mock, datastore.AuditPurge, datastore.ResourceBlog, "test-id")
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name: "blog not in trash",
+			id:   datastore.ID("test-id"),
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec("DELETE FROM blogs").
+					WithArgs("test-id", tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "blog not found",
+		},
+		{
+			name: "database error",
+			id:   datastore.ID("test-id"),
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec("DELETE FROM blogs").
+					WithArgs("test-id", tenantID).
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "failed to purge blog",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			err = store.Purge(context.Background(), tc.id)
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPurgeDeleted(t *testing.T) {
+	before := time.Now().Add(-24 * time.Hour)
+
+	// Define test cases
+	tests := []struct {
+		name        string
+		limit       int32
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorMsg    string
+		expectedIDs []datastore.ID
+	}{
+		{
+			name:  "purges expired blogs",
+			limit: 10,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT id, title, content, (.+), tenant_id FROM blogs WHERE deleted_at <= \$1 ORDER BY deleted_at LIMIT \$2 FOR UPDATE SKIP LOCKED`).
+					WithArgs(before, int32(10)).
+					WillReturnRows(tenantBlogRows("draft", "test-id-1", "test-id-2"))
+				mock.ExpectExec(`DELETE FROM blogs WHERE id = ANY\(\$1::uuid\[\]\)`).
+					WithArgs(pq.Array([]string{"test-id-1", "test-id-2"})).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				expectInsertAudit(mock, "tenant-test-id-1", datastore.AuditPurge, datastore.ResourceBlog, "test-id-1")
+				expectInsertAudit(mock, "tenant-test-id-2", datastore.AuditPurge, datastore.ResourceBlog, "test-id-2")
+				mock.ExpectCommit()
+			},
+			expectError: false,
+			expectedIDs: []datastore.ID{"test-id-1", "test-id-2"},
+		},
+		{
+			name:  "nothing expired",
+			limit: 10,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT (.+) FROM blogs WHERE deleted_at").
+					WithArgs(before, int32(10)).
+					WillReturnRows(tenantBlogRows("draft"))
+				mock.ExpectCommit()
+			},
+			expectError: false,
+			expectedIDs: nil,
+		},
+		{
+			name:        "invalid limit",
+			limit:       0,
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "blog invalid (limit)",
+		},
+		{
+			name:  "database error",
+			limit: 10,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT (.+) FROM blogs WHERE deleted_at").
+					WithArgs(before, int32(10)).
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "failed to find deleted blogs",
+		},
+		{
+			name:  "delete error",
+			limit: 10,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT (.+) FROM blogs WHERE deleted_at").
+					WithArgs(before, int32(10)).
+					WillReturnRows(tenantBlogRows("draft", "test-id-1"))
+				mock.ExpectExec("DELETE FROM blogs").
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "failed to purge deleted blogs",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			ids, err := store.PurgeDeleted(context.Background(), before, tc.limit)
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedIDs, ids)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name          string
+		pageSize      int32
+		pageToken     string
+		filter        datastore.ListFilter
+		mockSetup     func(mock sqlmock.Sqlmock)
+		expectError   bool
+		errorMsg      string
+		expectedBlogs []*datastore.BlogSummary
+		nextPageToken string
+	}{
+		{
+			name:      "successful list without pagination",
+			pageSize:  10,
+			pageToken: "",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				testID1 := datastore.ID("test-id-1")
+				testID2 := datastore.ID("test-id-2")
+				testTitle1 := "Test Title 1"
+				testTitle2 := "Test Title 2"
+				commentCount1 := int32(5)
+				commentCount2 := int32(10)
+
+				rows := sqlmock.NewRows([]string{"id", "title", "status", "comment_count", "deleted_at", "slug"}).
+					AddRow(testID1, testTitle1, "published", commentCount1, nil, "test-title").
+					AddRow(testID2, testTitle2, "published", commentCount2, nil, "test-title")
+
+				mock.ExpectQuery("SELECT b.id, b.title, b.status, COUNT\\(c.id\\) as comment_count, b.deleted_at, b.slug FROM blogs b LEFT JOIN comments c ON b.id = c.blog_id").
+					WillReturnRows(rows)
+			},
+			expectError: false,
+			expectedBlogs: []*datastore.BlogSummary{
+				{
+					ID:           datastore.ID("test-id-1"),
+					Title:        "Test Title 1",
+					CommentCount: 5,
+				},
+				{
+					ID:           datastore.ID("test-id-2"),
+					Title:        "Test Title 2",
+					CommentCount: 10,
+				},
+			},
+			nextPageToken: "",
+		},
+		{
+			name:      "successful list with pagination",
+			pageSize:  1,
+			pageToken: "test-id-1",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				testID2 := datastore.ID("test-id-2")
+				testTitle2 := "Test Title 2"
+				commentCount2 := int32(10)
+
+				rows := sqlmock.NewRows([]string{"id", "title", "status", "comment_count", "deleted_at", "slug"}).
+					AddRow(testID2, testTitle2, "published", commentCount2, nil, "test-title")
+
+				mock.ExpectQuery("SELECT b.id, b.title, b.status, COUNT\\(c.id\\) as comment_count, b.deleted_at, b.slug FROM blogs b LEFT JOIN comments c ON b.id = c.blog_id AND c.state = 'approved' WHERE b.tenant_id = \\$1 AND b.deleted_at IS NULL AND b.id > \\$2 GROUP BY b.id, b.title, b.status, b.deleted_at, b.slug ORDER BY b.id LIMIT \\$3").
+					WithArgs(tenantID, "test-id-1", int32(2)). // pageSize + 1 = 1 + 1 = 2
+					WillReturnRows(rows)
+			},
+			expectError: false,
+			expectedBlogs: []*datastore.BlogSummary{
+				{
+					ID:           datastore.ID("test-id-2"),
+					Title:        "Test Title 2",
+					CommentCount: 10,
+				},
+			},
+			nextPageToken: "",
+		},
+		{
+			name:      "more results than page size",
+			pageSize:  2,
+			pageToken: "",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "status", "comment_count", "deleted_at", "slug"}).
+					AddRow("test-id-1", "Test Title 1", "published", int32(0), nil, "test-title").
+					AddRow("test-id-2", "Test Title 2", "published", int32(0), nil, "test-title").
+					AddRow("test-id-3", "Test Title 3", "published", int32(0), nil, "test-title")
+
+				mock.ExpectQuery("SELECT b.id, b.title, b.status, COUNT\\(c.id\\) as comment_count, b.deleted_at, b.slug FROM blogs b LEFT JOIN comments c ON b.id = c.blog_id AND c.state = 'approved' WHERE b.tenant_id = \\$1 AND b.deleted_at IS NULL GROUP BY b.id, b.title, b.status, b.deleted_at, b.slug ORDER BY b.id LIMIT \\$2").
+					WithArgs(tenantID, int32(3)). // pageSize + 1 = 2 + 1 = 3
+					WillReturnRows(rows)
+			},
+			expectError: false,
+			expectedBlogs: []*datastore.BlogSummary{
+				{
+					ID:    datastore.ID("test-id-1"),
+					Title: "Test Title 1",
+				},
+				{
+					ID:    datastore.ID("test-id-2"),
+					Title: "Test Title 2",
+				},
+			},
+			// The next page starts after the last returned result
+			nextPageToken: "test-id-2",
+		},
+		{
+			name:      "filter by status",
+			pageSize:  1,
+			pageToken: "test-id-1",
+			filter:    datastore.ListFilter{Status: datastore.StatusDraft},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "status", "comment_count", "deleted_at", "slug"}).
+					AddRow("test-id-2", "Test Title 2", "draft", int32(0), nil, "test-title")
+
+				mock.ExpectQuery("SELECT b.id, b.title, b.status, COUNT\\(c.id\\) as comment_count, b.deleted_at, b.slug FROM blogs b LEFT JOIN comments c ON b.id = c.blog_id AND c.state = 'approved' WHERE b.tenant_id = \\$1 AND b.deleted_at IS NULL AND b.status = \\$2::post_status AND b.id > \\$3 GROUP BY b.id, b.title, b.status, b.deleted_at, b.slug ORDER BY b.id LIMIT \\$4").
+					WithArgs(tenantID, "draft", "test-id-1", int32(2)).
+					WillReturnRows(rows)
+			},
+			expectError: false,
+			expectedBlogs: []*datastore.BlogSummary{
+				{
+					ID:     datastore.ID("test-id-2"),
+					Title:  "Test Title 2",
+					Status: datastore.StatusDraft,
+				},
+			},
+			nextPageToken: "",
+		},
+		{
+			name:     "filter by tag",
+			pageSize: 10,
+			filter:   datastore.ListFilter{Tag: "gardening"},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "status", "comment_count", "deleted_at", "slug"}).
+					AddRow("test-id-1", "Test Title 1", "published", int32(2), nil, "test-title")
+
+				mock.ExpectQuery("SELECT b.id, b.title, b.status, COUNT\\(c.id\\) as comment_count, b.deleted_at, b.slug FROM blogs b LEFT JOIN comments c ON b.id = c.blog_id AND c.state = 'approved' WHERE b.tenant_id = \\$1 AND b.deleted_at IS NULL AND b.id IN \\(SELECT bt.blog_id FROM blog_tags bt JOIN tags t ON t.id = bt.tag_id WHERE t.name = \\$2\\) GROUP BY b.id, b.title, b.status, b.deleted_at, b.slug ORDER BY b.id LIMIT \\$3").
+					WithArgs(tenantID, "gardening", int32(11)).
+					WillReturnRows(rows)
+			},
+			expectError: false,
+			expectedBlogs: []*datastore.BlogSummary{
+				{
+					ID:           datastore.ID("test-id-1"),
+					Title:        "Test Title 1",
+					CommentCount: 2,
+				},
+			},
+			nextPageToken: "",
+		},
+		{
+			name:     "filter by owner",
+			pageSize: 10,
+			filter:   datastore.ListFilter{Deleted: datastore.OnlyDeleted, Owner: "alice"},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "status", "comment_count", "deleted_at", "slug"}).
+					AddRow("test-id-1", "Test Title 1", "draft", int32(0), nil, "test-title")
+
+				mock.ExpectQuery("SELECT b.id, b.title, b.status, COUNT\\(c.id\\) as comment_count, b.deleted_at, b.slug FROM blogs b LEFT JOIN comments c ON b.id = c.blog_id AND c.state = 'approved' WHERE b.tenant_id = \\$1 AND b.deleted_at IS NOT NULL AND b.owner = \\$2 GROUP BY b.id, b.title, b.status, b.deleted_at, b.slug ORDER BY b.id LIMIT \\$3").
+					WithArgs(tenantID, "alice", int32(11)).
+					WillReturnRows(rows)
+			},
+			expectError: false,
+			expectedBlogs: []*datastore.BlogSummary{
+				{
+					ID:     datastore.ID("test-id-1"),
+					Title:  "Test Title 1",
+					Status: datastore.StatusDraft,
+				},
+			},
+			nextPageToken: "",
+		},
+		{
+			name:        "unknown status filter",
+			pageSize:    10,
+			filter:      datastore.ListFilter{Status: datastore.Status("unknown")},
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "blog invalid (status)",
+		},
+		{
+			name:        "invalid page size",
+			pageSize:    0,
+			pageToken:   "",
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "page_size",
+		},
+		{
+			name:      "database error",
+			pageSize:  10,
+			pageToken: "",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT b.id, b.title, b.status, COUNT\\(c.id\\) as comment_count, b.deleted_at, b.slug FROM blogs b LEFT JOIN comments c ON b.id = c.blog_id").
+					WillReturnError(errors.New("database error"))
+			},
+			expectError: true,
+			errorMsg:    "failed to list blogs",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			summaries, nextPageToken, err := store.List(context.Background(), tc.pageSize, tc.pageToken, tc.filter)
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+				assert.Nil(t, summaries)
+				assert.Empty(t, nextPageToken)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, len(tc.expectedBlogs), len(summaries))
+				for i, expected := range tc.expectedBlogs {
+					assert.Equal(t, expected.ID, summaries[i].ID)
+					assert.Equal(t, expected.Title, summaries[i].Title)
+					assert.Equal(t, expected.CommentCount, summaries[i].CommentCount)
+				}
+				assert.Equal(t, tc.nextPageToken, nextPageToken)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestListTags(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name         string
+		status       datastore.Status
+		mockSetup    func(mock sqlmock.Sqlmock)
+		expectError  bool
+		errorMsg     string
+		expectedTags []*datastore.TagCount
+	}{
+		{
+			name: "all statuses",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"name", "blog_count"}).
+					AddRow("gardening", int32(3)).
+					AddRow("tomatoes", int32(1))
+
+				mock.ExpectQuery(`SELECT t.name, COUNT\(\*\) AS blog_count FROM tags t JOIN blog_tags bt ON bt.tag_id = t.id JOIN blogs b ON b.id = bt.blog_id WHERE b.tenant_id = \$1 AND b.deleted_at IS NULL GROUP BY t.name ORDER BY t.name`).
+					WithArgs(tenantID).
+					WillReturnRows(rows)
+			},
+			expectError: false,
+			expectedTags: []*datastore.TagCount{
+				{Name: "gardening", BlogCount: 3},
+				{Name: "tomatoes", BlogCount: 1},
+			},
+		},
+		{
+			name:   "filter by status",
+			status: datastore.StatusPublished,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"name", "blog_count"}).
+					AddRow("gardening", int32(2))
+
+				mock.ExpectQuery(`WHERE b.tenant_id = \$1 AND b.deleted_at IS NULL AND b.status = \$2::post_status GROUP BY t.name ORDER BY t.name`).
+					WithArgs(tenantID, "published").
+					WillReturnRows(rows)
+			},
+			expectError: false,
+			expectedTags: []*datastore.TagCount{
+				{Name: "gardening", BlogCount: 2},
+			},
+		},
+		{
+			name: "no tags",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT t.name").
+					WillReturnRows(sqlmock.NewRows([]string{"name", "blog_count"}))
+			},
+			expectError:  false,
+			expectedTags: []*datastore.TagCount{},
+		},
+		{
+			name:        "unknown status",
+			status:      datastore.Status("unknown"),
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "blog invalid (status)",
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT t.name").
+					WillReturnError(errors.New("database error"))
+			},
+			expectError: true,
+			errorMsg:    "failed to list tags",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			tags, err := store.ListTags(context.Background(), tc.status)
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+				assert.Nil(t, tags)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedTags, tags)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSearch(t *testing.T) {
+	tomatoes := datastore.SearchQuery{Terms: []datastore.SearchTerm{{Words: []string{"tomatoes"}}}}
+	options := "StartSel=<b>, StopSel=</b>"
+
+	// Define test cases
+	tests := []struct {
+		name            string
+		query           datastore.SearchQuery
+		pageSize        int32
+		pageToken       string
+		mockSetup       func(mock sqlmock.Sqlmock)
+		expectError     bool
+		errorMsg        string
+		expectedResults []*datastore.SearchResult
+		nextPageToken   string
+	}{
+		{
+			name:     "successful search",
+			query:    tomatoes,
+			pageSize: 10,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "status", "comment_count", "rank", "snippet", "slug"}).
+					AddRow("test-id-1", "Gardening", "published", 2, float32(0.6), "grow <b>tomatoes</b>", "gardening").
+					AddRow("test-id-2", "Cooking", "published", 0, float32(0.2), "fresh <b>tomatoes</b>", "cooking")
+
+				mock.ExpectQuery(`WITH q AS \( SELECT to_tsquery\('english', \$1\) AS query \), hits AS \( SELECT b.id, ts_rank\(b.search_vector, q.query\) AS rank, b.content AS doc FROM blogs b, q WHERE b.search_vector @@ q.query AND b.tenant_id = \$3 AND b.status = 'published' AND b.deleted_at IS NULL \), best AS \( SELECT DISTINCT ON \(id\) id, rank, doc FROM hits ORDER BY id, rank DESC \) SELECT b.id, b.title, b.status, \(SELECT COUNT\(\*\) FROM comments c WHERE c.blog_id = b.id AND c.state = 'approved'\) AS comment_count, best.rank, ts_headline\('english', best.doc, q.query, \$2\) AS snippet, b.slug FROM best JOIN blogs b ON b.id = best.id, q ORDER BY best.rank DESC, best.id LIMIT \$4`).
+					WithArgs("('tomatoes')", options, tenantID, int32(11)).
+					WillReturnRows(rows)
+			},
+			expectError: false,
+			expectedResults: []*datastore.SearchResult{
+				{
+					BlogSummary: datastore.BlogSummary{ID: "test-id-1", Title: "Gardening", Status: datastore.StatusPublished, CommentCount: 2, Slug: "gardening"},
+					Rank:        0.6,
+					Snippet:     "grow <b>tomatoes</b>",
+				},
+				{
+					BlogSummary: datastore.BlogSummary{ID: "test-id-2", Title: "Cooking", Status: datastore.StatusPublished, Slug: "cooking"},
+					Rank:        0.2,
+					Snippet:     "fresh <b>tomatoes</b>",
+				},
+			},
+		},
+		{
+			name: "phrase and prefix with comments",
+			query: datastore.SearchQuery{
+				Terms: []datastore.SearchTerm{
+					{Words: []string{"grow", "tom"}, Prefix: true},
+					{Words: []string{"pots"}},
+				},
+				IncludeComments: true,
+			},
+			pageSize: 10,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UNION ALL SELECT b.id, ts_rank\(c.search_vector, q.query\) AS rank, c.content AS doc FROM comments c JOIN blogs b ON b.id = c.blog_id, q WHERE c.search_vector @@ q.query AND c.state = 'approved' AND b.tenant_id = \$3 AND b.status = 'published' AND b.deleted_at IS NULL \), best AS`).
+					WithArgs("('grow' <-> 'tom':*) & ('pots')", options, tenantID, int32(11)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "title", "status", "comment_count", "rank", "snippet", "slug"}))
+			},
+			expectError:     false,
+			expectedResults: nil,
+		},
+		{
+			name:      "more results than page size",
+			query:     tomatoes,
+			pageSize:  1,
+			pageToken: "0.8/123e4567-e89b-12d3-a456-426614174000",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "status", "comment_count", "rank", "snippet", "slug"}).
+					AddRow("test-id-1", "Gardening", "published", 2, float32(0.6), "grow <b>tomatoes</b>", "gardening").
+					AddRow("test-id-2", "Cooking", "published", 0, float32(0.2), "fresh <b>tomatoes</b>", "cooking")
+
+				mock.ExpectQuery(`WHERE best.rank < \$4::real OR \(best.rank = \$4::real AND best.id > \$5\) ORDER BY best.rank DESC, best.id LIMIT \$6`).
+					WithArgs("('tomatoes')", options, tenantID, float32(0.8), "123e4567-e89b-12d3-a456-426614174000", int32(2)).
+					WillReturnRows(rows)
+			},
+			expectError: false,
+			expectedResults: []*datastore.SearchResult{
+				{
+					BlogSummary: datastore.BlogSummary{ID: "test-id-1", Title: "Gardening", Status: datastore.StatusPublished, CommentCount: 2, Slug: "gardening"},
+					Rank:        0.6,
+					Snippet:     "grow <b>tomatoes</b>",
+				},
+			},
+			nextPageToken: "0.6/test-id-1",
+		},
+		{
+			name:        "no terms",
+			query:       datastore.SearchQuery{},
+			pageSize:    10,
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "blog invalid (query)",
+		},
+		{
+			name:        "invalid page token",
+			query:       tomatoes,
+			pageSize:    10,
+			pageToken:   "0.8/not-a-uuid",
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "blog invalid (page_token)",
+		},
+		{
+			name:        "invalid page size",
+			query:       tomatoes,
+			pageSize:    0,
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "blog invalid (page_size)",
+		},
+		{
+			name:     "database error",
+			query:    tomatoes,
+			pageSize: 10,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("WITH q AS").
+					WithArgs("('tomatoes')", options, tenantID, int32(11)).
+					WillReturnError(errors.New("database error"))
+			},
+			expectError: true,
+			errorMsg:    "failed to search blogs",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			results, nextPageToken, err := store.Search(context.Background(), tc.query, tc.pageSize, tc.pageToken)
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedResults, results)
+				assert.Equal(t, tc.nextPageToken, nextPageToken)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAddComment(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name        string
+		blogID      datastore.ID
+		parentID    *datastore.ID
+		content     string
+		author      string
+		maxDepth    int32
+		opts        []datastore.CommentOption
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:    "successful comment addition",
+			blogID:  datastore.ID("test-blog-id"),
+			content: "Test Comment",
+			author:  "Test Author",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				// Set up expectations for checking if blog exists
+				rows := sqlmock.NewRows([]string{"exists"}).AddRow(1)
+				mock.ExpectQuery("SELECT 1 FROM blogs WHERE id = ?").
+					WithArgs(string(datastore.ID("test-blog-id")), tenantID).
+					WillReturnRows(rows)
+
+				// Set up expectations for inserting comment
+				mock.ExpectBegin()
+				mock.ExpectQuery("INSERT INTO comments").
+					WithArgs(sqlmock.AnyArg(), string(datastore.ID("test-blog-id")), nil, 0, "Test Comment", "Test Author", "approved", nil, tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(time.Now()))
+				expectRecordAudit(mock, datastore.AuditCreate, datastore.ResourceComment, sqlmock.AnyArg())
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name:    "blog not found",
+			blogID:  datastore.ID("non-existent-blog-id"),
+			content: "Test Comment",
+			author:  "Test Author",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT 1 FROM blogs WHERE id = ?").
+					WithArgs(string(datastore.ID("non-existent-blog-id")), tenantID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectError: true,
+			errorMsg:    "blog not found",
+		},
+		{
+			name:    "database error on check",
+			blogID:  datastore.ID("test-blog-id"),
+			content: "Test Comment",
+			author:  "Test Author",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT 1 FROM blogs WHERE id = ?").
+					WithArgs(string(datastore.ID("test-blog-id")), tenantID).
+					WillReturnError(errors.New("database error"))
+			},
+			expectError: true,
+			errorMsg:    "failed to check blog existence",
+		},
+		{
+			name:    "database error on insert",
+			blogID:  datastore.ID("test-blog-id"),
+			content: "Test Comment",
+			author:  "Test Author",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				// Set up expectations for checking if blog exists
+				rows := sqlmock.NewRows([]string{"exists"}).AddRow(1)
+				mock.ExpectQuery("SELECT 1 FROM blogs WHERE id = ?").
+					WithArgs(string(datastore.ID("test-blog-id")), tenantID).
+					WillReturnRows(rows)
+
+				// Set up expectations for inserting comment with error
+				mock.ExpectBegin()
+				mock.ExpectQuery("INSERT INTO comments").
+					WithArgs(sqlmock.AnyArg(), string(datastore.ID("test-blog-id")), nil, 0, "Test Comment", "Test Author", "approved", nil, tenantID).
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "failed to add comment",
+		},
+		{
+			name:    "successful comment addition with author",
+			blogID:  datastore.ID("test-blog-id"),
+			content: "Test Comment",
+			opts:    []datastore.CommentOption{datastore.WithCommentAuthor("test-user-id")},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT 1 FROM blogs WHERE id = ?").
+					WithArgs("test-blog-id", tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(1))
+				mock.ExpectQuery(`SELECT 1 FROM users WHERE id = \$1 AND tenant_id = \$2`).
+					WithArgs("test-user-id", tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"?column?"}).AddRow(1))
+				mock.ExpectBegin()
+				mock.ExpectQuery("INSERT INTO comments").
+					WithArgs(sqlmock.AnyArg(), "test-blog-id", nil, 0, "Test Comment", "", "approved", "test-user-id", tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(time.Now()))
+				expectRecordAudit(mock, datastore.AuditCreate, datastore.ResourceComment, sqlmock.AnyArg())
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name:    "successful comment addition with verdicts",
+			blogID:  datastore.ID("test-blog-id"),
+			content: "Test Comment",
+			author:  "Test Author",
+			opts: []datastore.CommentOption{datastore.WithCommentVerdicts([]datastore.CommentVerdict{
+				{Classifier: "links", Score: 1},
+				{Classifier: "duplicates", State: datastore.CommentStateSpam, Reason: "Duplicate", Score: 1},
+			})},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT 1 FROM blogs WHERE id = ?").
+					WithArgs("test-blog-id", tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(1))
+
+				// The verdicts are recorded in the same transaction as the comment
+				mock.ExpectBegin()
+				mock.ExpectQuery("INSERT INTO comments").
+					WithArgs(sqlmock.AnyArg(), "test-blog-id", nil, 0, "Test Comment", "Test Author", "approved", nil, tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(time.Now()))
+				mock.ExpectExec(`INSERT INTO comment_verdicts \(comment_id, position, classifier, state, reason, score\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6\)`).
+					WithArgs(sqlmock.AnyArg(), 0, "links", nil, "", float64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("INSERT INTO comment_verdicts").
+					WithArgs(sqlmock.AnyArg(), 1, "duplicates", "spam", "Duplicate", float64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectRecordAudit(mock, datastore.AuditCreate, datastore.ResourceComment, sqlmock.AnyArg())
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name:        "unknown verdict state",
+			blogID:      datastore.ID("test-blog-id"),
+			content:     "Test Comment",
+			opts:        []datastore.CommentOption{datastore.WithCommentVerdicts([]datastore.CommentVerdict{{Classifier: "links", State: "unknown"}})},
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "comment invalid (state)",
+		},
+		{
+			name:    "database error on verdict",
+			blogID:  datastore.ID("test-blog-id"),
+			content: "Test Comment",
+			author:  "Test Author",
+			opts:    []datastore.CommentOption{datastore.WithCommentVerdicts([]datastore.CommentVerdict{{Classifier: "links", Score: 1}})},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT 1 FROM blogs WHERE id = ?").
+					WithArgs("test-blog-id", tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(1))
+
+				// The comment is rolled back along with its verdicts
+				mock.ExpectBegin()
+				mock.ExpectQuery("INSERT INTO comments").
+					WithArgs(sqlmock.AnyArg(), "test-blog-id", nil, 0, "Test Comment", "Test Author", "approved", nil, tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(time.Now()))
+				mock.ExpectExec("INSERT INTO comment_verdicts").
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "failed to add comment verdict",
+		},
+		{
+			name:    "author not found",
+			blogID:  datastore.ID("test-blog-id"),
+			content: "Test Comment",
+			opts:    []datastore.CommentOption{datastore.WithCommentAuthor("test-user-id")},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT 1 FROM blogs WHERE id = ?").
+					WithArgs("test-blog-id", tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(1))
+				mock.ExpectQuery(`SELECT 1 FROM users WHERE id = \$1 AND tenant_id = \$2`).
+					WithArgs("test-user-id", tenantID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectError: true,
+			errorMsg:    "user not found",
+		},
+		{
+			name:     "successful reply",
+			blogID:   datastore.ID("test-blog-id"),
+			parentID: idPtr("test-comment-id"),
+			content:  "Test Reply",
+			author:   "Test Author",
+			maxDepth: 2,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"exists"}).AddRow(1)
+				mock.ExpectQuery("SELECT 1 FROM blogs WHERE id = ?").
+					WithArgs("test-blog-id", tenantID).
+					WillReturnRows(rows)
+
+				// Set up expectations for finding the parent on the same blog
+				mock.ExpectQuery(`SELECT depth FROM comments WHERE id = \$1 AND blog_id = \$2 AND tenant_id = \$3 AND state = 'approved'`).
+					WithArgs("test-comment-id", "test-blog-id", tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"depth"}).AddRow(1))
+
+				mock.ExpectBegin()
+				mock.ExpectQuery("INSERT INTO comments").
+					WithArgs(sqlmock.AnyArg(), "test-blog-id", "test-comment-id", 2, "Test Reply", "Test Author", "approved", nil, tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(time.Now()))
+				expectRecordAudit(mock, datastore.AuditCreate, datastore.ResourceComment, sqlmock.AnyArg())
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name:     "reply too deep",
+			blogID:   datastore.ID("test-blog-id"),
+			parentID: idPtr("test-comment-id"),
+			content:  "Test Reply",
+			author:   "Test Author",
+			maxDepth: 2,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"exists"}).AddRow(1)
+				mock.ExpectQuery("SELECT 1 FROM blogs WHERE id = ?").
+					WithArgs("test-blog-id", tenantID).
+					WillReturnRows(rows)
+				mock.ExpectQuery(`SELECT depth FROM comments WHERE id = \$1 AND blog_id = \$2 AND tenant_id = \$3 AND state = 'approved'`).
+					WithArgs("test-comment-id", "test-blog-id", tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"depth"}).AddRow(2))
+			},
+			expectError: true,
+			errorMsg:    "replies nest at most 2 deep",
+		},
+		{
+			name:     "parent not found",
+			blogID:   datastore.ID("test-blog-id"),
+			parentID: idPtr("other-comment-id"),
+			content:  "Test Reply",
+			author:   "Test Author",
+			maxDepth: 2,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"exists"}).AddRow(1)
+				mock.ExpectQuery("SELECT 1 FROM blogs WHERE id = ?").
+					WithArgs("test-blog-id", tenantID).
+					WillReturnRows(rows)
+				mock.ExpectQuery(`SELECT depth FROM comments WHERE id = \$1 AND blog_id = \$2 AND tenant_id = \$3 AND state = 'approved'`).
+					WithArgs("other-comment-id", "test-blog-id", tenantID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectError: true,
+			errorMsg:    "comment not found",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			comment, err := store.AddComment(context.Background(), tc.blogID, tc.parentID, tc.content, tc.author, tc.maxDepth, datastore.CommentStateApproved, tc.opts...)
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+				assert.Nil(t, comment)
+			} else {
+				require.NoError(t, err)
+				assert.NotEmpty(t, comment.ID)
+				assert.Equal(t, tc.content, comment.Content)
+				assert.False(t, comment.CreatedAt.IsZero())
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetComment(t *testing.T) {
+	createdAt := time.Now()
+	columns := []string{"id", "blog_id", "parent_id", "depth", "content", "author", "created_at", "updated_at", "state", "moderation_reason", "moderated_at", "author_id"}
+
+	// Define test cases
+	tests := []struct {
+		name        string
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorMsg    string
+		expected    *datastore.Comment
+	}{
+		{
+			name: "successful retrieval",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow("test-comment-id", "test-blog-id", "test-parent-id", 1, "Test Comment", "Test Author", createdAt, createdAt, "approved", "", nil, nil)
+				mock.ExpectQuery(`SELECT id, blog_id, parent_id, depth, content, author, created_at, updated_at, state, moderation_reason, moderated_at, author_id FROM comments WHERE id = \$1 AND blog_id = \$2 AND tenant_id = \$3 AND state = 'approved' AND EXISTS \(SELECT 1 FROM blogs b WHERE b.id = comments.blog_id AND b.deleted_at IS NULL\)`).
+					WithArgs("test-comment-id", "test-blog-id", tenantID).
+					WillReturnRows(rows)
+			},
+			expectError: false,
+			expected: &datastore.Comment{
+				ID:        "test-comment-id",
+				BlogID:    "test-blog-id",
+				ParentID:  idPtr("test-parent-id"),
+				Depth:     1,
+				Content:   "Test Comment",
+				Author:    "Test Author",
+				CreatedAt: createdAt,
+				UpdatedAt: &createdAt,
+				State:     datastore.CommentStateApproved,
+			},
+		},
+		{
+			name: "comment not found",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id, blog_id").
+					WithArgs("test-comment-id", "test-blog-id", tenantID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectError: true,
+			errorMsg:    "comment not found",
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id, blog_id").
+					WithArgs("test-comment-id", "test-blog-id", tenantID).
+					WillReturnError(errors.New("database error"))
+			},
+			expectError: true,
+			errorMsg:    "failed to get comment",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			comment, err := store.GetComment(context.Background(), "test-blog-id", "test-comment-id")
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+				assert.Nil(t, comment)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, comment)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUpdateComment(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name        string
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name: "successful update",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotComment(mock, "test-blog-id", "test-comment-id", datastore.CommentStateApproved)
+				mock.ExpectExec(`UPDATE comments SET content = \$1, updated_at = NOW\(\) WHERE id = \$2 AND blog_id = \$3 AND tenant_id = \$4 AND EXISTS \(SELECT 1 FROM blogs b WHERE b.id = comments.blog_id AND b.deleted_at IS NULL\)`).
+					WithArgs("Edited Comment", "test-comment-id", "test-blog-id", tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectSnapshotComment(mock, "test-blog-id", "test-comment-id", datastore.CommentStateApproved)
+				expectRecordAudit(mock, datastore.AuditUpdate, datastore.ResourceComment, "test-comment-id")
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name: "comment not found",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT (.+) FROM comments").
+					WithArgs("test-comment-id", "test-blog-id", tenantID).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "comment not found",
+		},
+		{
+			name: "comment of blog in trash",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotComment(mock, "test-blog-id", "test-comment-id", datastore.CommentStateApproved)
+				mock.ExpectExec("UPDATE comments").
+					WithArgs("Edited Comment", "test-comment-id", "test-blog-id", tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "comment not found",
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotComment(mock, "test-blog-id", "test-comment-id", datastore.CommentStateApproved)
+				mock.ExpectExec("UPDATE comments").
+					WithArgs("Edited Comment", "test-comment-id", "test-blog-id", tenantID).
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "failed to update comment",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			err = store.UpdateComment(context.Background(), "test-blog-id", "test-comment-id", "Edited Comment")
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDeleteComment(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name        string
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name: "successful deletion",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotComment(mock, "test-blog-id", "test-comment-id", datastore.CommentStateApproved)
+				mock.ExpectExec(`DELETE FROM comments WHERE id = \$1 AND blog_id = \$2 AND tenant_id = \$3 AND EXISTS \(SELECT 1 FROM blogs b WHERE b.id = comments.blog_id AND b.deleted_at IS NULL\)`).
+					WithArgs("test-comment-id", "test-blog-id", tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectRecordAudit(mock, datastore.AuditDelete, datastore.ResourceComment, "test-comment-id")
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name: "comment not found",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT (.+) FROM comments").
+					WithArgs("test-comment-id", "test-blog-id", tenantID).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "comment not found",
+		},
+		{
+			name: "comment of blog in trash",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotComment(mock, "test-blog-id", "test-comment-id", datastore.CommentStateApproved)
+				mock.ExpectExec("DELETE FROM comments").
+					WithArgs("test-comment-id", "test-blog-id", tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "comment not found",
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotComment(mock, "test-blog-id", "test-comment-id", datastore.CommentStateApproved)
+				mock.ExpectExec("DELETE FROM comments").
+					WithArgs("test-comment-id", "test-blog-id", tenantID).
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "failed to delete comment",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			err = store.DeleteComment(context.Background(), "test-blog-id", "test-comment-id")
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestListComments(t *testing.T) {
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	columns := []string{"id", "blog_id", "parent_id", "depth", "content", "author", "created_at", "updated_at", "state", "moderation_reason", "moderated_at", "author_id"}
+	pageToken := datastore.CommentPageToken(&datastore.Comment{ID: "comment-2", CreatedAt: createdAt})
+
+	// Define test cases
+	tests := []struct {
+		name              string
+		pageSize          int32
+		pageToken         string
+		mockSetup         func(mock sqlmock.Sqlmock)
+		expectError       bool
+		errorMsg          string
+		expectedIDs       []datastore.ID
+		expectedPageToken string
+	}{
+		{
+			name:     "first page",
+			pageSize: 2,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT 1 FROM blogs WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL`).
+					WithArgs("test-id", tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+
+				rows := sqlmock.NewRows(columns).
+					AddRow("comment-1", "test-id", nil, 0, "Comment 1", "alice", createdAt, nil, "approved", "", nil, nil).
+					AddRow("comment-2", "test-id", "comment-1", 1, "Comment 2", "bob", createdAt, nil, "approved", "", nil, nil).
+					AddRow("comment-3", "test-id", nil, 0, "Comment 3", "alice", createdAt, nil, "approved", "", nil, nil)
+				mock.ExpectQuery(`SELECT id, blog_id, parent_id, depth, content, author, created_at, updated_at, state, moderation_reason, moderated_at, author_id FROM comments WHERE blog_id = \$1 AND tenant_id = \$2 AND state = 'approved' ORDER BY created_at, id LIMIT \$3`).
+					WithArgs("test-id", tenantID, int32(3)).
+					WillReturnRows(rows)
+			},
+			expectError:       false,
+			expectedIDs:       []datastore.ID{"comment-1", "comment-2"},
+			expectedPageToken: pageToken,
+		},
+		{
+			name:      "last page",
+			pageSize:  2,
+			pageToken: pageToken,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT 1 FROM blogs WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL`).
+					WithArgs("test-id", tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+
+				rows := sqlmock.NewRows(columns).
+					AddRow("comment-3", "test-id", nil, 0, "Comment 3", "alice", createdAt, nil, "approved", "", nil, nil)
+				mock.ExpectQuery(`SELECT id, blog_id, .* FROM comments WHERE blog_id = \$1 AND tenant_id = \$2 AND state = 'approved' AND \(created_at, id\) > \(\$3, \$4\) ORDER BY created_at, id LIMIT \$5`).
+					WithArgs("test-id", tenantID, createdAt, "comment-2", int32(3)).
+					WillReturnRows(rows)
+			},
+			expectError:       false,
+			expectedIDs:       []datastore.ID{"comment-3"},
+			expectedPageToken: "",
+		},
+		{
+			name:        "invalid page size",
+			pageSize:    0,
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "comment invalid (page_size)",
+		},
+		{
+			name:      "malformed page token",
+			pageSize:  2,
+			pageToken: "invalid-token",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT 1 FROM blogs WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL`).
+					WithArgs("test-id", tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+			},
+			expectError: true,
+			errorMsg:    "comment invalid (page_token)",
+		},
+		{
+			name:     "blog not found",
+			pageSize: 2,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT 1 FROM blogs WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL`).
+					WithArgs("test-id", tenantID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectError: true,
+			errorMsg:    "blog not found",
+		},
+		{
+			name:     "database error",
+			pageSize: 2,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT 1 FROM blogs WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL`).
+					WithArgs("test-id", tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+				mock.ExpectQuery("SELECT id, blog_id").
+					WillReturnError(errors.New("database error"))
+			},
+			expectError: true,
+			errorMsg:    "failed to list comments",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			comments, nextPageToken, err := store.ListComments(context.Background(), "test-id", tc.pageSize, tc.pageToken)
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+				ids := make([]datastore.ID, len(comments))
+				for i, comment := range comments {
+					ids[i] = comment.ID
+				}
+				assert.Equal(t, tc.expectedIDs, ids)
+				assert.Equal(t, tc.expectedPageToken, nextPageToken)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestListPendingComments(t *testing.T) {
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	columns := []string{"id", "blog_id", "parent_id", "depth", "content", "author", "created_at", "updated_at", "state", "moderation_reason", "moderated_at", "author_id"}
+	pageToken := datastore.CommentPageToken(&datastore.Comment{ID: "comment-2", CreatedAt: createdAt})
+	blogID := datastore.ID("test-id")
+
+	// Define test cases
+	tests := []struct {
+		name              string
+		blogID            *datastore.ID
+		pageSize          int32
+		pageToken         string
+		mockSetup         func(mock sqlmock.Sqlmock)
+		expectError       bool
+		errorMsg          string
+		expectedIDs       []datastore.ID
+		expectedPageToken string
+	}{
+		{
+			name:     "first page across blogs",
+			pageSize: 2,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow("comment-1", "test-id", nil, 0, "Comment 1", "alice", createdAt, nil, "pending", "", nil, nil).
+					AddRow("comment-2", "other-id", nil, 0, "Comment 2", "bob", createdAt, nil, "pending", "", nil, nil).
+					AddRow("comment-3", "test-id", nil, 0, "Comment 3", "alice", createdAt, nil, "pending", "", nil, nil)
+				mock.ExpectQuery(`SELECT id, blog_id, parent_id, depth, content, author, created_at, updated_at, state, moderation_reason, moderated_at, author_id FROM comments WHERE tenant_id = \$1 AND state = 'pending' AND EXISTS \(SELECT 1 FROM blogs b WHERE b.id = comments.blog_id AND b.deleted_at IS NULL\) ORDER BY created_at, id LIMIT \$2`).
+					WithArgs(tenantID, int32(3)).
+					WillReturnRows(rows)
+			},
+			expectError:       false,
+			expectedIDs:       []datastore.ID{"comment-1", "comment-2"},
+			expectedPageToken: pageToken,
+		},
+		{
+			name:      "last page of one blog",
+			blogID:    &blogID,
+			pageSize:  2,
+			pageToken: pageToken,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT 1 FROM blogs WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL`).
+					WithArgs("test-id", tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+
+				rows := sqlmock.NewRows(columns).
+					AddRow("comment-3", "test-id", nil, 0, "Comment 3", "alice", createdAt, nil, "pending", "", nil, nil)
+				mock.ExpectQuery(`SELECT id, blog_id, .* FROM comments WHERE tenant_id = \$1 AND state = 'pending' AND EXISTS \(.*\) AND blog_id = \$2 AND \(created_at, id\) > \(\$3, \$4\) ORDER BY created_at, id LIMIT \$5`).
+					WithArgs(tenantID, "test-id", createdAt, "comment-2", int32(3)).
+					WillReturnRows(rows)
+			},
+			expectError:       false,
+			expectedIDs:       []datastore.ID{"comment-3"},
+			expectedPageToken: "",
+		},
+		{
+			name:        "invalid page size",
+			pageSize:    0,
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "comment invalid (page_size)",
+		},
+		{
+			name:        "malformed page token",
+			pageSize:    2,
+			pageToken:   "invalid-token",
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "comment invalid (page_token)",
+		},
+		{
+			name:     "blog not found",
+			blogID:   &blogID,
+			pageSize: 2,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT 1 FROM blogs WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL`).
+					WithArgs("test-id", tenantID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectError: true,
+			errorMsg:    "blog not found",
+		},
+		{
+			name:     "database error",
+			pageSize: 2,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id, blog_id").
+					WillReturnError(errors.New("database error"))
+			},
+			expectError: true,
+			errorMsg:    "failed to list pending comments",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			comments, nextPageToken, err := store.ListPendingComments(context.Background(), tc.blogID, tc.pageSize, tc.pageToken)
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+				ids := make([]datastore.ID, len(comments))
+				for i, comment := range comments {
+					ids[i] = comment.ID
+					assert.Equal(t, datastore.CommentStatePending, comment.State)
+				}
+				assert.Equal(t, tc.expectedIDs, ids)
+				assert.Equal(t, tc.expectedPageToken, nextPageToken)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestModerateComment(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name        string
+		state       datastore.CommentState
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:  "successful moderation",
+			state: datastore.CommentStateSpam,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotComment(mock, "test-blog-id", "test-comment-id", datastore.CommentStatePending)
+				mock.ExpectExec(`UPDATE comments SET state = \$1, moderation_reason = \$2, moderated_at = NOW\(\) WHERE id = \$3 AND blog_id = \$4 AND tenant_id = \$5 AND EXISTS \(SELECT 1 FROM blogs b WHERE b.id = comments.blog_id AND b.deleted_at IS NULL\)`).
+					WithArgs("spam", "Selling watches", "test-comment-id", "test-blog-id", tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectSnapshotComment(mock, "test-blog-id", "test-comment-id", datastore.CommentStateSpam)
+				expectRecordAudit(mock, datastore.AuditModerate, datastore.ResourceComment, "test-comment-id")
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name:        "unknown state",
+			state:       datastore.CommentState("unknown"),
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "comment invalid (state)",
+		},
+		{
+			name:  "comment not found",
+			state: datastore.CommentStateSpam,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT id, blog_id, (.+) FROM comments WHERE id = \$1 AND blog_id = \$2 AND tenant_id = \$3 FOR UPDATE`).
+					WithArgs("test-comment-id", "test-blog-id", tenantID).
+					WillReturnRows(sqlmock.NewRows(commentColumns))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "comment not found",
+		},
+		{
+			name:  "comment of blog in trash",
+			state: datastore.CommentStateSpam,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotComment(mock, "test-blog-id", "test-comment-id", datastore.CommentStatePending)
+				mock.ExpectExec("UPDATE comments").
+					WithArgs("spam", "Selling watches", "test-comment-id", "test-blog-id", tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "comment not found",
+		},
+		{
+			name:  "database error",
+			state: datastore.CommentStateSpam,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotComment(mock, "test-blog-id", "test-comment-id", datastore.CommentStatePending)
+				mock.ExpectExec("UPDATE comments").
+					WithArgs("spam", "Selling watches", "test-comment-id", "test-blog-id", tenantID).
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "failed to moderate comment",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			err = store.ModerateComment(context.Background(), "test-blog-id", "test-comment-id", tc.state, "Selling watches")
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestListRecentComments(t *testing.T) {
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	since := createdAt.Add(-time.Hour)
+	columns := []string{"id", "blog_id", "parent_id", "depth", "content", "author", "created_at", "updated_at", "state", "moderation_reason", "moderated_at", "author_id"}
+
+	// Define test cases
+	tests := []struct {
+		name        string
+		limit       int32
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorMsg    string
+		expectedIDs []datastore.ID
+	}{
+		{
+			name:  "successful list",
+			limit: 10,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow("comment-2", "other-id", nil, 0, "Comment 2", "bob", createdAt, nil, "spam", "", nil, nil).
+					AddRow("comment-1", "test-id", nil, 0, "Comment 1", "alice", createdAt, nil, "approved", "", nil, nil)
+				mock.ExpectQuery(`SELECT id, blog_id, .* FROM comments WHERE tenant_id = \$1 AND created_at >= \$2 AND EXISTS \(SELECT 1 FROM blogs b WHERE b.id = comments.blog_id AND b.deleted_at IS NULL\) ORDER BY created_at DESC, id DESC LIMIT \$3`).
+					WithArgs(tenantID, since, int32(10)).
+					WillReturnRows(rows)
+			},
+			expectError: false,
+			expectedIDs: []datastore.ID{"comment-2", "comment-1"},
+		},
+		{
+			name:        "invalid limit",
+			limit:       0,
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "comment invalid (limit)",
+		},
+		{
+			name:  "database error",
+			limit: 10,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id, blog_id").
+					WillReturnError(errors.New("database error"))
+			},
+			expectError: true,
+			errorMsg:    "failed to list recent comments",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			comments, err := store.ListRecentComments(context.Background(), since, tc.limit)
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+				ids := make([]datastore.ID, len(comments))
+				for i, comment := range comments {
+					ids[i] = comment.ID
+				}
+				assert.Equal(t, tc.expectedIDs, ids)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestListModeratedComments(t *testing.T) {
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	moderatedAt := createdAt.Add(time.Hour)
+	columns := []string{"id", "blog_id", "parent_id", "depth", "content", "author", "created_at", "updated_at", "state", "moderation_reason", "moderated_at", "author_id"}
+
+	// Define test cases
+	tests := []struct {
+		name        string
+		limit       int32
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorMsg    string
+		expected    []datastore.CommentState
+	}{
+		{
+			name:  "successful list",
+			limit: 10,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow("comment-2", "other-id", nil, 0, "Comment 2", "bob", createdAt, nil, "spam", "Sells watches", moderatedAt, nil).
+					AddRow("comment-1", "test-id", nil, 0, "Comment 1", "alice", createdAt, nil, "approved", "", moderatedAt, nil)
+				mock.ExpectQuery(`SELECT id, blog_id, .* FROM comments WHERE tenant_id = \$1 AND moderated_at IS NOT NULL AND EXISTS \(.*\) ORDER BY moderated_at DESC, id DESC LIMIT \$2`).
+					WithArgs(tenantID, int32(10)).
+					WillReturnRows(rows)
+			},
+			expectError: false,
+			expected:    []datastore.CommentState{datastore.CommentStateSpam, datastore.CommentStateApproved},
+		},
+		{
+			name:        "invalid limit",
+			limit:       -1,
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "comment invalid (limit)",
+		},
+		{
+			name:  "database error",
+			limit: 10,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id, blog_id").
+					WillReturnError(errors.New("database error"))
+			},
+			expectError: true,
+			errorMsg:    "failed to list moderated comments",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			comments, err := store.ListModeratedComments(context.Background(), tc.limit)
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+				states := make([]datastore.CommentState, len(comments))
+				for i, comment := range comments {
+					states[i] = comment.State
+					require.NotNil(t, comment.ModeratedAt)
+					assert.Equal(t, moderatedAt, *comment.ModeratedAt)
+				}
+				assert.Equal(t, tc.expected, states)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestListCommentVerdicts(t *testing.T) {
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	// Define test cases
+	tests := []struct {
+		name        string
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorMsg    string
+		expected    []*datastore.CommentVerdict
+	}{
+		{
+			name: "successful list",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT 1 FROM comments WHERE id = \$1 AND blog_id = \$2 AND tenant_id = \$3 AND EXISTS \(.*\)`).
+					WithArgs("test-comment-id", "test-blog-id", tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+				rows := sqlmock.NewRows([]string{"comment_id", "classifier", "state", "reason", "score", "created_at"}).
+					AddRow("test-comment-id", "links", nil, "", 1.0, createdAt).
+					AddRow("test-comment-id", "duplicates", "spam", "Duplicate", 1.0, createdAt)
+				mock.ExpectQuery(`SELECT comment_id, classifier, state, reason, score, created_at FROM comment_verdicts WHERE comment_id = \$1 ORDER BY position`).
+					WithArgs("test-comment-id").
+					WillReturnRows(rows)
+			},
+			expectError: false,
+			expected: []*datastore.CommentVerdict{
+				{CommentID: "test-comment-id", Classifier: "links", Score: 1, CreatedAt: createdAt},
+				{CommentID: "test-comment-id", Classifier: "duplicates", State: datastore.CommentStateSpam, Reason: "Duplicate", Score: 1, CreatedAt: createdAt},
+			},
+		},
+		{
+			name: "no verdicts",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT 1 FROM comments").
+					WithArgs("test-comment-id", "test-blog-id", tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+				mock.ExpectQuery("SELECT comment_id, classifier").
+					WithArgs("test-comment-id").
+					WillReturnRows(sqlmock.NewRows([]string{"comment_id", "classifier", "state", "reason", "score", "created_at"}))
+			},
+			expectError: false,
+			expected:    []*datastore.CommentVerdict{},
+		},
+		{
+			name: "comment not found",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT 1 FROM comments").
+					WithArgs("test-comment-id", "test-blog-id", tenantID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectError: true,
+			errorMsg:    "comment not found",
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT 1 FROM comments").
+					WithArgs("test-comment-id", "test-blog-id", tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+				mock.ExpectQuery("SELECT comment_id, classifier").
+					WillReturnError(errors.New("database error"))
+			},
+			expectError: true,
+			errorMsg:    "failed to list comment verdicts",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			verdicts, err := store.ListCommentVerdicts(context.Background(), "test-blog-id", "test-comment-id")
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, verdicts)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPublish(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name        string
+		id          datastore.ID
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name: "successful publish",
+			id:   datastore.ID("test-id"),
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec(`UPDATE blogs SET status = 'published', published_at = CASE WHEN status <> 'published' THEN NOW\(\) ELSE published_at END, publish_at = NULL WHERE id = \$1 AND tenant_id = \$2`).
+					WithArgs("test-id", tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordAudit(mock, datastore.AuditPublish, datastore.ResourceBlog, "test-id")
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name: "blog not found",
+			id:   datastore.ID("non-existent-id"),
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotMissingBlog(mock, "non-existent-id")
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "blog not found",
+		},
+		{
+			name: "blog in trash",
+			id:   datastore.ID("test-id"),
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec("UPDATE blogs SET status = 'published'").
+					WithArgs("test-id", tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "blog not found",
+		},
+		{
+			name: "database error",
+			id:   datastore.ID("test-id"),
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec("UPDATE blogs SET status = 'published'").
+					WithArgs("test-id", tenantID).
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "failed to publish blog",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			err = store.Publish(context.Background(), tc.id)
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUnpublish(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name        string
+		id          datastore.ID
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name: "successful unpublish",
+			id:   datastore.ID("test-id"),
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec(`UPDATE blogs SET status = 'draft', publish_at = NULL WHERE id = \$1 AND tenant_id = \$2`).
+					WithArgs("test-id", tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordAudit(mock, datastore.AuditUnpublish, datastore.ResourceBlog, "test-id")
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name: "blog not found",
+			id:   datastore.ID("non-existent-id"),
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotMissingBlog(mock, "non-existent-id")
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "blog not found",
+		},
+		{
+			name: "database error",
+			id:   datastore.ID("test-id"),
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectExec("UPDATE blogs SET status = 'draft'").
+					WithArgs("test-id", tenantID).
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "failed to unpublish blog",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			err = store.Unpublish(context.Background(), tc.id)
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPublishScheduled(t *testing.T) {
+	now := time.Now()
+
+	// Define test cases
+	tests := []struct {
+		name        string
+		limit       int32
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorMsg    string
+		expectedIDs []datastore.ID
+	}{
+		{
+			name:  "publishes due blogs",
+			limit: 10,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT id, title, content, (.+), tenant_id FROM blogs WHERE status = 'scheduled' AND publish_at <= \$1 AND deleted_at IS NULL ORDER BY publish_at LIMIT \$2 FOR UPDATE SKIP LOCKED`).
+					WithArgs(now, int32(10)).
+					WillReturnRows(tenantBlogRows("scheduled", "test-id-1", "test-id-2"))
+				mock.ExpectQuery(`UPDATE blogs SET status = 'published', published_at = NOW\(\), publish_at = NULL WHERE id = ANY\(\$1::uuid\[\]\) RETURNING id, title, content, (.+), tenant_id`).
+					WithArgs(pq.Array([]string{"test-id-1", "test-id-2"})).
+					WillReturnRows(tenantBlogRows("published", "test-id-2", "test-id-1"))
+				expectInsertAudit(mock, "tenant-test-id-1", datastore.AuditPublish, datastore.ResourceBlog, "test-id-1")
+				expectInsertAudit(mock, "tenant-test-id-2", datastore.AuditPublish, datastore.ResourceBlog, "test-id-2")
+				mock.ExpectCommit()
+			},
+			expectError: false,
+			expectedIDs: []datastore.ID{"test-id-1", "test-id-2"},
+		},
+		{
+			name:  "nothing due",
+			limit: 10,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT (.+) FROM blogs WHERE status = 'scheduled'").
+					WithArgs(now, int32(10)).
+					WillReturnRows(tenantBlogRows("scheduled"))
+				mock.ExpectCommit()
+			},
+			expectError: false,
+			expectedIDs: nil,
+		},
+		{
+			name:        "invalid limit",
+			limit:       0,
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "blog invalid (limit)",
+		},
+		{
+			name:  "database error",
+			limit: 10,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT (.+) FROM blogs WHERE status = 'scheduled'").
+					WithArgs(now, int32(10)).
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "failed to find scheduled blogs",
+		},
+		{
+			name:  "update error",
+			limit: 10,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT (.+) FROM blogs WHERE status = 'scheduled'").
+					WithArgs(now, int32(10)).
+					WillReturnRows(tenantBlogRows("scheduled", "test-id-1"))
+				mock.ExpectQuery("UPDATE blogs SET status = 'published'").
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "failed to publish scheduled blogs",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			ids, err := store.PublishScheduled(context.Background(), now, tc.limit)
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedIDs, ids)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestListRevisions(t *testing.T) {
+	createdAt := time.Now()
+	columns := []string{"blog_id", "number", "title", "content", "editor", "created_at"}
+
+	// Define test cases
+	tests := []struct {
+		name              string
+		pageSize          int32
+		pageToken         string
+		mockSetup         func(mock sqlmock.Sqlmock)
+		expectError       bool
+		errorMsg          string
+		expectedNumbers   []int32
+		expectedPageToken string
+	}{
+		{
+			name:     "first page",
+			pageSize: 2,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT 1 FROM blogs WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL`).
+					WithArgs("test-id", tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+
+				rows := sqlmock.NewRows(columns).
+					AddRow("test-id", 3, "Title 3", "Content 3", "alice", createdAt).
+					AddRow("test-id", 2, "Title 2", "Content 2", "bob", createdAt).
+					AddRow("test-id", 1, "Title 1", "Content 1", "alice", createdAt)
+				mock.ExpectQuery(`SELECT blog_id, number, title, content, editor, created_at FROM revisions WHERE blog_id = \$1 AND number < \$2 ORDER BY number DESC LIMIT \$3`).
+					WithArgs("test-id", int64(math.MaxInt32), int32(3)).
+					WillReturnRows(rows)
+			},
+			expectError:       false,
+			expectedNumbers:   []int32{3, 2},
+			expectedPageToken: "2",
+		},
+		{
+			name:      "last page",
+			pageSize:  2,
+			pageToken: "2",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT 1 FROM blogs WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL`).
+					WithArgs("test-id", tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+
+				rows := sqlmock.NewRows(columns).
+					AddRow("test-id", 1, "Title 1", "Content 1", "alice", createdAt)
+				mock.ExpectQuery("SELECT blog_id, number").
+					WithArgs("test-id", int64(2), int32(3)).
+					WillReturnRows(rows)
+			},
+			expectError:       false,
+			expectedNumbers:   []int32{1},
+			expectedPageToken: "",
+		},
+		{
+			name:        "invalid page size",
+			pageSize:    0,
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "revision invalid (page_size)",
+		},
+		{
+			name:        "malformed page token",
+			pageSize:    2,
+			pageToken:   "invalid-token",
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "revision invalid (page_token)",
+		},
+		{
+			name:     "blog not found",
+			pageSize: 2,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT 1 FROM blogs WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL`).
+					WithArgs("test-id", tenantID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectError: true,
+			errorMsg:    "blog not found",
+		},
+		{
+			name:     "database error",
+			pageSize: 2,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT 1 FROM blogs WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL`).
+					WithArgs("test-id", tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+				mock.ExpectQuery("SELECT blog_id, number").
+					WillReturnError(errors.New("database error"))
+			},
+			expectError: true,
+			errorMsg:    "failed to list revisions",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			revisions, nextPageToken, err := store.ListRevisions(context.Background(), "test-id", tc.pageSize, tc.pageToken)
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+				numbers := make([]int32, len(revisions))
+				for i, revision := range revisions {
+					numbers[i] = revision.Number
+				}
+				assert.Equal(t, tc.expectedNumbers, numbers)
+				assert.Equal(t, tc.expectedPageToken, nextPageToken)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetRevision(t *testing.T) {
+	createdAt := time.Now()
+
+	// Define test cases
+	tests := []struct {
+		name             string
+		mockSetup        func(mock sqlmock.Sqlmock)
+		expectError      bool
+		errorMsg         string
+		expectedRevision *datastore.Revision
+	}{
+		{
+			name: "successful get",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"blog_id", "number", "title", "content", "editor", "created_at"}).
+					AddRow("test-id", 2, "Old Title", "Old Content", "alice", createdAt)
+				mock.ExpectQuery(`SELECT blog_id, number, title, content, editor, created_at FROM revisions WHERE blog_id = \$1 AND number = \$2 AND EXISTS \(SELECT 1 FROM blogs WHERE id = \$1 AND tenant_id = \$3 AND deleted_at IS NULL\)`).
+					WithArgs("test-id", int32(2), tenantID).
+					WillReturnRows(rows)
+			},
+			expectError: false,
+			expectedRevision: &datastore.Revision{
+				BlogID:    "test-id",
+				Number:    2,
+				Title:     "Old Title",
+				Content:   "Old Content",
+				Editor:    "alice",
+				CreatedAt: createdAt,
+			},
+		},
+		{
+			name: "revision not found",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT blog_id, number").
+					WithArgs("test-id", int32(2), tenantID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectError: true,
+			errorMsg:    "revision not found",
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT blog_id, number").
+					WithArgs("test-id", int32(2), tenantID).
+					WillReturnError(errors.New("database error"))
+			},
+			expectError: true,
+			errorMsg:    "failed to get revision",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			revision, err := store.GetRevision(context.Background(), "test-id", 2)
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedRevision, revision)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRestoreRevision(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name        string
+		number      int32
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:   "successful restore",
+			number: 1,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordRevision(mock, "test-id", "alice", 3)
+				mock.ExpectExec(`UPDATE blogs b SET title = r.title, content = r.content FROM revisions r WHERE b.id = \$1 AND b.tenant_id = \$3 AND r.blog_id = b.id AND r.number = \$2`).
+					WithArgs("test-id", int32(1), tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordAudit(mock, datastore.AuditRestore, datastore.ResourceBlog, "test-id")
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name:   "blog in trash",
+			number: 1,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				mock.ExpectQuery(`SELECT title, content FROM blogs WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL FOR UPDATE`).
+					WithArgs("test-id", tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"title", "content"}))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "blog not found",
+		},
+		{
+			name:   "revision not found",
+			number: 2,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordRevision(mock, "test-id", "alice", 3)
+				mock.ExpectExec("UPDATE blogs b").
+					WithArgs("test-id", int32(2), tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "revision not found",
+		},
+		{
+			name:   "missing blog",
+			number: 1,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotMissingBlog(mock, "test-id")
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "blog not found",
+		},
+		{
+			name:   "current version",
+			number: 3,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordRevision(mock, "test-id", "alice", 3)
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "revision not found",
+		},
+		{
+			name:   "database error",
+			number: 1,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordRevision(mock, "test-id", "alice", 3)
+				mock.ExpectExec("UPDATE blogs b").
+					WithArgs("test-id", int32(1), tenantID).
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "failed to restore revision",
+		},
+		{
+			name:   "commit error",
+			number: 1,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordRevision(mock, "test-id", "alice", 3)
+				mock.ExpectExec("UPDATE blogs b").
+					WithArgs("test-id", int32(1), tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectSnapshotBlog(mock, "test-id")
+				expectRecordAudit(mock, datastore.AuditRestore, datastore.ResourceBlog, "test-id")
+				mock.ExpectCommit().WillReturnError(errors.New("database error"))
+			},
+			expectError: true,
+			errorMsg:    "failed to commit transaction",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			err = store.RestoreRevision(context.Background(), "test-id", tc.number, "alice")
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// expectClaimNewSlug expects the slugs derived from base to be looked up and
+// slug to be claimed for a new blog
+func expectClaimNewSlug(mock sqlmock.Sqlmock, base string, taken []string, slug string) {
+	rows := sqlmock.NewRows([]string{"slug"})
+	for _, t := range taken {
+		rows.AddRow(t)
+	}
+	mock.ExpectQuery(`SELECT slug FROM slugs WHERE tenant_id = \$1 AND \(slug = \$2 OR slug LIKE \$3\)`).
+		WithArgs(tenantID, base, base+"-%").
+		WillReturnRows(rows)
+	mock.ExpectQuery(`INSERT INTO slugs \(slug, blog_id, tenant_id\) VALUES \(\$1, \$2, \$3\) ON CONFLICT \(tenant_id, slug\) DO UPDATE SET slug = EXCLUDED.slug WHERE slugs.blog_id = EXCLUDED.blog_id RETURNING blog_id`).
+		WithArgs(slug, sqlmock.AnyArg(), tenantID).
+		WillReturnRows(sqlmock.NewRows([]string{"blog_id"}).AddRow("test-id"))
+}
+
+// expectRecordRevision expects a blog to be locked and its current version
+// recorded as the given revision
+func expectRecordRevision(mock sqlmock.Sqlmock, id, editor string, number int32) {
+	mock.ExpectQuery(`SELECT title, content FROM blogs WHERE id = \$1 AND tenant_id = \$2 AND deleted_at IS NULL FOR UPDATE`).
+		WithArgs(id, tenantID).
+		WillReturnRows(sqlmock.NewRows([]string{"title", "content"}).AddRow("Old Title", "Old Content"))
+	mock.ExpectQuery(`INSERT INTO revisions \(blog_id, number, title, content, editor\) SELECT \$1, COALESCE\(MAX\(number\), 0\) \+ 1, \$2, \$3, \$4 FROM revisions WHERE blog_id = \$1 RETURNING number`).
+		WithArgs(id, "Old Title", "Old Content", editor).
+		WillReturnRows(sqlmock.NewRows([]string{"number"}).AddRow(number))
+}
+
+// blogColumns are the columns of blogs read by the store
+var blogColumns = []string{"id", "title", "content", "created_at", "updated_at", "status", "published_at", "publish_at", "version", "deleted_at", "slug", "tags", "comment_count", "comment_policy", "owner", "author_id"}
+
+// commentColumns are the columns of comments read by the store
+var commentColumns = []string{"id", "blog_id", "parent_id", "depth", "content", "author", "created_at", "updated_at", "state", "moderation_reason", "moderated_at", "author_id"}
+
+// expectSnapshotBlog expects a blog to be locked and read for an audit event
+func expectSnapshotBlog(mock sqlmock.Sqlmock, id interface{}) {
+	now := time.Now()
+	mock.ExpectQuery(`SELECT id, title, content, (.+) FROM blogs WHERE id = \$1 AND tenant_id = \$2 FOR UPDATE`).
+		WithArgs(id, tenantID).
+		WillReturnRows(sqlmock.NewRows(blogColumns).
+			AddRow("test-id", "Test Title", "Test Content", now, now, "published", now, nil, 1, nil, "test-title", "{}", 0, nil, "", nil))
+}
+
+// expectSnapshotMissingBlog expects a blog to be looked up for an audit
+// event and not found
+func expectSnapshotMissingBlog(mock sqlmock.Sqlmock, id interface{}) {
+	mock.ExpectQuery(`SELECT id, title, content, (.+) FROM blogs WHERE id = \$1 AND tenant_id = \$2 FOR UPDATE`).
+		WithArgs(id, tenantID).
+		WillReturnError(sql.ErrNoRows)
+}
+
+// expectSnapshotComment expects a comment to be locked and read for an
+// audit event
+func expectSnapshotComment(mock sqlmock.Sqlmock, blogID, id string, state datastore.CommentState) {
+	mock.ExpectQuery(`SELECT id, blog_id, (.+) FROM comments WHERE id = \$1 AND blog_id = \$2 AND tenant_id = \$3 FOR UPDATE`).
+		WithArgs(id, blogID, tenantID).
+		WillReturnRows(sqlmock.NewRows(commentColumns).
+			AddRow(id, blogID, nil, 0, "Test Comment", "Test Author", time.Now(), nil, string(state), "", nil, nil))
+}
+
+// expectRecordAudit expects an audit event to be recorded for a change to a
+// resource by a caller the context carries no audit information of
+func expectRecordAudit(mock sqlmock.Sqlmock, action datastore.AuditAction, resource string, id interface{}) {
+	expectInsertAudit(mock, tenantID, action, resource, id)
+}
+
+// expectInsertAudit expects an audit event to be recorded in a tenant
+func expectInsertAudit(mock sqlmock.Sqlmock, tenant string, action datastore.AuditAction, resource string, id interface{}) {
+	mock.ExpectExec(`INSERT INTO audit_events \(id, tenant_id, actor, method, action, resource, resource_id, before, after, request_id, client_ip\)`).
+		WithArgs(sqlmock.AnyArg(), tenant, "", "", string(action), resource, id, sqlmock.AnyArg(), sqlmock.AnyArg(), "", "").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+}
+
+// tenantBlogRows returns blogs with a status read along with their tenant
+// by statements acting on the blogs of every tenant
+func tenantBlogRows(status string, ids ...string) *sqlmock.Rows {
+	now := time.Now()
+	rows := sqlmock.NewRows(append(blogColumns[:len(blogColumns):len(blogColumns)], "tenant_id"))
+	for _, id := range ids {
+		rows.AddRow(id, "Test Title", "Test Content", now, now, status, nil, nil, 1, nil, "test-title", "{}", 0, nil, "", nil, "tenant-"+id)
+	}
+	return rows
+}
+
+// tenantID is the tenant the tests act for, which is the default tenant as
+// their contexts carry none
+const tenantID = string(datastore.DefaultTenantID)
+
+// idPtr returns a pointer to the given ID
+func idPtr(id datastore.ID) *datastore.ID {
+	return &id
+}
+
+// apiKeyColumns are the columns of API keys read by the store
+var apiKeyColumns = []string{"id", "name", "prefix", "key_hash", "scopes", "created_at", "expires_at", "last_used_at", "revoked_at"}
+
+func TestCreateAPIKey(t *testing.T) {
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	expiresAt := createdAt.Add(24 * time.Hour)
+
+	// Define test cases
+	tests := []struct {
+		name        string
+		scopes      []string
+		expiresAt   *time.Time
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorMsg    string
+		expected    *datastore.APIKey
+	}{
+		{
+			name:      "successful creation",
+			scopes:    []string{"author"},
+			expiresAt: &expiresAt,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`INSERT INTO api_keys \(id, name, prefix, key_hash, scopes, expires_at, tenant_id\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7\) RETURNING id, name, prefix, key_hash, scopes, created_at, expires_at, last_used_at, revoked_at`).
+					WithArgs(sqlmock.AnyArg(), "ingest", "psk_abcd", "test-hash", "{\"author\"}", &expiresAt, tenantID).
+					WillReturnRows(sqlmock.NewRows(apiKeyColumns).
+						AddRow("test-key-id", "ingest", "psk_abcd", "test-hash", "{author}", createdAt, expiresAt, nil, nil))
+			},
+			expectError: false,
+			expected: &datastore.APIKey{
+				ID:        "test-key-id",
+				Name:      "ingest",
+				Prefix:    "psk_abcd",
+				Hash:      "test-hash",
+				Scopes:    []string{"author"},
+				CreatedAt: createdAt,
+				ExpiresAt: &expiresAt,
+			},
+		},
+		{
+			name: "without scopes",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("INSERT INTO api_keys").
+					WithArgs(sqlmock.AnyArg(), "ingest", "psk_abcd", "test-hash", "{}", nil, tenantID).
+					WillReturnRows(sqlmock.NewRows(apiKeyColumns).
+						AddRow("test-key-id", "ingest", "psk_abcd", "test-hash", "{}", createdAt, nil, nil, nil))
+			},
+			expectError: false,
+			expected: &datastore.APIKey{
+				ID:        "test-key-id",
+				Name:      "ingest",
+				Prefix:    "psk_abcd",
+				Hash:      "test-hash",
+				Scopes:    []string{},
+				CreatedAt: createdAt,
+			},
+		},
+		{
+			name: "hash in use",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("INSERT INTO api_keys").
+					WillReturnError(&pq.Error{Code: "23505", Table: "api_keys", Constraint: "api_keys_key_hash_key"})
+			},
+			expectError: true,
+			errorMsg:    "api key",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			key, err := store.CreateAPIKey(context.Background(), "ingest", "psk_abcd", "test-hash", tc.scopes, tc.expiresAt)
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, key)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestListAPIKeys(t *testing.T) {
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	store := pg.NewWithDB(db)
+
+	mock.ExpectQuery(`SELECT id, name, prefix, key_hash, scopes, created_at, expires_at, last_used_at, revoked_at FROM api_keys WHERE tenant_id = \$1 AND \(\$2 OR revoked_at IS NULL\) ORDER BY created_at DESC, id DESC`).
+		WithArgs(tenantID, true).
+		WillReturnRows(sqlmock.NewRows(apiKeyColumns).
+			AddRow("key-2", "backup", "psk_0002", "hash-2", "{}", createdAt, nil, createdAt, nil).
+			AddRow("key-1", "ingest", "psk_0001", "hash-1", "{author,editor}", createdAt, nil, nil, createdAt))
+
+	keys, err := store.ListAPIKeys(context.Background(), true)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, datastore.ID("key-2"), keys[0].ID)
+	assert.Equal(t, &createdAt, keys[0].LastUsedAt)
+	assert.Nil(t, keys[0].RevokedAt)
+	assert.Equal(t, []string{"author", "editor"}, keys[1].Scopes)
+	assert.Equal(t, &createdAt, keys[1].RevokedAt)
+
+	mock.ExpectQuery("SELECT id, name, prefix").
+		WithArgs(tenantID, false).
+		WillReturnError(errors.New("database error"))
+	_, err = store.ListAPIKeys(context.Background(), false)
+	assert.ErrorContains(t, err, "failed to list api keys")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAPIKeyByHash(t *testing.T) {
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	store := pg.NewWithDB(db)
+
+	mock.ExpectQuery(`SELECT id, name, prefix, key_hash, scopes, created_at, expires_at, last_used_at, revoked_at FROM api_keys WHERE key_hash = \$1 AND tenant_id = \$2`).
+		WithArgs("hash-1", tenantID).
+		WillReturnRows(sqlmock.NewRows(apiKeyColumns).
+			AddRow("key-1", "ingest", "psk_0001", "hash-1", "{author}", createdAt, nil, nil, nil))
+	key, err := store.GetAPIKeyByHash(context.Background(), "hash-1")
+	require.NoError(t, err)
+	assert.Equal(t, datastore.ID("key-1"), key.ID)
+	assert.Equal(t, []string{"author"}, key.Scopes)
+
+	mock.ExpectQuery("SELECT id, name, prefix").
+		WithArgs("hash-2", tenantID).
+		WillReturnError(sql.ErrNoRows)
+	_, err = store.GetAPIKeyByHash(context.Background(), "hash-2")
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRevokeAPIKey(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name        string
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name: "successful revocation",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE api_keys SET revoked_at = COALESCE\(revoked_at, NOW\(\)\) WHERE id = \$1 AND tenant_id = \$2`).
+					WithArgs("test-key-id", tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			expectError: false,
+		},
+		{
+			name: "key not found",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE api_keys").
+					WithArgs("test-key-id", tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectError: true,
+			errorMsg:    "api key not found",
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE api_keys").
+					WithArgs("test-key-id", tenantID).
+					WillReturnError(errors.New("database error"))
+			},
+			expectError: true,
+			errorMsg:    "failed to revoke api key",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			err = store.RevokeAPIKey(context.Background(), "test-key-id")
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTouchAPIKey(t *testing.T) {
+	usedAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	store := pg.NewWithDB(db)
+
+	mock.ExpectExec(`UPDATE api_keys SET last_used_at = GREATEST\(last_used_at, \$2\) WHERE id = \$1 AND tenant_id = \$3`).
+		WithArgs("test-key-id", usedAt, tenantID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, store.TouchAPIKey(context.Background(), "test-key-id", usedAt))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+var userColumns = []string{"id", "display_name", "bio", "avatar_url", "owner", "created_at", "updated_at"}
+
+func TestCreateUser(t *testing.T) {
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	// Define test cases
+	tests := []struct {
+		name        string
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorMsg    string
+		expected    *datastore.User
+	}{
+		{
+			name: "successful creation",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`INSERT INTO users \(id, display_name, bio, avatar_url, owner, tenant_id\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6\) RETURNING id, display_name, bio, avatar_url, COALESCE\(owner, ''\) AS owner, created_at, updated_at`).
+					WithArgs(sqlmock.AnyArg(), "Alice", "Gardener", "https://example.com/alice.png", "alice", tenantID).
+					WillReturnRows(sqlmock.NewRows(userColumns).
+						AddRow("test-user-id", "Alice", "Gardener", "https://example.com/alice.png", "alice", createdAt, createdAt))
+			},
+			expectError: false,
+			expected: &datastore.User{
+				ID:          "test-user-id",
+				DisplayName: "Alice",
+				Bio:         "Gardener",
+				AvatarURL:   "https://example.com/alice.png",
+				Owner:       "alice",
+				CreatedAt:   createdAt,
+				UpdatedAt:   createdAt,
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("INSERT INTO users").
+					WillReturnError(errors.New("database error"))
+			},
+			expectError: true,
+			errorMsg:    "failed to create user",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			user, err := store.CreateUser(context.Background(), "Alice", "Gardener", "https://example.com/alice.png", datastore.WithUserOwner("alice"))
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, user)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetUser(t *testing.T) {
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	// Define test cases
+	tests := []struct {
+		name        string
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name: "successful retrieval",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT id, display_name, bio, avatar_url, COALESCE\(owner, ''\) AS owner, created_at, updated_at FROM users WHERE id = \$1 AND tenant_id = \$2`).
+					WithArgs("test-user-id", tenantID).
+					WillReturnRows(sqlmock.NewRows(userColumns).
+						AddRow("test-user-id", "Alice", "", "", "", createdAt, createdAt))
+			},
+			expectError: false,
+		},
+		{
+			name: "user not found",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM users").
+					WithArgs("test-user-id", tenantID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectError: true,
+			errorMsg:    "user not found",
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM users").
+					WithArgs("test-user-id", tenantID).
+					WillReturnError(errors.New("database error"))
+			},
+			expectError: true,
+			errorMsg:    "failed to get user",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			user, err := store.GetUser(context.Background(), "test-user-id")
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, datastore.ID("test-user-id"), user.ID)
+				assert.Equal(t, "Alice", user.DisplayName)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetUsers(t *testing.T) {
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	store := pg.NewWithDB(db)
+
+	mock.ExpectQuery(`SELECT id, display_name, bio, avatar_url, COALESCE\(owner, ''\) AS owner, created_at, updated_at FROM users WHERE id = ANY\(\$1::uuid\[\]\) AND tenant_id = \$2`).
+		WithArgs(`{"user-1","user-2"}`, tenantID).
+		WillReturnRows(sqlmock.NewRows(userColumns).
+			AddRow("user-2", "Bob", "", "", "", createdAt, createdAt))
+
+	users, err := store.GetUsers(context.Background(), []datastore.ID{"user-1", "user-2"})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, "Bob", users[0].DisplayName)
+
+	// No IDs need no query
+	users, err = store.GetUsers(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, users)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateUser(t *testing.T) {
+	displayName := "Alice"
+
+	// Define test cases
+	tests := []struct {
+		name        string
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name: "successful update",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE users SET display_name = COALESCE\(\$2, display_name\), bio = COALESCE\(\$3, bio\), avatar_url = COALESCE\(\$4, avatar_url\) WHERE id = \$1 AND tenant_id = \$5`).
+					WithArgs("test-user-id", "Alice", nil, nil, tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			expectError: false,
+		},
+		{
+			name: "user not found",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE users").
+					WithArgs("test-user-id", "Alice", nil, nil, tenantID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectError: true,
+			errorMsg:    "user not found",
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE users").
+					WithArgs("test-user-id", "Alice", nil, nil, tenantID).
+					WillReturnError(errors.New("database error"))
+			},
+			expectError: true,
+			errorMsg:    "failed to update user",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			err = store.UpdateUser(context.Background(), "test-user-id", datastore.UserPatch{DisplayName: &displayName})
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestListUsers(t *testing.T) {
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	pageToken := datastore.UserPageToken(&datastore.User{ID: "user-1", CreatedAt: createdAt})
+
+	// Define test cases
+	tests := []struct {
+		name          string
+		pageToken     string
+		mockSetup     func(mock sqlmock.Sqlmock)
+		expectError   bool
+		errorMsg      string
+		expectedCount int
+		expectedToken string
+	}{
+		{
+			name: "first page",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT id, display_name, bio, avatar_url, COALESCE\(owner, ''\) AS owner, created_at, updated_at FROM users WHERE tenant_id = \$1 ORDER BY created_at, id LIMIT \$2`).
+					WithArgs(tenantID, 2).
+					WillReturnRows(sqlmock.NewRows(userColumns).
+						AddRow("user-1", "Alice", "", "", "", createdAt, createdAt).
+						AddRow("user-2", "Bob", "", "", "", createdAt, createdAt))
+			},
+			expectError:   false,
+			expectedCount: 1,
+			expectedToken: pageToken,
+		},
+		{
+			name:      "next page",
+			pageToken: pageToken,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT (.+) FROM users WHERE tenant_id = \$1 AND \(created_at, id\) > \(\$2, \$3\) ORDER BY created_at, id LIMIT \$4`).
+					WithArgs(tenantID, createdAt, "user-1", 2).
+					WillReturnRows(sqlmock.NewRows(userColumns).
+						AddRow("user-2", "Bob", "", "", "", createdAt, createdAt))
+			},
+			expectError:   false,
+			expectedCount: 1,
+		},
+		{
+			name:        "invalid page token",
+			pageToken:   "invalid",
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "page_token",
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM users").
+					WillReturnError(errors.New("database error"))
+			},
+			expectError: true,
+			errorMsg:    "failed to list users",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			users, nextPageToken, err := store.ListUsers(context.Background(), 1, tc.pageToken)
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+				assert.Len(t, users, tc.expectedCount)
+				assert.Equal(t, tc.expectedToken, nextPageToken)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// auditEventColumns are the columns of audit events read by the store
+var auditEventColumns = []string{"id", "created_at", "actor", "method", "action", "resource", "resource_id", "before", "after", "request_id", "client_ip"}
+
+func TestListAuditEvents(t *testing.T) {
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	since := createdAt.Add(-time.Hour)
+	until := createdAt.Add(time.Hour)
+	pageToken := datastore.AuditPageToken(&datastore.AuditEvent{ID: "event-1", CreatedAt: createdAt})
+
+	// Define test cases
+	tests := []struct {
+		name           string
+		filter         datastore.AuditFilter
+		pageToken      string
+		mockSetup      func(mock sqlmock.Sqlmock)
+		expectError    bool
+		errorMsg       string
+		expectedEvents []*datastore.AuditEvent
+		expectedToken  string
+	}{
+		{
+			name: "first page",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT id, created_at, actor, method, action, resource, resource_id, before, after, request_id, client_ip FROM audit_events WHERE tenant_id = \$1 ORDER BY created_at, id LIMIT \$2`).
+					WithArgs(tenantID, 2).
+					WillReturnRows(sqlmock.NewRows(auditEventColumns).
+						AddRow("event-1", createdAt, "alice", "/blog.v1.BlogService/CreateBlog", "create", "blog", "blog-1", nil, []byte(`{"title":"Hello"}`), "req-1", "203.0.113.7").
+						AddRow("event-2", createdAt, "alice", "/blog.v1.BlogService/DeleteBlog", "delete", "blog", "blog-1", []byte(`{}`), []byte(`{}`), "req-2", "203.0.113.7"))
+			},
+			expectError: false,
+			expectedEvents: []*datastore.AuditEvent{{
+				ID:         "event-1",
+				CreatedAt:  createdAt,
+				Actor:      "alice",
+				Method:     "/blog.v1.BlogService/CreateBlog",
+				Action:     datastore.AuditCreate,
+				Resource:   datastore.ResourceBlog,
+				ResourceID: "blog-1",
+				After:      []byte(`{"title":"Hello"}`),
+				RequestID:  "req-1",
+				ClientIP:   "203.0.113.7",
+			}},
+			expectedToken: pageToken,
+		},
+		{
+			name:      "filtered next page",
+			filter:    datastore.AuditFilter{Resource: datastore.ResourceComment, ResourceID: "comment-1", Actor: "bob", Since: &since, Until: &until},
+			pageToken: pageToken,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT (.+) FROM audit_events WHERE tenant_id = \$1 AND resource = \$2 AND resource_id = \$3 AND actor = \$4 AND created_at >= \$5 AND created_at < \$6 AND \(created_at, id\) > \(\$7, \$8\) ORDER BY created_at, id LIMIT \$9`).
+					WithArgs(tenantID, "comment", "comment-1", "bob", since, until, createdAt, "event-1", 2).
+					WillReturnRows(sqlmock.NewRows(auditEventColumns))
+			},
+			expectError:    false,
+			expectedEvents: []*datastore.AuditEvent{},
+		},
+		{
+			name:        "invalid page token",
+			pageToken:   "invalid",
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectError: true,
+			errorMsg:    "audit event invalid (page_token)",
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM audit_events").
+					WillReturnError(errors.New("database error"))
+			},
+			expectError: true,
+			errorMsg:    "failed to list audit events",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Create a new store with the mock database
+			store := pg.NewWithDB(db)
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			// Call the method
+			events, nextPageToken, err := store.ListAuditEvents(context.Background(), tc.filter, 1, tc.pageToken)
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedEvents, events)
+				assert.Equal(t, tc.expectedToken, nextPageToken)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAuditContext(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := pg.NewWithDB(db)
+	ctx := datastore.NewAuditContext(context.Background(), datastore.AuditInfo{
+		Actor:     "alice",
+		Method:    "/blog.v1.BlogService/DeleteBlog",
+		RequestID: "req-1",
+		ClientIP:  "203.0.113.7",
+	})
+
+	// The event records who made the change and the blog before and after it
+	mock.ExpectBegin()
+	expectSnapshotBlog(mock, "test-id")
+	mock.ExpectExec(`UPDATE blogs SET deleted_at = NOW\(\)`).
+		WithArgs("test-id", tenantID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectSnapshotBlog(mock, "test-id")
+	mock.ExpectExec("INSERT INTO audit_events").
+		WithArgs(sqlmock.AnyArg(), tenantID, "alice", "/blog.v1.BlogService/DeleteBlog", "delete", "blog", "test-id",
+			sqlmock.AnyArg(), sqlmock.AnyArg(), "req-1", "203.0.113.7").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, store.Delete(ctx, "test-id", 0))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
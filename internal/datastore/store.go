@@ -3,27 +3,184 @@ package datastore
 
 import (
 	"context"
+	"time"
 )
 
 //go:generate mockery --name=Store --output=mocks --outpkg=mocks --filename=store.go
 
-// Store defines the interface for blog data operations
+// Store defines the interface for blog data operations. Every method except
+// the tenant methods, PublishScheduled and PurgeDeleted acts only on the
+// data of the tenant carried by the context, see TenantID, as if the data of
+// other tenants did not exist.
 type Store interface {
-	// Create creates a new blog entry
-	Create(ctx context.Context, title, content string) (ID, error)
+	// Create creates a new blog entry with the given status and tags, and a
+	// slug generated from the title that no blog has used yet. Scheduled
+	// blogs require a publish time, which other blogs must not have.
+	// Options can record the owner and the author of the blog.
+	Create(ctx context.Context, title, content string, status Status, publishAt *time.Time, tags []string, opts ...CreateOption) (ID, error)
 
-	// Get retrieves a blog by ID with its comments
-	Get(ctx context.Context, id ID) (*Blog, error)
+	// Get retrieves a blog by ID with its approved comments, oldest first.
+	// Blogs in the trash are not found unless asked for. Options can skip
+	// reading the content or limit the comments read.
+	Get(ctx context.Context, id ID, opts ...GetOption) (*Blog, error)
 
-	// Update updates an existing blog
-	Update(ctx context.Context, id ID, title, content *string) error
+	// GetBySlug retrieves a blog by its current slug or one of its previous
+	// slugs, like Get. Callers tell the two apart by the slug of the blog.
+	GetBySlug(ctx context.Context, slug string, opts ...GetOption) (*Blog, error)
 
-	// Delete deletes a blog and its comments
-	Delete(ctx context.Context, id ID) error
+	// Update applies a patch to an existing blog. Setting a publish time
+	// schedules the blog, and moving it out of scheduled clears the publish
+	// time. Changing the title or content records the previous version as a
+	// revision by editor, while tag and slug changes are not recorded. A new
+	// slug must not have been used by another blog, and the previous slug is
+	// kept as an alias. A non-zero version must match the current version of
+	// the blog.
+	Update(ctx context.Context, id ID, patch BlogPatch, editor string, version int64) error
 
-	// List retrieves a paginated list of blog summaries
-	List(ctx context.Context, pageSize int32, pageToken string) ([]*BlogSummary, string, error)
+	// Delete moves a blog to the trash, where it is hidden from every other
+	// method until it is undeleted or purged. A non-zero version must match
+	// the current version of the blog.
+	Delete(ctx context.Context, id ID, version int64) error
 
-	// AddComment adds a comment to a blog
-	AddComment(ctx context.Context, blogID ID, content, author string) (ID, error)
+	// Undelete moves a blog out of the trash
+	Undelete(ctx context.Context, id ID) error
+
+	// Purge permanently deletes a blog in the trash with its comments and
+	// revisions
+	Purge(ctx context.Context, id ID) error
+
+	// PurgeDeleted permanently deletes up to limit blogs of any tenant that
+	// were moved to the trash no later than before, and returns their IDs.
+	// Concurrent callers never purge the same blog twice.
+	PurgeDeleted(ctx context.Context, before time.Time, limit int32) ([]ID, error)
+
+	// List retrieves a paginated list of blog summaries matching the filter
+	List(ctx context.Context, pageSize int32, pageToken string, filter ListFilter) ([]*BlogSummary, string, error)
+
+	// ListTags retrieves every tag of the blogs outside the trash with the
+	// given status, or any status if empty, along with how many blogs have
+	// it, sorted by name
+	ListTags(ctx context.Context, status Status) ([]*TagCount, error)
+
+	// Search retrieves a paginated list of the blogs matching the query, best
+	// matches first
+	Search(ctx context.Context, query SearchQuery, pageSize int32, pageToken string) ([]*SearchResult, string, error)
+
+	// AddComment adds a comment in the given state to a blog, replying to
+	// the approved comment parentID of the same blog if it is not nil.
+	// Replies nest at most maxDepth deep, so a maxDepth of 0 allows no
+	// replies. Options can record the user who wrote the comment.
+	AddComment(ctx context.Context, blogID ID, parentID *ID, content, author string, maxDepth int32, state CommentState, opts ...CommentOption) (*Comment, error)
+
+	// GetComment retrieves an approved comment of a blog
+	GetComment(ctx context.Context, blogID, id ID) (*Comment, error)
+
+	// UpdateComment replaces the content of a comment of a blog, whatever
+	// its state
+	UpdateComment(ctx context.Context, blogID, id ID, content string) error
+
+	// DeleteComment deletes a comment of a blog along with its replies,
+	// whatever its state
+	DeleteComment(ctx context.Context, blogID, id ID) error
+
+	// ListComments retrieves a paginated list of the approved comments of a
+	// blog, oldest first
+	ListComments(ctx context.Context, blogID ID, pageSize int32, pageToken string) ([]*Comment, string, error)
+
+	// ListPendingComments retrieves a paginated list of the pending comments
+	// of the blogs outside the trash, or of one blog if blogID is not nil,
+	// oldest first
+	ListPendingComments(ctx context.Context, blogID *ID, pageSize int32, pageToken string) ([]*Comment, string, error)
+
+	// ModerateComment sets the state of a comment of a blog, recording the
+	// reason and the time
+	ModerateComment(ctx context.Context, blogID, id ID, state CommentState, reason string) error
+
+	// ListRecentComments retrieves up to limit comments of the blogs outside
+	// the trash created at or after since, whatever their state, newest
+	// first
+	ListRecentComments(ctx context.Context, since time.Time, limit int32) ([]*Comment, error)
+
+	// ListModeratedComments retrieves up to limit comments of the blogs
+	// outside the trash that a moderator settled, most recently moderated
+	// first
+	ListModeratedComments(ctx context.Context, limit int32) ([]*Comment, error)
+
+	// ListCommentVerdicts retrieves the verdicts recorded for a comment of a
+	// blog, whatever its state, in the order they were given
+	ListCommentVerdicts(ctx context.Context, blogID, id ID) ([]*CommentVerdict, error)
+
+	// Publish publishes a blog, recording the publish time if it was not
+	// already published
+	Publish(ctx context.Context, id ID) error
+
+	// Unpublish moves a blog back to draft
+	Unpublish(ctx context.Context, id ID) error
+
+	// PublishScheduled publishes up to limit scheduled blogs of any tenant
+	// whose publish time is at or before now, and returns their IDs.
+	// Concurrent callers never publish the same blog twice.
+	PublishScheduled(ctx context.Context, now time.Time, limit int32) ([]ID, error)
+
+	// ListRevisions retrieves a paginated list of the revisions of a blog,
+	// newest first
+	ListRevisions(ctx context.Context, blogID ID, pageSize int32, pageToken string) ([]*Revision, string, error)
+
+	// GetRevision retrieves a single revision of a blog
+	GetRevision(ctx context.Context, blogID ID, number int32) (*Revision, error)
+
+	// RestoreRevision sets the title and content of a blog back to those of
+	// a revision, recording the replaced version as a new revision by editor
+	RestoreRevision(ctx context.Context, blogID ID, number int32, editor string) error
+
+	// CreateAPIKey stores a new API key by the hash of the key, which no
+	// other key may have, and returns it
+	CreateAPIKey(ctx context.Context, name, prefix, hash string, scopes []string, expiresAt *time.Time) (*APIKey, error)
+
+	// ListAPIKeys retrieves the API keys, newest first, leaving out revoked
+	// keys unless asked for
+	ListAPIKeys(ctx context.Context, showRevoked bool) ([]*APIKey, error)
+
+	// GetAPIKeyByHash retrieves the API key with the given hash, whether or
+	// not it is still active
+	GetAPIKeyByHash(ctx context.Context, hash string) (*APIKey, error)
+
+	// TouchAPIKey records that an API key was used at the given time
+	TouchAPIKey(ctx context.Context, id ID, at time.Time) error
+
+	// RevokeAPIKey revokes an API key. Revoking it again keeps the time it
+	// was first revoked.
+	RevokeAPIKey(ctx context.Context, id ID) error
+
+	// CreateUser creates a user and returns it
+	CreateUser(ctx context.Context, displayName, bio, avatarURL string, opts ...UserOption) (*User, error)
+
+	// GetUser retrieves a user by ID
+	GetUser(ctx context.Context, id ID) (*User, error)
+
+	// GetUsers retrieves the users with the given IDs in no particular order,
+	// leaving out IDs no user has
+	GetUsers(ctx context.Context, ids []ID) ([]*User, error)
+
+	// UpdateUser applies a patch to an existing user
+	UpdateUser(ctx context.Context, id ID, patch UserPatch) error
+
+	// ListUsers retrieves a paginated list of users, oldest first
+	ListUsers(ctx context.Context, pageSize int32, pageToken string) ([]*User, string, error)
+
+	// CreateTenant creates a tenant with a slug no other tenant has and
+	// returns it
+	CreateTenant(ctx context.Context, slug, name string) (*Tenant, error)
+
+	// GetTenantBySlug retrieves a tenant by its slug
+	GetTenantBySlug(ctx context.Context, slug string) (*Tenant, error)
+
+	// ListTenants retrieves every tenant, sorted by slug
+	ListTenants(ctx context.Context) ([]*Tenant, error)
+
+	// ListAuditEvents retrieves a paginated list of the audit events matching
+	// the filter, oldest first. Every method changing a blog or comment
+	// records an audit event in the same transaction as its change, with the
+	// audit information carried by the context, see NewAuditContext.
+	ListAuditEvents(ctx context.Context, filter AuditFilter, pageSize int32, pageToken string) ([]*AuditEvent, string, error)
 }
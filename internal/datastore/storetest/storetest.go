@@ -0,0 +1,2462 @@
+// Package storetest provides a conformance test suite for datastore.Store implementations.
+//
+// Every Store implementation is expected to behave identically from the
+// caller's point of view. Backends verify this by running the shared suite
+// from their own tests:
+//
+//	func TestConformance(t *testing.T) {
+//		storetest.Run(t, func(t *testing.T) datastore.Store {
+//			return memory.New()
+//		})
+//	}
+package storetest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/search"
+)
+
+// Factory returns an empty store for a single test. Any cleanup should be
+// registered with t.Cleanup.
+type Factory func(t *testing.T) datastore.Store
+
+// Run runs the conformance suite against the stores returned by newStore
+func Run(t *testing.T, newStore Factory) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, store datastore.Store)
+	}{
+		{"CreateAndGet", testCreateAndGet},
+		{"GetOptions", testGetOptions},
+		{"Update", testUpdate},
+		{"Delete", testDelete},
+		{"Trash", testTrash},
+		{"PurgeDeleted", testPurgeDeleted},
+		{"List", testList},
+		{"ListPagination", testListPagination},
+		{"Tags", testTags},
+		{"Slugs", testSlugs},
+		{"Search", testSearch},
+		{"SearchPagination", testSearchPagination},
+		{"AddComment", testAddComment},
+		{"CommentReplies", testCommentReplies},
+		{"Comments", testComments},
+		{"ListComments", testListComments},
+		{"Moderation", testModeration},
+		{"ListPendingComments", testListPendingComments},
+		{"CommentVerdicts", testCommentVerdicts},
+		{"APIKeys", testAPIKeys},
+		{"Users", testUsers},
+		{"Authors", testAuthors},
+		{"Tenants", testTenants},
+		{"TenantIsolation", testTenantIsolation},
+		{"AuditEvents", testAuditEvents},
+		{"AuditedChanges", testAuditedChanges},
+		{"Lifecycle", testLifecycle},
+		{"ListByStatus", testListByStatus},
+		{"Schedule", testSchedule},
+		{"PublishScheduled", testPublishScheduled},
+		{"Versions", testVersions},
+		{"ConcurrentVersionedUpdates", testConcurrentVersionedUpdates},
+		{"Revisions", testRevisions},
+		{"RevisionPagination", testRevisionPagination},
+		{"ErrorKinds", testErrorKinds},
+		{"ConcurrentAccess", testConcurrentAccess},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.test(t, newStore(t))
+		})
+	}
+}
+
+func testCreateAndGet(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	id, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+	_, err = uuid.Parse(string(id))
+	require.NoError(t, err, "IDs must be UUIDs")
+
+	blog, err := store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, id, blog.ID)
+	assert.Equal(t, "Test Title", blog.Title)
+	assert.Equal(t, "Test Content", blog.Content)
+	assert.False(t, blog.CreatedAt.IsZero())
+	assert.False(t, blog.UpdatedAt.Before(blog.CreatedAt))
+	assert.Equal(t, datastore.StatusPublished, blog.Status)
+	require.NotNil(t, blog.PublishedAt, "published blogs must have a publish time")
+	assert.NotNil(t, blog.Comments)
+	assert.Empty(t, blog.Comments)
+	assert.Empty(t, blog.Owner, "blogs created without an owner have none")
+
+	// Every create yields a distinct blog
+	otherID, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusPublished, nil, nil, datastore.WithOwner("alice"))
+	require.NoError(t, err)
+	assert.NotEqual(t, id, otherID)
+
+	other, err := store.Get(ctx, otherID, datastore.WithoutContent(), datastore.WithoutComments())
+	require.NoError(t, err)
+	assert.Equal(t, "alice", other.Owner)
+}
+
+func testGetOptions(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	id, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+	_, err = store.AddComment(ctx, id, nil, "Test Comment", "Author", 0, datastore.CommentStateApproved)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		opts             []datastore.GetOption
+		expectedContent  string
+		expectedComments int
+	}{
+		{"everything", nil, "Test Content", 1},
+		{"without content", []datastore.GetOption{datastore.WithoutContent()}, "", 1},
+		{"without comments", []datastore.GetOption{datastore.WithoutComments()}, "Test Content", 0},
+		{"without both", []datastore.GetOption{datastore.WithoutContent(), datastore.WithoutComments()}, "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blog, err := store.Get(ctx, id, tt.opts...)
+			require.NoError(t, err)
+			assert.Equal(t, id, blog.ID)
+			assert.Equal(t, "Test Title", blog.Title)
+			assert.Equal(t, tt.expectedContent, blog.Content)
+			assert.Equal(t, datastore.StatusPublished, blog.Status)
+			assert.Equal(t, int64(1), blog.Version)
+			assert.NotNil(t, blog.Comments)
+			assert.Len(t, blog.Comments, tt.expectedComments)
+		})
+	}
+
+	// Skipped fields are only skipped in the result
+	blog, err := store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "Test Content", blog.Content)
+	assert.Len(t, blog.Comments, 1)
+}
+
+func testUpdate(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+	newTitle := "Updated Title"
+	newContent := "Updated Content"
+
+	tests := []struct {
+		name            string
+		title           *string
+		content         *string
+		expectedTitle   string
+		expectedContent string
+	}{
+		{"both fields", &newTitle, &newContent, newTitle, newContent},
+		{"title only", &newTitle, nil, newTitle, "Test Content"},
+		{"content only", nil, &newContent, "Test Title", newContent},
+		{"no fields", nil, nil, "Test Title", "Test Content"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusPublished, nil, nil)
+			require.NoError(t, err)
+			before, err := store.Get(ctx, id)
+			require.NoError(t, err)
+
+			require.NoError(t, store.Update(ctx, id, datastore.BlogPatch{Title: tt.title, Content: tt.content}, "editor", 0))
+
+			after, err := store.Get(ctx, id)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedTitle, after.Title)
+			assert.Equal(t, tt.expectedContent, after.Content)
+			assert.True(t, after.CreatedAt.Equal(before.CreatedAt), "created_at must not change")
+			assert.False(t, after.UpdatedAt.Before(before.UpdatedAt), "updated_at must not go backwards")
+		})
+	}
+}
+
+func testDelete(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	id, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+	otherID, err := store.Create(ctx, "Other Title", "Other Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, err = store.AddComment(ctx, id, nil, fmt.Sprintf("Comment %d", i), "Author", 0, datastore.CommentStateApproved)
+		require.NoError(t, err)
+	}
+	_, err = store.AddComment(ctx, otherID, nil, "Other Comment", "Author", 0, datastore.CommentStateApproved)
+	require.NoError(t, err)
+
+	require.NoError(t, store.Delete(ctx, id, 0))
+
+	_, err = store.Get(ctx, id)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+
+	// The deleted blog is in the trash, so it no longer shows up in the list
+	summaries, _, err := store.List(ctx, 100, "", datastore.ListFilter{})
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+	assert.Equal(t, otherID, summaries[0].ID)
+	assert.Equal(t, int32(1), summaries[0].CommentCount)
+
+	// Other blogs and their comments are untouched
+	other, err := store.Get(ctx, otherID)
+	require.NoError(t, err)
+	assert.Len(t, other.Comments, 1)
+}
+
+func testTrash(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	id, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusDraft, nil, nil)
+	require.NoError(t, err)
+	_, err = store.AddComment(ctx, id, nil, "Test Comment", "Author", 0, datastore.CommentStateApproved)
+	require.NoError(t, err)
+	otherID, err := store.Create(ctx, "Other Title", "Other Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+
+	// Only blogs in the trash can be restored or purged
+	assert.ErrorIs(t, store.Undelete(ctx, id), datastore.ErrNotFound)
+	assert.ErrorIs(t, store.Purge(ctx, id), datastore.ErrNotFound)
+
+	require.NoError(t, store.Delete(ctx, id, 0))
+	assert.ErrorIs(t, store.Delete(ctx, id, 0), datastore.ErrNotFound)
+
+	// A trashed blog can only be read when asking for it
+	blog, err := store.Get(ctx, id, datastore.WithDeleted())
+	require.NoError(t, err)
+	assert.NotNil(t, blog.DeletedAt)
+	assert.Len(t, blog.Comments, 1)
+
+	// Nothing can change a trashed blog
+	title := "New Title"
+	assert.ErrorIs(t, store.Update(ctx, id, datastore.BlogPatch{Title: &title}, "", 0), datastore.ErrNotFound)
+	_, err = store.AddComment(ctx, id, nil, "Another Comment", "Author", 0, datastore.CommentStateApproved)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	assert.ErrorIs(t, store.Publish(ctx, id), datastore.ErrNotFound)
+	_, _, err = store.ListRevisions(ctx, id, 10, "")
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+
+	// The deleted filter selects live blogs, trashed blogs or both
+	filters := []struct {
+		deleted  datastore.DeletedFilter
+		expected []datastore.ID
+	}{
+		{datastore.ExcludeDeleted, []datastore.ID{otherID}},
+		{datastore.OnlyDeleted, []datastore.ID{id}},
+		{datastore.IncludeDeleted, []datastore.ID{id, otherID}},
+	}
+	for _, f := range filters {
+		summaries, _, err := store.List(ctx, 100, "", datastore.ListFilter{Deleted: f.deleted})
+		require.NoError(t, err)
+		var ids []datastore.ID
+		for _, summary := range summaries {
+			ids = append(ids, summary.ID)
+			assert.Equal(t, summary.ID == id, summary.DeletedAt != nil)
+		}
+		assert.ElementsMatch(t, f.expected, ids)
+	}
+
+	// The owner filter only selects the blogs of one principal
+	ownedID, err := store.Create(ctx, "Owned Title", "Owned Content", datastore.StatusDraft, nil, nil, datastore.WithOwner("alice"))
+	require.NoError(t, err)
+	require.NoError(t, store.Delete(ctx, ownedID, 0))
+	summaries, _, err := store.List(ctx, 100, "", datastore.ListFilter{Deleted: datastore.OnlyDeleted, Owner: "alice"})
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+	assert.Equal(t, ownedID, summaries[0].ID)
+
+	// Undelete restores the blog with its comments
+	require.NoError(t, store.Undelete(ctx, id))
+	blog, err = store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Nil(t, blog.DeletedAt)
+	assert.Len(t, blog.Comments, 1)
+	require.NoError(t, store.Update(ctx, id, datastore.BlogPatch{Title: &title}, "", 0))
+
+	// Purge removes a trashed blog for good
+	require.NoError(t, store.Delete(ctx, id, 0))
+	require.NoError(t, store.Purge(ctx, id))
+	_, err = store.Get(ctx, id, datastore.WithDeleted())
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	assert.ErrorIs(t, store.Undelete(ctx, id), datastore.ErrNotFound)
+}
+
+func testPurgeDeleted(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	var trashed []datastore.ID
+	for i := 0; i < 3; i++ {
+		id, err := store.Create(ctx, fmt.Sprintf("Trashed Title %d", i), "Test Content", datastore.StatusPublished, nil, nil)
+		require.NoError(t, err)
+		require.NoError(t, store.Delete(ctx, id, 0))
+		trashed = append(trashed, id)
+	}
+	liveID, err := store.Create(ctx, "Live Title", "Test Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+
+	// Nothing was deleted before the cutoff yet
+	none, err := store.PurgeDeleted(ctx, time.Now().Add(-time.Hour), 10)
+	require.NoError(t, err)
+	assert.Empty(t, none)
+
+	// Expired blogs are purged a batch at a time
+	before := time.Now().Add(time.Minute)
+	first, err := store.PurgeDeleted(ctx, before, 2)
+	require.NoError(t, err)
+	assert.Len(t, first, 2)
+
+	rest, err := store.PurgeDeleted(ctx, before, 2)
+	require.NoError(t, err)
+	assert.Len(t, rest, 1)
+	assert.ElementsMatch(t, trashed, append(first, rest...))
+
+	for _, id := range trashed {
+		_, err := store.Get(ctx, id, datastore.WithDeleted())
+		assert.ErrorIs(t, err, datastore.ErrNotFound)
+	}
+
+	// Live blogs are never purged
+	_, err = store.Get(ctx, liveID)
+	require.NoError(t, err)
+
+	// A scheduled blog in the trash is not published
+	publishAt := time.Now().Add(-time.Minute)
+	scheduledID, err := store.Create(ctx, "Scheduled Title", "Test Content", datastore.StatusScheduled, &publishAt, nil)
+	require.NoError(t, err)
+	require.NoError(t, store.Delete(ctx, scheduledID, 0))
+	published, err := store.PublishScheduled(ctx, time.Now(), 10)
+	require.NoError(t, err)
+	assert.Empty(t, published)
+
+	_, err = store.PurgeDeleted(ctx, before, 0)
+	assert.ErrorIs(t, err, datastore.ErrInvalid)
+}
+
+func testList(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	summaries, nextPageToken, err := store.List(ctx, 10, "", datastore.ListFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, summaries)
+	assert.Empty(t, nextPageToken)
+
+	counts := map[datastore.ID]int32{}
+	for i := 0; i < 3; i++ {
+		id, err := store.Create(ctx, fmt.Sprintf("Test Title %d", i), "Test Content", datastore.StatusPublished, nil, nil)
+		require.NoError(t, err)
+		for j := 0; j < i; j++ {
+			_, err = store.AddComment(ctx, id, nil, "Comment", "Author", 0, datastore.CommentStateApproved)
+			require.NoError(t, err)
+		}
+		counts[id] = int32(i)
+	}
+
+	summaries, nextPageToken, err = store.List(ctx, 10, "", datastore.ListFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, nextPageToken)
+	require.Len(t, summaries, 3)
+	for i, summary := range summaries {
+		assert.Equal(t, counts[summary.ID], summary.CommentCount)
+		assert.NotEmpty(t, summary.Title)
+		if i > 0 {
+			assert.Less(t, summaries[i-1].ID, summary.ID, "summaries must be ordered by ID")
+		}
+	}
+}
+
+func testListPagination(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	const total = 7
+	created := map[datastore.ID]bool{}
+	for i := 0; i < total; i++ {
+		id, err := store.Create(ctx, fmt.Sprintf("Test Title %d", i), "Test Content", datastore.StatusPublished, nil, nil)
+		require.NoError(t, err)
+		created[id] = true
+	}
+
+	for _, pageSize := range []int32{1, 2, 3, total, total + 1} {
+		t.Run(fmt.Sprintf("page size %d", pageSize), func(t *testing.T) {
+			var seen []datastore.ID
+			pageToken := ""
+			for pages := 0; pages <= total; pages++ {
+				summaries, nextPageToken, err := store.List(ctx, pageSize, pageToken, datastore.ListFilter{})
+				require.NoError(t, err)
+				for _, summary := range summaries {
+					seen = append(seen, summary.ID)
+				}
+				if nextPageToken == "" {
+					break
+				}
+				// Only full pages may have a successor
+				assert.Len(t, summaries, int(pageSize))
+				assert.Equal(t, string(summaries[len(summaries)-1].ID), nextPageToken)
+				pageToken = nextPageToken
+			}
+
+			// Every blog is returned exactly once, in order, across pages
+			require.Len(t, seen, total)
+			for i, id := range seen {
+				assert.True(t, created[id])
+				if i > 0 {
+					assert.Less(t, seen[i-1], id)
+				}
+			}
+		})
+	}
+}
+
+func testTags(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	// Tags are stored sorted and without duplicates
+	id, err := store.Create(ctx, "Tomatoes", "Content", datastore.StatusPublished, nil, []string{"tomatoes", "gardening", "tomatoes"})
+	require.NoError(t, err)
+	blog, err := store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"gardening", "tomatoes"}, blog.Tags)
+
+	_, err = store.Create(ctx, "Bad Tag", "Content", datastore.StatusPublished, nil, []string{"Not A Tag"})
+	assert.ErrorIs(t, err, datastore.ErrInvalid)
+
+	draftID, err := store.Create(ctx, "Roses", "Content", datastore.StatusDraft, nil, []string{"gardening", "roses"})
+	require.NoError(t, err)
+	trashedID, err := store.Create(ctx, "Weeds", "Content", datastore.StatusPublished, nil, []string{"gardening"})
+	require.NoError(t, err)
+	require.NoError(t, store.Delete(ctx, trashedID, 0))
+	_, err = store.Create(ctx, "Untagged", "Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+
+	// Trashed blogs are not counted, and the status narrows the count
+	tags, err := store.ListTags(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, []*datastore.TagCount{
+		{Name: "gardening", BlogCount: 2},
+		{Name: "roses", BlogCount: 1},
+		{Name: "tomatoes", BlogCount: 1},
+	}, tags)
+	tags, err = store.ListTags(ctx, datastore.StatusPublished)
+	require.NoError(t, err)
+	assert.Equal(t, []*datastore.TagCount{
+		{Name: "gardening", BlogCount: 1},
+		{Name: "tomatoes", BlogCount: 1},
+	}, tags)
+
+	// The tag filter combines with the other filters
+	summaries, _, err := store.List(ctx, 100, "", datastore.ListFilter{Tag: "gardening"})
+	require.NoError(t, err)
+	var ids []datastore.ID
+	for _, summary := range summaries {
+		ids = append(ids, summary.ID)
+	}
+	assert.ElementsMatch(t, []datastore.ID{id, draftID}, ids)
+	summaries, _, err = store.List(ctx, 100, "", datastore.ListFilter{Tag: "gardening", Status: datastore.StatusDraft})
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+	assert.Equal(t, draftID, summaries[0].ID)
+	summaries, _, err = store.List(ctx, 100, "", datastore.ListFilter{Tag: "unknown"})
+	require.NoError(t, err)
+	assert.Empty(t, summaries)
+
+	// Updating the tags replaces all of them and bumps the version
+	tagsPatch := []string{"roses", "climbing"}
+	require.NoError(t, store.Update(ctx, draftID, datastore.BlogPatch{Tags: &tagsPatch}, "", 0))
+	blog, err = store.Get(ctx, draftID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"climbing", "roses"}, blog.Tags)
+	assert.Equal(t, int64(2), blog.Version)
+
+	// Other updates leave the tags alone
+	title := "Climbing Roses"
+	require.NoError(t, store.Update(ctx, draftID, datastore.BlogPatch{Title: &title}, "", 0))
+	blog, err = store.Get(ctx, draftID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"climbing", "roses"}, blog.Tags)
+
+	// An empty list clears the tags
+	noTags := []string{}
+	require.NoError(t, store.Update(ctx, draftID, datastore.BlogPatch{Tags: &noTags}, "", 0))
+	blog, err = store.Get(ctx, draftID)
+	require.NoError(t, err)
+	assert.Empty(t, blog.Tags)
+
+	invalid := []string{"-"}
+	err = store.Update(ctx, id, datastore.BlogPatch{Tags: &invalid}, "", 0)
+	assert.ErrorIs(t, err, datastore.ErrInvalid)
+}
+
+func testSlugs(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	// Slugs are generated from the title, with a suffix once taken
+	id, err := store.Create(ctx, "Hello, World!", "Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+	otherID, err := store.Create(ctx, "Hello World", "Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+	thirdID, err := store.Create(ctx, "hello -- world", "Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+	wordlessID, err := store.Create(ctx, "!!!", "Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+
+	expected := map[datastore.ID]string{
+		id:         "hello-world",
+		otherID:    "hello-world-2",
+		thirdID:    "hello-world-3",
+		wordlessID: datastore.DefaultSlug,
+	}
+	for blogID, slug := range expected {
+		blog, err := store.GetBySlug(ctx, slug)
+		require.NoError(t, err)
+		assert.Equal(t, blogID, blog.ID)
+		assert.Equal(t, slug, blog.Slug)
+	}
+	summaries, _, err := store.List(ctx, 100, "", datastore.ListFilter{})
+	require.NoError(t, err)
+	for _, summary := range summaries {
+		assert.Equal(t, expected[summary.ID], summary.Slug)
+	}
+	_, err = store.GetBySlug(ctx, "missing")
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+
+	// Changing the slug keeps the previous one as an alias and bumps the
+	// version
+	slug := "greetings"
+	require.NoError(t, store.Update(ctx, id, datastore.BlogPatch{Slug: &slug}, "", 0))
+	blog, err := store.GetBySlug(ctx, "hello-world")
+	require.NoError(t, err)
+	assert.Equal(t, id, blog.ID)
+	assert.Equal(t, "greetings", blog.Slug)
+	assert.Equal(t, int64(2), blog.Version)
+	blog, err = store.GetBySlug(ctx, "greetings", datastore.WithoutContent(), datastore.WithoutComments())
+	require.NoError(t, err)
+	assert.Equal(t, id, blog.ID)
+	assert.Empty(t, blog.Content)
+
+	// Slugs a blog ever had cannot be taken by another blog, but the blog
+	// itself can go back to them
+	for _, taken := range []string{"hello-world", "greetings"} {
+		err = store.Update(ctx, otherID, datastore.BlogPatch{Slug: &taken}, "", 0)
+		assert.ErrorIs(t, err, datastore.ErrConflict)
+	}
+	slug = "hello-world"
+	require.NoError(t, store.Update(ctx, id, datastore.BlogPatch{Slug: &slug}, "", 0))
+	blog, err = store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "hello-world", blog.Slug)
+	greetingsID, err := store.Create(ctx, "Greetings", "Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+	blog, err = store.Get(ctx, greetingsID)
+	require.NoError(t, err)
+	assert.Equal(t, "greetings-2", blog.Slug)
+
+	invalid := "Not A Slug"
+	err = store.Update(ctx, id, datastore.BlogPatch{Slug: &invalid}, "", 0)
+	assert.ErrorIs(t, err, datastore.ErrInvalid)
+
+	// Trashed blogs are only found when asking for them, and purging a blog
+	// frees its slugs
+	require.NoError(t, store.Delete(ctx, id, 0))
+	_, err = store.GetBySlug(ctx, "greetings")
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	_, err = store.GetBySlug(ctx, "greetings", datastore.WithDeleted())
+	require.NoError(t, err)
+	require.NoError(t, store.Purge(ctx, id))
+	_, err = store.GetBySlug(ctx, "greetings", datastore.WithDeleted())
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	slug = "greetings"
+	require.NoError(t, store.Update(ctx, otherID, datastore.BlogPatch{Slug: &slug}, "", 0))
+}
+
+func testSearch(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	gardenID, err := store.Create(ctx, "Gardening Tips", "How to grow tomatoes in pots.", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+	cookingID, err := store.Create(ctx, "Cooking", "Fresh tomatoes make the best sauce. Gardening helps.", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+	travelID, err := store.Create(ctx, "Travel", "A trip to Rome.", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+	_, err = store.AddComment(ctx, travelID, nil, "Loved the tomatoes there", "Author", 0, datastore.CommentStateApproved)
+	require.NoError(t, err)
+
+	// Drafts and trashed blogs are never found
+	_, err = store.Create(ctx, "Draft Tomatoes", "Not yet", datastore.StatusDraft, nil, nil)
+	require.NoError(t, err)
+	trashedID, err := store.Create(ctx, "Trashed Tomatoes", "Gone", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, store.Delete(ctx, trashedID, 0))
+
+	find := func(q string, includeComments bool) []*datastore.SearchResult {
+		t.Helper()
+		query := datastore.SearchQuery{Terms: search.Parse(q), IncludeComments: includeComments}
+		results, _, err := store.Search(ctx, query, 100, "")
+		require.NoError(t, err)
+		return results
+	}
+	ids := func(results []*datastore.SearchResult) []datastore.ID {
+		var ids []datastore.ID
+		for _, result := range results {
+			ids = append(ids, result.ID)
+		}
+		return ids
+	}
+
+	// Matches in the title rank above matches in the content
+	results := find("gardening", false)
+	assert.Equal(t, []datastore.ID{gardenID, cookingID}, ids(results))
+	assert.Greater(t, results[0].Rank, results[1].Rank)
+
+	// Every term has to match
+	assert.Equal(t, []datastore.ID{cookingID}, ids(find("tomatoes sauce", false)))
+
+	// Phrases match words in order, and prefixes match the start of words
+	assert.Equal(t, []datastore.ID{gardenID}, ids(find(`"grow tomatoes"`, false)))
+	assert.Empty(t, find(`"tomatoes grow"`, false))
+	assert.Equal(t, []datastore.ID{gardenID}, ids(find(`"grow tomat"*`, false)))
+	assert.Empty(t, find(`"grow tomat"`, false))
+
+	// Comments only match when asked for, and rank below the blogs' own text
+	assert.ElementsMatch(t, []datastore.ID{gardenID, cookingID}, ids(find("tomatoes", false)))
+	results = find("tomatoes", true)
+	require.Len(t, results, 3)
+	assert.Equal(t, travelID, results[2].ID)
+	assert.Equal(t, int32(1), results[2].CommentCount)
+	assert.Contains(t, results[2].Snippet, datastore.SnippetStart+"tomatoes"+datastore.SnippetStop)
+
+	// Snippets highlight the matches in the content
+	results = find("pots", false)
+	require.Len(t, results, 1)
+	assert.Equal(t, "Gardening Tips", results[0].Title)
+	assert.Equal(t, datastore.StatusPublished, results[0].Status)
+	assert.Contains(t, results[0].Snippet, datastore.SnippetStart+"pots"+datastore.SnippetStop)
+
+	_, _, err = store.Search(ctx, datastore.SearchQuery{}, 10, "")
+	assert.ErrorIs(t, err, datastore.ErrInvalid)
+	_, _, err = store.Search(ctx, datastore.SearchQuery{Terms: search.Parse("tomatoes")}, 10, "invalid")
+	assert.ErrorIs(t, err, datastore.ErrInvalid)
+}
+
+func testSearchPagination(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	// Blogs with the same rank are ordered by ID
+	for i := 0; i < 7; i++ {
+		content := strings.Repeat("tomatoes ", i%3+1)
+		_, err := store.Create(ctx, fmt.Sprintf("Title %d", i), content, datastore.StatusPublished, nil, nil)
+		require.NoError(t, err)
+	}
+	query := datastore.SearchQuery{Terms: search.Parse("tomatoes")}
+
+	all, next, err := store.Search(ctx, query, 100, "")
+	require.NoError(t, err)
+	require.Len(t, all, 7)
+	assert.Empty(t, next)
+
+	for _, pageSize := range []int32{1, 2, 3, 7} {
+		t.Run(fmt.Sprintf("page size %d", pageSize), func(t *testing.T) {
+			var paged []*datastore.SearchResult
+			token := ""
+			for {
+				results, next, err := store.Search(ctx, query, pageSize, token)
+				require.NoError(t, err)
+				assert.LessOrEqual(t, len(results), int(pageSize))
+				paged = append(paged, results...)
+				if next == "" {
+					break
+				}
+				token = next
+			}
+			assert.Equal(t, all, paged)
+		})
+	}
+}
+
+func testAddComment(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	id, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+
+	var added []*datastore.Comment
+	for i := 0; i < 3; i++ {
+		comment, err := store.AddComment(ctx, id, nil, fmt.Sprintf("Comment %d", i), fmt.Sprintf("Author %d", i), 0, datastore.CommentStateApproved)
+		require.NoError(t, err)
+		added = append(added, comment)
+	}
+
+	blog, err := store.Get(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, blog.Comments, 3)
+	assert.Equal(t, int32(3), blog.CommentCount)
+	for i, comment := range blog.Comments {
+		// Comments are returned in the order they were created, as added
+		assert.Equal(t, added[i].ID, comment.ID)
+		assert.True(t, added[i].CreatedAt.Equal(comment.CreatedAt))
+		assert.Equal(t, id, comment.BlogID)
+		assert.Equal(t, fmt.Sprintf("Comment %d", i), comment.Content)
+		assert.Equal(t, fmt.Sprintf("Author %d", i), comment.Author)
+		assert.Nil(t, comment.ParentID)
+		assert.Equal(t, int32(0), comment.Depth)
+		assert.Nil(t, comment.UpdatedAt)
+		assert.False(t, comment.CreatedAt.IsZero())
+		if i > 0 {
+			assert.False(t, comment.CreatedAt.Before(blog.Comments[i-1].CreatedAt))
+		}
+	}
+}
+
+func testCommentReplies(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	id, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+	otherID, err := store.Create(ctx, "Other Title", "Other Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+
+	root, err := store.AddComment(ctx, id, nil, "Root", "Author", 2, datastore.CommentStateApproved)
+	require.NoError(t, err)
+	rootID := root.ID
+	reply, err := store.AddComment(ctx, id, &rootID, "Reply", "Author", 2, datastore.CommentStateApproved)
+	require.NoError(t, err)
+	replyID := reply.ID
+	require.NotNil(t, reply.ParentID)
+	assert.Equal(t, rootID, *reply.ParentID)
+	assert.Equal(t, int32(1), reply.Depth)
+	nested, err := store.AddComment(ctx, id, &replyID, "Nested Reply", "Author", 2, datastore.CommentStateApproved)
+	require.NoError(t, err)
+	nestedID := nested.ID
+
+	blog, err := store.Get(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, blog.Comments, 3)
+	assert.Nil(t, blog.Comments[0].ParentID)
+	assert.Equal(t, int32(0), blog.Comments[0].Depth)
+	require.NotNil(t, blog.Comments[1].ParentID)
+	assert.Equal(t, rootID, *blog.Comments[1].ParentID)
+	assert.Equal(t, int32(1), blog.Comments[1].Depth)
+	require.NotNil(t, blog.Comments[2].ParentID)
+	assert.Equal(t, replyID, *blog.Comments[2].ParentID)
+	assert.Equal(t, int32(2), blog.Comments[2].Depth)
+
+	// Replies cannot nest deeper than the maximum depth
+	_, err = store.AddComment(ctx, id, &nestedID, "Too Deep", "Author", 2, datastore.CommentStateApproved)
+	assert.ErrorIs(t, err, datastore.ErrInvalid)
+	var dsErr *datastore.Error
+	require.ErrorAs(t, err, &dsErr)
+	assert.Equal(t, "parent_id", dsErr.Field)
+	_, err = store.AddComment(ctx, id, &rootID, "No Replies", "Author", 0, datastore.CommentStateApproved)
+	assert.ErrorIs(t, err, datastore.ErrInvalid)
+
+	// The parent must be a comment of the same blog
+	_, err = store.AddComment(ctx, otherID, &rootID, "Wrong Blog", "Author", 2, datastore.CommentStateApproved)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	missingID := datastore.ID(uuid.New().String())
+	_, err = store.AddComment(ctx, id, &missingID, "Missing Parent", "Author", 2, datastore.CommentStateApproved)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+
+	blog, err = store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Len(t, blog.Comments, 3)
+	blog, err = store.Get(ctx, otherID)
+	require.NoError(t, err)
+	assert.Empty(t, blog.Comments)
+}
+
+func testComments(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	id, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+	otherID, err := store.Create(ctx, "Other Title", "Other Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+
+	root, err := store.AddComment(ctx, id, nil, "Root", "Author", 5, datastore.CommentStateApproved)
+	require.NoError(t, err)
+	reply, err := store.AddComment(ctx, id, &root.ID, "Reply", "Replier", 5, datastore.CommentStateApproved)
+	require.NoError(t, err)
+	_, err = store.AddComment(ctx, id, &reply.ID, "Nested Reply", "Author", 5, datastore.CommentStateApproved)
+	require.NoError(t, err)
+	other, err := store.AddComment(ctx, id, nil, "Other Root", "Author", 5, datastore.CommentStateApproved)
+	require.NoError(t, err)
+
+	comment, err := store.GetComment(ctx, id, reply.ID)
+	require.NoError(t, err)
+	assert.Equal(t, reply.ID, comment.ID)
+	assert.Equal(t, id, comment.BlogID)
+	assert.Equal(t, "Reply", comment.Content)
+	assert.Equal(t, "Replier", comment.Author)
+	require.NotNil(t, comment.ParentID)
+	assert.Equal(t, root.ID, *comment.ParentID)
+	assert.Equal(t, int32(1), comment.Depth)
+	assert.True(t, reply.CreatedAt.Equal(comment.CreatedAt))
+	assert.Nil(t, comment.UpdatedAt)
+
+	// Comments are only found on their own blog
+	_, err = store.GetComment(ctx, otherID, reply.ID)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	err = store.UpdateComment(ctx, otherID, reply.ID, "Edited")
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	err = store.DeleteComment(ctx, otherID, reply.ID)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	missingID := datastore.ID(uuid.New().String())
+	_, err = store.GetComment(ctx, id, missingID)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+
+	// Editing records when the comment was edited
+	require.NoError(t, store.UpdateComment(ctx, id, reply.ID, "Edited Reply"))
+	comment, err = store.GetComment(ctx, id, reply.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Edited Reply", comment.Content)
+	assert.Equal(t, "Replier", comment.Author)
+	require.NotNil(t, comment.UpdatedAt)
+	assert.False(t, comment.UpdatedAt.Before(comment.CreatedAt))
+
+	// The comment limit keeps the oldest comments and the full count
+	blog, err := store.Get(ctx, id, datastore.WithCommentLimit(2))
+	require.NoError(t, err)
+	require.Len(t, blog.Comments, 2)
+	assert.Equal(t, root.ID, blog.Comments[0].ID)
+	assert.Equal(t, reply.ID, blog.Comments[1].ID)
+	assert.Equal(t, int32(4), blog.CommentCount)
+
+	// Deleting a comment deletes its replies
+	require.NoError(t, store.DeleteComment(ctx, id, reply.ID))
+	blog, err = store.Get(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, blog.Comments, 2)
+	assert.Equal(t, root.ID, blog.Comments[0].ID)
+	assert.Equal(t, other.ID, blog.Comments[1].ID)
+	assert.Equal(t, int32(2), blog.CommentCount)
+	err = store.DeleteComment(ctx, id, reply.ID)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+
+	// Comments of blogs in the trash are hidden along with their blog
+	require.NoError(t, store.Delete(ctx, id, 0))
+	_, err = store.GetComment(ctx, id, root.ID)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	err = store.UpdateComment(ctx, id, root.ID, "Edited")
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	err = store.DeleteComment(ctx, id, root.ID)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	_, _, err = store.ListComments(ctx, id, 10, "")
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+}
+
+func testListComments(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	id, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+
+	var all []datastore.ID
+	for i := 0; i < 5; i++ {
+		comment, err := store.AddComment(ctx, id, nil, fmt.Sprintf("Comment %d", i), "Author", 0, datastore.CommentStateApproved)
+		require.NoError(t, err)
+		all = append(all, comment.ID)
+	}
+
+	for _, pageSize := range []int32{1, 2, 5, 10} {
+		t.Run(fmt.Sprintf("page size %d", pageSize), func(t *testing.T) {
+			var paged []datastore.ID
+			token := ""
+			for {
+				comments, next, err := store.ListComments(ctx, id, pageSize, token)
+				require.NoError(t, err)
+				assert.LessOrEqual(t, len(comments), int(pageSize))
+				for _, comment := range comments {
+					paged = append(paged, comment.ID)
+				}
+				if next == "" {
+					break
+				}
+				token = next
+			}
+			assert.Equal(t, all, paged)
+		})
+	}
+
+	_, _, err = store.ListComments(ctx, id, 0, "")
+	assert.ErrorIs(t, err, datastore.ErrInvalid)
+	_, _, err = store.ListComments(ctx, id, 10, "not-a-token")
+	assert.ErrorIs(t, err, datastore.ErrInvalid)
+	_, _, err = store.ListComments(ctx, datastore.ID(uuid.New().String()), 10, "")
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+}
+
+func testModeration(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	id, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+
+	approved, err := store.AddComment(ctx, id, nil, "Approved", "Author", 2, datastore.CommentStateApproved)
+	require.NoError(t, err)
+	assert.Equal(t, datastore.CommentStateApproved, approved.State)
+	pending, err := store.AddComment(ctx, id, nil, "Pending", "Author", 2, datastore.CommentStatePending)
+	require.NoError(t, err)
+	assert.Equal(t, datastore.CommentStatePending, pending.State)
+	spam, err := store.AddComment(ctx, id, nil, "Spam", "Author", 2, datastore.CommentStateSpam)
+	require.NoError(t, err)
+
+	// Only approved comments are public
+	blog, err := store.Get(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, blog.Comments, 1)
+	assert.Equal(t, approved.ID, blog.Comments[0].ID)
+	assert.Equal(t, int32(1), blog.CommentCount)
+	_, err = store.GetComment(ctx, id, pending.ID)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	comments, _, err := store.ListComments(ctx, id, 10, "")
+	require.NoError(t, err)
+	require.Len(t, comments, 1)
+	assert.Equal(t, approved.ID, comments[0].ID)
+	summaries, _, err := store.List(ctx, 10, "", datastore.ListFilter{})
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+	assert.Equal(t, int32(1), summaries[0].CommentCount)
+
+	// Replies need an approved parent
+	_, err = store.AddComment(ctx, id, &pending.ID, "Reply", "Author", 2, datastore.CommentStateApproved)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	_, err = store.AddComment(ctx, id, nil, "Unknown", "Author", 2, datastore.CommentState("unknown"))
+	assert.ErrorIs(t, err, datastore.ErrInvalid)
+
+	// Approving a comment makes it public and records the moderation
+	require.NoError(t, store.ModerateComment(ctx, id, pending.ID, datastore.CommentStateApproved, "Looks fine"))
+	comment, err := store.GetComment(ctx, id, pending.ID)
+	require.NoError(t, err)
+	assert.Equal(t, datastore.CommentStateApproved, comment.State)
+	assert.Equal(t, "Looks fine", comment.ModerationReason)
+	require.NotNil(t, comment.ModeratedAt)
+	assert.False(t, comment.ModeratedAt.Before(comment.CreatedAt))
+	blog, err = store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), blog.CommentCount)
+
+	// Rejecting a comment hides it again
+	require.NoError(t, store.ModerateComment(ctx, id, approved.ID, datastore.CommentStateRejected, "Off topic"))
+	_, err = store.GetComment(ctx, id, approved.ID)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	blog, err = store.Get(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, blog.Comments, 1)
+	assert.Equal(t, pending.ID, blog.Comments[0].ID)
+
+	// Hidden comments can still be deleted
+	require.NoError(t, store.DeleteComment(ctx, id, spam.ID))
+	err = store.ModerateComment(ctx, id, spam.ID, datastore.CommentStateApproved, "")
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	err = store.ModerateComment(ctx, id, pending.ID, datastore.CommentState("unknown"), "")
+	assert.ErrorIs(t, err, datastore.ErrInvalid)
+
+	// The comment policy follows the server default until it is set
+	assert.Equal(t, datastore.CommentPolicyDefault, blog.CommentPolicy)
+	moderated := datastore.CommentPolicyModerated
+	require.NoError(t, store.Update(ctx, id, datastore.BlogPatch{CommentPolicy: &moderated}, "", 0))
+	blog, err = store.Get(ctx, id, datastore.WithoutContent(), datastore.WithoutComments())
+	require.NoError(t, err)
+	assert.Equal(t, datastore.CommentPolicyModerated, blog.CommentPolicy)
+	reset := datastore.CommentPolicyDefault
+	require.NoError(t, store.Update(ctx, id, datastore.BlogPatch{CommentPolicy: &reset}, "", 0))
+	blog, err = store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, datastore.CommentPolicyDefault, blog.CommentPolicy)
+	unknown := datastore.CommentPolicy("unknown")
+	err = store.Update(ctx, id, datastore.BlogPatch{CommentPolicy: &unknown}, "", 0)
+	assert.ErrorIs(t, err, datastore.ErrInvalid)
+}
+
+func testListPendingComments(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	id, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+	otherID, err := store.Create(ctx, "Other Title", "Other Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+	doomedID, err := store.Create(ctx, "Doomed Title", "Doomed Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+
+	// Pending comments of both blogs interleave, oldest first
+	var all, own []datastore.ID
+	for i := 0; i < 6; i++ {
+		blogID := id
+		if i%2 == 1 {
+			blogID = otherID
+		}
+		comment, err := store.AddComment(ctx, blogID, nil, fmt.Sprintf("Comment %d", i), "Author", 0, datastore.CommentStatePending)
+		require.NoError(t, err)
+		all = append(all, comment.ID)
+		if blogID == id {
+			own = append(own, comment.ID)
+		}
+	}
+	_, err = store.AddComment(ctx, id, nil, "Approved", "Author", 0, datastore.CommentStateApproved)
+	require.NoError(t, err)
+	_, err = store.AddComment(ctx, doomedID, nil, "Trashed", "Author", 0, datastore.CommentStatePending)
+	require.NoError(t, err)
+	require.NoError(t, store.Delete(ctx, doomedID, 0))
+
+	for _, pageSize := range []int32{1, 2, 6, 10} {
+		t.Run(fmt.Sprintf("page size %d", pageSize), func(t *testing.T) {
+			for _, tc := range []struct {
+				blogID   *datastore.ID
+				expected []datastore.ID
+			}{
+				{nil, all},
+				{&id, own},
+			} {
+				var paged []datastore.ID
+				token := ""
+				for {
+					comments, next, err := store.ListPendingComments(ctx, tc.blogID, pageSize, token)
+					require.NoError(t, err)
+					assert.LessOrEqual(t, len(comments), int(pageSize))
+					for _, comment := range comments {
+						assert.Equal(t, datastore.CommentStatePending, comment.State)
+						paged = append(paged, comment.ID)
+					}
+					if next == "" {
+						break
+					}
+					token = next
+				}
+				assert.Equal(t, tc.expected, paged)
+			}
+		})
+	}
+
+	// Moderated comments leave the queue
+	require.NoError(t, store.ModerateComment(ctx, id, all[0], datastore.CommentStateSpam, ""))
+	comments, _, err := store.ListPendingComments(ctx, &id, 10, "")
+	require.NoError(t, err)
+	require.Len(t, comments, len(own)-1)
+	assert.Equal(t, own[1], comments[0].ID)
+
+	_, _, err = store.ListPendingComments(ctx, nil, 0, "")
+	assert.ErrorIs(t, err, datastore.ErrInvalid)
+	_, _, err = store.ListPendingComments(ctx, nil, 10, "not-a-token")
+	assert.ErrorIs(t, err, datastore.ErrInvalid)
+	_, _, err = store.ListPendingComments(ctx, &doomedID, 10, "")
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+}
+
+func testAPIKeys(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+	hash := func(i int) string { return fmt.Sprintf("%064x", i) }
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+
+	key, err := store.CreateAPIKey(ctx, "ingest", "psk_0001", hash(1), []string{"author"}, &expiresAt)
+	require.NoError(t, err)
+	_, err = uuid.Parse(string(key.ID))
+	require.NoError(t, err, "IDs must be UUIDs")
+	assert.Equal(t, "ingest", key.Name)
+	assert.Equal(t, "psk_0001", key.Prefix)
+	assert.Equal(t, hash(1), key.Hash)
+	assert.Equal(t, []string{"author"}, key.Scopes)
+	assert.False(t, key.CreatedAt.IsZero())
+	require.NotNil(t, key.ExpiresAt)
+	assert.True(t, expiresAt.Equal(*key.ExpiresAt))
+	assert.Nil(t, key.LastUsedAt)
+	assert.Nil(t, key.RevokedAt)
+	assert.True(t, key.Active(time.Now()))
+	assert.False(t, key.Active(expiresAt))
+
+	other, err := store.CreateAPIKey(ctx, "backup", "psk_0002", hash(2), nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, other.Scopes)
+	assert.Nil(t, other.ExpiresAt)
+
+	// Hashes identify keys, so they cannot be reused
+	_, err = store.CreateAPIKey(ctx, "again", "psk_0001", hash(1), nil, nil)
+	assert.ErrorIs(t, err, datastore.ErrConflict)
+
+	// Keys are looked up by hash
+	found, err := store.GetAPIKeyByHash(ctx, hash(1))
+	require.NoError(t, err)
+	assert.Equal(t, key.ID, found.ID)
+	_, err = store.GetAPIKeyByHash(ctx, hash(3))
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+
+	// Using a key records when, never moving back in time
+	usedAt := time.Now().Truncate(time.Microsecond)
+	require.NoError(t, store.TouchAPIKey(ctx, key.ID, usedAt))
+	require.NoError(t, store.TouchAPIKey(ctx, key.ID, usedAt.Add(-time.Minute)))
+	found, err = store.GetAPIKeyByHash(ctx, hash(1))
+	require.NoError(t, err)
+	require.NotNil(t, found.LastUsedAt)
+	assert.True(t, usedAt.Equal(*found.LastUsedAt))
+
+	// Keys are listed newest first
+	keys, err := store.ListAPIKeys(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, other.ID, keys[0].ID)
+	assert.Equal(t, key.ID, keys[1].ID)
+
+	// Revoked keys are kept, but only listed if asked for
+	require.NoError(t, store.RevokeAPIKey(ctx, key.ID))
+	found, err = store.GetAPIKeyByHash(ctx, hash(1))
+	require.NoError(t, err)
+	require.NotNil(t, found.RevokedAt)
+	assert.False(t, found.Active(time.Now()))
+	revokedAt := *found.RevokedAt
+	require.NoError(t, store.RevokeAPIKey(ctx, key.ID))
+	found, err = store.GetAPIKeyByHash(ctx, hash(1))
+	require.NoError(t, err)
+	assert.True(t, revokedAt.Equal(*found.RevokedAt), "revoking again keeps the first revocation")
+
+	keys, err = store.ListAPIKeys(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, other.ID, keys[0].ID)
+	keys, err = store.ListAPIKeys(ctx, true)
+	require.NoError(t, err)
+	assert.Len(t, keys, 2)
+
+	missing := datastore.ID(uuid.New().String())
+	assert.ErrorIs(t, store.RevokeAPIKey(ctx, missing), datastore.ErrNotFound)
+	assert.ErrorIs(t, store.TouchAPIKey(ctx, missing, usedAt), datastore.ErrNotFound)
+}
+
+func testUsers(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	alice, err := store.CreateUser(ctx, "Alice", "Grows tomatoes", "https://example.com/alice.png", datastore.WithUserOwner("alice"))
+	require.NoError(t, err)
+	_, err = uuid.Parse(string(alice.ID))
+	require.NoError(t, err, "IDs must be UUIDs")
+	assert.Equal(t, "Alice", alice.DisplayName)
+	assert.Equal(t, "Grows tomatoes", alice.Bio)
+	assert.Equal(t, "https://example.com/alice.png", alice.AvatarURL)
+	assert.False(t, alice.CreatedAt.IsZero())
+
+	assert.Equal(t, "alice", alice.Owner)
+
+	found, err := store.GetUser(ctx, alice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, alice.DisplayName, found.DisplayName)
+	assert.Equal(t, "alice", found.Owner)
+	_, err = store.GetUser(ctx, datastore.ID(uuid.NewString()))
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+
+	// Only the fields set in a patch change
+	bio := "Grows roses"
+	require.NoError(t, store.UpdateUser(ctx, alice.ID, datastore.UserPatch{Bio: &bio}))
+	found, err = store.GetUser(ctx, alice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Alice", found.DisplayName)
+	assert.Equal(t, bio, found.Bio)
+	assert.Equal(t, "https://example.com/alice.png", found.AvatarURL)
+	err = store.UpdateUser(ctx, datastore.ID(uuid.NewString()), datastore.UserPatch{Bio: &bio})
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+
+	// Users are fetched in batches, leaving out missing ones
+	bob, err := store.CreateUser(ctx, "Bob", "", "")
+	require.NoError(t, err)
+	assert.Empty(t, bob.Owner, "users created without an owner have none")
+	users, err := store.GetUsers(ctx, []datastore.ID{alice.ID, bob.ID, datastore.ID(uuid.NewString())})
+	require.NoError(t, err)
+	names := []string{}
+	for _, user := range users {
+		names = append(names, user.DisplayName)
+	}
+	assert.ElementsMatch(t, []string{"Alice", "Bob"}, names)
+	users, err = store.GetUsers(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, users)
+
+	// Users are listed oldest first, a page at a time
+	carol, err := store.CreateUser(ctx, "Carol", "", "")
+	require.NoError(t, err)
+	var listed []datastore.ID
+	pageToken := ""
+	for {
+		page, next, err := store.ListUsers(ctx, 2, pageToken)
+		require.NoError(t, err)
+		for _, user := range page {
+			listed = append(listed, user.ID)
+		}
+		if next == "" {
+			break
+		}
+		pageToken = next
+	}
+	assert.Equal(t, []datastore.ID{alice.ID, bob.ID, carol.ID}, listed)
+
+	_, _, err = store.ListUsers(ctx, 0, "")
+	assert.ErrorIs(t, err, datastore.ErrInvalid)
+	_, _, err = store.ListUsers(ctx, 2, "invalid")
+	assert.ErrorIs(t, err, datastore.ErrInvalid)
+}
+
+func testAuthors(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	author, err := store.CreateUser(ctx, "Alice", "", "")
+	require.NoError(t, err)
+
+	// Blogs and comments refer to their author
+	id, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusPublished, nil, nil, datastore.WithAuthor(author.ID))
+	require.NoError(t, err)
+	blog, err := store.Get(ctx, id)
+	require.NoError(t, err)
+	require.NotNil(t, blog.AuthorID)
+	assert.Equal(t, author.ID, *blog.AuthorID)
+
+	comment, err := store.AddComment(ctx, id, nil, "Signed comment", "", 3, datastore.CommentStateApproved, datastore.WithCommentAuthor(author.ID))
+	require.NoError(t, err)
+	require.NotNil(t, comment.AuthorID)
+	assert.Equal(t, author.ID, *comment.AuthorID)
+	found, err := store.GetComment(ctx, id, comment.ID)
+	require.NoError(t, err)
+	require.NotNil(t, found.AuthorID)
+	assert.Equal(t, author.ID, *found.AuthorID)
+
+	// Guests sign with a free-text name only
+	guest, err := store.AddComment(ctx, id, nil, "Guest comment", "Guest", 3, datastore.CommentStateApproved)
+	require.NoError(t, err)
+	assert.Nil(t, guest.AuthorID)
+	blog, err = store.Get(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, blog.Comments, 2)
+	require.NotNil(t, blog.Comments[0].AuthorID)
+	assert.Nil(t, blog.Comments[1].AuthorID)
+
+	// Authors must exist
+	missing := datastore.ID(uuid.NewString())
+	_, err = store.Create(ctx, "Other Title", "Content", datastore.StatusPublished, nil, nil, datastore.WithAuthor(missing))
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	_, err = store.AddComment(ctx, id, nil, "Comment", "", 3, datastore.CommentStateApproved, datastore.WithCommentAuthor(missing))
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+}
+
+func testTenants(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	// The default tenant always exists
+	def, err := store.GetTenantBySlug(ctx, datastore.DefaultTenantSlug)
+	require.NoError(t, err)
+	assert.Equal(t, datastore.DefaultTenantID, def.ID)
+
+	acme, err := store.CreateTenant(ctx, "acme", "Acme Gardening")
+	require.NoError(t, err)
+	_, err = uuid.Parse(string(acme.ID))
+	require.NoError(t, err, "IDs must be UUIDs")
+	assert.Equal(t, "acme", acme.Slug)
+	assert.Equal(t, "Acme Gardening", acme.Name)
+	assert.False(t, acme.CreatedAt.IsZero())
+
+	found, err := store.GetTenantBySlug(ctx, "acme")
+	require.NoError(t, err)
+	assert.Equal(t, acme.ID, found.ID)
+	_, err = store.GetTenantBySlug(ctx, "missing")
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+
+	_, err = store.CreateTenant(ctx, "acme", "Other")
+	assert.ErrorIs(t, err, datastore.ErrConflict)
+	_, err = store.CreateTenant(ctx, "Not A Slug", "Other")
+	assert.ErrorIs(t, err, datastore.ErrInvalid)
+
+	// Tenants are listed by slug
+	_, err = store.CreateTenant(ctx, "beta", "Beta")
+	require.NoError(t, err)
+	tenants, err := store.ListTenants(ctx)
+	require.NoError(t, err)
+	var slugs []string
+	for _, tenant := range tenants {
+		slugs = append(slugs, tenant.Slug)
+	}
+	assert.Equal(t, []string{"acme", "beta", datastore.DefaultTenantSlug}, slugs)
+}
+
+func testTenantIsolation(t *testing.T, store datastore.Store) {
+	tenantA, err := store.CreateTenant(context.Background(), "tenant-a", "Tenant A")
+	require.NoError(t, err)
+	tenantB, err := store.CreateTenant(context.Background(), "tenant-b", "Tenant B")
+	require.NoError(t, err)
+	ctxA := datastore.NewTenantContext(context.Background(), tenantA)
+	ctxB := datastore.NewTenantContext(context.Background(), tenantB)
+
+	author, err := store.CreateUser(ctxA, "Alice", "", "")
+	require.NoError(t, err)
+	id, err := store.Create(ctxA, "Test Title", "Test Content", datastore.StatusPublished, nil, []string{"go"}, datastore.WithAuthor(author.ID))
+	require.NoError(t, err)
+	comment, err := store.AddComment(ctxA, id, nil, "Comment", "alice", 3, datastore.CommentStateApproved)
+	require.NoError(t, err)
+
+	// Slugs and tags are only unique within a tenant
+	otherID, err := store.Create(ctxB, "Test Title", "Other Content", datastore.StatusPublished, nil, []string{"go"})
+	require.NoError(t, err)
+	blog, err := store.Get(ctxB, otherID)
+	require.NoError(t, err)
+	assert.Equal(t, "test-title", blog.Slug)
+	blog, err = store.GetBySlug(ctxA, "test-title")
+	require.NoError(t, err)
+	assert.Equal(t, id, blog.ID)
+	tags, err := store.ListTags(ctxA, "")
+	require.NoError(t, err)
+	assert.Equal(t, []*datastore.TagCount{{Name: "go", BlogCount: 1}}, tags)
+
+	// Nothing of one tenant can be reached from another, even by ID
+	_, err = store.Get(ctxB, id)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	_, err = store.Get(context.Background(), id)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	title := "Stolen"
+	err = store.Update(ctxB, id, datastore.BlogPatch{Title: &title}, "mallory", 0)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	assert.ErrorIs(t, store.Delete(ctxB, id, 0), datastore.ErrNotFound)
+	assert.ErrorIs(t, store.Publish(ctxB, id), datastore.ErrNotFound)
+	_, err = store.GetComment(ctxB, id, comment.ID)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	_, err = store.AddComment(ctxB, id, nil, "Comment", "mallory", 3, datastore.CommentStateApproved)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	_, _, err = store.ListComments(ctxB, id, 10, "")
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	_, err = store.GetUser(ctxB, author.ID)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	_, err = store.Create(ctxB, "Other Title", "Content", datastore.StatusPublished, nil, nil, datastore.WithAuthor(author.ID))
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+
+	blogs, _, err := store.List(ctxB, 10, "", datastore.ListFilter{})
+	require.NoError(t, err)
+	require.Len(t, blogs, 1)
+	assert.Equal(t, otherID, blogs[0].ID)
+	hits, _, err := store.Search(ctxB, datastore.SearchQuery{Terms: search.Parse("content")}, 10, "")
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, otherID, hits[0].ID)
+
+	_, err = store.CreateAPIKey(ctxA, "ingest", "psk_0001", fmt.Sprintf("%064x", 1), nil, nil)
+	require.NoError(t, err)
+	_, err = store.GetAPIKeyByHash(ctxB, fmt.Sprintf("%064x", 1))
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	keys, err := store.ListAPIKeys(ctxB, true)
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	// Scheduled blogs are published for every tenant
+	now := time.Now()
+	scheduledA, err := store.Create(ctxA, "Scheduled A", "Content", datastore.StatusScheduled, &now, nil)
+	require.NoError(t, err)
+	scheduledB, err := store.Create(ctxB, "Scheduled B", "Content", datastore.StatusScheduled, &now, nil)
+	require.NoError(t, err)
+	published, err := store.PublishScheduled(context.Background(), now, 10)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []datastore.ID{scheduledA, scheduledB}, published)
+}
+
+func testCommentVerdicts(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	id, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+	doomedID, err := store.Create(ctx, "Doomed Title", "Doomed Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+
+	// Recent comments of every state are listed newest first, skipping
+	// blogs in the trash
+	var added []datastore.ID
+	for i, state := range []datastore.CommentState{datastore.CommentStateApproved, datastore.CommentStatePending, datastore.CommentStateSpam} {
+		comment, err := store.AddComment(ctx, id, nil, fmt.Sprintf("Comment %d", i), "Author", 0, state)
+		require.NoError(t, err)
+		added = append(added, comment.ID)
+	}
+	trashed, err := store.AddComment(ctx, doomedID, nil, "Trashed", "Author", 0, datastore.CommentStateApproved)
+	require.NoError(t, err)
+	require.NoError(t, store.ModerateComment(ctx, doomedID, trashed.ID, datastore.CommentStateSpam, ""))
+	require.NoError(t, store.Delete(ctx, doomedID, 0))
+
+	recent, err := store.ListRecentComments(ctx, time.Now().Add(-time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, recent, 3)
+	assert.ElementsMatch(t, added, []datastore.ID{recent[0].ID, recent[1].ID, recent[2].ID})
+	for i := 1; i < len(recent); i++ {
+		assert.False(t, recent[i].CreatedAt.After(recent[i-1].CreatedAt))
+	}
+	recent, err = store.ListRecentComments(ctx, time.Now().Add(-time.Hour), 2)
+	require.NoError(t, err)
+	assert.Len(t, recent, 2)
+	recent, err = store.ListRecentComments(ctx, time.Now().Add(time.Hour), 10)
+	require.NoError(t, err)
+	assert.Empty(t, recent)
+
+	// Only comments a moderator settled are moderation history
+	moderated, err := store.ListModeratedComments(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, moderated)
+	require.NoError(t, store.ModerateComment(ctx, id, added[1], datastore.CommentStateApproved, ""))
+	require.NoError(t, store.ModerateComment(ctx, id, added[2], datastore.CommentStateSpam, "Sells watches"))
+	moderated, err = store.ListModeratedComments(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, moderated, 2)
+	assert.ElementsMatch(t, added[1:], []datastore.ID{moderated[0].ID, moderated[1].ID})
+	assert.False(t, moderated[1].ModeratedAt.After(*moderated[0].ModeratedAt))
+	moderated, err = store.ListModeratedComments(ctx, 1)
+	require.NoError(t, err)
+	assert.Len(t, moderated, 1)
+
+	// Verdicts are recorded along with their comment, in the order they were
+	// given
+	verdicts, err := store.ListCommentVerdicts(ctx, id, added[0])
+	require.NoError(t, err)
+	assert.Empty(t, verdicts)
+	given := []datastore.CommentVerdict{
+		{Classifier: "links", Score: 1},
+		{Classifier: "duplicates", State: datastore.CommentStateSpam, Reason: "Duplicate", Score: 0.5},
+		{Classifier: "bayes", State: datastore.CommentStateApproved, Reason: "Ham"},
+	}
+	filtered, err := store.AddComment(ctx, id, nil, "Filtered", "Author", 0, datastore.CommentStateSpam, datastore.WithCommentVerdicts(given))
+	require.NoError(t, err)
+	verdicts, err = store.ListCommentVerdicts(ctx, id, filtered.ID)
+	require.NoError(t, err)
+	require.Len(t, verdicts, 3)
+	for i, expected := range given {
+		assert.Equal(t, filtered.ID, verdicts[i].CommentID)
+		assert.Equal(t, expected.Classifier, verdicts[i].Classifier)
+		assert.Equal(t, expected.State, verdicts[i].State)
+		assert.Equal(t, expected.Reason, verdicts[i].Reason)
+		assert.Equal(t, expected.Score, verdicts[i].Score)
+		assert.False(t, verdicts[i].CreatedAt.IsZero())
+	}
+
+	// Verdicts need known states, and neither they nor their comment are
+	// stored if either cannot be
+	_, err = store.AddComment(ctx, id, nil, "Unknown", "Author", 0, datastore.CommentStateSpam,
+		datastore.WithCommentVerdicts([]datastore.CommentVerdict{{Classifier: "links", State: "unknown"}}))
+	assert.ErrorIs(t, err, datastore.ErrInvalid)
+	_, err = store.AddComment(ctx, doomedID, nil, "Trashed", "Author", 0, datastore.CommentStateSpam, datastore.WithCommentVerdicts(given))
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	recent, err = store.ListRecentComments(ctx, time.Now().Add(-time.Hour), 10)
+	require.NoError(t, err)
+	assert.Len(t, recent, 4)
+
+	// Verdicts go along with the comment
+	_, err = store.ListCommentVerdicts(ctx, doomedID, trashed.ID)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	require.NoError(t, store.DeleteComment(ctx, id, filtered.ID))
+	_, err = store.ListCommentVerdicts(ctx, id, filtered.ID)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+
+	_, err = store.ListRecentComments(ctx, time.Now(), 0)
+	assert.ErrorIs(t, err, datastore.ErrInvalid)
+	_, err = store.ListModeratedComments(ctx, 0)
+	assert.ErrorIs(t, err, datastore.ErrInvalid)
+}
+
+func testAuditEvents(t *testing.T, store datastore.Store) {
+	alice := datastore.AuditInfo{Actor: "alice", Method: "/blog.v1.BlogService/UpdateBlog", RequestID: "req-1", ClientIP: "203.0.113.7"}
+	ctx := datastore.NewAuditContext(context.Background(), alice)
+	bobCtx := datastore.NewAuditContext(context.Background(), datastore.AuditInfo{Actor: "bob"})
+
+	id, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusDraft, nil, []string{"go"})
+	require.NoError(t, err)
+	title := "New Title"
+	require.NoError(t, store.Update(ctx, id, datastore.BlogPatch{Title: &title}, "alice", 0))
+	comment, err := store.AddComment(bobCtx, id, nil, "Comment", "bob", 3, datastore.CommentStatePending)
+	require.NoError(t, err)
+	require.NoError(t, store.ModerateComment(ctx, id, comment.ID, datastore.CommentStateSpam, "Selling watches"))
+
+	// Empty and failed changes record nothing
+	require.NoError(t, store.Update(ctx, id, datastore.BlogPatch{}, "alice", 0))
+	require.NoError(t, store.Delete(ctx, id, 0))
+	err = store.Update(ctx, id, datastore.BlogPatch{Title: &title}, "alice", 0)
+	require.ErrorIs(t, err, datastore.ErrNotFound)
+	err = store.Publish(ctx, id)
+	require.ErrorIs(t, err, datastore.ErrNotFound)
+
+	events, next, err := store.ListAuditEvents(context.Background(), datastore.AuditFilter{}, 10, "")
+	require.NoError(t, err)
+	assert.Empty(t, next)
+	require.Len(t, events, 5)
+
+	type change struct {
+		action     datastore.AuditAction
+		resource   string
+		resourceID datastore.ID
+		actor      string
+	}
+	var changes []change
+	for _, event := range events {
+		changes = append(changes, change{event.Action, event.Resource, event.ResourceID, event.Actor})
+		_, err := uuid.Parse(string(event.ID))
+		assert.NoError(t, err, "IDs must be UUIDs")
+		assert.False(t, event.CreatedAt.IsZero())
+	}
+	assert.Equal(t, []change{
+		{datastore.AuditCreate, datastore.ResourceBlog, id, "alice"},
+		{datastore.AuditUpdate, datastore.ResourceBlog, id, "alice"},
+		{datastore.AuditCreate, datastore.ResourceComment, comment.ID, "bob"},
+		{datastore.AuditModerate, datastore.ResourceComment, comment.ID, "alice"},
+		{datastore.AuditDelete, datastore.ResourceBlog, id, "alice"},
+	}, changes)
+
+	// Events hold the request that made the change
+	assert.Equal(t, alice.Method, events[0].Method)
+	assert.Equal(t, alice.RequestID, events[0].RequestID)
+	assert.Equal(t, alice.ClientIP, events[0].ClientIP)
+	assert.Empty(t, events[2].Method)
+
+	// and snapshots of the resource before and after it
+	snapshot := func(data json.RawMessage) map[string]any {
+		t.Helper()
+		if data == nil {
+			return nil
+		}
+		var fields map[string]any
+		require.NoError(t, json.Unmarshal(data, &fields))
+		return fields
+	}
+	assert.Nil(t, events[0].Before)
+	assert.Equal(t, "Test Title", snapshot(events[0].After)["title"])
+	assert.Equal(t, []any{"go"}, snapshot(events[0].After)["tags"])
+	assert.Equal(t, "Test Title", snapshot(events[1].Before)["title"])
+	assert.Equal(t, "New Title", snapshot(events[1].After)["title"])
+	assert.Nil(t, events[2].Before)
+	assert.Equal(t, "pending", snapshot(events[2].After)["state"])
+	assert.Equal(t, "pending", snapshot(events[3].Before)["state"])
+	assert.Equal(t, "spam", snapshot(events[3].After)["state"])
+	assert.Equal(t, "Selling watches", snapshot(events[3].After)["moderation_reason"])
+	assert.NotContains(t, snapshot(events[4].Before), "deleted_at")
+	assert.Contains(t, snapshot(events[4].After), "deleted_at")
+
+	// Filters narrow the events down
+	count := func(filter datastore.AuditFilter) int {
+		t.Helper()
+		events, _, err := store.ListAuditEvents(context.Background(), filter, 10, "")
+		require.NoError(t, err)
+		return len(events)
+	}
+	assert.Equal(t, 3, count(datastore.AuditFilter{Resource: datastore.ResourceBlog}))
+	assert.Equal(t, 2, count(datastore.AuditFilter{ResourceID: comment.ID}))
+	assert.Equal(t, 1, count(datastore.AuditFilter{Actor: "bob"}))
+	assert.Equal(t, 0, count(datastore.AuditFilter{Actor: "mallory"}))
+	first, last := events[0].CreatedAt, events[4].CreatedAt
+	assert.Equal(t, 5, count(datastore.AuditFilter{Since: &first}))
+	assert.Equal(t, 0, count(datastore.AuditFilter{Until: &first}))
+	assert.GreaterOrEqual(t, count(datastore.AuditFilter{Since: &last}), 1)
+
+	// Pages follow each other without gaps
+	var paged []datastore.ID
+	token := ""
+	for {
+		page, next, err := store.ListAuditEvents(context.Background(), datastore.AuditFilter{}, 2, token)
+		require.NoError(t, err)
+		for _, event := range page {
+			paged = append(paged, event.ID)
+		}
+		if next == "" {
+			break
+		}
+		token = next
+	}
+	var all []datastore.ID
+	for _, event := range events {
+		all = append(all, event.ID)
+	}
+	assert.Equal(t, all, paged)
+
+	// Events belong to the tenant of the change
+	tenant, err := store.CreateTenant(context.Background(), "audited", "Audited")
+	require.NoError(t, err)
+	tenantCtx := datastore.NewTenantContext(context.Background(), tenant)
+	events, _, err = store.ListAuditEvents(tenantCtx, datastore.AuditFilter{}, 10, "")
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	_, _, err = store.ListAuditEvents(context.Background(), datastore.AuditFilter{}, 0, "")
+	assert.ErrorIs(t, err, datastore.ErrInvalid)
+	_, _, err = store.ListAuditEvents(context.Background(), datastore.AuditFilter{}, 10, "invalid")
+	assert.ErrorIs(t, err, datastore.ErrInvalid)
+}
+
+func testAuditedChanges(t *testing.T, store datastore.Store) {
+	ctx := datastore.NewAuditContext(context.Background(), datastore.AuditInfo{Actor: "alice"})
+	past := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name    string
+		comment bool // whether the change is to the comment rather than the blog
+		change  func(t *testing.T, blogID, commentID datastore.ID)
+		action  datastore.AuditAction
+		check   func(t *testing.T, before, after map[string]any)
+	}{
+		{
+			name:    "update comment",
+			comment: true,
+			change: func(t *testing.T, blogID, commentID datastore.ID) {
+				require.NoError(t, store.UpdateComment(ctx, blogID, commentID, "Edited"))
+			},
+			action: datastore.AuditUpdate,
+			check: func(t *testing.T, before, after map[string]any) {
+				assert.Equal(t, "Comment", before["content"])
+				assert.Equal(t, "Edited", after["content"])
+			},
+		},
+		{
+			name:    "delete comment",
+			comment: true,
+			change: func(t *testing.T, blogID, commentID datastore.ID) {
+				require.NoError(t, store.DeleteComment(ctx, blogID, commentID))
+			},
+			action: datastore.AuditDelete,
+			check: func(t *testing.T, before, after map[string]any) {
+				assert.Equal(t, "Comment", before["content"])
+				assert.Nil(t, after)
+			},
+		},
+		{
+			name: "publish",
+			change: func(t *testing.T, blogID, _ datastore.ID) {
+				require.NoError(t, store.Publish(ctx, blogID))
+			},
+			action: datastore.AuditPublish,
+			check: func(t *testing.T, before, after map[string]any) {
+				assert.Equal(t, "draft", before["status"])
+				assert.Equal(t, "published", after["status"])
+				assert.Contains(t, after, "published_at")
+			},
+		},
+		{
+			name: "unpublish",
+			change: func(t *testing.T, blogID, _ datastore.ID) {
+				require.NoError(t, store.Publish(ctx, blogID))
+				require.NoError(t, store.Unpublish(ctx, blogID))
+			},
+			action: datastore.AuditUnpublish,
+			check: func(t *testing.T, before, after map[string]any) {
+				assert.Equal(t, "published", before["status"])
+				assert.Equal(t, "draft", after["status"])
+			},
+		},
+		{
+			name: "publish scheduled",
+			change: func(t *testing.T, blogID, _ datastore.ID) {
+				require.NoError(t, store.Update(ctx, blogID, datastore.BlogPatch{PublishAt: &past}, "alice", 0))
+				published, err := store.PublishScheduled(ctx, time.Now(), 100)
+				require.NoError(t, err)
+				assert.Contains(t, published, blogID)
+			},
+			action: datastore.AuditPublish,
+			check: func(t *testing.T, before, after map[string]any) {
+				assert.Equal(t, "scheduled", before["status"])
+				assert.Contains(t, before, "publish_at")
+				assert.Equal(t, "published", after["status"])
+				assert.NotContains(t, after, "publish_at")
+			},
+		},
+		{
+			name: "undelete",
+			change: func(t *testing.T, blogID, _ datastore.ID) {
+				require.NoError(t, store.Delete(ctx, blogID, 0))
+				require.NoError(t, store.Undelete(ctx, blogID))
+			},
+			action: datastore.AuditUndelete,
+			check: func(t *testing.T, before, after map[string]any) {
+				assert.Contains(t, before, "deleted_at")
+				assert.NotContains(t, after, "deleted_at")
+			},
+		},
+		{
+			name: "purge",
+			change: func(t *testing.T, blogID, _ datastore.ID) {
+				require.NoError(t, store.Delete(ctx, blogID, 0))
+				require.NoError(t, store.Purge(ctx, blogID))
+			},
+			action: datastore.AuditPurge,
+			check: func(t *testing.T, before, after map[string]any) {
+				assert.Equal(t, "Test Title", before["title"])
+				assert.Contains(t, before, "deleted_at")
+				assert.Nil(t, after)
+			},
+		},
+		{
+			name: "purge deleted",
+			change: func(t *testing.T, blogID, _ datastore.ID) {
+				require.NoError(t, store.Delete(ctx, blogID, 0))
+				purged, err := store.PurgeDeleted(ctx, time.Now(), 100)
+				require.NoError(t, err)
+				assert.Contains(t, purged, blogID)
+			},
+			action: datastore.AuditPurge,
+			check: func(t *testing.T, before, after map[string]any) {
+				assert.Contains(t, before, "deleted_at")
+				assert.Nil(t, after)
+			},
+		},
+		{
+			name: "restore revision",
+			change: func(t *testing.T, blogID, _ datastore.ID) {
+				title := "New Title"
+				require.NoError(t, store.Update(ctx, blogID, datastore.BlogPatch{Title: &title}, "alice", 0))
+				require.NoError(t, store.RestoreRevision(ctx, blogID, 1, "alice"))
+			},
+			action: datastore.AuditRestore,
+			check: func(t *testing.T, before, after map[string]any) {
+				assert.Equal(t, "New Title", before["title"])
+				assert.Equal(t, "Test Title", after["title"])
+			},
+		},
+	}
+
+	snapshot := func(t *testing.T, data json.RawMessage) map[string]any {
+		t.Helper()
+		if data == nil {
+			return nil
+		}
+		var fields map[string]any
+		require.NoError(t, json.Unmarshal(data, &fields))
+		return fields
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blogID, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusDraft, nil, nil)
+			require.NoError(t, err)
+			comment, err := store.AddComment(ctx, blogID, nil, "Comment", "bob", 3, datastore.CommentStateApproved)
+			require.NoError(t, err)
+
+			tt.change(t, blogID, comment.ID)
+
+			resourceID := blogID
+			if tt.comment {
+				resourceID = comment.ID
+			}
+			events, _, err := store.ListAuditEvents(ctx, datastore.AuditFilter{ResourceID: resourceID}, 100, "")
+			require.NoError(t, err)
+			require.NotEmpty(t, events)
+
+			// The change is the last one made to the resource
+			event := events[len(events)-1]
+			assert.Equal(t, tt.action, event.Action)
+			assert.Equal(t, "alice", event.Actor)
+			tt.check(t, snapshot(t, event.Before), snapshot(t, event.After))
+		})
+	}
+
+	// Changes made for every tenant at once are recorded in the tenant of
+	// each changed blog
+	tenant, err := store.CreateTenant(context.Background(), "scheduled", "Scheduled")
+	require.NoError(t, err)
+	tenantCtx := datastore.NewTenantContext(ctx, tenant)
+	id, err := store.Create(tenantCtx, "Test Title", "Test Content", datastore.StatusScheduled, &past, nil)
+	require.NoError(t, err)
+	published, err := store.PublishScheduled(ctx, time.Now(), 100)
+	require.NoError(t, err)
+	assert.Contains(t, published, id)
+
+	events, _, err := store.ListAuditEvents(tenantCtx, datastore.AuditFilter{ResourceID: id}, 100, "")
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, datastore.AuditPublish, events[1].Action)
+	events, _, err = store.ListAuditEvents(ctx, datastore.AuditFilter{ResourceID: id}, 100, "")
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func testLifecycle(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	id, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusDraft, nil, nil)
+	require.NoError(t, err)
+	blog, err := store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, datastore.StatusDraft, blog.Status)
+	assert.Nil(t, blog.PublishedAt, "drafts must not have a publish time")
+	assert.Nil(t, blog.PublishAt, "drafts must not be scheduled")
+
+	require.NoError(t, store.Publish(ctx, id))
+	blog, err = store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, datastore.StatusPublished, blog.Status)
+	require.NotNil(t, blog.PublishedAt)
+	publishedAt := *blog.PublishedAt
+
+	// Publishing again keeps the original publish time
+	require.NoError(t, store.Publish(ctx, id))
+	blog, err = store.Get(ctx, id)
+	require.NoError(t, err)
+	require.NotNil(t, blog.PublishedAt)
+	assert.True(t, blog.PublishedAt.Equal(publishedAt))
+
+	// Unpublishing keeps the last publish time for reference
+	require.NoError(t, store.Unpublish(ctx, id))
+	blog, err = store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, datastore.StatusDraft, blog.Status)
+	require.NotNil(t, blog.PublishedAt)
+	assert.True(t, blog.PublishedAt.Equal(publishedAt))
+
+	archived := datastore.StatusArchived
+	require.NoError(t, store.Update(ctx, id, datastore.BlogPatch{Status: &archived}, "", 0))
+	blog, err = store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, datastore.StatusArchived, blog.Status)
+	assert.Equal(t, "Test Title", blog.Title)
+
+	// Publishing through an update records a new publish time
+	published := datastore.StatusPublished
+	require.NoError(t, store.Update(ctx, id, datastore.BlogPatch{Status: &published}, "", 0))
+	blog, err = store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, datastore.StatusPublished, blog.Status)
+	require.NotNil(t, blog.PublishedAt)
+	assert.False(t, blog.PublishedAt.Before(publishedAt))
+}
+
+func testListByStatus(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	byStatus := map[datastore.Status][]datastore.ID{}
+	statuses := []datastore.Status{
+		datastore.StatusDraft,
+		datastore.StatusScheduled,
+		datastore.StatusPublished,
+		datastore.StatusArchived,
+	}
+	for i, status := range statuses {
+		// Create a different number of blogs per status to tell them apart
+		for j := 0; j <= i; j++ {
+			var publishAt *time.Time
+			if status == datastore.StatusScheduled {
+				tomorrow := time.Now().Add(24 * time.Hour)
+				publishAt = &tomorrow
+			}
+			id, err := store.Create(ctx, fmt.Sprintf("Test Title %d", j), "Test Content", status, publishAt, nil)
+			require.NoError(t, err)
+			byStatus[status] = append(byStatus[status], id)
+		}
+	}
+
+	for _, status := range statuses {
+		t.Run(string(status), func(t *testing.T) {
+			var seen []datastore.ID
+			pageToken := ""
+			for {
+				summaries, nextPageToken, err := store.List(ctx, 2, pageToken, datastore.ListFilter{Status: status})
+				require.NoError(t, err)
+				for _, summary := range summaries {
+					assert.Equal(t, status, summary.Status)
+					seen = append(seen, summary.ID)
+				}
+				if nextPageToken == "" {
+					break
+				}
+				pageToken = nextPageToken
+			}
+			assert.ElementsMatch(t, byStatus[status], seen)
+		})
+	}
+
+	// The zero filter matches every status
+	summaries, _, err := store.List(ctx, 100, "", datastore.ListFilter{})
+	require.NoError(t, err)
+	assert.Len(t, summaries, 10)
+}
+
+func testSchedule(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+	publishAt := time.Now().Add(24 * time.Hour)
+
+	id, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusScheduled, &publishAt, nil)
+	require.NoError(t, err)
+	blog, err := store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, datastore.StatusScheduled, blog.Status)
+	require.NotNil(t, blog.PublishAt)
+	assert.WithinDuration(t, publishAt, *blog.PublishAt, time.Millisecond)
+	assert.Nil(t, blog.PublishedAt)
+
+	// Rescheduling keeps the blog scheduled
+	later := publishAt.Add(time.Hour)
+	require.NoError(t, store.Update(ctx, id, datastore.BlogPatch{PublishAt: &later}, "", 0))
+	blog, err = store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, datastore.StatusScheduled, blog.Status)
+	require.NotNil(t, blog.PublishAt)
+	assert.WithinDuration(t, later, *blog.PublishAt, time.Millisecond)
+
+	// Publishing early drops the schedule
+	require.NoError(t, store.Publish(ctx, id))
+	blog, err = store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, datastore.StatusPublished, blog.Status)
+	assert.Nil(t, blog.PublishAt)
+	assert.NotNil(t, blog.PublishedAt)
+
+	// Setting a publish time on a published blog schedules it again
+	require.NoError(t, store.Update(ctx, id, datastore.BlogPatch{PublishAt: &publishAt}, "", 0))
+	blog, err = store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, datastore.StatusScheduled, blog.Status)
+	require.NotNil(t, blog.PublishAt)
+
+	// Moving out of scheduled drops the schedule
+	draft := datastore.StatusDraft
+	require.NoError(t, store.Update(ctx, id, datastore.BlogPatch{Status: &draft}, "", 0))
+	blog, err = store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, datastore.StatusDraft, blog.Status)
+	assert.Nil(t, blog.PublishAt)
+}
+
+func testPublishScheduled(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+	now := time.Now()
+
+	var due []datastore.ID
+	for i := 0; i < 3; i++ {
+		publishAt := now.Add(-time.Duration(3-i) * time.Minute)
+		id, err := store.Create(ctx, fmt.Sprintf("Due Title %d", i), "Test Content", datastore.StatusScheduled, &publishAt, nil)
+		require.NoError(t, err)
+		due = append(due, id)
+	}
+	future := now.Add(time.Hour)
+	futureID, err := store.Create(ctx, "Future Title", "Test Content", datastore.StatusScheduled, &future, nil)
+	require.NoError(t, err)
+	draftID, err := store.Create(ctx, "Draft Title", "Test Content", datastore.StatusDraft, nil, nil)
+	require.NoError(t, err)
+
+	// Due blogs are published in publish time order, a batch at a time
+	first, err := store.PublishScheduled(ctx, now, 2)
+	require.NoError(t, err)
+	assert.Equal(t, due[:2], first)
+
+	rest, err := store.PublishScheduled(ctx, now, 2)
+	require.NoError(t, err)
+	assert.Equal(t, due[2:], rest)
+
+	none, err := store.PublishScheduled(ctx, now, 2)
+	require.NoError(t, err)
+	assert.Empty(t, none)
+
+	for _, id := range due {
+		blog, err := store.Get(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, datastore.StatusPublished, blog.Status)
+		assert.NotNil(t, blog.PublishedAt)
+		assert.Nil(t, blog.PublishAt)
+	}
+
+	scheduled, err := store.Get(ctx, futureID)
+	require.NoError(t, err)
+	assert.Equal(t, datastore.StatusScheduled, scheduled.Status)
+
+	draft, err := store.Get(ctx, draftID)
+	require.NoError(t, err)
+	assert.Equal(t, datastore.StatusDraft, draft.Status)
+
+	// Concurrent publishers never publish the same blog twice
+	for i := 0; i < 20; i++ {
+		_, err := store.Create(ctx, fmt.Sprintf("Race Title %d", i), "Test Content", datastore.StatusScheduled, &now, nil)
+		require.NoError(t, err)
+	}
+
+	var mu sync.Mutex
+	published := map[datastore.ID]int{}
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				ids, err := store.PublishScheduled(ctx, now, 3)
+				if !assert.NoError(t, err) || len(ids) == 0 {
+					return
+				}
+				mu.Lock()
+				for _, id := range ids {
+					published[id]++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, published, 20)
+	for id, count := range published {
+		assert.Equal(t, 1, count, "blog %s published more than once", id)
+	}
+}
+
+func testVersions(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	id, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusDraft, nil, nil)
+	require.NoError(t, err)
+	blog, err := store.Get(ctx, id)
+	require.NoError(t, err)
+	require.Positive(t, blog.Version)
+	version := blog.Version
+
+	// Every change to the blog moves its version on
+	title := "Updated Title"
+	require.NoError(t, store.Update(ctx, id, datastore.BlogPatch{Title: &title}, "", version))
+	blog, err = store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Greater(t, blog.Version, version)
+	stale := version
+	version = blog.Version
+
+	require.NoError(t, store.Publish(ctx, id))
+	blog, err = store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Greater(t, blog.Version, version)
+	version = blog.Version
+
+	// Comments are not part of the blog version
+	_, err = store.AddComment(ctx, id, nil, "Comment", "Author", 0, datastore.CommentStateApproved)
+	require.NoError(t, err)
+	blog, err = store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, version, blog.Version)
+
+	// Stale versions are rejected without changing anything
+	staleTitle := "Stale Title"
+	err = store.Update(ctx, id, datastore.BlogPatch{Title: &staleTitle}, "", stale)
+	assert.ErrorIs(t, err, datastore.ErrVersionMismatch)
+	err = store.Delete(ctx, id, stale)
+	assert.ErrorIs(t, err, datastore.ErrVersionMismatch)
+
+	blog, err = store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "Updated Title", blog.Title)
+	assert.Equal(t, version, blog.Version)
+	revisions, _, err := store.ListRevisions(ctx, id, 10, "")
+	require.NoError(t, err)
+	assert.Len(t, revisions, 1, "rejected updates record no revision")
+
+	// The current version is accepted
+	require.NoError(t, store.Delete(ctx, id, version))
+	_, err = store.Get(ctx, id)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+
+	// Missing blogs are not found, whatever the version
+	err = store.Update(ctx, id, datastore.BlogPatch{Title: &title}, "", version)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	err = store.Delete(ctx, id, version)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+}
+
+func testConcurrentVersionedUpdates(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	id, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+	blog, err := store.Get(ctx, id)
+	require.NoError(t, err)
+
+	// Editors racing to update the same version must not clobber each other
+	const workers = 10
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	succeeded := 0
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			title := fmt.Sprintf("Title %d", i)
+			err := store.Update(ctx, id, datastore.BlogPatch{Title: &title}, "", blog.Version)
+			if err != nil {
+				assert.ErrorIs(t, err, datastore.ErrVersionMismatch)
+				return
+			}
+			mu.Lock()
+			succeeded++
+			mu.Unlock()
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, succeeded)
+	revisions, _, err := store.ListRevisions(ctx, id, 100, "")
+	require.NoError(t, err)
+	assert.Len(t, revisions, 1)
+}
+
+func testRevisions(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	id, err := store.Create(ctx, "First Title", "First Content", datastore.StatusDraft, nil, nil)
+	require.NoError(t, err)
+
+	revisions, nextPageToken, err := store.ListRevisions(ctx, id, 10, "")
+	require.NoError(t, err)
+	assert.Empty(t, revisions, "new blogs have no revisions")
+	assert.Empty(t, nextPageToken)
+
+	// Every change to the title or content records the replaced version
+	secondTitle := "Second Title"
+	require.NoError(t, store.Update(ctx, id, datastore.BlogPatch{Title: &secondTitle}, "alice", 0))
+	secondContent := "Second Content"
+	require.NoError(t, store.Update(ctx, id, datastore.BlogPatch{Content: &secondContent}, "bob", 0))
+
+	// Status changes leave the text alone and record nothing
+	published := datastore.StatusPublished
+	require.NoError(t, store.Update(ctx, id, datastore.BlogPatch{Status: &published}, "carol", 0))
+	require.NoError(t, store.Unpublish(ctx, id))
+
+	revisions, _, err = store.ListRevisions(ctx, id, 10, "")
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, int32(2), revisions[0].Number, "revisions are listed newest first")
+	assert.Equal(t, "Second Title", revisions[0].Title)
+	assert.Equal(t, "First Content", revisions[0].Content)
+	assert.Equal(t, "bob", revisions[0].Editor)
+	assert.Equal(t, int32(1), revisions[1].Number)
+	assert.Equal(t, "First Title", revisions[1].Title)
+	assert.Equal(t, "First Content", revisions[1].Content)
+	assert.Equal(t, "alice", revisions[1].Editor)
+	for _, revision := range revisions {
+		assert.Equal(t, id, revision.BlogID)
+		assert.False(t, revision.CreatedAt.IsZero())
+	}
+
+	revision, err := store.GetRevision(ctx, id, 1)
+	require.NoError(t, err)
+	assert.Equal(t, revisions[1], revision)
+
+	// Restoring brings back the old text and records the version it replaced
+	require.NoError(t, store.RestoreRevision(ctx, id, 1, "dave"))
+
+	blog, err := store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "First Title", blog.Title)
+	assert.Equal(t, "First Content", blog.Content)
+	assert.Equal(t, datastore.StatusDraft, blog.Status, "restoring keeps the status")
+
+	revision, err = store.GetRevision(ctx, id, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "Second Title", revision.Title)
+	assert.Equal(t, "Second Content", revision.Content)
+	assert.Equal(t, "dave", revision.Editor)
+
+	// The current version is not a revision, so it cannot be restored
+	err = store.RestoreRevision(ctx, id, 4, "dave")
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+
+	// Revisions go with their blog
+	require.NoError(t, store.Delete(ctx, id, 0))
+	_, err = store.GetRevision(ctx, id, 1)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+}
+
+func testRevisionPagination(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	id, err := store.Create(ctx, "Title 0", "Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+
+	const edits = 5
+	for i := 1; i <= edits; i++ {
+		title := fmt.Sprintf("Title %d", i)
+		require.NoError(t, store.Update(ctx, id, datastore.BlogPatch{Title: &title}, "editor", 0))
+	}
+
+	var numbers []int32
+	pageToken := ""
+	for {
+		revisions, nextPageToken, err := store.ListRevisions(ctx, id, 2, pageToken)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(revisions), 2)
+		for _, revision := range revisions {
+			numbers = append(numbers, revision.Number)
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+
+	assert.Equal(t, []int32{5, 4, 3, 2, 1}, numbers)
+}
+
+func testErrorKinds(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+	missingID := datastore.ID(uuid.New().String())
+	malformedID := datastore.ID("not-a-uuid")
+	title := "Test Title"
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	tests := []struct {
+		name         string
+		call         func() error
+		expectedKind error
+	}{
+		{
+			name: "get missing blog",
+			call: func() error {
+				_, err := store.Get(ctx, missingID)
+				return err
+			},
+			expectedKind: datastore.ErrNotFound,
+		},
+		{
+			name: "update missing blog",
+			call: func() error {
+				return store.Update(ctx, missingID, datastore.BlogPatch{Title: &title}, "", 0)
+			},
+			expectedKind: datastore.ErrNotFound,
+		},
+		{
+			name: "delete missing blog",
+			call: func() error {
+				return store.Delete(ctx, missingID, 0)
+			},
+			expectedKind: datastore.ErrNotFound,
+		},
+		{
+			name: "comment on missing blog",
+			call: func() error {
+				_, err := store.AddComment(ctx, missingID, nil, "Comment", "Author", 0, datastore.CommentStateApproved)
+				return err
+			},
+			expectedKind: datastore.ErrNotFound,
+		},
+		{
+			name: "publish missing blog",
+			call: func() error {
+				return store.Publish(ctx, missingID)
+			},
+			expectedKind: datastore.ErrNotFound,
+		},
+		{
+			name: "unpublish missing blog",
+			call: func() error {
+				return store.Unpublish(ctx, missingID)
+			},
+			expectedKind: datastore.ErrNotFound,
+		},
+		{
+			name: "list revisions of missing blog",
+			call: func() error {
+				_, _, err := store.ListRevisions(ctx, missingID, 10, "")
+				return err
+			},
+			expectedKind: datastore.ErrNotFound,
+		},
+		{
+			name: "get missing revision",
+			call: func() error {
+				_, err := store.GetRevision(ctx, missingID, 1)
+				return err
+			},
+			expectedKind: datastore.ErrNotFound,
+		},
+		{
+			name: "restore revision of missing blog",
+			call: func() error {
+				return store.RestoreRevision(ctx, missingID, 1, "")
+			},
+			expectedKind: datastore.ErrNotFound,
+		},
+		{
+			name: "restore missing revision",
+			call: func() error {
+				id, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusDraft, nil, nil)
+				if err != nil {
+					return err
+				}
+				return store.RestoreRevision(ctx, id, 1, "")
+			},
+			expectedKind: datastore.ErrNotFound,
+		},
+		{
+			name: "create with unknown status",
+			call: func() error {
+				_, err := store.Create(ctx, "Test Title", "Test Content", datastore.Status("unknown"), nil, nil)
+				return err
+			},
+			expectedKind: datastore.ErrInvalid,
+		},
+		{
+			name: "list with unknown status",
+			call: func() error {
+				_, _, err := store.List(ctx, 10, "", datastore.ListFilter{Status: datastore.Status("unknown")})
+				return err
+			},
+			expectedKind: datastore.ErrInvalid,
+		},
+		{
+			name: "create scheduled blog without publish time",
+			call: func() error {
+				_, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusScheduled, nil, nil)
+				return err
+			},
+			expectedKind: datastore.ErrInvalid,
+		},
+		{
+			name: "create draft with publish time",
+			call: func() error {
+				publishAt := time.Now()
+				_, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusDraft, &publishAt, nil)
+				return err
+			},
+			expectedKind: datastore.ErrInvalid,
+		},
+		{
+			name: "schedule without publish time",
+			call: func() error {
+				id, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusDraft, nil, nil)
+				if err != nil {
+					return err
+				}
+				scheduled := datastore.StatusScheduled
+				return store.Update(ctx, id, datastore.BlogPatch{Status: &scheduled}, "", 0)
+			},
+			expectedKind: datastore.ErrInvalid,
+		},
+		{
+			name: "publish scheduled with invalid limit",
+			call: func() error {
+				_, err := store.PublishScheduled(ctx, time.Now(), 0)
+				return err
+			},
+			expectedKind: datastore.ErrInvalid,
+		},
+		{
+			name: "get malformed ID",
+			call: func() error {
+				_, err := store.Get(ctx, malformedID)
+				return err
+			},
+			expectedKind: datastore.ErrInvalid,
+		},
+		{
+			name: "list with invalid page size",
+			call: func() error {
+				_, _, err := store.List(ctx, 0, "", datastore.ListFilter{})
+				return err
+			},
+			expectedKind: datastore.ErrInvalid,
+		},
+		{
+			name: "list with malformed page token",
+			call: func() error {
+				_, _, err := store.List(ctx, 10, "invalid-token", datastore.ListFilter{})
+				return err
+			},
+			expectedKind: datastore.ErrInvalid,
+		},
+		{
+			name: "list revisions with invalid page size",
+			call: func() error {
+				_, _, err := store.ListRevisions(ctx, missingID, 0, "")
+				return err
+			},
+			expectedKind: datastore.ErrInvalid,
+		},
+		{
+			name: "list revisions with malformed page token",
+			call: func() error {
+				_, _, err := store.ListRevisions(ctx, missingID, 10, "invalid-token")
+				return err
+			},
+			expectedKind: datastore.ErrInvalid,
+		},
+		{
+			name: "canceled context",
+			call: func() error {
+				_, err := store.Create(canceled, "Test Title", "Test Content", datastore.StatusPublished, nil, nil)
+				return err
+			},
+			expectedKind: context.Canceled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tt.expectedKind)
+		})
+	}
+}
+
+func testConcurrentAccess(t *testing.T, store datastore.Store) {
+	ctx := context.Background()
+
+	id, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+	doomedID, err := store.Create(ctx, "Doomed Title", "Doomed Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+
+	const workers = 10
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			title := fmt.Sprintf("Title %d", i)
+			assert.NoError(t, store.Update(ctx, id, datastore.BlogPatch{Title: &title}, fmt.Sprintf("editor-%d", i), 0))
+
+			_, err := store.AddComment(ctx, id, nil, "Comment", "Author", 0, datastore.CommentStateApproved)
+			assert.NoError(t, err)
+
+			_, err = store.Create(ctx, title, "Content", datastore.StatusPublished, nil, nil)
+			assert.NoError(t, err)
+
+			_, err = store.Get(ctx, id)
+			assert.NoError(t, err)
+
+			_, _, err = store.List(ctx, 10, "", datastore.ListFilter{})
+			assert.NoError(t, err)
+
+			// Racing a delete may only ever fail with not found
+			if i%2 == 0 {
+				err = store.Delete(ctx, doomedID, 0)
+			} else {
+				_, err = store.AddComment(ctx, doomedID, nil, "Comment", "Author", 0, datastore.CommentStateApproved)
+			}
+			if err != nil && !errors.Is(err, datastore.ErrNotFound) {
+				t.Errorf("unexpected error racing delete: %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	blog, err := store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Len(t, blog.Comments, workers)
+
+	// Every update recorded a revision, numbered without gaps
+	revisions, _, err := store.ListRevisions(ctx, id, 100, "")
+	require.NoError(t, err)
+	require.Len(t, revisions, workers)
+	for i, revision := range revisions {
+		assert.Equal(t, int32(workers-i), revision.Number)
+	}
+
+	_, err = store.Get(ctx, doomedID)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+
+	summaries, _, err := store.List(ctx, 100, "", datastore.ListFilter{})
+	require.NoError(t, err)
+	assert.Len(t, summaries, workers+1)
+}
@@ -0,0 +1,56 @@
+package datastore
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultTenantID is the ID of the default tenant, which owns everything
+// stored by deployments hosting a single blog
+const DefaultTenantID ID = "00000000-0000-0000-0000-000000000000"
+
+// DefaultTenantSlug is the slug of the default tenant
+const DefaultTenantSlug = "default"
+
+// MaxTenantSlugLen is the maximum length of a tenant slug, which fits in a
+// DNS label so that tenants can be addressed by subdomain
+const MaxTenantSlugLen = 63
+
+// Tenant is one of the independent blogs hosted by a deployment. Blogs,
+// comments, tags, slugs, API keys and users all belong to a tenant and are
+// invisible to every other tenant.
+type Tenant struct {
+	ID        ID        `db:"id"`
+	Slug      string    `db:"slug"`
+	Name      string    `db:"name"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// ValidTenantSlug reports whether slug is a valid tenant slug
+func ValidTenantSlug(slug string) bool {
+	return len(slug) <= MaxTenantSlugLen && wordsPattern.MatchString(slug)
+}
+
+// tenantKey is the context key of the tenant a call is made for
+type tenantKey struct{}
+
+// NewTenantContext returns a copy of ctx carrying the tenant the stores act
+// for
+func NewTenantContext(ctx context.Context, tenant *Tenant) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// TenantFromContext returns the tenant carried by ctx, if any
+func TenantFromContext(ctx context.Context) (*Tenant, bool) {
+	tenant, ok := ctx.Value(tenantKey{}).(*Tenant)
+	return tenant, ok && tenant != nil
+}
+
+// TenantID returns the ID of the tenant carried by ctx, or the default
+// tenant if it carries none. Stores scope every method to this tenant.
+func TenantID(ctx context.Context) ID {
+	if tenant, ok := TenantFromContext(ctx); ok {
+		return tenant.ID
+	}
+	return DefaultTenantID
+}
@@ -0,0 +1,48 @@
+package datastore_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+)
+
+func TestValidTenantSlug(t *testing.T) {
+	tests := []struct {
+		slug string
+		want bool
+	}{
+		{"default", true},
+		{"acme-gardening", true},
+		{"team42", true},
+		{"", false},
+		{"Acme", false},
+		{"acme_gardening", false},
+		{"-acme", false},
+		{"acme-", false},
+		{"acme--gardening", false},
+		{strings.Repeat("a", datastore.MaxTenantSlugLen), true},
+		{strings.Repeat("a", datastore.MaxTenantSlugLen+1), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.slug, func(t *testing.T) {
+			assert.Equal(t, tt.want, datastore.ValidTenantSlug(tt.slug))
+		})
+	}
+}
+
+func TestTenantID(t *testing.T) {
+	assert.Equal(t, datastore.DefaultTenantID, datastore.TenantID(context.Background()))
+	assert.Equal(t, datastore.DefaultTenantID, datastore.TenantID(datastore.NewTenantContext(context.Background(), nil)))
+
+	tenant := &datastore.Tenant{ID: "tenant-id", Slug: "acme"}
+	ctx := datastore.NewTenantContext(context.Background(), tenant)
+	assert.Equal(t, datastore.ID("tenant-id"), datastore.TenantID(ctx))
+	found, ok := datastore.TenantFromContext(ctx)
+	assert.True(t, ok)
+	assert.Same(t, tenant, found)
+}
@@ -0,0 +1,215 @@
+// Package diff computes the differences between two versions of a text
+package diff
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Op is the kind of change a chunk represents
+type Op int
+
+// Diff operations
+const (
+	Equal Op = iota
+	Insert
+	Delete
+)
+
+// String returns the name of the operation
+func (op Op) String() string {
+	switch op {
+	case Equal:
+		return "equal"
+	case Insert:
+		return "insert"
+	case Delete:
+		return "delete"
+	}
+	return "unknown"
+}
+
+// Chunk is a run of text with the same change
+type Chunk struct {
+	Op   Op
+	Text string
+}
+
+// maxEdits bounds the work spent on a diff. Texts that differ in more tokens
+// are reported as deleted and inserted in full, which is still a correct
+// if not minimal diff.
+const maxEdits = 1000
+
+// Lines compares two texts line by line. Each line keeps its line break, so
+// the chunks join back into the original texts.
+func Lines(a, b string) []Chunk {
+	return diff(splitLines(a), splitLines(b))
+}
+
+// Words compares two texts word by word. Runs of whitespace are tokens of
+// their own, so the chunks join back into the original texts.
+func Words(a, b string) []Chunk {
+	return diff(splitWords(a), splitWords(b))
+}
+
+// diff returns the chunks that turn the tokens of a into the tokens of b
+func diff(a, b []string) []Chunk {
+	// Common prefixes and suffixes are cheap to find and keep the edit
+	// search small for the usual case of a local change
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var c chunker
+	c.add(Equal, a[:prefix]...)
+	c.edits(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])
+	c.add(Equal, a[len(a)-suffix:]...)
+	return c.chunks
+}
+
+// chunker collects tokens into chunks, merging adjacent tokens with the same
+// operation
+type chunker struct {
+	chunks []Chunk
+}
+
+// add appends tokens with the given operation
+func (c *chunker) add(op Op, tokens ...string) {
+	if len(tokens) == 0 {
+		return
+	}
+	text := strings.Join(tokens, "")
+	if n := len(c.chunks); n > 0 && c.chunks[n-1].Op == op {
+		c.chunks[n-1].Text += text
+		return
+	}
+	c.chunks = append(c.chunks, Chunk{Op: op, Text: text})
+}
+
+// edits appends the shortest edit script from a to b, found with Myers'
+// O(ND) algorithm
+func (c *chunker) edits(a, b []string) {
+	n, m := len(a), len(b)
+	if n == 0 || m == 0 {
+		c.add(Delete, a...)
+		c.add(Insert, b...)
+		return
+	}
+
+	// v holds the furthest x reached on each diagonal k = x - y, offset so
+	// that negative diagonals can be indexed. trace keeps the diagonals
+	// -d-1 to d+1 of v as they were before round d, which is all the walk
+	// back along the path reads.
+	limit := min(n+m, maxEdits)
+	offset := limit + 1
+	v := make([]int, 2*limit+3)
+	var trace [][]int
+
+	for d := 0; d <= limit; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d-1:offset+d+2]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1] // move down, inserting from b
+			} else {
+				x = v[offset+k-1] + 1 // move right, deleting from a
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				c.backtrack(a, b, trace)
+				return
+			}
+		}
+	}
+
+	// Too many differences to find the shortest script
+	c.add(Delete, a...)
+	c.add(Insert, b...)
+}
+
+// backtrack walks the edit path found by edits from the end back to the
+// start and appends its chunks in order
+func (c *chunker) backtrack(a, b []string, trace [][]int) {
+	type step struct {
+		op    Op
+		token string
+	}
+	var steps []step
+
+	x, y := len(a), len(b)
+	for d := len(trace) - 1; d >= 0; d-- {
+		// Diagonal k of round d is stored at index k+d+1
+		v := trace[d]
+		offset := d + 1
+		k := x - y
+
+		var prevK int
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			steps = append(steps, step{Equal, a[x-1]})
+			x--
+			y--
+		}
+		if d > 0 {
+			if x == prevX {
+				steps = append(steps, step{Insert, b[y-1]})
+			} else {
+				steps = append(steps, step{Delete, a[x-1]})
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	for i := len(steps) - 1; i >= 0; i-- {
+		c.add(steps[i].op, steps[i].token)
+	}
+}
+
+// splitLines splits text after each line break
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// splitWords splits text into alternating runs of whitespace and non-whitespace
+func splitWords(text string) []string {
+	var words []string
+	start, space := 0, false
+	for i, r := range text {
+		if i > start && unicode.IsSpace(r) != space {
+			words = append(words, text[start:i])
+			start = i
+		}
+		if i == start {
+			space = unicode.IsSpace(r)
+		}
+	}
+	if start < len(text) {
+		words = append(words, text[start:])
+	}
+	return words
+}
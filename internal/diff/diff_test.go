@@ -0,0 +1,188 @@
+package diff_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/agruetz/prosigliere/internal/diff"
+)
+
+func TestLines(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected []diff.Chunk
+	}{
+		{
+			name:     "both empty",
+			a:        "",
+			b:        "",
+			expected: nil,
+		},
+		{
+			name:     "unchanged",
+			a:        "one\ntwo\n",
+			b:        "one\ntwo\n",
+			expected: []diff.Chunk{{diff.Equal, "one\ntwo\n"}},
+		},
+		{
+			name:     "added text",
+			a:        "",
+			b:        "one\n",
+			expected: []diff.Chunk{{diff.Insert, "one\n"}},
+		},
+		{
+			name: "inserted line",
+			a:    "one\nthree\n",
+			b:    "one\ntwo\nthree\n",
+			expected: []diff.Chunk{
+				{diff.Equal, "one\n"},
+				{diff.Insert, "two\n"},
+				{diff.Equal, "three\n"},
+			},
+		},
+		{
+			name: "changed line",
+			a:    "one\ntwo\nthree",
+			b:    "one\n2\nthree",
+			expected: []diff.Chunk{
+				{diff.Equal, "one\n"},
+				{diff.Delete, "two\n"},
+				{diff.Insert, "2\n"},
+				{diff.Equal, "three"},
+			},
+		},
+		{
+			name: "moved line",
+			a:    "a\nb\nc\nd\n",
+			b:    "b\nc\na\nd\n",
+			expected: []diff.Chunk{
+				{diff.Delete, "a\n"},
+				{diff.Equal, "b\nc\n"},
+				{diff.Insert, "a\n"},
+				{diff.Equal, "d\n"},
+			},
+		},
+		{
+			name: "missing final line break",
+			a:    "one\ntwo",
+			b:    "one\ntwo\n",
+			expected: []diff.Chunk{
+				{diff.Equal, "one\n"},
+				{diff.Delete, "two"},
+				{diff.Insert, "two\n"},
+			},
+		},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chunks := diff.Lines(tt.a, tt.b)
+			assert.Equal(t, tt.expected, chunks)
+			assertJoins(t, chunks, tt.a, tt.b)
+		})
+	}
+}
+
+func TestWords(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected []diff.Chunk
+	}{
+		{
+			name: "changed word",
+			a:    "The quick fox",
+			b:    "The slow fox",
+			expected: []diff.Chunk{
+				{diff.Equal, "The "},
+				{diff.Delete, "quick"},
+				{diff.Insert, "slow"},
+				{diff.Equal, " fox"},
+			},
+		},
+		{
+			name: "added words",
+			a:    "Hello world",
+			b:    "Hello brave new world",
+			expected: []diff.Chunk{
+				{diff.Equal, "Hello "},
+				{diff.Insert, "brave new "},
+				{diff.Equal, "world"},
+			},
+		},
+		{
+			name: "changed whitespace",
+			a:    "one two",
+			b:    "one \ttwo",
+			expected: []diff.Chunk{
+				{diff.Equal, "one"},
+				{diff.Delete, " "},
+				{diff.Insert, " \t"},
+				{diff.Equal, "two"},
+			},
+		},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chunks := diff.Words(tt.a, tt.b)
+			assert.Equal(t, tt.expected, chunks)
+			assertJoins(t, chunks, tt.a, tt.b)
+		})
+	}
+}
+
+func TestLinesMinimal(t *testing.T) {
+	a := "a\nb\nc\na\nb\nb\na\n"
+	b := "c\nb\na\nb\na\nc\n"
+
+	// The classic example from Myers' paper needs five edits
+	edits := 0
+	for _, chunk := range diff.Lines(a, b) {
+		if chunk.Op != diff.Equal {
+			edits += strings.Count(chunk.Text, "\n")
+		}
+	}
+	assert.Equal(t, 5, edits)
+}
+
+func TestLinesTooManyEdits(t *testing.T) {
+	var a, b strings.Builder
+	for i := 0; i < 2000; i++ {
+		a.WriteString("a\n")
+		b.WriteString("b\n")
+	}
+
+	// Texts that differ everywhere fall back to replacing the whole text
+	chunks := diff.Lines(a.String(), b.String())
+	assert.Equal(t, []diff.Chunk{
+		{diff.Delete, a.String()},
+		{diff.Insert, b.String()},
+	}, chunks)
+}
+
+// assertJoins checks that the chunks join back into both texts
+func assertJoins(t *testing.T, chunks []diff.Chunk, a, b string) {
+	t.Helper()
+
+	var gotA, gotB strings.Builder
+	for _, chunk := range chunks {
+		if chunk.Op != diff.Insert {
+			gotA.WriteString(chunk.Text)
+		}
+		if chunk.Op != diff.Delete {
+			gotB.WriteString(chunk.Text)
+		}
+	}
+	assert.Equal(t, a, gotA.String())
+	assert.Equal(t, b, gotB.String())
+}
@@ -0,0 +1,172 @@
+package filter
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+)
+
+// ModeratedCommentLister lists the comments moderators settled, as
+// datastore.Store does
+type ModeratedCommentLister interface {
+	ListModeratedComments(ctx context.Context, limit int32) ([]*datastore.Comment, error)
+}
+
+// Bayes is a naive Bayes classifier learning from the moderation history.
+// Comments moderators approved are examples of wanted comments, and comments
+// they rejected or marked as spam are examples of spam. The classifier flags
+// comments that are most likely spam, approves comments that are most likely
+// not, and leaves the rest to the comment policy. Until the history holds
+// enough examples of both, it does not judge comments at all. Every tenant
+// has a model of its own, learned from its own history.
+type Bayes struct {
+	comments ModeratedCommentLister
+	cfg      *bayesConfig
+
+	mu     sync.Mutex
+	models map[datastore.ID]*bayesModel // by tenant
+}
+
+// bayesModel holds the word counts learned from the moderation history
+type bayesModel struct {
+	docs      [2]int            // number of ham and spam examples
+	words     [2]int            // number of words in ham and spam examples
+	counts    map[string][2]int // ham and spam occurrences of every word
+	trainedAt time.Time
+}
+
+// Classes of a bayesModel
+const (
+	ham  = 0
+	spam = 1
+)
+
+// NewBayes creates a Bayes classifier learning from the moderated comments
+func NewBayes(comments ModeratedCommentLister, opts ...BayesOption) *Bayes {
+	cfg := defaultBayesConfig()
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return &Bayes{
+		comments: comments,
+		cfg:      cfg,
+		models:   make(map[datastore.ID]*bayesModel),
+	}
+}
+
+// Name identifies the classifier in recorded verdicts
+func (b *Bayes) Name() string {
+	return "bayes"
+}
+
+// Train learns from the most recently moderated comments of the tenant of
+// ctx, replacing what was learned for it before
+func (b *Bayes) Train(ctx context.Context) error {
+	comments, err := b.comments.ListModeratedComments(ctx, b.cfg.trainingSize)
+	if err != nil {
+		return fmt.Errorf("failed to read moderation history: %w", err)
+	}
+
+	model := &bayesModel{counts: make(map[string][2]int)}
+	for _, comment := range comments {
+		class := ham
+		switch comment.State {
+		case datastore.CommentStateApproved:
+		case datastore.CommentStateRejected, datastore.CommentStateSpam:
+			class = spam
+		default:
+			continue
+		}
+		model.docs[class]++
+		for _, word := range normalize(comment.Content) {
+			counts := model.counts[word]
+			counts[class]++
+			model.counts[word] = counts
+			model.words[class]++
+		}
+	}
+
+	model.trainedAt = b.cfg.now()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.models[datastore.TenantID(ctx)] = model
+	return nil
+}
+
+// Classify judges the comment by the probability that it is spam, which is
+// the score of the verdict. The classifier learns from the moderation
+// history first if it never did or its last training is too old.
+func (b *Bayes) Classify(ctx context.Context, comment *datastore.Comment) (Verdict, error) {
+	model, err := b.current(ctx)
+	if err != nil {
+		return Verdict{}, err
+	}
+	if model.docs[ham] < b.cfg.minExamples || model.docs[spam] < b.cfg.minExamples {
+		return Verdict{}, nil
+	}
+
+	p := model.spamProbability(normalize(comment.Content))
+	switch {
+	case p >= b.cfg.spamThreshold:
+		return Verdict{
+			State:  datastore.CommentStateSpam,
+			Reason: fmt.Sprintf("spam probability %.2f", p),
+			Score:  p,
+		}, nil
+	case p <= b.cfg.hamThreshold:
+		return Verdict{
+			State:  datastore.CommentStateApproved,
+			Reason: fmt.Sprintf("spam probability %.2f", p),
+			Score:  p,
+		}, nil
+	}
+	return Verdict{Score: p}, nil
+}
+
+// current returns the model of the tenant of ctx, training it first if it
+// is missing or stale
+func (b *Bayes) current(ctx context.Context) (*bayesModel, error) {
+	tenantID := datastore.TenantID(ctx)
+	b.mu.Lock()
+	model := b.models[tenantID]
+	b.mu.Unlock()
+
+	if model == nil || b.cfg.now().Sub(model.trainedAt) >= b.cfg.retrainInterval {
+		if err := b.Train(ctx); err != nil {
+			return nil, err
+		}
+		b.mu.Lock()
+		model = b.models[tenantID]
+		b.mu.Unlock()
+	}
+	return model, nil
+}
+
+// spamProbability returns the probability that a text with the given words
+// is spam, using Laplace smoothing for words seen in only one class
+func (m *bayesModel) spamProbability(words []string) float64 {
+	vocabulary := float64(len(m.counts))
+	total := float64(m.docs[ham] + m.docs[spam])
+
+	var logp [2]float64
+	for class := range logp {
+		logp[class] = math.Log(float64(m.docs[class]) / total)
+		denominator := float64(m.words[class]) + vocabulary
+		for _, word := range words {
+			counts, ok := m.counts[word]
+			if !ok {
+				// Words never seen tell nothing about the class
+				continue
+			}
+			logp[class] += math.Log((float64(counts[class]) + 1) / denominator)
+		}
+	}
+
+	// Equivalent to exp(spam) / (exp(ham) + exp(spam)) without overflowing
+	return 1 / (1 + math.Exp(logp[ham]-logp[spam]))
+}
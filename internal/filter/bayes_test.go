@@ -0,0 +1,152 @@
+package filter_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/datastore/mocks"
+	"github.com/agruetz/prosigliere/internal/filter"
+)
+
+// history returns a moderation history of n approved and n spam comments
+func history(n int) []*datastore.Comment {
+	var comments []*datastore.Comment
+	for i := 0; i < n; i++ {
+		comments = append(comments,
+			&datastore.Comment{
+				Content: fmt.Sprintf("Great article on growing tomatoes, part %d helped my garden", i),
+				State:   datastore.CommentStateApproved,
+			},
+			&datastore.Comment{
+				Content: fmt.Sprintf("Cheap pills online, buy now at discount %d", i),
+				State:   datastore.CommentStateSpam,
+			},
+			&datastore.Comment{
+				Content: fmt.Sprintf("Win the casino jackpot, buy tokens now %d", i),
+				State:   datastore.CommentStateRejected,
+			},
+			// Still pending, so it teaches nothing
+			&datastore.Comment{
+				Content: "Great article on growing tomatoes",
+				State:   datastore.CommentStatePending,
+			},
+		)
+	}
+	return comments
+}
+
+func TestBayes(t *testing.T) {
+	store := &mocks.Store{}
+	store.On("ListModeratedComments", mock.Anything, int32(5000)).Return(history(10), nil).Once()
+
+	bayes := filter.NewBayes(store, filter.WithMinExamples(10))
+	assert.Equal(t, "bayes", bayes.Name())
+
+	// Define test cases
+	tests := []struct {
+		name     string
+		content  string
+		expected datastore.CommentState
+	}{
+		{
+			name:     "spam",
+			content:  "Buy cheap pills now!",
+			expected: datastore.CommentStateSpam,
+		},
+		{
+			name:     "ham",
+			content:  "This article helped my tomatoes grow in the garden",
+			expected: datastore.CommentStateApproved,
+		},
+		{
+			name:     "unsure",
+			content:  "Buy tomatoes",
+			expected: "",
+		},
+		{
+			name:     "unknown words",
+			content:  "Lorem ipsum dolor",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, err := bayes.Classify(context.Background(), &datastore.Comment{Content: tt.content})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, verdict.State)
+			assert.GreaterOrEqual(t, verdict.Score, 0.0)
+			assert.LessOrEqual(t, verdict.Score, 1.0)
+		})
+	}
+
+	// The model is trained once and reused
+	store.AssertExpectations(t)
+}
+
+func TestBayesTooFewExamples(t *testing.T) {
+	store := &mocks.Store{}
+	store.On("ListModeratedComments", mock.Anything, int32(100)).Return(history(5), nil)
+
+	bayes := filter.NewBayes(store, filter.WithTrainingSize(100), filter.WithMinExamples(10))
+	verdict, err := bayes.Classify(context.Background(), &datastore.Comment{Content: "Buy cheap pills now!"})
+	require.NoError(t, err)
+	assert.Equal(t, filter.Verdict{}, verdict)
+}
+
+func TestBayesRetrain(t *testing.T) {
+	now := time.Now()
+	store := &mocks.Store{}
+	store.On("ListModeratedComments", mock.Anything, int32(5000)).Return(history(5), nil).Once()
+
+	bayes := filter.NewBayes(store,
+		filter.WithMinExamples(5),
+		filter.WithRetrainInterval(time.Minute),
+		filter.WithClock(func() time.Time { return now }),
+	)
+	comment := &datastore.Comment{Content: "Buy cheap pills now!"}
+
+	verdict, err := bayes.Classify(context.Background(), comment)
+	require.NoError(t, err)
+	assert.Equal(t, datastore.CommentStateSpam, verdict.State)
+
+	// Once the model is stale the classifier learns again, failing if the
+	// history cannot be read
+	now = now.Add(time.Minute)
+	store.On("ListModeratedComments", mock.Anything, int32(5000)).Return(nil, errors.New("connection refused")).Once()
+
+	_, err = bayes.Classify(context.Background(), comment)
+	require.Error(t, err)
+	store.AssertExpectations(t)
+}
+
+func TestBayesTenants(t *testing.T) {
+	acme := datastore.NewTenantContext(context.Background(), &datastore.Tenant{ID: "acme-id", Slug: "acme"})
+	store := &mocks.Store{}
+	store.On("ListModeratedComments", mock.MatchedBy(func(ctx context.Context) bool {
+		return datastore.TenantID(ctx) == datastore.DefaultTenantID
+	}), int32(5000)).Return(history(5), nil).Once()
+	store.On("ListModeratedComments", mock.MatchedBy(func(ctx context.Context) bool {
+		return datastore.TenantID(ctx) == "acme-id"
+	}), int32(5000)).Return(history(1), nil).Once()
+
+	bayes := filter.NewBayes(store, filter.WithMinExamples(5))
+	comment := &datastore.Comment{Content: "Buy cheap pills now!"}
+
+	// Every tenant learns from its own history only
+	verdict, err := bayes.Classify(context.Background(), comment)
+	require.NoError(t, err)
+	assert.Equal(t, datastore.CommentStateSpam, verdict.State)
+	verdict, err = bayes.Classify(acme, comment)
+	require.NoError(t, err)
+	assert.Equal(t, filter.Verdict{}, verdict)
+	store.AssertExpectations(t)
+}
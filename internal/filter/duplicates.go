@@ -0,0 +1,82 @@
+package filter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/search"
+)
+
+// RecentCommentLister lists the latest comments, as datastore.Store does
+type RecentCommentLister interface {
+	ListRecentComments(ctx context.Context, since time.Time, limit int32) ([]*datastore.Comment, error)
+}
+
+// Duplicate detection settings
+const (
+	// duplicateMinWords keeps short replies such as "thanks!" from being
+	// flagged, as different people legitimately write them
+	duplicateMinWords = 3
+
+	// duplicateScanLimit is how many recent comments are compared at most
+	duplicateScanLimit = 1000
+)
+
+// Duplicates flags comments repeating the content of a comment made shortly
+// before, on any blog, as spam. Content is compared by its words, ignoring
+// case, punctuation and spacing.
+type Duplicates struct {
+	comments RecentCommentLister
+	window   time.Duration
+}
+
+// NewDuplicates creates a Duplicates comparing comments against the comments
+// made within window before them
+func NewDuplicates(comments RecentCommentLister, window time.Duration) *Duplicates {
+	return &Duplicates{
+		comments: comments,
+		window:   window,
+	}
+}
+
+// Name identifies the classifier in recorded verdicts
+func (d *Duplicates) Name() string {
+	return "duplicates"
+}
+
+// Classify flags the comment as spam if a recent comment has the same words
+func (d *Duplicates) Classify(ctx context.Context, comment *datastore.Comment) (Verdict, error) {
+	words := normalize(comment.Content)
+	if len(words) < duplicateMinWords {
+		return Verdict{}, nil
+	}
+	text := strings.Join(words, " ")
+
+	recent, err := d.comments.ListRecentComments(ctx, time.Now().Add(-d.window), duplicateScanLimit)
+	if err != nil {
+		return Verdict{}, err
+	}
+	for _, other := range recent {
+		if strings.Join(normalize(other.Content), " ") == text {
+			return Verdict{
+				State:  datastore.CommentStateSpam,
+				Reason: fmt.Sprintf("duplicate of comment %s", other.ID),
+				Score:  1,
+			}, nil
+		}
+	}
+	return Verdict{}, nil
+}
+
+// normalize returns the lower case words of text
+func normalize(text string) []string {
+	tokens := search.Tokenize(text)
+	words := make([]string, len(tokens))
+	for i, token := range tokens {
+		words[i] = token.Word
+	}
+	return words
+}
@@ -0,0 +1,78 @@
+package filter_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/datastore/memory"
+	"github.com/agruetz/prosigliere/internal/datastore/mocks"
+	"github.com/agruetz/prosigliere/internal/filter"
+)
+
+func TestDuplicates(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+
+	blogID, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+	original, err := store.AddComment(ctx, blogID, nil, "Check out my great site!", "Test Author", 0, datastore.CommentStateApproved)
+	require.NoError(t, err)
+	_, err = store.AddComment(ctx, blogID, nil, "Thanks!", "Test Author", 0, datastore.CommentStateApproved)
+	require.NoError(t, err)
+
+	duplicates := filter.NewDuplicates(store, time.Hour)
+	assert.Equal(t, "duplicates", duplicates.Name())
+
+	// Define test cases
+	tests := []struct {
+		name     string
+		content  string
+		expected datastore.CommentState
+	}{
+		{
+			name:     "new content",
+			content:  "Check out my other site!",
+			expected: "",
+		},
+		{
+			name:     "same words",
+			content:  "check OUT my great site...",
+			expected: datastore.CommentStateSpam,
+		},
+		{
+			name:     "short replies are not duplicates",
+			content:  "thanks",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, err := duplicates.Classify(ctx, &datastore.Comment{BlogID: blogID, Content: tt.content})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, verdict.State)
+			if tt.expected != "" {
+				assert.Equal(t, "duplicate of comment "+string(original.ID), verdict.Reason)
+			}
+		})
+	}
+}
+
+func TestDuplicatesWindow(t *testing.T) {
+	store := &mocks.Store{}
+	store.On("ListRecentComments", mock.Anything, mock.MatchedBy(func(since time.Time) bool {
+		ago := time.Since(since)
+		return ago >= 30*time.Minute && ago < 31*time.Minute
+	}), int32(1000)).Return(nil, errors.New("connection refused"))
+
+	_, err := filter.NewDuplicates(store, 30*time.Minute).Classify(context.Background(), &datastore.Comment{Content: "Check out my great site!"})
+	require.Error(t, err)
+	store.AssertExpectations(t)
+}
@@ -0,0 +1,108 @@
+// Package filter screens new comments with a chain of classifiers, each of
+// which may approve the comment, hold it for a moderator or reject it before
+// it is stored.
+package filter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+)
+
+// Verdict is what a classifier makes of a comment
+type Verdict struct {
+	// State is the state the comment should get: approved, pending, or
+	// rejected or spam to turn it down. Empty means the classifier has no
+	// objection but does not vouch for the comment either.
+	State datastore.CommentState
+
+	// Reason explains the verdict to moderators
+	Reason string
+
+	// Score is the confidence of the classifier, its meaning depends on the
+	// classifier
+	Score float64
+}
+
+// Classifier judges new comments
+type Classifier interface {
+	// Name identifies the classifier in recorded verdicts
+	Name() string
+
+	// Classify judges a comment that is about to be added. The comment has
+	// its blog, parent, content and author set, but no ID or creation time
+	// yet.
+	Classify(ctx context.Context, comment *datastore.Comment) (Verdict, error)
+}
+
+// Decision is the outcome of running a comment through a Pipeline
+type Decision struct {
+	// State is the state the comment gets, or empty if no classifier asked
+	// for one, in which case the comment policy decides
+	State datastore.CommentState
+
+	// Reason is the reason of the verdict that decided the state
+	Reason string
+
+	// Verdicts holds the verdict of every classifier that ran, in order
+	Verdicts []datastore.CommentVerdict
+}
+
+// Pipeline runs comments through classifiers in order. The strictest verdict
+// decides the state of a comment: turning it down beats holding it, which
+// beats approving it, and among equally strict verdicts the first one wins.
+// A comment that is turned down skips the remaining classifiers.
+type Pipeline struct {
+	classifiers []Classifier
+}
+
+// New creates a Pipeline running the given classifiers in order
+func New(classifiers ...Classifier) *Pipeline {
+	return &Pipeline{classifiers: classifiers}
+}
+
+// Run runs a comment through the classifiers and decides its state. Any
+// classifier failing fails the whole run.
+func (p *Pipeline) Run(ctx context.Context, comment *datastore.Comment) (Decision, error) {
+	var decision Decision
+	for _, classifier := range p.classifiers {
+		verdict, err := classifier.Classify(ctx, comment)
+		if err != nil {
+			return Decision{}, fmt.Errorf("%s filter failed: %w", classifier.Name(), err)
+		}
+
+		decision.Verdicts = append(decision.Verdicts, datastore.CommentVerdict{
+			Classifier: classifier.Name(),
+			State:      verdict.State,
+			Reason:     verdict.Reason,
+			Score:      verdict.Score,
+		})
+		if severity(verdict.State) > severity(decision.State) {
+			decision.State = verdict.State
+			decision.Reason = verdict.Reason
+		}
+		if rejects(verdict.State) {
+			break
+		}
+	}
+	return decision, nil
+}
+
+// severity ranks the states a verdict can ask for from lenient to strict
+func severity(state datastore.CommentState) int {
+	switch state {
+	case datastore.CommentStateApproved:
+		return 1
+	case datastore.CommentStatePending:
+		return 2
+	case datastore.CommentStateRejected, datastore.CommentStateSpam:
+		return 3
+	}
+	return 0
+}
+
+// rejects reports whether a verdict asking for state turns the comment down
+func rejects(state datastore.CommentState) bool {
+	return state == datastore.CommentStateRejected || state == datastore.CommentStateSpam
+}
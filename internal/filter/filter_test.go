@@ -0,0 +1,128 @@
+package filter_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/filter"
+)
+
+// stub is a classifier returning a fixed verdict
+type stub struct {
+	name    string
+	verdict filter.Verdict
+	err     error
+	calls   int
+}
+
+func (s *stub) Name() string {
+	return s.name
+}
+
+func (s *stub) Classify(ctx context.Context, comment *datastore.Comment) (filter.Verdict, error) {
+	s.calls++
+	return s.verdict, s.err
+}
+
+func TestPipelineRun(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name     string
+		verdicts []filter.Verdict
+		expected datastore.CommentState
+		reason   string
+		ran      int
+	}{
+		{
+			name:     "no classifiers",
+			verdicts: nil,
+			expected: "",
+		},
+		{
+			name:     "no objection",
+			verdicts: []filter.Verdict{{Score: 0.5}, {}},
+			expected: "",
+			ran:      2,
+		},
+		{
+			name: "approved",
+			verdicts: []filter.Verdict{
+				{},
+				{State: datastore.CommentStateApproved, Reason: "looks fine"},
+			},
+			expected: datastore.CommentStateApproved,
+			reason:   "looks fine",
+			ran:      2,
+		},
+		{
+			name: "pending beats approved",
+			verdicts: []filter.Verdict{
+				{State: datastore.CommentStateApproved, Reason: "looks fine"},
+				{State: datastore.CommentStatePending, Reason: "many links"},
+				{State: datastore.CommentStateApproved, Reason: "looks fine too"},
+			},
+			expected: datastore.CommentStatePending,
+			reason:   "many links",
+			ran:      3,
+		},
+		{
+			name: "first of equal verdicts wins",
+			verdicts: []filter.Verdict{
+				{State: datastore.CommentStatePending, Reason: "first"},
+				{State: datastore.CommentStatePending, Reason: "second"},
+			},
+			expected: datastore.CommentStatePending,
+			reason:   "first",
+			ran:      2,
+		},
+		{
+			name: "rejection stops the pipeline",
+			verdicts: []filter.Verdict{
+				{State: datastore.CommentStatePending, Reason: "many links"},
+				{State: datastore.CommentStateSpam, Reason: "duplicate"},
+				{State: datastore.CommentStateRejected, Reason: "blocked word"},
+			},
+			expected: datastore.CommentStateSpam,
+			reason:   "duplicate",
+			ran:      2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var classifiers []filter.Classifier
+			for i, verdict := range tt.verdicts {
+				classifiers = append(classifiers, &stub{name: string(rune('a' + i)), verdict: verdict})
+			}
+
+			decision, err := filter.New(classifiers...).Run(context.Background(), &datastore.Comment{Content: "Test Comment"})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, decision.State)
+			assert.Equal(t, tt.reason, decision.Reason)
+
+			// Every classifier that ran has its verdict recorded
+			require.Len(t, decision.Verdicts, tt.ran)
+			for i, verdict := range decision.Verdicts {
+				assert.Equal(t, classifiers[i].Name(), verdict.Classifier)
+				assert.Equal(t, tt.verdicts[i].State, verdict.State)
+				assert.Equal(t, tt.verdicts[i].Reason, verdict.Reason)
+				assert.Equal(t, tt.verdicts[i].Score, verdict.Score)
+			}
+		})
+	}
+}
+
+func TestPipelineRunError(t *testing.T) {
+	failing := &stub{name: "failing", err: errors.New("connection refused")}
+	next := &stub{name: "next"}
+
+	_, err := filter.New(failing, next).Run(context.Background(), &datastore.Comment{Content: "Test Comment"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failing filter failed")
+	assert.Equal(t, 0, next.calls)
+}
@@ -0,0 +1,42 @@
+package filter
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+)
+
+// linkPattern matches the web links of a text
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>"]+`)
+
+// LinkLimit holds comments with more than a given number of links for a
+// moderator, as link farms are the most common comment spam
+type LinkLimit struct {
+	max int
+}
+
+// NewLinkLimit creates a LinkLimit allowing at most max links per comment
+func NewLinkLimit(max int) *LinkLimit {
+	return &LinkLimit{max: max}
+}
+
+// Name identifies the classifier in recorded verdicts
+func (l *LinkLimit) Name() string {
+	return "links"
+}
+
+// Classify holds the comment if it has too many links. The score is the
+// number of links.
+func (l *LinkLimit) Classify(ctx context.Context, comment *datastore.Comment) (Verdict, error) {
+	links := len(linkPattern.FindAllStringIndex(comment.Content, -1))
+	if links <= l.max {
+		return Verdict{Score: float64(links)}, nil
+	}
+	return Verdict{
+		State:  datastore.CommentStatePending,
+		Reason: fmt.Sprintf("has %d links, at most %d allowed", links, l.max),
+		Score:  float64(links),
+	}, nil
+}
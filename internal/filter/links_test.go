@@ -0,0 +1,55 @@
+package filter_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/filter"
+)
+
+func TestLinkLimit(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name     string
+		content  string
+		expected datastore.CommentState
+		score    float64
+	}{
+		{
+			name:     "no links",
+			content:  "Great post about http and www",
+			expected: "",
+			score:    0,
+		},
+		{
+			name:     "within limit",
+			content:  "See https://example.com and www.example.org/page",
+			expected: "",
+			score:    2,
+		},
+		{
+			name:     "over limit",
+			content:  "Buy at http://a.example, HTTPS://b.example and www.c.example",
+			expected: datastore.CommentStatePending,
+			score:    3,
+		},
+	}
+
+	limit := filter.NewLinkLimit(2)
+	assert.Equal(t, "links", limit.Name())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, err := limit.Classify(context.Background(), &datastore.Comment{Content: tt.content})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, verdict.State)
+			assert.Equal(t, tt.score, verdict.Score)
+			if tt.expected != "" {
+				assert.Equal(t, "has 3 links, at most 2 allowed", verdict.Reason)
+			}
+		})
+	}
+}
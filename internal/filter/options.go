@@ -0,0 +1,77 @@
+package filter
+
+import (
+	"time"
+)
+
+// bayesConfig holds the configuration for a Bayes classifier
+type bayesConfig struct {
+	spamThreshold   float64
+	hamThreshold    float64
+	minExamples     int
+	trainingSize    int32
+	retrainInterval time.Duration
+	now             func() time.Time
+}
+
+// defaultBayesConfig returns the default configuration for a Bayes classifier
+func defaultBayesConfig() *bayesConfig {
+	return &bayesConfig{
+		spamThreshold:   0.95,
+		hamThreshold:    0.05,
+		minExamples:     20,
+		trainingSize:    5000,
+		retrainInterval: 10 * time.Minute,
+		now:             time.Now,
+	}
+}
+
+// BayesOption is a function that modifies bayesConfig
+type BayesOption func(*bayesConfig)
+
+// WithSpamThreshold sets the spam probability from which comments are flagged
+// as spam
+func WithSpamThreshold(threshold float64) BayesOption {
+	return func(c *bayesConfig) {
+		c.spamThreshold = threshold
+	}
+}
+
+// WithHamThreshold sets the spam probability up to which comments are
+// approved. A negative threshold never approves comments.
+func WithHamThreshold(threshold float64) BayesOption {
+	return func(c *bayesConfig) {
+		c.hamThreshold = threshold
+	}
+}
+
+// WithMinExamples sets how many approved and how many turned down comments
+// the moderation history must hold before the classifier judges comments
+func WithMinExamples(n int) BayesOption {
+	return func(c *bayesConfig) {
+		c.minExamples = n
+	}
+}
+
+// WithTrainingSize sets how many of the most recently moderated comments the
+// classifier learns from
+func WithTrainingSize(size int32) BayesOption {
+	return func(c *bayesConfig) {
+		c.trainingSize = size
+	}
+}
+
+// WithRetrainInterval sets how often the classifier learns from the
+// moderation history again, to pick up new moderator decisions
+func WithRetrainInterval(interval time.Duration) BayesOption {
+	return func(c *bayesConfig) {
+		c.retrainInterval = interval
+	}
+}
+
+// WithClock sets the function returning the current time, for tests
+func WithClock(now func() time.Time) BayesOption {
+	return func(c *bayesConfig) {
+		c.now = now
+	}
+}
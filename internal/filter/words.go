@@ -0,0 +1,89 @@
+package filter
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/search"
+)
+
+// BlockedWords rejects comments containing any of a list of words or
+// phrases. Matching ignores case and punctuation, and only matches whole
+// words, so blocking "ass" does not reject "class". An entry ending in *
+// also matches words starting with its last word.
+type BlockedWords struct {
+	terms   []datastore.SearchTerm
+	entries []string
+}
+
+// NewBlockedWords creates a BlockedWords rejecting comments that contain any
+// of the given words or phrases. Entries without letters or digits are
+// ignored.
+func NewBlockedWords(entries []string) *BlockedWords {
+	b := &BlockedWords{}
+	for _, entry := range entries {
+		words := normalize(entry)
+		if len(words) == 0 {
+			continue
+		}
+		term := datastore.SearchTerm{
+			Words:  words,
+			Prefix: strings.HasSuffix(strings.TrimSpace(entry), "*"),
+		}
+		b.terms = append(b.terms, term)
+		b.entries = append(b.entries, strings.TrimSpace(entry))
+	}
+	return b
+}
+
+// LoadBlockedWords creates a BlockedWords from a file listing a word or
+// phrase per line. Blank lines and lines starting with # are skipped.
+func LoadBlockedWords(path string) (*BlockedWords, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open blocked words: %w", err)
+	}
+	defer f.Close()
+
+	var entries []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		entries = append(entries, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read blocked words: %w", err)
+	}
+
+	return NewBlockedWords(entries), nil
+}
+
+// Name identifies the classifier in recorded verdicts
+func (b *BlockedWords) Name() string {
+	return "blocked-words"
+}
+
+// Classify rejects the comment if its content or author contains a blocked
+// word or phrase
+func (b *BlockedWords) Classify(ctx context.Context, comment *datastore.Comment) (Verdict, error) {
+	for _, text := range []string{comment.Content, comment.Author} {
+		tokens := search.Tokenize(text)
+		for i, term := range b.terms {
+			if len(search.Match(tokens, term)) > 0 {
+				return Verdict{
+					State:  datastore.CommentStateRejected,
+					Reason: fmt.Sprintf("contains blocked word %q", b.entries[i]),
+					Score:  1,
+				}, nil
+			}
+		}
+	}
+	return Verdict{}, nil
+}
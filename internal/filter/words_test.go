@@ -0,0 +1,81 @@
+package filter_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/filter"
+)
+
+func TestBlockedWords(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "blocked.txt")
+	require.NoError(t, os.WriteFile(path, []byte("# Spam\n\nviagra\n  Cheap Pills  \ncasino*\n"), 0o600))
+
+	words, err := filter.LoadBlockedWords(path)
+	require.NoError(t, err)
+	assert.Equal(t, "blocked-words", words.Name())
+
+	// Define test cases
+	tests := []struct {
+		name     string
+		comment  datastore.Comment
+		expected datastore.CommentState
+		reason   string
+	}{
+		{
+			name:     "clean",
+			comment:  datastore.Comment{Content: "Pills of wisdom, cheap at any price", Author: "Test Author"},
+			expected: "",
+		},
+		{
+			name:     "word ignoring case",
+			comment:  datastore.Comment{Content: "Get VIAGRA now!", Author: "Test Author"},
+			expected: datastore.CommentStateRejected,
+			reason:   `contains blocked word "viagra"`,
+		},
+		{
+			name:     "phrase",
+			comment:  datastore.Comment{Content: "Order cheap, pills delivered", Author: "Test Author"},
+			expected: datastore.CommentStateRejected,
+			reason:   `contains blocked word "Cheap Pills"`,
+		},
+		{
+			name:     "prefix",
+			comment:  datastore.Comment{Content: "Visit our casinos", Author: "Test Author"},
+			expected: datastore.CommentStateRejected,
+			reason:   `contains blocked word "casino*"`,
+		},
+		{
+			name:     "whole words only",
+			comment:  datastore.Comment{Content: "The occasional viagrafree joke", Author: "Test Author"},
+			expected: "",
+		},
+		{
+			name:     "author",
+			comment:  datastore.Comment{Content: "Nice post", Author: "Viagra Shop"},
+			expected: datastore.CommentStateRejected,
+			reason:   `contains blocked word "viagra"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, err := words.Classify(context.Background(), &tt.comment)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, verdict.State)
+			assert.Equal(t, tt.reason, verdict.Reason)
+		})
+	}
+}
+
+func TestLoadBlockedWordsMissing(t *testing.T) {
+	_, err := filter.LoadBlockedWords(filepath.Join(t.TempDir(), "missing.txt"))
+	require.Error(t, err)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -0,0 +1,183 @@
+// Package gateway adapts the HTTP/REST gateway to the conventions of HTTP
+package gateway
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"math"
+	"net/http"
+	"net/textproto"
+	"strconv"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
+)
+
+// ServeMuxOptions returns the options to create the gateway mux with
+func ServeMuxOptions() []runtime.ServeMuxOption {
+	return []runtime.ServeMuxOption{
+		runtime.WithForwardResponseOption(SetETag),
+		// Redirects write the status line, so they go after every header
+		runtime.WithForwardResponseOption(RedirectSlug),
+		runtime.WithErrorHandler(ErrorHandler),
+		runtime.WithIncomingHeaderMatcher(IncomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(OutgoingHeaderMatcher),
+	}
+}
+
+// keyKey is the metadata key the gateway sends its key under, which tells the
+// gRPC server that a call comes through the gateway
+const keyKey = "x-gateway-key"
+
+// NewKey returns a random key for the gateway to send with its calls. The
+// gRPC server only trusts the client addresses forwarded on calls carrying
+// it, so it must be kept from everybody else.
+func NewKey() (string, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(key), nil
+}
+
+// SendKey returns a unary client interceptor that sends key with every call
+// the gateway makes, in the x-gateway-key metadata
+func SendKey(key string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(metadata.AppendToOutgoingContext(ctx, keyKey, key), method, req, reply, cc, opts...)
+	}
+}
+
+// IncomingHeaderMatcher forwards the Authorization, X-Api-Key, X-Tenant and
+// X-Request-Id headers to the gRPC server as authorization, x-api-key,
+// x-tenant and x-request-id metadata, where gRPC clients send bearer tokens,
+// API keys, tenants and request IDs too. Clients may not send the key of the
+// gateway as Grpc-Metadata-X-Gateway-Key. Other headers are forwarded like
+// runtime.DefaultHeaderMatcher does.
+func IncomingHeaderMatcher(key string) (string, bool) {
+	switch textproto.CanonicalMIMEHeaderKey(key) {
+	case runtime.MetadataHeaderPrefix + "X-Gateway-Key":
+		return "", false
+	case "Authorization":
+		return "authorization", true
+	case "X-Api-Key":
+		return "x-api-key", true
+	case tenantHeader:
+		return "x-tenant", true
+	case "X-Request-Id":
+		return "x-request-id", true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// OutgoingHeaderMatcher sends the x-ratelimit-limit, x-ratelimit-remaining,
+// x-ratelimit-reset and x-request-id headers of the gRPC server as the
+// X-RateLimit-Limit, X-RateLimit-Remaining, X-RateLimit-Reset and
+// X-Request-Id headers. Other headers are sent with the Grpc-Metadata- prefix
+// like the default matcher does.
+func OutgoingHeaderMatcher(key string) (string, bool) {
+	switch key {
+	case "x-ratelimit-limit":
+		return "X-RateLimit-Limit", true
+	case "x-ratelimit-remaining":
+		return "X-RateLimit-Remaining", true
+	case "x-ratelimit-reset":
+		return "X-RateLimit-Reset", true
+	case "x-request-id":
+		return "X-Request-Id", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// SetETag sets the ETag header of responses carrying a blog to the blog's etag
+func SetETag(ctx context.Context, w http.ResponseWriter, resp proto.Message) error {
+	withBlog, ok := resp.(interface{ GetBlog() *blogpb.Blog })
+	if !ok {
+		return nil
+	}
+	if etag := withBlog.GetBlog().GetEtag(); etag != "" {
+		w.Header().Set("ETag", `"`+etag+`"`)
+	}
+	return nil
+}
+
+// slugPath is the path of blogs looked up by slug
+const slugPath = "/v1/posts/by-slug/"
+
+// RedirectSlug answers lookups of a blog by a previous slug with 301 Moved
+// Permanently to its current slug. The blog is still sent in the body.
+func RedirectSlug(ctx context.Context, w http.ResponseWriter, resp proto.Message) error {
+	bySlug, ok := resp.(*blogpb.GetBySlugResp)
+	if !ok || bySlug.GetRedirectSlug() == "" {
+		return nil
+	}
+	w.Header().Set("Location", slugPath+bySlug.GetRedirectSlug())
+	w.WriteHeader(http.StatusMovedPermanently)
+	return nil
+}
+
+// ErrorHandler writes errors like runtime.DefaultHTTPErrorHandler, except
+// that a request whose If-Match header no longer matches the resource fails
+// with 412 Precondition Failed rather than 409 Conflict, that 401
+// Unauthorized responses ask for a bearer token in WWW-Authenticate, and that
+// 429 Too Many Requests responses tell when to retry in Retry-After
+func ErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if r.Header.Get("If-Match") != "" && status.Code(err) == codes.Aborted {
+		w = &statusWriter{ResponseWriter: w, status: http.StatusPreconditionFailed}
+	}
+	if status.Code(err) == codes.Unauthenticated {
+		// The default handler sets the header to the error message
+		w = &challengeWriter{ResponseWriter: w, challenge: "Bearer"}
+	}
+	if retryAfter, ok := retryDelay(err); ok {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
+
+// retryDelay returns the delay of the RetryInfo detail of a ResourceExhausted
+// error
+func retryDelay(err error) (time.Duration, bool) {
+	st := status.Convert(err)
+	if st.Code() != codes.ResourceExhausted {
+		return 0, false
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok && info.GetRetryDelay() != nil {
+			return info.GetRetryDelay().AsDuration(), true
+		}
+	}
+	return 0, false
+}
+
+// statusWriter replaces the status code written to a response
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader writes the replacement status code
+func (w *statusWriter) WriteHeader(int) {
+	w.ResponseWriter.WriteHeader(w.status)
+}
+
+// challengeWriter replaces the WWW-Authenticate header of a response
+type challengeWriter struct {
+	http.ResponseWriter
+	challenge string
+}
+
+// WriteHeader writes the status code with the replacement challenge
+func (w *challengeWriter) WriteHeader(code int) {
+	w.Header().Set("WWW-Authenticate", w.challenge)
+	w.ResponseWriter.WriteHeader(code)
+}
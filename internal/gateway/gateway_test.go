@@ -0,0 +1,259 @@
+package gateway_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/agruetz/prosigliere/internal/gateway"
+	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
+)
+
+func TestSetETag(t *testing.T) {
+	tests := []struct {
+		name         string
+		resp         proto.Message
+		expectedETag string
+	}{
+		{
+			name:         "blog with etag",
+			resp:         &blogpb.GetResp{Blog: &blogpb.Blog{Etag: "3"}},
+			expectedETag: `"3"`,
+		},
+		{
+			name:         "blog without etag",
+			resp:         &blogpb.GetResp{Blog: &blogpb.Blog{}},
+			expectedETag: "",
+		},
+		{
+			name:         "response without blog",
+			resp:         &emptypb.Empty{},
+			expectedETag: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			require.NoError(t, gateway.SetETag(context.Background(), w, tt.resp))
+			assert.Equal(t, tt.expectedETag, w.Header().Get("ETag"))
+		})
+	}
+}
+
+func TestRedirectSlug(t *testing.T) {
+	tests := []struct {
+		name             string
+		resp             proto.Message
+		expectedCode     int
+		expectedLocation string
+	}{
+		{
+			name:             "previous slug",
+			resp:             &blogpb.GetBySlugResp{Blog: &blogpb.Blog{}, RedirectSlug: "new-slug"},
+			expectedCode:     http.StatusMovedPermanently,
+			expectedLocation: "/v1/posts/by-slug/new-slug",
+		},
+		{
+			name:             "current slug",
+			resp:             &blogpb.GetBySlugResp{Blog: &blogpb.Blog{Slug: "new-slug"}},
+			expectedCode:     http.StatusOK,
+			expectedLocation: "",
+		},
+		{
+			name:             "other response",
+			resp:             &blogpb.GetResp{Blog: &blogpb.Blog{Slug: "new-slug"}},
+			expectedCode:     http.StatusOK,
+			expectedLocation: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			require.NoError(t, gateway.RedirectSlug(context.Background(), w, tt.resp))
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.expectedLocation, w.Header().Get("Location"))
+		})
+	}
+}
+
+func TestErrorHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		ifMatch        string
+		err            error
+		expectedStatus int
+	}{
+		{
+			name:           "stale If-Match",
+			ifMatch:        `"3"`,
+			err:            status.Error(codes.Aborted, "blog version mismatch"),
+			expectedStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:           "aborted without If-Match",
+			err:            status.Error(codes.Aborted, "blog version mismatch"),
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "other error with If-Match",
+			ifMatch:        `"3"`,
+			err:            status.Error(codes.NotFound, "blog not found"),
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "unauthenticated",
+			err:            status.Error(codes.Unauthenticated, "authentication required"),
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	mux := runtime.NewServeMux()
+	marshaler := &runtime.JSONPb{}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPatch, "/v1/posts/123e4567-e89b-12d3-a456-426614174000", nil)
+			if tt.ifMatch != "" {
+				r.Header.Set("If-Match", tt.ifMatch)
+			}
+			w := httptest.NewRecorder()
+
+			gateway.ErrorHandler(context.Background(), mux, marshaler, w, r, tt.err)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), status.Convert(tt.err).Message())
+			if tt.expectedStatus == http.StatusUnauthorized {
+				assert.Equal(t, "Bearer", w.Header().Get("WWW-Authenticate"))
+			} else {
+				assert.Empty(t, w.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
+
+func TestErrorHandlerRetryAfter(t *testing.T) {
+	exhausted, err := status.New(codes.ResourceExhausted, "rate limit exceeded for /blog.v1.Blogs/AddComment").
+		WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(1500 * time.Millisecond)})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		err                error
+		expectedRetryAfter string
+	}{
+		{
+			name:               "rate limited",
+			err:                exhausted.Err(),
+			expectedRetryAfter: "2",
+		},
+		{
+			name:               "exhausted without retry info",
+			err:                status.Error(codes.ResourceExhausted, "too large"),
+			expectedRetryAfter: "",
+		},
+		{
+			name:               "other error",
+			err:                status.Error(codes.NotFound, "blog not found"),
+			expectedRetryAfter: "",
+		},
+	}
+
+	mux := runtime.NewServeMux()
+	marshaler := &runtime.JSONPb{}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/v1/posts/123e4567-e89b-12d3-a456-426614174000/comments", nil)
+			w := httptest.NewRecorder()
+
+			gateway.ErrorHandler(context.Background(), mux, marshaler, w, r, tt.err)
+
+			assert.Equal(t, tt.expectedRetryAfter, w.Header().Get("Retry-After"))
+			if tt.expectedRetryAfter != "" {
+				assert.Equal(t, http.StatusTooManyRequests, w.Code)
+			}
+		})
+	}
+}
+
+func TestOutgoingHeaderMatcher(t *testing.T) {
+	tests := []struct {
+		header   string
+		expected string
+	}{
+		{header: "x-ratelimit-limit", expected: "X-RateLimit-Limit"},
+		{header: "x-ratelimit-remaining", expected: "X-RateLimit-Remaining"},
+		{header: "x-ratelimit-reset", expected: "X-RateLimit-Reset"},
+		{header: "x-request-id", expected: "X-Request-Id"},
+		{header: "x-custom", expected: runtime.MetadataHeaderPrefix + "x-custom"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			key, ok := gateway.OutgoingHeaderMatcher(tt.header)
+			assert.True(t, ok)
+			assert.Equal(t, tt.expected, key)
+		})
+	}
+}
+
+func TestSendKey(t *testing.T) {
+	key, err := gateway.NewKey()
+	require.NoError(t, err)
+	assert.Len(t, key, 64)
+	other, err := gateway.NewKey()
+	require.NoError(t, err)
+	assert.NotEqual(t, key, other)
+
+	var sent []string
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		sent = md.Get("x-gateway-key")
+		return nil
+	}
+	err = gateway.SendKey(key)(context.Background(), "/blog.v1.Blogs/Get", nil, nil, nil, invoker)
+	require.NoError(t, err)
+	assert.Equal(t, []string{key}, sent)
+}
+
+func TestIncomingHeaderMatcher(t *testing.T) {
+	tests := []struct {
+		header   string
+		expected string
+		ok       bool
+	}{
+		{header: "Authorization", expected: "authorization", ok: true},
+		{header: "authorization", expected: "authorization", ok: true},
+		{header: "X-API-Key", expected: "x-api-key", ok: true},
+		{header: "x-tenant", expected: "x-tenant", ok: true},
+		{header: "X-Request-Id", expected: "x-request-id", ok: true},
+		{header: "Grpc-Metadata-X-Gateway-Key", expected: "", ok: false},
+		{header: "grpc-metadata-x-gateway-key", expected: "", ok: false},
+		{header: "If-Match", expected: runtime.MetadataPrefix + "If-Match", ok: true},
+		{header: "X-Custom", expected: "", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			key, ok := gateway.IncomingHeaderMatcher(tt.header)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, key)
+		})
+	}
+}
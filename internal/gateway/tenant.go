@@ -0,0 +1,99 @@
+package gateway
+
+import (
+	"net"
+	"net/http"
+	"strings"
+)
+
+// tenantHeader is the header naming the tenant of a request, which the gRPC
+// server receives as x-tenant metadata
+const tenantHeader = "X-Tenant"
+
+// tenantPathPrefix starts the paths of requests naming their tenant by path
+const tenantPathPrefix = "/t/"
+
+// Tenant returns a handler resolving the tenant of requests before passing
+// them to next. Requests name their tenant by the X-Tenant header, by a
+// subdomain of domain such as acme.blogs.example for the domain
+// blogs.example, or by a /t/{tenant} prefix of their path, which is
+// stripped. Subdomains are not resolved if domain is empty. The tenant is
+// passed on in the X-Tenant header, and requests naming none are left to the
+// default tenant. Requests naming different tenants in different ways fail
+// with 400 Bad Request. Redirects answering requests that named their tenant
+// by path keep the prefix in their Location.
+func Tenant(next http.Handler, domain string) http.Handler {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		slug := strings.TrimSpace(r.Header.Get(tenantHeader))
+		for _, named := range []string{subdomain(r.Host, domain), pathTenant(r.URL.Path)} {
+			if named == "" {
+				continue
+			}
+			if slug != "" && slug != named {
+				http.Error(w, "request names more than one tenant", http.StatusBadRequest)
+				return
+			}
+			slug = named
+		}
+		if slug == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		r = r.Clone(r.Context())
+		r.Header.Set(tenantHeader, slug)
+		if prefix := tenantPathPrefix + slug; pathTenant(r.URL.Path) == slug {
+			r.URL.Path = strings.TrimPrefix(r.URL.Path, prefix)
+			r.URL.RawPath = strings.TrimPrefix(r.URL.RawPath, prefix)
+			if r.URL.Path == "" {
+				r.URL.Path = "/"
+			}
+			w = &locationWriter{ResponseWriter: w, prefix: prefix}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// subdomain returns the tenant named by the subdomain of domain a request was
+// made to, or an empty string if it was not made to one
+func subdomain(host, domain string) string {
+	if domain == "" {
+		return ""
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	sub, _ := strings.CutSuffix(host, "."+domain)
+	if sub == host {
+		return ""
+	}
+	return sub
+}
+
+// pathTenant returns the tenant named by the prefix of a path, or an empty
+// string if it has none
+func pathTenant(path string) string {
+	rest, ok := strings.CutPrefix(path, tenantPathPrefix)
+	if !ok {
+		return ""
+	}
+	slug, _, _ := strings.Cut(rest, "/")
+	return slug
+}
+
+// locationWriter prefixes the paths of the Location header of a response
+type locationWriter struct {
+	http.ResponseWriter
+	prefix string
+}
+
+// WriteHeader writes the status code with the prefixed Location
+func (w *locationWriter) WriteHeader(code int) {
+	if location := w.Header().Get("Location"); strings.HasPrefix(location, "/") {
+		w.Header().Set("Location", w.prefix+location)
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
@@ -0,0 +1,134 @@
+package gateway_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/agruetz/prosigliere/internal/gateway"
+)
+
+func TestTenant(t *testing.T) {
+	tests := []struct {
+		name           string
+		host           string
+		path           string
+		header         string
+		expectedCode   int
+		expectedTenant string
+		expectedPath   string
+	}{
+		{
+			name:         "no tenant",
+			host:         "blogs.example",
+			path:         "/v1/posts",
+			expectedCode: http.StatusOK,
+			expectedPath: "/v1/posts",
+		},
+		{
+			name:           "header",
+			host:           "blogs.example",
+			path:           "/v1/posts",
+			header:         "acme",
+			expectedCode:   http.StatusOK,
+			expectedTenant: "acme",
+			expectedPath:   "/v1/posts",
+		},
+		{
+			name:           "subdomain",
+			host:           "Acme.Blogs.Example:8080",
+			path:           "/v1/posts",
+			expectedCode:   http.StatusOK,
+			expectedTenant: "acme",
+			expectedPath:   "/v1/posts",
+		},
+		{
+			name:         "other domain",
+			host:         "acme.other.example",
+			path:         "/v1/posts",
+			expectedCode: http.StatusOK,
+			expectedPath: "/v1/posts",
+		},
+		{
+			name:           "path prefix",
+			host:           "blogs.example",
+			path:           "/t/acme/v1/posts",
+			expectedCode:   http.StatusOK,
+			expectedTenant: "acme",
+			expectedPath:   "/v1/posts",
+		},
+		{
+			name:           "same tenant named twice",
+			host:           "acme.blogs.example",
+			path:           "/t/acme/v1/posts",
+			header:         "acme",
+			expectedCode:   http.StatusOK,
+			expectedTenant: "acme",
+			expectedPath:   "/v1/posts",
+		},
+		{
+			name:         "conflicting tenants",
+			host:         "acme.blogs.example",
+			path:         "/t/other/v1/posts",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "conflicting header",
+			host:         "blogs.example",
+			path:         "/t/acme/v1/posts",
+			header:       "other",
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tenant, path string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				tenant = r.Header.Get("X-Tenant")
+				path = r.URL.Path
+			})
+
+			r := httptest.NewRequest(http.MethodGet, "http://"+tt.host+tt.path, nil)
+			if tt.header != "" {
+				r.Header.Set("X-Tenant", tt.header)
+			}
+			w := httptest.NewRecorder()
+			gateway.Tenant(next, "blogs.example").ServeHTTP(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.expectedTenant, tenant)
+			assert.Equal(t, tt.expectedPath, path)
+		})
+	}
+}
+
+func TestTenantWithoutDomain(t *testing.T) {
+	var tenant string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenant = r.Header.Get("X-Tenant")
+	})
+
+	r := httptest.NewRequest(http.MethodGet, "http://acme.blogs.example/v1/posts", nil)
+	gateway.Tenant(next, "").ServeHTTP(httptest.NewRecorder(), r)
+	assert.Empty(t, tenant)
+}
+
+func TestTenantRedirect(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Location", "/v1/posts/by-slug/new-slug")
+		w.WriteHeader(http.StatusMovedPermanently)
+	})
+	handler := gateway.Tenant(next, "blogs.example")
+
+	// Redirects keep the path prefix naming the tenant
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://blogs.example/t/acme/v1/posts/by-slug/old-slug", nil))
+	assert.Equal(t, "/t/acme/v1/posts/by-slug/new-slug", w.Header().Get("Location"))
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://acme.blogs.example/v1/posts/by-slug/old-slug", nil))
+	assert.Equal(t, "/v1/posts/by-slug/new-slug", w.Header().Get("Location"))
+}
@@ -0,0 +1,55 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/agruetz/prosigliere/internal/auth"
+	"github.com/agruetz/prosigliere/internal/datastore"
+)
+
+// requestIDKey is the metadata key of the ID of a request, which the HTTP
+// gateway forwards from and back to the X-Request-Id header
+const requestIDKey = "x-request-id"
+
+// maxRequestIDLen is the length of the longest request ID taken from a
+// caller. Longer ones are replaced, as they would not fit in the audit log.
+const maxRequestIDLen = 128
+
+// Audit returns a unary server interceptor that puts the audit information
+// of a call into the context, which stores record with every change the
+// call makes. The actor is the subject of the principal put into the
+// context by Auth, or empty for anonymous callers, and the client IP the one
+// put into it by ClientIP. Calls keep the request ID given in the
+// x-request-id metadata, and get a new one if they have none, which is sent
+// back in the x-request-id header either way.
+func Audit() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		audit := datastore.AuditInfo{
+			Method:    info.FullMethod,
+			RequestID: requestID(ctx),
+			ClientIP:  clientIP(ctx),
+		}
+		if principal, ok := auth.FromContext(ctx); ok {
+			audit.Actor = principal.Subject
+		}
+
+		// Headers are only informative, so failing to set them is ignored
+		_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDKey, audit.RequestID))
+		return handler(datastore.NewAuditContext(ctx, audit), req)
+	}
+}
+
+// requestID returns the ID of the request a call is made for, generating
+// one if the caller gave none that fits
+func requestID(ctx context.Context) string {
+	if values := metadata.ValueFromIncomingContext(ctx, requestIDKey); len(values) > 0 {
+		if id := values[0]; id != "" && len(id) <= maxRequestIDLen {
+			return id
+		}
+	}
+	return uuid.New().String()
+}
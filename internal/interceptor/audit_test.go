@@ -0,0 +1,78 @@
+package interceptor
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/agruetz/prosigliere/internal/auth"
+	"github.com/agruetz/prosigliere/internal/datastore"
+)
+
+func TestAudit(t *testing.T) {
+	remote := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 50000}})
+	alice := auth.NewContext(remote, &auth.Principal{Subject: "alice"})
+
+	tests := []struct {
+		name              string
+		ctx               context.Context
+		expectedActor     string
+		expectedRequestID string // empty for a generated one
+	}{
+		{
+			name:          "principal",
+			ctx:           alice,
+			expectedActor: "alice",
+		},
+		{
+			name: "anonymous",
+			ctx:  remote,
+		},
+		{
+			name:              "request ID of the caller",
+			ctx:               metadata.NewIncomingContext(alice, metadata.Pairs(requestIDKey, "req-1")),
+			expectedActor:     "alice",
+			expectedRequestID: "req-1",
+		},
+		{
+			name:          "request ID too long",
+			ctx:           metadata.NewIncomingContext(alice, metadata.Pairs(requestIDKey, strings.Repeat("a", maxRequestIDLen+1))),
+			expectedActor: "alice",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := &headerStream{}
+			ctx := grpc.NewContextWithServerTransportStream(tt.ctx, stream)
+
+			var got datastore.AuditInfo
+			handler := func(ctx context.Context, req any) (any, error) {
+				got = datastore.AuditFromContext(ctx)
+				return req, nil
+			}
+
+			_, err := Audit()(ctx, "request", &grpc.UnaryServerInfo{FullMethod: "/blog.v1.BlogService/CreateBlog"}, handler)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedActor, got.Actor)
+			assert.Equal(t, "/blog.v1.BlogService/CreateBlog", got.Method)
+			assert.Equal(t, "203.0.113.7", got.ClientIP)
+			if tt.expectedRequestID != "" {
+				assert.Equal(t, tt.expectedRequestID, got.RequestID)
+			} else {
+				_, err := uuid.Parse(got.RequestID)
+				assert.NoError(t, err, "generated request IDs are UUIDs")
+			}
+			assert.Equal(t, []string{got.RequestID}, stream.header.Get(requestIDKey))
+		})
+	}
+}
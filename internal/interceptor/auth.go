@@ -0,0 +1,83 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/agruetz/prosigliere/internal/auth"
+)
+
+// authorizationKey is the metadata key of bearer tokens, which the HTTP
+// gateway forwards the Authorization header under as well
+const authorizationKey = "authorization"
+
+// apiKeyKey is the metadata key of API keys, which the HTTP gateway forwards
+// the X-Api-Key header under as well
+const apiKeyKey = "x-api-key"
+
+// Auth returns a unary server interceptor that authenticates callers by the
+// bearer token in the authorization metadata or the API key in the x-api-key
+// metadata, and puts the principal it identifies into the context. Either
+// verifier may be nil to not accept its credentials. Requests without
+// credentials are rejected with Unauthenticated unless anonymous reports that
+// their method may be called anonymously. Credentials that fail to verify
+// are rejected even then, as are principals of another tenant than the one
+// put into the context by Tenant.
+func Auth(bearer, apiKeys auth.Verifier, anonymous func(fullMethod string) bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		tokens := metadata.ValueFromIncomingContext(ctx, authorizationKey)
+		keys := metadata.ValueFromIncomingContext(ctx, apiKeyKey)
+
+		var verifier auth.Verifier
+		var kind, credential string
+		switch {
+		case len(tokens) > 0 && len(keys) > 0:
+			return nil, status.Error(codes.Unauthenticated, "only one of a bearer token and an API key may be sent")
+		case len(tokens) > 0:
+			token, ok := bearerToken(tokens[0])
+			if !ok {
+				return nil, status.Error(codes.Unauthenticated, "authorization must be a bearer token")
+			}
+			verifier, kind, credential = bearer, "bearer tokens", token
+		case len(keys) > 0:
+			verifier, kind, credential = apiKeys, "API keys", strings.TrimSpace(keys[0])
+		default:
+			if anonymous(info.FullMethod) {
+				return handler(ctx, req)
+			}
+			return nil, status.Error(codes.Unauthenticated, "authentication required")
+		}
+
+		if verifier == nil {
+			return nil, status.Errorf(codes.Unauthenticated, "%s are not accepted", kind)
+		}
+		principal, err := verifier.Verify(ctx, credential)
+		if errors.Is(err, auth.ErrInvalidToken) {
+			return nil, status.Errorf(codes.Unauthenticated, "failed to authenticate: %v", err)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to authenticate: %v", err)
+		}
+		if principal.TenantSlug() != tenantSlug(ctx) {
+			return nil, status.Error(codes.PermissionDenied, "credentials belong to another tenant")
+		}
+
+		return handler(auth.NewContext(ctx, principal), req)
+	}
+}
+
+// bearerToken returns the token of a Bearer authorization value
+func bearerToken(value string) (string, bool) {
+	scheme, token, ok := strings.Cut(strings.TrimSpace(value), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
@@ -0,0 +1,215 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/agruetz/prosigliere/internal/auth"
+	"github.com/agruetz/prosigliere/internal/datastore"
+)
+
+// tokenVerifier accepts the token "valid" as alice
+type tokenVerifier struct{}
+
+func (tokenVerifier) Verify(ctx context.Context, token string) (*auth.Principal, error) {
+	if token != "valid" {
+		return nil, fmt.Errorf("%w: %v", auth.ErrInvalidToken, errors.New("signature is invalid"))
+	}
+	return &auth.Principal{Subject: "alice"}, nil
+}
+
+// keyVerifier accepts the API key "psk_valid" as an ingestion job and fails
+// to look up the key "psk_broken"
+type keyVerifier struct{}
+
+func (keyVerifier) Verify(ctx context.Context, key string) (*auth.Principal, error) {
+	switch key {
+	case "psk_valid":
+		return &auth.Principal{Subject: "apikey:ingest", Roles: []string{"author"}}, nil
+	case "psk_broken":
+		return nil, errors.New("failed to look up API key: connection refused")
+	default:
+		return nil, fmt.Errorf("%w: %v", auth.ErrInvalidToken, errors.New("unknown API key"))
+	}
+}
+
+func TestAuth(t *testing.T) {
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		apiKey        string
+		expectedCode  codes.Code
+		expectedMsg   string
+		expected      *auth.Principal
+	}{
+		{
+			name:          "bearer token",
+			method:        "/blog.v1.Blogs/Delete",
+			authorization: "Bearer valid",
+			expectedCode:  codes.OK,
+			expected:      &auth.Principal{Subject: "alice"},
+		},
+		{
+			name:          "scheme is case insensitive",
+			method:        "/blog.v1.Blogs/Delete",
+			authorization: "bearer  valid",
+			expectedCode:  codes.OK,
+			expected:      &auth.Principal{Subject: "alice"},
+		},
+		{
+			name:          "token on anonymous method",
+			method:        "/blog.v1.Blogs/Get",
+			authorization: "Bearer valid",
+			expectedCode:  codes.OK,
+			expected:      &auth.Principal{Subject: "alice"},
+		},
+		{
+			name:         "anonymous method",
+			method:       "/blog.v1.Blogs/Get",
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "missing token",
+			method:       "/blog.v1.Blogs/Delete",
+			expectedCode: codes.Unauthenticated,
+			expectedMsg:  "authentication required",
+		},
+		{
+			name:          "invalid token",
+			method:        "/blog.v1.Blogs/Delete",
+			authorization: "Bearer forged",
+			expectedCode:  codes.Unauthenticated,
+			expectedMsg:   "failed to authenticate: invalid token: signature is invalid",
+		},
+		{
+			name:          "invalid token on anonymous method",
+			method:        "/blog.v1.Blogs/Get",
+			authorization: "Bearer forged",
+			expectedCode:  codes.Unauthenticated,
+			expectedMsg:   "failed to authenticate: invalid token: signature is invalid",
+		},
+		{
+			name:          "basic credentials",
+			method:        "/blog.v1.Blogs/Delete",
+			authorization: "Basic YWxpY2U6c2VjcmV0",
+			expectedCode:  codes.Unauthenticated,
+			expectedMsg:   "authorization must be a bearer token",
+		},
+		{
+			name:          "empty bearer token",
+			method:        "/blog.v1.Blogs/Delete",
+			authorization: "Bearer ",
+			expectedCode:  codes.Unauthenticated,
+			expectedMsg:   "authorization must be a bearer token",
+		},
+		{
+			name:         "API key",
+			method:       "/blog.v1.Blogs/Create",
+			apiKey:       "psk_valid",
+			expectedCode: codes.OK,
+			expected:     &auth.Principal{Subject: "apikey:ingest", Roles: []string{"author"}},
+		},
+		{
+			name:         "invalid API key",
+			method:       "/blog.v1.Blogs/Get",
+			apiKey:       "psk_forged",
+			expectedCode: codes.Unauthenticated,
+			expectedMsg:  "failed to authenticate: invalid token: unknown API key",
+		},
+		{
+			name:         "API key lookup failure",
+			method:       "/blog.v1.Blogs/Create",
+			apiKey:       "psk_broken",
+			expectedCode: codes.Internal,
+			expectedMsg:  "failed to authenticate: failed to look up API key: connection refused",
+		},
+		{
+			name:          "bearer token and API key",
+			method:        "/blog.v1.Blogs/Create",
+			authorization: "Bearer valid",
+			apiKey:        "psk_valid",
+			expectedCode:  codes.Unauthenticated,
+			expectedMsg:   "only one of a bearer token and an API key may be sent",
+		},
+	}
+
+	interceptor := Auth(tokenVerifier{}, keyVerifier{}, func(fullMethod string) bool {
+		return fullMethod == "/blog.v1.Blogs/Get"
+	})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := metadata.MD{}
+			if tt.authorization != "" {
+				md.Set(authorizationKey, tt.authorization)
+			}
+			if tt.apiKey != "" {
+				md.Set(apiKeyKey, tt.apiKey)
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+
+			called := false
+			var principal *auth.Principal
+			handler := func(ctx context.Context, req any) (any, error) {
+				called = true
+				principal, _ = auth.FromContext(ctx)
+				return req, nil
+			}
+
+			_, err := interceptor(ctx, "request", &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			if tt.expectedCode == codes.OK {
+				require.NoError(t, err)
+				assert.True(t, called)
+				assert.Equal(t, tt.expected, principal)
+				return
+			}
+			assert.False(t, called)
+			st := status.Convert(err)
+			assert.Equal(t, tt.expectedCode, st.Code())
+			assert.Equal(t, tt.expectedMsg, st.Message())
+		})
+	}
+}
+
+func TestAuthUnacceptedCredentials(t *testing.T) {
+	interceptor := Auth(nil, keyVerifier{}, func(fullMethod string) bool { return false })
+	handler := func(ctx context.Context, req any) (any, error) { return req, nil }
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationKey, "Bearer valid"))
+	_, err := interceptor(ctx, "request", &grpc.UnaryServerInfo{FullMethod: "/blog.v1.Blogs/Create"}, handler)
+	st := status.Convert(err)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+	assert.Equal(t, "bearer tokens are not accepted", st.Message())
+}
+
+func TestAuthTenant(t *testing.T) {
+	interceptor := Auth(tokenVerifier{}, nil, func(fullMethod string) bool { return false })
+	handler := func(ctx context.Context, req any) (any, error) { return req, nil }
+	info := &grpc.UnaryServerInfo{FullMethod: "/blog.v1.Blogs/Create"}
+	md := metadata.Pairs(authorizationKey, "Bearer valid")
+
+	// alice belongs to the default tenant
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	_, err := interceptor(ctx, "request", info, handler)
+	require.NoError(t, err)
+	ctx = datastore.NewTenantContext(ctx, &datastore.Tenant{ID: datastore.DefaultTenantID, Slug: datastore.DefaultTenantSlug})
+	_, err = interceptor(ctx, "request", info, handler)
+	require.NoError(t, err)
+
+	ctx = datastore.NewTenantContext(metadata.NewIncomingContext(context.Background(), md), &datastore.Tenant{ID: "acme-id", Slug: "acme"})
+	_, err = interceptor(ctx, "request", info, handler)
+	st := status.Convert(err)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+	assert.Equal(t, "credentials belong to another tenant", st.Message())
+}
@@ -0,0 +1,60 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/agruetz/prosigliere/internal/auth"
+	"github.com/agruetz/prosigliere/internal/authz"
+)
+
+// errorDomain is the domain of the ErrorInfo details of denials
+const errorDomain = "prosigliere"
+
+// Authorize returns a unary server interceptor that checks every call
+// against a policy, with the principal put into the context by Auth. Owners
+// looks up who owns what a call touches for rules restricted to owners.
+// Calls the policy does not allow are rejected with PermissionDenied, or
+// Unauthenticated if they have no principal, and an ErrorInfo detail giving
+// the reason.
+func Authorize(policy *authz.Policy, owners authz.Owners) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		principal, _ := auth.FromContext(ctx)
+		err := policy.Authorize(ctx, info.FullMethod, principal, func(ctx context.Context) (string, error) {
+			return owners.Owner(ctx, req)
+		})
+
+		var denial *authz.Denial
+		switch {
+		case err == nil:
+			return handler(ctx, req)
+		case errors.As(err, &denial):
+			return nil, denialStatus(denial)
+		default:
+			return nil, err
+		}
+	}
+}
+
+// denialStatus converts a denial to a status with its reason as ErrorInfo
+func denialStatus(denial *authz.Denial) error {
+	code := codes.PermissionDenied
+	if denial.Reason == authz.ReasonUnauthenticated {
+		code = codes.Unauthenticated
+	}
+
+	st, err := status.New(code, denial.Message).WithDetails(&errdetails.ErrorInfo{
+		Reason:   string(denial.Reason),
+		Domain:   errorDomain,
+		Metadata: map[string]string{"method": denial.Method},
+	})
+	if err != nil {
+		return status.Error(code, denial.Message)
+	}
+	return st.Err()
+}
@@ -0,0 +1,143 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/agruetz/prosigliere/internal/auth"
+	"github.com/agruetz/prosigliere/internal/authz"
+)
+
+// requestOwners reports the request itself as the owner, or fails with the
+// status Unavailable for the request "unavailable"
+type requestOwners struct{}
+
+func (requestOwners) Owner(ctx context.Context, req any) (string, error) {
+	if req == "unavailable" {
+		return "", status.Error(codes.Unavailable, "failed to look up blog owner")
+	}
+	return req.(string), nil
+}
+
+func TestAuthorize(t *testing.T) {
+	policy, err := authz.New(
+		authz.Rule{Methods: []string{"/blog.v1.Blogs/Get"}, Anyone: true},
+		authz.Rule{Methods: []string{"/blog.v1.Blogs/Update"}, Roles: []string{"editor"}},
+		authz.Rule{Methods: []string{"/blog.v1.Blogs/Update"}, Roles: []string{"author"}, Owner: true},
+	)
+	require.NoError(t, err)
+
+	alice := &auth.Principal{Subject: "alice", Roles: []string{"author"}}
+
+	tests := []struct {
+		name         string
+		method       string
+		principal    *auth.Principal
+		req          string
+		expectedCode codes.Code
+		expectedMsg  string
+		reason       string
+	}{
+		{
+			name:         "anonymous read",
+			method:       "/blog.v1.Blogs/Get",
+			req:          "",
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "owner",
+			method:       "/blog.v1.Blogs/Update",
+			principal:    alice,
+			req:          "alice",
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "not owner",
+			method:       "/blog.v1.Blogs/Update",
+			principal:    alice,
+			req:          "bob",
+			expectedCode: codes.PermissionDenied,
+			expectedMsg:  "/blog.v1.Blogs/Update is only allowed on resources owned by the caller",
+			reason:       "NOT_OWNER",
+		},
+		{
+			name:         "missing role",
+			method:       "/blog.v1.Blogs/Update",
+			principal:    &auth.Principal{Subject: "carol", Roles: []string{"commenter"}},
+			req:          "carol",
+			expectedCode: codes.PermissionDenied,
+			expectedMsg:  "/blog.v1.Blogs/Update requires one of the roles author, editor",
+			reason:       "MISSING_ROLE",
+		},
+		{
+			name:         "no rule",
+			method:       "/blog.v1.Admin/PurgeBlog",
+			principal:    alice,
+			expectedCode: codes.PermissionDenied,
+			expectedMsg:  "no rule allows calling /blog.v1.Admin/PurgeBlog",
+			reason:       "NO_RULE",
+		},
+		{
+			name:         "anonymous",
+			method:       "/blog.v1.Blogs/Update",
+			req:          "alice",
+			expectedCode: codes.Unauthenticated,
+			expectedMsg:  "/blog.v1.Blogs/Update requires authentication",
+			reason:       "UNAUTHENTICATED",
+		},
+		{
+			name:         "owner lookup failure",
+			method:       "/blog.v1.Blogs/Update",
+			principal:    alice,
+			req:          "unavailable",
+			expectedCode: codes.Unavailable,
+			expectedMsg:  "failed to look up blog owner",
+		},
+	}
+
+	interceptor := Authorize(policy, requestOwners{})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.principal != nil {
+				ctx = auth.NewContext(ctx, tt.principal)
+			}
+
+			called := false
+			handler := func(ctx context.Context, req any) (any, error) {
+				called = true
+				return req, nil
+			}
+
+			_, err := interceptor(ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			if tt.expectedCode == codes.OK {
+				require.NoError(t, err)
+				assert.True(t, called)
+				return
+			}
+			assert.False(t, called)
+			st := status.Convert(err)
+			assert.Equal(t, tt.expectedCode, st.Code())
+			assert.Equal(t, tt.expectedMsg, st.Message())
+			if tt.reason == "" {
+				assert.Empty(t, st.Details())
+				return
+			}
+			require.Len(t, st.Details(), 1)
+			info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+			require.True(t, ok)
+			assert.Equal(t, tt.reason, info.GetReason())
+			assert.Equal(t, "prosigliere", info.GetDomain())
+			assert.Equal(t, tt.method, info.GetMetadata()["method"])
+		})
+	}
+}
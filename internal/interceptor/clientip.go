@@ -0,0 +1,74 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/subtle"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// forwardedForKey is the metadata key the HTTP gateway forwards the address
+// of its clients under
+const forwardedForKey = "x-forwarded-for"
+
+// gatewayKeyKey is the metadata key the HTTP gateway sends its key under
+const gatewayKeyKey = "x-gateway-key"
+
+// clientIPContextKey is the context key of the IP address of the caller
+type clientIPContextKey struct{}
+
+// ClientIP returns a unary server interceptor that puts the IP address of the
+// caller into the context, which RateLimit and Audit tell anonymous callers
+// apart by. Calls carrying gatewayKey in the x-gateway-key metadata come from
+// the HTTP gateway, and are made on behalf of the last address in
+// x-forwarded-for, which the gateway adds. Every other call is made from its
+// peer address, whatever it forwards, and so is every call if gatewayKey is
+// empty.
+func ClientIP(gatewayKey string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ip := peerIP(ctx)
+		if gatewayKey != "" && fromGateway(ctx, gatewayKey) {
+			if forwarded := metadata.ValueFromIncomingContext(ctx, forwardedForKey); len(forwarded) > 0 {
+				addrs := strings.Split(forwarded[len(forwarded)-1], ",")
+				ip = strings.TrimSpace(addrs[len(addrs)-1])
+			}
+		}
+		return handler(context.WithValue(ctx, clientIPContextKey{}, ip), req)
+	}
+}
+
+// fromGateway reports whether a call carries the key of the HTTP gateway
+func fromGateway(ctx context.Context, gatewayKey string) bool {
+	for _, key := range metadata.ValueFromIncomingContext(ctx, gatewayKeyKey) {
+		if subtle.ConstantTimeCompare([]byte(key), []byte(gatewayKey)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP returns the IP address of the caller put into the context by
+// ClientIP, or its peer address without it
+func clientIP(ctx context.Context) string {
+	if ip, ok := ctx.Value(clientIPContextKey{}).(string); ok {
+		return ip
+	}
+	return peerIP(ctx)
+}
+
+// peerIP returns the IP address of the peer of a call
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	ip := p.Addr.String()
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+	return ip
+}
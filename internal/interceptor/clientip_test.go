@@ -0,0 +1,84 @@
+package interceptor
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestClientIP(t *testing.T) {
+	remote := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 50000}})
+	loopback := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50001}})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		gatewayKey string
+		expected   string
+	}{
+		{
+			name:       "peer address",
+			ctx:        remote,
+			gatewayKey: "secret",
+			expected:   "203.0.113.7",
+		},
+		{
+			name:       "through the gateway",
+			ctx:        metadata.NewIncomingContext(loopback, metadata.Pairs(gatewayKeyKey, "secret", forwardedForKey, "10.0.0.1, 198.51.100.9")),
+			gatewayKey: "secret",
+			expected:   "198.51.100.9",
+		},
+		{
+			name:       "through the gateway without forwarded address",
+			ctx:        metadata.NewIncomingContext(loopback, metadata.Pairs(gatewayKeyKey, "secret")),
+			gatewayKey: "secret",
+			expected:   "127.0.0.1",
+		},
+		{
+			name:       "forwarded address from loopback without the key",
+			ctx:        metadata.NewIncomingContext(loopback, metadata.Pairs(forwardedForKey, "198.51.100.9")),
+			gatewayKey: "secret",
+			expected:   "127.0.0.1",
+		},
+		{
+			name:       "forwarded address with the wrong key",
+			ctx:        metadata.NewIncomingContext(remote, metadata.Pairs(gatewayKeyKey, "guess", forwardedForKey, "198.51.100.9")),
+			gatewayKey: "secret",
+			expected:   "203.0.113.7",
+		},
+		{
+			name:     "forwarded address without a gateway",
+			ctx:      metadata.NewIncomingContext(loopback, metadata.Pairs(gatewayKeyKey, "", forwardedForKey, "198.51.100.9")),
+			expected: "127.0.0.1",
+		},
+		{
+			name:       "unknown peer",
+			ctx:        metadata.NewIncomingContext(context.Background(), metadata.Pairs(forwardedForKey, "198.51.100.9")),
+			gatewayKey: "secret",
+			expected:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			handler := func(ctx context.Context, req any) (any, error) {
+				got = clientIP(ctx)
+				return req, nil
+			}
+
+			_, err := ClientIP(tt.gatewayKey)(tt.ctx, "request", &grpc.UnaryServerInfo{FullMethod: "/blog.v1.Blogs/Get"}, handler)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+
+	// Without the interceptor, calls are made from their peer address
+	assert.Equal(t, "127.0.0.1", clientIP(metadata.NewIncomingContext(loopback, metadata.Pairs(forwardedForKey, "198.51.100.9"))))
+}
@@ -0,0 +1,59 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ifMatchKey is the metadata key the HTTP gateway forwards the If-Match header under
+const ifMatchKey = runtime.MetadataPrefix + "if-match"
+
+// IfMatch returns a unary server interceptor that copies the entity tag of
+// an If-Match header forwarded by the HTTP gateway into the etag field of the
+// request. Requests without an etag field, or that already set it, are left
+// alone, as are wildcard headers, which match any version.
+func IfMatch() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		etag := ifMatch(ctx)
+		if etag == "" {
+			return handler(ctx, req)
+		}
+
+		refl := msg.ProtoReflect()
+		field := refl.Descriptor().Fields().ByName("etag")
+		if field == nil || field.Kind() != protoreflect.StringKind || field.IsList() || refl.Get(field).String() != "" {
+			return handler(ctx, req)
+		}
+		refl.Set(field, protoreflect.ValueOfString(etag))
+
+		return handler(ctx, req)
+	}
+}
+
+// ifMatch returns the entity tag of the If-Match header without its quotes
+func ifMatch(ctx context.Context) string {
+	values := metadata.ValueFromIncomingContext(ctx, ifMatchKey)
+	if len(values) == 0 {
+		return ""
+	}
+
+	tag := strings.TrimSpace(values[0])
+	if tag == "*" {
+		return ""
+	}
+	if len(tag) >= 2 && strings.HasPrefix(tag, `"`) && strings.HasSuffix(tag, `"`) {
+		tag = tag[1 : len(tag)-1]
+	}
+	return tag
+}
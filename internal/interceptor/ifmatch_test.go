@@ -0,0 +1,93 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
+)
+
+func TestIfMatch(t *testing.T) {
+	validID := &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"}
+
+	tests := []struct {
+		name     string
+		header   string
+		req      any
+		expected any
+	}{
+		{
+			name:     "quoted entity tag",
+			header:   `"3"`,
+			req:      &blogpb.UpdateReq{Id: validID},
+			expected: &blogpb.UpdateReq{Id: validID, Etag: "3"},
+		},
+		{
+			name:     "unquoted entity tag",
+			header:   "3",
+			req:      &blogpb.DeleteReq{Id: validID},
+			expected: &blogpb.DeleteReq{Id: validID, Etag: "3"},
+		},
+		{
+			name:     "etag field takes precedence",
+			header:   `"3"`,
+			req:      &blogpb.UpdateReq{Id: validID, Etag: "4"},
+			expected: &blogpb.UpdateReq{Id: validID, Etag: "4"},
+		},
+		{
+			name:     "wildcard",
+			header:   "*",
+			req:      &blogpb.DeleteReq{Id: validID},
+			expected: &blogpb.DeleteReq{Id: validID},
+		},
+		{
+			name:     "no header",
+			req:      &blogpb.DeleteReq{Id: validID},
+			expected: &blogpb.DeleteReq{Id: validID},
+		},
+		{
+			name:     "request without etag",
+			header:   `"3"`,
+			req:      &blogpb.GetReq{Id: validID},
+			expected: &blogpb.GetReq{Id: validID},
+		},
+		{
+			name:     "non-proto request",
+			header:   `"3"`,
+			req:      "not a proto message",
+			expected: "not a proto message",
+		},
+	}
+
+	interceptor := IfMatch()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.header != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(ifMatchKey, tt.header))
+			}
+
+			var received any
+			handler := func(ctx context.Context, req any) (any, error) {
+				received = req
+				return req, nil
+			}
+
+			_, err := interceptor(ctx, tt.req, &grpc.UnaryServerInfo{}, handler)
+			require.NoError(t, err)
+
+			if msg, ok := tt.expected.(proto.Message); ok {
+				assert.True(t, proto.Equal(msg, received.(proto.Message)), "got %v", received)
+			} else {
+				assert.Equal(t, tt.expected, received)
+			}
+		})
+	}
+}
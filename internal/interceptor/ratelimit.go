@@ -0,0 +1,88 @@
+package interceptor
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/agruetz/prosigliere/internal/auth"
+	"github.com/agruetz/prosigliere/internal/ratelimit"
+)
+
+// Metadata keys of the rate limit headers sent with limited calls, which the
+// HTTP gateway forwards as X-RateLimit-Limit, X-RateLimit-Remaining and
+// X-RateLimit-Reset
+const (
+	rateLimitLimitKey     = "x-ratelimit-limit"
+	rateLimitRemainingKey = "x-ratelimit-remaining"
+	rateLimitResetKey     = "x-ratelimit-reset"
+)
+
+// RateLimit returns a unary server interceptor that limits how often callers
+// may call methods. Callers are told apart by the principal put into the
+// context by Auth, which for API keys names the key, or by the IP address
+// put into it by ClientIP if they are anonymous. The state of their bucket is
+// sent in the x-ratelimit-limit, x-ratelimit-remaining and x-ratelimit-reset
+// headers, the latter in seconds. Calls over the limit are rejected with
+// ResourceExhausted and a RetryInfo detail telling when to retry.
+func RateLimit(limiter *ratelimit.Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		result, ok, err := limiter.Allow(ctx, info.FullMethod, callerKey(ctx))
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to check rate limit: %v", err)
+		}
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		// Headers are only informative, so failing to set them is ignored
+		_ = grpc.SetHeader(ctx, metadata.Pairs(
+			rateLimitLimitKey, strconv.Itoa(result.Limit),
+			rateLimitRemainingKey, strconv.Itoa(result.Remaining),
+			rateLimitResetKey, strconv.Itoa(seconds(result.Reset)),
+		))
+		if !result.Allowed {
+			return nil, exhaustedStatus(info.FullMethod, result.RetryAfter)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// callerKey identifies the caller of a call for rate limiting. Subjects are
+// only unique within a tenant, so those of other tenants than the default
+// one are prefixed with their tenant.
+func callerKey(ctx context.Context) string {
+	if principal, ok := auth.FromContext(ctx); ok {
+		if principal.Tenant != "" {
+			return "principal:" + principal.Tenant + "/" + principal.Subject
+		}
+		return "principal:" + principal.Subject
+	}
+	return "ip:" + clientIP(ctx)
+}
+
+// exhaustedStatus returns the status of a call over its rate limit, with a
+// RetryInfo detail
+func exhaustedStatus(fullMethod string, retryAfter time.Duration) error {
+	msg := "rate limit exceeded for " + fullMethod
+	st, err := status.New(codes.ResourceExhausted, msg).WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryAfter),
+	})
+	if err != nil {
+		return status.Error(codes.ResourceExhausted, msg)
+	}
+	return st.Err()
+}
+
+// seconds returns a duration in whole seconds, rounded up
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,172 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/agruetz/prosigliere/internal/auth"
+	"github.com/agruetz/prosigliere/internal/ratelimit"
+)
+
+// headerStream records the headers set by handlers
+type headerStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *headerStream) Method() string {
+	return ""
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+// failingBuckets is a shared bucket store that is unavailable
+type failingBuckets struct{}
+
+func (failingBuckets) Take(ctx context.Context, key string, limit ratelimit.Limit) (ratelimit.Result, error) {
+	return ratelimit.Result{}, errors.New("connection refused")
+}
+
+func TestRateLimit(t *testing.T) {
+	now := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	limiter, err := ratelimit.New(ratelimit.NewMemory(ratelimit.WithClock(func() time.Time { return now })),
+		ratelimit.Limit{Methods: []string{"/blog.v1.Blogs/AddComment"}, Requests: 1, Per: time.Minute},
+	)
+	require.NoError(t, err)
+	interceptor := RateLimit(limiter)
+
+	alice := auth.NewContext(context.Background(), &auth.Principal{Subject: "alice"})
+	bob := auth.NewContext(context.Background(), &auth.Principal{Subject: "bob"})
+	otherAlice := auth.NewContext(context.Background(), &auth.Principal{Subject: "alice", Tenant: "acme"})
+	remote := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 50000}})
+	gateway := context.WithValue(
+		peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50001}}),
+		clientIPContextKey{}, "198.51.100.9",
+	)
+	spoofed := metadata.NewIncomingContext(remote, metadata.Pairs(forwardedForKey, "198.51.100.9"))
+
+	tests := []struct {
+		name              string
+		ctx               context.Context
+		method            string
+		expectedCode      codes.Code
+		expectedRemaining string
+	}{
+		{
+			name:         "unlimited method",
+			ctx:          alice,
+			method:       "/blog.v1.Blogs/Get",
+			expectedCode: codes.OK,
+		},
+		{
+			name:              "principal",
+			ctx:               alice,
+			method:            "/blog.v1.Blogs/AddComment",
+			expectedCode:      codes.OK,
+			expectedRemaining: "0",
+		},
+		{
+			name:              "principal over the limit",
+			ctx:               alice,
+			method:            "/blog.v1.Blogs/AddComment",
+			expectedCode:      codes.ResourceExhausted,
+			expectedRemaining: "0",
+		},
+		{
+			name:              "other principal",
+			ctx:               bob,
+			method:            "/blog.v1.Blogs/AddComment",
+			expectedCode:      codes.OK,
+			expectedRemaining: "0",
+		},
+		{
+			name:              "same subject of another tenant",
+			ctx:               otherAlice,
+			method:            "/blog.v1.Blogs/AddComment",
+			expectedCode:      codes.OK,
+			expectedRemaining: "0",
+		},
+		{
+			name:              "anonymous",
+			ctx:               remote,
+			method:            "/blog.v1.Blogs/AddComment",
+			expectedCode:      codes.OK,
+			expectedRemaining: "0",
+		},
+		{
+			name:              "anonymous through the gateway",
+			ctx:               gateway,
+			method:            "/blog.v1.Blogs/AddComment",
+			expectedCode:      codes.OK,
+			expectedRemaining: "0",
+		},
+		{
+			name:              "forwarded address from a remote peer",
+			ctx:               spoofed,
+			method:            "/blog.v1.Blogs/AddComment",
+			expectedCode:      codes.ResourceExhausted,
+			expectedRemaining: "0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := &headerStream{}
+			ctx := grpc.NewContextWithServerTransportStream(tt.ctx, stream)
+
+			called := false
+			handler := func(ctx context.Context, req any) (any, error) {
+				called = true
+				return req, nil
+			}
+
+			_, err := interceptor(ctx, "request", &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			if tt.expectedRemaining == "" {
+				assert.Empty(t, stream.header)
+			} else {
+				assert.Equal(t, []string{"1"}, stream.header.Get(rateLimitLimitKey))
+				assert.Equal(t, []string{tt.expectedRemaining}, stream.header.Get(rateLimitRemainingKey))
+				assert.Equal(t, []string{"60"}, stream.header.Get(rateLimitResetKey))
+			}
+			if tt.expectedCode == codes.OK {
+				require.NoError(t, err)
+				assert.True(t, called)
+				return
+			}
+			assert.False(t, called)
+			st := status.Convert(err)
+			assert.Equal(t, tt.expectedCode, st.Code())
+			assert.Equal(t, "rate limit exceeded for "+tt.method, st.Message())
+			require.Len(t, st.Details(), 1)
+			info, ok := st.Details()[0].(*errdetails.RetryInfo)
+			require.True(t, ok)
+			assert.Equal(t, time.Minute, info.GetRetryDelay().AsDuration())
+		})
+	}
+}
+
+func TestRateLimitError(t *testing.T) {
+	limiter, err := ratelimit.New(failingBuckets{}, ratelimit.Limit{Methods: []string{"*"}, Requests: 1})
+	require.NoError(t, err)
+
+	_, err = RateLimit(limiter)(context.Background(), "request", &grpc.UnaryServerInfo{FullMethod: "/blog.v1.Blogs/Get"},
+		func(ctx context.Context, req any) (any, error) { return req, nil })
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "connection refused")
+}
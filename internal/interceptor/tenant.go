@@ -0,0 +1,83 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+)
+
+// tenantKey is the metadata key naming the tenant a call is made for, which
+// the HTTP gateway forwards the tenant it resolves under
+const tenantKey = "x-tenant"
+
+// TenantResolver looks up the tenants calls are made for
+type TenantResolver interface {
+	// GetTenantBySlug retrieves the tenant with the given slug
+	GetTenantBySlug(ctx context.Context, slug string) (*datastore.Tenant, error)
+}
+
+// Tenant returns a unary server interceptor that puts the tenant named by
+// the x-tenant metadata into the context, which limits every store call made
+// for the request to that tenant. Calls naming no tenant are made for the
+// default tenant. Unknown tenants are rejected with NotFound and malformed
+// slugs with InvalidArgument. Tenants are never deleted, so the ones found
+// are cached.
+func Tenant(resolver TenantResolver) grpc.UnaryServerInterceptor {
+	var mu sync.RWMutex
+	cache := map[string]*datastore.Tenant{}
+
+	resolve := func(ctx context.Context, slug string) (*datastore.Tenant, error) {
+		mu.RLock()
+		tenant, ok := cache[slug]
+		mu.RUnlock()
+		if ok {
+			return tenant, nil
+		}
+
+		tenant, err := resolver.GetTenantBySlug(ctx, slug)
+		if err != nil {
+			return nil, err
+		}
+		mu.Lock()
+		cache[slug] = tenant
+		mu.Unlock()
+		return tenant, nil
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		slug := datastore.DefaultTenantSlug
+		if values := metadata.ValueFromIncomingContext(ctx, tenantKey); len(values) > 0 {
+			slug = strings.TrimSpace(values[0])
+		}
+		if !datastore.ValidTenantSlug(slug) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tenant %q", slug)
+		}
+
+		tenant, err := resolve(ctx, slug)
+		if errors.Is(err, datastore.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "tenant %q not found", slug)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to look up tenant: %v", err)
+		}
+
+		return handler(datastore.NewTenantContext(ctx, tenant), req)
+	}
+}
+
+// tenantSlug returns the slug of the tenant put into the context by Tenant,
+// or of the default tenant if there is none
+func tenantSlug(ctx context.Context) string {
+	if tenant, ok := datastore.TenantFromContext(ctx); ok {
+		return tenant.Slug
+	}
+	return datastore.DefaultTenantSlug
+}
@@ -0,0 +1,131 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+)
+
+// tenantResolver knows the default and acme tenants, counting its lookups,
+// and fails to look up the tenant "broken"
+type tenantResolver struct {
+	lookups int
+}
+
+func (r *tenantResolver) GetTenantBySlug(ctx context.Context, slug string) (*datastore.Tenant, error) {
+	r.lookups++
+	switch slug {
+	case datastore.DefaultTenantSlug:
+		return &datastore.Tenant{ID: datastore.DefaultTenantID, Slug: slug}, nil
+	case "acme":
+		return &datastore.Tenant{ID: "acme-id", Slug: slug}, nil
+	case "broken":
+		return nil, datastore.Unavailable(errors.New("connection refused"))
+	default:
+		return nil, datastore.NotFound(datastore.ResourceTenant, datastore.ID(slug))
+	}
+}
+
+func TestTenant(t *testing.T) {
+	tests := []struct {
+		name         string
+		tenant       string
+		expectedCode codes.Code
+		expectedMsg  string
+		expectedID   datastore.ID
+	}{
+		{
+			name:         "no tenant",
+			expectedCode: codes.OK,
+			expectedID:   datastore.DefaultTenantID,
+		},
+		{
+			name:         "named tenant",
+			tenant:       "acme",
+			expectedCode: codes.OK,
+			expectedID:   "acme-id",
+		},
+		{
+			name:         "unknown tenant",
+			tenant:       "missing",
+			expectedCode: codes.NotFound,
+			expectedMsg:  `tenant "missing" not found`,
+		},
+		{
+			name:         "malformed tenant",
+			tenant:       "Not A Slug",
+			expectedCode: codes.InvalidArgument,
+			expectedMsg:  `invalid tenant "Not A Slug"`,
+		},
+		{
+			name:         "lookup failure",
+			tenant:       "broken",
+			expectedCode: codes.Unavailable,
+			expectedMsg:  "failed to look up tenant: unavailable: connection refused",
+		},
+	}
+
+	interceptor := Tenant(&tenantResolver{})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := metadata.MD{}
+			if tt.tenant != "" {
+				md.Set(tenantKey, tt.tenant)
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+
+			called := false
+			var id datastore.ID
+			handler := func(ctx context.Context, req any) (any, error) {
+				called = true
+				id = datastore.TenantID(ctx)
+				return req, nil
+			}
+
+			_, err := interceptor(ctx, "request", &grpc.UnaryServerInfo{FullMethod: "/blog.v1.Blogs/Get"}, handler)
+
+			if tt.expectedCode == codes.OK {
+				require.NoError(t, err)
+				assert.True(t, called)
+				assert.Equal(t, tt.expectedID, id)
+				return
+			}
+			assert.False(t, called)
+			st := status.Convert(err)
+			assert.Equal(t, tt.expectedCode, st.Code())
+			assert.Equal(t, tt.expectedMsg, st.Message())
+		})
+	}
+}
+
+func TestTenantCache(t *testing.T) {
+	resolver := &tenantResolver{}
+	interceptor := Tenant(resolver)
+	handler := func(ctx context.Context, req any) (any, error) { return req, nil }
+	info := &grpc.UnaryServerInfo{FullMethod: "/blog.v1.Blogs/Get"}
+
+	// Found tenants are looked up once, unknown ones every time
+	for i := 0; i < 3; i++ {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenantKey, "acme"))
+		_, err := interceptor(ctx, "request", info, handler)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, resolver.lookups)
+
+	for i := 0; i < 2; i++ {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenantKey, "missing"))
+		_, err := interceptor(ctx, "request", info, handler)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	}
+	assert.Equal(t, 3, resolver.lookups)
+}
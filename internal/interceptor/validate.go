@@ -0,0 +1,64 @@
+// Package interceptor provides gRPC server interceptors shared by all services
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"buf.build/go/protovalidate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Validate returns a unary server interceptor that validates every incoming
+// request against the buf.validate rules declared in its proto definition.
+// Requests that violate a rule are rejected with InvalidArgument and a
+// BadRequest detail listing every field violation.
+func Validate(validator protovalidate.Validator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		if err := validator.Validate(msg); err != nil {
+			return nil, validationError(err)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// validationError converts a protovalidate error into a gRPC status error
+func validationError(err error) error {
+	var valErr *protovalidate.ValidationError
+	if !errors.As(err, &valErr) {
+		// Compilation and runtime errors indicate a broken rule, not a bad request
+		return status.Errorf(codes.Internal, "failed to validate request: %v", err)
+	}
+
+	badRequest := &errdetails.BadRequest{
+		FieldViolations: make([]*errdetails.BadRequest_FieldViolation, 0, len(valErr.Violations)),
+	}
+	summaries := make([]string, 0, len(valErr.Violations))
+	for _, violation := range valErr.Violations {
+		field := protovalidate.FieldPathString(violation.Proto.GetField())
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: violation.Proto.GetMessage(),
+			Reason:      violation.Proto.GetRuleId(),
+		})
+		summaries = append(summaries, field+": "+violation.Proto.GetMessage())
+	}
+
+	st := status.New(codes.InvalidArgument, "invalid request: "+strings.Join(summaries, "; "))
+	withDetails, detailErr := st.WithDetails(badRequest)
+	if detailErr != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+	return withDetails.Err()
+}
@@ -0,0 +1,340 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"buf.build/go/protovalidate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
+)
+
+func TestValidate(t *testing.T) {
+	validID := &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"}
+
+	tests := []struct {
+		name           string
+		req            any
+		expectedCode   codes.Code
+		expectedFields []string
+	}{
+		{
+			name:         "valid create request",
+			req:          &blogpb.CreateReq{Title: "Test Blog", Content: "This is a test blog content"},
+			expectedCode: codes.OK,
+		},
+		{
+			name:           "title too long",
+			req:            &blogpb.CreateReq{Title: strings.Repeat("a", 101), Content: "This is a test blog content"},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"title"},
+		},
+		{
+			name:         "empty title and content",
+			req:          &blogpb.CreateReq{},
+			expectedCode: codes.InvalidArgument,
+			// An empty title violates both the length and the pattern rule
+			expectedFields: []string{"title", "title", "content"},
+		},
+		{
+			name:           "malformed UUID",
+			req:            &blogpb.GetReq{Id: &blogpb.UUID{Value: "not-a-uuid"}},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"id.value"},
+		},
+		{
+			name:           "missing ID",
+			req:            &blogpb.DeleteReq{},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"id"},
+		},
+		{
+			name:         "partial update",
+			req:          &blogpb.UpdateReq{Id: validID, Title: stringPtr("Updated Title")},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "scheduled create request",
+			req:          &blogpb.CreateReq{Title: "Test Blog", Content: "This is a test blog content", PublishAt: timestamppb.Now()},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "scheduled create request without publish time",
+			req: &blogpb.CreateReq{
+				Title:   "Test Blog",
+				Content: "This is a test blog content",
+				Status:  blogpb.BlogStatus_BLOG_STATUS_SCHEDULED,
+			},
+			expectedCode: codes.InvalidArgument,
+			// Message rules are not tied to a single field
+			expectedFields: []string{""},
+		},
+		{
+			name:           "unset status on update",
+			req:            &blogpb.UpdateReq{Id: validID, Status: blogpb.BlogStatus_BLOG_STATUS_UNSPECIFIED.Enum()},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"status"},
+		},
+		{
+			name:           "empty comment author",
+			req:            &blogpb.AddCommentReq{Id: validID, Content: "Test comment"},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{""},
+		},
+		{
+			name:         "comment by user",
+			req:          &blogpb.AddCommentReq{Id: validID, Content: "Test comment", AuthorId: validID},
+			expectedCode: codes.OK,
+		},
+		{
+			name:           "empty user display name",
+			req:            &blogpb.CreateUserReq{DisplayName: ""},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"display_name"},
+		},
+		{
+			name:           "avatar url not http",
+			req:            &blogpb.CreateUserReq{DisplayName: "Alice", AvatarUrl: "ftp://example.com/alice.png"},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"avatar_url"},
+		},
+		{
+			name:           "editor too long",
+			req:            &blogpb.UpdateReq{Id: validID, Title: stringPtr("Updated Title"), Editor: strings.Repeat("a", 51)},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"editor"},
+		},
+		{
+			name:         "update with etag",
+			req:          &blogpb.UpdateReq{Id: validID, Title: stringPtr("Updated Title"), Etag: "3"},
+			expectedCode: codes.OK,
+		},
+		{
+			name:           "malformed etag",
+			req:            &blogpb.DeleteReq{Id: validID, Etag: "W/3"},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"etag"},
+		},
+		{
+			name:         "update with update mask",
+			req:          &blogpb.UpdateReq{Id: validID, Title: stringPtr("Updated Title"), UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title", "publish_at"}}},
+			expectedCode: codes.OK,
+		},
+		{
+			name:           "unsupported update mask path",
+			req:            &blogpb.UpdateReq{Id: validID, Title: stringPtr("Updated Title"), UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"*"}}},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"update_mask"},
+		},
+		{
+			name:           "revision number missing",
+			req:            &blogpb.RestoreRevisionReq{Id: validID},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"revision"},
+		},
+		{
+			name:         "diff with current version",
+			req:          &blogpb.DiffRevisionsReq{Id: validID, FromRevision: 1},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "default page size",
+			req:          &blogpb.ListReq{},
+			expectedCode: codes.OK,
+		},
+		{
+			name:           "page size too large",
+			req:            &blogpb.ListReq{PageSize: 200},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"page_size"},
+		},
+		{
+			name:           "undelete without ID",
+			req:            &blogpb.UndeleteReq{},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"id"},
+		},
+		{
+			name:           "deleted page size too large",
+			req:            &blogpb.ListDeletedReq{PageSize: 200},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"page_size"},
+		},
+		{
+			name:           "empty search query",
+			req:            &blogpb.SearchReq{},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"q"},
+		},
+		{
+			name:         "create request with tags",
+			req:          &blogpb.CreateReq{Title: "Test Blog", Content: "This is a test blog content", Tags: []string{"gardening", "raised-beds"}},
+			expectedCode: codes.OK,
+		},
+		{
+			name:           "invalid tag",
+			req:            &blogpb.CreateReq{Title: "Test Blog", Content: "This is a test blog content", Tags: []string{"gardening", "Raised Beds"}},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"tags[1]"},
+		},
+		{
+			name:           "too many tags",
+			req:            &blogpb.CreateReq{Title: "Test Blog", Content: "This is a test blog content", Tags: strings.Fields("a b c d e f g h i j k")},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"tags"},
+		},
+		{
+			name:           "duplicate tags",
+			req:            &blogpb.UpdateReq{Id: &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"}, Tags: []string{"go", "go"}},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"tags"},
+		},
+		{
+			name:         "get by slug",
+			req:          &blogpb.GetBySlugReq{Slug: "my-first-post"},
+			expectedCode: codes.OK,
+		},
+		{
+			name:           "get by invalid slug",
+			req:            &blogpb.GetBySlugReq{Slug: "My First Post"},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"slug"},
+		},
+		{
+			name:           "update with invalid slug",
+			req:            &blogpb.UpdateReq{Id: validID, Slug: stringPtr("my_first_post")},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"slug"},
+		},
+		{
+			name:         "comment reply",
+			req:          &blogpb.AddCommentReq{Id: validID, Content: "Test reply", Author: "Test Author", ParentId: validID},
+			expectedCode: codes.OK,
+		},
+		{
+			name:           "comment reply to invalid parent",
+			req:            &blogpb.AddCommentReq{Id: validID, Content: "Test reply", Author: "Test Author", ParentId: &blogpb.UUID{Value: "not-a-uuid"}},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"parent_id.value"},
+		},
+		{
+			name:           "undefined comment view",
+			req:            &blogpb.GetReq{Id: validID, CommentView: blogpb.CommentView(99)},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"comment_view"},
+		},
+		{
+			name:           "get comment without comment ID",
+			req:            &blogpb.GetCommentReq{Id: validID},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"comment_id"},
+		},
+		{
+			name:           "empty comment edit",
+			req:            &blogpb.UpdateCommentReq{Id: validID, CommentId: validID},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"content"},
+		},
+		{
+			name:         "default comment page size",
+			req:          &blogpb.ListCommentsReq{Id: validID},
+			expectedCode: codes.OK,
+		},
+		{
+			name:           "too many embedded comments",
+			req:            &blogpb.GetReq{Id: validID, MaxComments: 1001},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"max_comments"},
+		},
+		{
+			name:         "list pending comments across blogs",
+			req:          &blogpb.ListPendingCommentsReq{},
+			expectedCode: codes.OK,
+		},
+		{
+			name:           "pending comment page size too large",
+			req:            &blogpb.ListPendingCommentsReq{Id: validID, PageSize: 200},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"page_size"},
+		},
+		{
+			name:         "moderate comment",
+			req:          &blogpb.ModerateCommentReq{Id: validID, CommentId: validID, State: blogpb.CommentState_COMMENT_STATE_SPAM, Reason: "Sells watches"},
+			expectedCode: codes.OK,
+		},
+		{
+			name:           "moderate comment back to pending",
+			req:            &blogpb.ModerateCommentReq{Id: validID, CommentId: validID, State: blogpb.CommentState_COMMENT_STATE_PENDING},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"state"},
+		},
+		{
+			name:           "comment verdicts without comment",
+			req:            &blogpb.ListCommentVerdictsReq{Id: validID},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"comment_id"},
+		},
+		{
+			name:           "undefined comment policy",
+			req:            &blogpb.UpdateReq{Id: validID, CommentPolicy: blogpb.CommentPolicy(99).Enum()},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"comment_policy"},
+		},
+		{
+			name:         "non-proto request",
+			req:          "not a proto message",
+			expectedCode: codes.OK,
+		},
+	}
+
+	validator, err := protovalidate.New()
+	require.NoError(t, err)
+	interceptor := Validate(validator)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := func(ctx context.Context, req any) (any, error) {
+				called = true
+				return req, nil
+			}
+
+			_, err := interceptor(context.Background(), tt.req, &grpc.UnaryServerInfo{}, handler)
+
+			if tt.expectedCode == codes.OK {
+				assert.NoError(t, err)
+				assert.True(t, called)
+				return
+			}
+
+			assert.False(t, called)
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, tt.expectedCode, st.Code())
+
+			require.Len(t, st.Details(), 1)
+			badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+			require.True(t, ok)
+			fields := make([]string, 0, len(badRequest.FieldViolations))
+			for _, violation := range badRequest.FieldViolations {
+				fields = append(fields, violation.Field)
+				assert.NotEmpty(t, violation.Description)
+			}
+			assert.ElementsMatch(t, tt.expectedFields, fields)
+		})
+	}
+}
+
+// Helper function to create string pointers
+func stringPtr(s string) *string {
+	return &s
+}
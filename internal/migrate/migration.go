@@ -0,0 +1,148 @@
+// Package migrate applies versioned SQL migrations to a PostgreSQL database
+package migrate
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"hash/crc32"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Migration is a single versioned migration script
+type Migration struct {
+	// Version is the dotted version of the migration, e.g. "1" or "2.1"
+	Version string
+
+	// Description is derived from the script name, e.g. "initial schema"
+	Description string
+
+	// Script is the file name of the migration
+	Script string
+
+	// SQL is the content of the migration
+	SQL string
+
+	// Checksum is the Flyway-compatible checksum of the script
+	Checksum int32
+}
+
+// migrationName matches Flyway versioned migration names: V{version}__{description}.sql
+var migrationName = regexp.MustCompile(`^V([0-9]+(?:[._][0-9]+)*)__(.+)\.sql$`)
+
+// Load reads all migrations from the root of fsys, ordered by version
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	var migrations []Migration
+	seen := map[string]string{}
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+
+		match := migrationName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration name %q, expected V{version}__{description}.sql", entry.Name())
+		}
+
+		version := strings.ReplaceAll(match[1], "_", ".")
+		if other, ok := seen[version]; ok {
+			return nil, fmt.Errorf("duplicate migration version %s in %q and %q", version, other, entry.Name())
+		}
+		seen[version] = entry.Name()
+
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %q: %w", entry.Name(), err)
+		}
+
+		migrations = append(migrations, Migration{
+			Version:     version,
+			Description: strings.ReplaceAll(match[2], "_", " "),
+			Script:      entry.Name(),
+			SQL:         string(content),
+			Checksum:    Checksum(content),
+		})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return compareVersions(migrations[i].Version, migrations[j].Version) < 0
+	})
+
+	return migrations, nil
+}
+
+// Checksum computes the checksum of a migration script the same way Flyway
+// does: a CRC32 over every line of the script without its line terminator,
+// ignoring a leading byte order mark.
+func Checksum(content []byte) int32 {
+	content = bytes.TrimPrefix(content, []byte("\ufeff"))
+
+	crc := crc32.NewIEEE()
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(content)+1)
+	scanner.Split(scanLines)
+	for scanner.Scan() {
+		crc.Write(scanner.Bytes())
+	}
+
+	return int32(crc.Sum32())
+}
+
+// scanLines splits on \n, \r\n and lone \r, matching Java's BufferedReader.readLine
+func scanLines(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+	if i := bytes.IndexAny(data, "\r\n"); i >= 0 {
+		if data[i] == '\n' {
+			return i + 1, data[:i], nil
+		}
+		// A \r may be followed by \n, which needs the next byte to decide
+		if i+1 < len(data) {
+			if data[i+1] == '\n' {
+				return i + 2, data[:i], nil
+			}
+			return i + 1, data[:i], nil
+		}
+		if atEOF {
+			return i + 1, data[:i], nil
+		}
+		return 0, nil, nil
+	}
+	if atEOF {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
+// compareVersions compares two dotted versions numerically
+func compareVersions(a, b string) int {
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var an, bn int64
+		if i < len(as) {
+			an, _ = strconv.ParseInt(as[i], 10, 64)
+		}
+		if i < len(bs) {
+			bn, _ = strconv.ParseInt(bs[i], 10, 64)
+		}
+		if an != bn {
+			if an < bn {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
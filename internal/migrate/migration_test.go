@@ -0,0 +1,95 @@
+package migrate_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/agruetz/prosigliere/db"
+	"github.com/agruetz/prosigliere/internal/migrate"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name             string
+		fsys             fstest.MapFS
+		expectError      bool
+		errorMsg         string
+		expectedVersions []string
+	}{
+		{
+			name: "ordered by numeric version",
+			fsys: fstest.MapFS{
+				"V10__later.sql":         {Data: []byte("SELECT 10;")},
+				"V2__second.sql":         {Data: []byte("SELECT 2;")},
+				"V1__initial_schema.sql": {Data: []byte("SELECT 1;")},
+				"V2_1__patch.sql":        {Data: []byte("SELECT 2.1;")},
+				"README.md":              {Data: []byte("not a migration")},
+			},
+			expectedVersions: []string{"1", "2", "2.1", "10"},
+		},
+		{
+			name: "invalid name",
+			fsys: fstest.MapFS{
+				"initial.sql": {Data: []byte("SELECT 1;")},
+			},
+			expectError: true,
+			errorMsg:    "invalid migration name",
+		},
+		{
+			name: "duplicate version",
+			fsys: fstest.MapFS{
+				"V1__first.sql": {Data: []byte("SELECT 1;")},
+				"V1__other.sql": {Data: []byte("SELECT 1;")},
+			},
+			expectError: true,
+			errorMsg:    "duplicate migration version",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			migrations, err := migrate.Load(tc.fsys)
+
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+				return
+			}
+			require.NoError(t, err)
+			versions := make([]string, 0, len(migrations))
+			for _, migration := range migrations {
+				versions = append(versions, migration.Version)
+			}
+			assert.Equal(t, tc.expectedVersions, versions)
+		})
+	}
+}
+
+func TestLoadEmbedded(t *testing.T) {
+	migrations, err := migrate.Load(db.Migrations())
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+
+	assert.Equal(t, "1", migrations[0].Version)
+	assert.Equal(t, "initial schema", migrations[0].Description)
+	assert.Equal(t, "V1__initial_schema.sql", migrations[0].Script)
+	assert.Contains(t, migrations[0].SQL, "CREATE TABLE blogs")
+}
+
+func TestChecksum(t *testing.T) {
+	unix := migrate.Checksum([]byte("CREATE TABLE a (id INT);\nCREATE TABLE b (id INT);\n"))
+
+	// Line terminators and a byte order mark do not affect the checksum
+	assert.Equal(t, unix, migrate.Checksum([]byte("CREATE TABLE a (id INT);\r\nCREATE TABLE b (id INT);\r\n")))
+	assert.Equal(t, unix, migrate.Checksum([]byte("CREATE TABLE a (id INT);\rCREATE TABLE b (id INT);")))
+	assert.Equal(t, unix, migrate.Checksum([]byte("\ufeffCREATE TABLE a (id INT);\nCREATE TABLE b (id INT);")))
+
+	// Content changes do
+	assert.NotEqual(t, unix, migrate.Checksum([]byte("CREATE TABLE a (id BIGINT);\nCREATE TABLE b (id INT);\n")))
+
+	// CRC32 of the concatenated lines, as computed by Flyway
+	assert.Equal(t, int32(0x352441c2), migrate.Checksum([]byte("a\nbc")))
+}
@@ -0,0 +1,401 @@
+// Package migrate applies versioned SQL migrations to a PostgreSQL database
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"hash/crc32"
+	"io/fs"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// State describes the state of a migration relative to the database
+type State string
+
+// Migration states reported by Status
+const (
+	// StatePending marks a migration that has not been applied yet
+	StatePending State = "pending"
+
+	// StateApplied marks a migration that has been applied successfully
+	StateApplied State = "applied"
+
+	// StateFailed marks a migration recorded as failed, which needs a repair
+	StateFailed State = "failed"
+
+	// StateChanged marks an applied migration whose script changed since
+	StateChanged State = "checksum mismatch"
+
+	// StateMissing marks an applied migration with no matching script
+	StateMissing State = "missing"
+
+	// StateBaseline marks the version the schema history was baselined at
+	StateBaseline State = "baseline"
+
+	// StateBelowBaseline marks a migration at or below the baseline, which is
+	// never applied
+	StateBelowBaseline State = "below baseline"
+)
+
+// Info describes a migration and its state in the database
+type Info struct {
+	Version       string
+	Description   string
+	Script        string
+	State         State
+	InstalledOn   time.Time
+	ExecutionTime time.Duration
+}
+
+// appliedMigration is a row of the schema history table
+type appliedMigration struct {
+	rank          int
+	version       string
+	description   string
+	script        string
+	checksum      sql.NullInt32
+	installedOn   time.Time
+	executionTime time.Duration
+	success       bool
+	baseline      bool
+}
+
+// Migrator applies embedded migrations and records them in a schema history
+// table compatible with Flyway's
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+	cfg        *config
+}
+
+// New creates a Migrator for the migrations at the root of fsys
+func New(db *sql.DB, fsys fs.FS, opts ...Option) (*Migrator, error) {
+	cfg := defaultConfig()
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+		cfg:        cfg,
+	}, nil
+}
+
+// Status reports the state of every known migration, including applied
+// migrations whose scripts no longer exist
+func (m *Migrator) Status(ctx context.Context) ([]Info, error) {
+	applied, err := m.applied(ctx, m.db)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[string]appliedMigration, len(applied))
+	for _, a := range applied {
+		byVersion[a.version] = a
+	}
+	baseline := baselineVersion(applied)
+
+	infos := make([]Info, 0, len(m.migrations))
+	local := make(map[string]bool, len(m.migrations))
+	for _, migration := range m.migrations {
+		local[migration.Version] = true
+		info := Info{
+			Version:     migration.Version,
+			Description: migration.Description,
+			Script:      migration.Script,
+			State:       StatePending,
+		}
+		if a, ok := byVersion[migration.Version]; ok {
+			info.InstalledOn = a.installedOn
+			info.ExecutionTime = a.executionTime
+			switch {
+			case a.baseline:
+				info.State = StateBaseline
+			case !a.success:
+				info.State = StateFailed
+			case !a.checksum.Valid || a.checksum.Int32 != migration.Checksum:
+				info.State = StateChanged
+			default:
+				info.State = StateApplied
+			}
+		} else if baseline != "" && compareVersions(migration.Version, baseline) <= 0 {
+			info.State = StateBelowBaseline
+		}
+		infos = append(infos, info)
+	}
+
+	for _, a := range applied {
+		if local[a.version] {
+			continue
+		}
+		state := StateMissing
+		if a.baseline {
+			state = StateBaseline
+		}
+		infos = append(infos, Info{
+			Version:       a.version,
+			Description:   a.description,
+			Script:        a.script,
+			State:         state,
+			InstalledOn:   a.installedOn,
+			ExecutionTime: a.executionTime,
+		})
+	}
+
+	return infos, nil
+}
+
+// Pending validates the applied migrations against the embedded scripts and
+// returns the migrations that still need to be applied. It does not modify
+// the database, which makes it suitable for dry runs.
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	applied, err := m.applied(ctx, m.db)
+	if err != nil {
+		return nil, err
+	}
+	return m.pending(applied)
+}
+
+// Migrate applies all pending migrations and returns the ones it applied.
+// Concurrent callers, e.g. several replicas starting at once, are serialized
+// with an advisory lock, and every migration runs in its own transaction
+// together with its schema history record.
+func (m *Migrator) Migrate(ctx context.Context) ([]Migration, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database connection: %w", err)
+	}
+	defer conn.Close()
+
+	// Advisory locks belong to the session, so lock and unlock on the same connection
+	lockKey := m.lockKey()
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockKey); err != nil {
+		return nil, fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", lockKey)
+
+	if err := m.createTable(ctx, conn); err != nil {
+		return nil, err
+	}
+
+	// Read the history only once we hold the lock, as another replica may have
+	// applied migrations while we were waiting for it
+	applied, err := m.applied(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := m.pending(applied)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rank after every row of the history, including those of other types,
+	// as installed_rank is its primary key
+	rank, err := m.lastRank(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	done := make([]Migration, 0, len(pending))
+	for _, migration := range pending {
+		rank++
+		if err := m.apply(ctx, conn, rank, migration); err != nil {
+			return done, err
+		}
+		done = append(done, migration)
+	}
+
+	return done, nil
+}
+
+// apply runs a single migration and records it in one transaction
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, rank int, migration Migration) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction for migration V%s: %w", migration.Version, err)
+	}
+	defer tx.Rollback()
+
+	start := time.Now()
+	if _, err := tx.ExecContext(ctx, migration.SQL); err != nil {
+		return fmt.Errorf("migration V%s (%s) failed: %w", migration.Version, migration.Script, err)
+	}
+	elapsed := time.Since(start)
+
+	query := fmt.Sprintf(`
+		INSERT INTO %s (installed_rank, version, description, type, script, checksum, installed_by, execution_time, success)
+		VALUES ($1, $2, $3, 'SQL', $4, $5, COALESCE(NULLIF($6, ''), current_user), $7, TRUE)
+	`, pq.QuoteIdentifier(m.cfg.table))
+	_, err = tx.ExecContext(ctx, query,
+		rank, migration.Version, migration.Description, migration.Script, migration.Checksum,
+		m.cfg.installedBy, elapsed.Milliseconds(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record migration V%s: %w", migration.Version, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit migration V%s: %w", migration.Version, err)
+	}
+
+	return nil
+}
+
+// pending validates the applied migrations and returns those still to apply
+func (m *Migrator) pending(applied []appliedMigration) ([]Migration, error) {
+	byVersion := make(map[string]Migration, len(m.migrations))
+	for _, migration := range m.migrations {
+		byVersion[migration.Version] = migration
+	}
+
+	baseline := baselineVersion(applied)
+	latest := baseline
+	done := make(map[string]bool, len(applied))
+	for _, a := range applied {
+		if a.baseline {
+			continue
+		}
+		if !a.success {
+			return nil, fmt.Errorf("migration V%s is recorded as failed, repair the schema history before migrating", a.version)
+		}
+		migration, ok := byVersion[a.version]
+		if !ok {
+			return nil, fmt.Errorf("applied migration V%s (%s) has no matching script", a.version, a.script)
+		}
+		if !a.checksum.Valid || a.checksum.Int32 != migration.Checksum {
+			return nil, fmt.Errorf("checksum mismatch for migration V%s: applied %d, local %d", a.version, a.checksum.Int32, migration.Checksum)
+		}
+		done[a.version] = true
+		if latest == "" || compareVersions(a.version, latest) > 0 {
+			latest = a.version
+		}
+	}
+
+	var pending []Migration
+	for _, migration := range m.migrations {
+		if done[migration.Version] {
+			continue
+		}
+		if baseline != "" && compareVersions(migration.Version, baseline) <= 0 {
+			continue
+		}
+		if latest != "" && compareVersions(migration.Version, latest) < 0 {
+			return nil, fmt.Errorf("migration V%s is older than the latest applied version %s", migration.Version, latest)
+		}
+		pending = append(pending, migration)
+	}
+
+	return pending, nil
+}
+
+// querier is implemented by both *sql.DB and *sql.Conn
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// applied reads the versioned migrations and baselines from the schema
+// history table. A missing table means nothing has been applied yet.
+func (m *Migrator) applied(ctx context.Context, q querier) ([]appliedMigration, error) {
+	var exists bool
+	err := q.QueryRowContext(ctx, "SELECT to_regclass($1) IS NOT NULL", m.cfg.table).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check schema history table: %w", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	query := fmt.Sprintf(`
+		SELECT installed_rank, version, description, script, checksum, installed_on, execution_time, success, type = 'BASELINE'
+		FROM %s
+		WHERE type IN ('SQL', 'BASELINE') AND version IS NOT NULL
+		ORDER BY installed_rank
+	`, pq.QuoteIdentifier(m.cfg.table))
+	rows, err := q.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema history: %w", err)
+	}
+	defer rows.Close()
+
+	var applied []appliedMigration
+	for rows.Next() {
+		var a appliedMigration
+		var executionMillis int64
+		err := rows.Scan(&a.rank, &a.version, &a.description, &a.script, &a.checksum, &a.installedOn, &executionMillis, &a.success, &a.baseline)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan schema history: %w", err)
+		}
+		a.executionTime = time.Duration(executionMillis) * time.Millisecond
+		applied = append(applied, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating schema history: %w", err)
+	}
+
+	return applied, nil
+}
+
+// lastRank returns the highest installed_rank of the schema history table,
+// or 0 if it is empty
+func (m *Migrator) lastRank(ctx context.Context, q querier) (int, error) {
+	var rank int
+	query := fmt.Sprintf("SELECT COALESCE(MAX(installed_rank), 0) FROM %s", pq.QuoteIdentifier(m.cfg.table))
+	if err := q.QueryRowContext(ctx, query).Scan(&rank); err != nil {
+		return 0, fmt.Errorf("failed to read schema history rank: %w", err)
+	}
+	return rank, nil
+}
+
+// baselineVersion returns the highest version the schema history was
+// baselined at, or "" if it never was. Migrations up to it count as applied.
+func baselineVersion(applied []appliedMigration) string {
+	baseline := ""
+	for _, a := range applied {
+		if a.baseline && (baseline == "" || compareVersions(a.version, baseline) > 0) {
+			baseline = a.version
+		}
+	}
+	return baseline
+}
+
+// createTable creates the schema history table using Flyway's layout
+func (m *Migrator) createTable(ctx context.Context, conn *sql.Conn) error {
+	table := pq.QuoteIdentifier(m.cfg.table)
+	query := fmt.Sprintf(`
+		CREATE TABLE IF NOT EXISTS %s (
+			installed_rank INT NOT NULL PRIMARY KEY,
+			version VARCHAR(50),
+			description VARCHAR(200) NOT NULL,
+			type VARCHAR(20) NOT NULL,
+			script VARCHAR(1000) NOT NULL,
+			checksum INT,
+			installed_by VARCHAR(100) NOT NULL,
+			installed_on TIMESTAMP NOT NULL DEFAULT NOW(),
+			execution_time INT NOT NULL,
+			success BOOLEAN NOT NULL
+		);
+		CREATE INDEX IF NOT EXISTS %s ON %s (success);
+	`, table, pq.QuoteIdentifier(m.cfg.table+"_s_idx"), table)
+	if _, err := conn.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to create schema history table: %w", err)
+	}
+	return nil
+}
+
+// lockKey derives the advisory lock key from the history table name, so
+// migrations tracked in different tables do not block each other
+func (m *Migrator) lockKey() int64 {
+	return int64(crc32.ChecksumIEEE([]byte("prosigliere.migrate." + m.cfg.table)))
+}
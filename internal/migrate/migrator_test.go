@@ -0,0 +1,276 @@
+package migrate_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/agruetz/prosigliere/internal/migrate"
+)
+
+var testMigrations = fstest.MapFS{
+	"V1__create_a.sql": {Data: []byte("CREATE TABLE a (id INT);")},
+	"V2__create_b.sql": {Data: []byte("CREATE TABLE b (id INT);")},
+}
+
+var historyColumns = []string{"installed_rank", "version", "description", "script", "checksum", "installed_on", "execution_time", "success", "baseline"}
+
+func TestMigrate(t *testing.T) {
+	checksumA := migrate.Checksum([]byte("CREATE TABLE a (id INT);"))
+
+	// Define test cases
+	tests := []struct {
+		name             string
+		mockSetup        func(mock sqlmock.Sqlmock)
+		expectError      bool
+		errorMsg         string
+		expectedVersions []string
+	}{
+		{
+			name: "fresh database",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("SELECT pg_advisory_lock").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`CREATE TABLE IF NOT EXISTS "schema_version"`).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("SELECT to_regclass").
+					WithArgs("schema_version").
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+				mock.ExpectQuery("SELECT installed_rank, version").
+					WillReturnRows(sqlmock.NewRows(historyColumns))
+				mock.ExpectQuery(`SELECT COALESCE\(MAX\(installed_rank\), 0\) FROM "schema_version"`).
+					WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(0))
+
+				for rank, script := range []string{"CREATE TABLE a (id INT);", "CREATE TABLE b (id INT);"} {
+					mock.ExpectBegin()
+					mock.ExpectExec(regexp.QuoteMeta(script)).WillReturnResult(sqlmock.NewResult(0, 0))
+					mock.ExpectExec(`INSERT INTO "schema_version"`).
+						WithArgs(rank+1, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), migrate.Checksum([]byte(script)), "", sqlmock.AnyArg()).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectCommit()
+				}
+
+				mock.ExpectExec("SELECT pg_advisory_unlock").WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectedVersions: []string{"1", "2"},
+		},
+		{
+			name: "partially migrated database",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("SELECT pg_advisory_lock").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`CREATE TABLE IF NOT EXISTS "schema_version"`).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("SELECT to_regclass").
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+				mock.ExpectQuery("SELECT installed_rank, version").
+					WillReturnRows(sqlmock.NewRows(historyColumns).
+						AddRow(1, "1", "create a", "V1__create_a.sql", checksumA, time.Now(), 12, true, false))
+				mock.ExpectQuery("SELECT COALESCE").
+					WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(1))
+
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE b (id INT);")).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`INSERT INTO "schema_version"`).
+					WithArgs(2, "2", "create b", "V2__create_b.sql", sqlmock.AnyArg(), "", sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+
+				mock.ExpectExec("SELECT pg_advisory_unlock").WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectedVersions: []string{"2"},
+		},
+		{
+			name: "baselined Flyway history",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("SELECT pg_advisory_lock").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`CREATE TABLE IF NOT EXISTS "schema_version"`).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("SELECT to_regclass").
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+				// Flyway baselined the database at V1, and later ran a repeatable
+				// migration at rank 2 that is not read as applied
+				mock.ExpectQuery(regexp.QuoteMeta("WHERE type IN ('SQL', 'BASELINE') AND version IS NOT NULL")).
+					WillReturnRows(sqlmock.NewRows(historyColumns).
+						AddRow(1, "1", "<< Flyway Baseline >>", "<< Flyway Baseline >>", nil, time.Now(), 0, true, true))
+				mock.ExpectQuery("SELECT COALESCE").
+					WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(2))
+
+				// V1 is below the baseline, and V2 is ranked after every row
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE b (id INT);")).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`INSERT INTO "schema_version"`).
+					WithArgs(3, "2", "create b", "V2__create_b.sql", sqlmock.AnyArg(), "", sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+
+				mock.ExpectExec("SELECT pg_advisory_unlock").WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectedVersions: []string{"2"},
+		},
+		{
+			name: "checksum mismatch",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("SELECT pg_advisory_lock").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`CREATE TABLE IF NOT EXISTS "schema_version"`).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("SELECT to_regclass").
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+				mock.ExpectQuery("SELECT installed_rank, version").
+					WillReturnRows(sqlmock.NewRows(historyColumns).
+						AddRow(1, "1", "create a", "V1__create_a.sql", checksumA+1, time.Now(), 12, true, false))
+				mock.ExpectExec("SELECT pg_advisory_unlock").WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectError: true,
+			errorMsg:    "checksum mismatch for migration V1",
+		},
+		{
+			name: "failed migration rolls back",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("SELECT pg_advisory_lock").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`CREATE TABLE IF NOT EXISTS "schema_version"`).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("SELECT to_regclass").
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+				mock.ExpectQuery("SELECT installed_rank, version").
+					WillReturnRows(sqlmock.NewRows(historyColumns))
+				mock.ExpectQuery("SELECT COALESCE").
+					WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(0))
+
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE a (id INT);")).WillReturnError(errors.New("syntax error"))
+				mock.ExpectRollback()
+
+				mock.ExpectExec("SELECT pg_advisory_unlock").WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectError: true,
+			errorMsg:    "migration V1 (V1__create_a.sql) failed",
+		},
+	}
+
+	// Run test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new mock database
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Set up expectations
+			tc.mockSetup(mock)
+
+			migrator, err := migrate.New(db, testMigrations)
+			require.NoError(t, err)
+
+			// Call the method
+			applied, err := migrator.Migrate(context.Background())
+
+			// Assert expectations
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+				versions := make([]string, 0, len(applied))
+				for _, migration := range applied {
+					versions = append(versions, migration.Version)
+				}
+				assert.Equal(t, tc.expectedVersions, versions)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestStatus(t *testing.T) {
+	checksumA := migrate.Checksum([]byte("CREATE TABLE a (id INT);"))
+
+	// Create a new mock database
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT to_regclass").
+		WithArgs("flyway_schema_history").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery(`SELECT installed_rank, version, .* FROM "flyway_schema_history"`).
+		WillReturnRows(sqlmock.NewRows(historyColumns).
+			AddRow(1, "1", "create a", "V1__create_a.sql", checksumA, time.Now(), 12, true, false).
+			AddRow(2, "0.5", "removed", "V0_5__removed.sql", 42, time.Now(), 3, true, false))
+
+	migrator, err := migrate.New(db, testMigrations, migrate.WithTable("flyway_schema_history"))
+	require.NoError(t, err)
+
+	infos, err := migrator.Status(context.Background())
+	require.NoError(t, err)
+	require.Len(t, infos, 3)
+
+	assert.Equal(t, "1", infos[0].Version)
+	assert.Equal(t, migrate.StateApplied, infos[0].State)
+	assert.Equal(t, 12*time.Millisecond, infos[0].ExecutionTime)
+	assert.Equal(t, "2", infos[1].Version)
+	assert.Equal(t, migrate.StatePending, infos[1].State)
+	assert.Equal(t, "0.5", infos[2].Version)
+	assert.Equal(t, migrate.StateMissing, infos[2].State)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStatusBaseline(t *testing.T) {
+	// Create a new mock database
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT to_regclass").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery("SELECT installed_rank, version").
+		WillReturnRows(sqlmock.NewRows(historyColumns).
+			AddRow(1, "1.5", "<< Flyway Baseline >>", "<< Flyway Baseline >>", nil, time.Now(), 0, true, true))
+
+	migrator, err := migrate.New(db, testMigrations)
+	require.NoError(t, err)
+
+	infos, err := migrator.Status(context.Background())
+	require.NoError(t, err)
+	require.Len(t, infos, 3)
+
+	assert.Equal(t, "1", infos[0].Version)
+	assert.Equal(t, migrate.StateBelowBaseline, infos[0].State)
+	assert.Equal(t, "2", infos[1].Version)
+	assert.Equal(t, migrate.StatePending, infos[1].State)
+	assert.Equal(t, "1.5", infos[2].Version)
+	assert.Equal(t, migrate.StateBaseline, infos[2].State)
+
+	// A dry run skips migrations below the baseline too
+	mock.ExpectQuery("SELECT to_regclass").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery("SELECT installed_rank, version").
+		WillReturnRows(sqlmock.NewRows(historyColumns).
+			AddRow(1, "1.5", "<< Flyway Baseline >>", "<< Flyway Baseline >>", nil, time.Now(), 0, true, true))
+	pending, err := migrator.Pending(context.Background())
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, "2", pending[0].Version)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPendingWithoutHistory(t *testing.T) {
+	// Create a new mock database
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT to_regclass").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+	migrator, err := migrate.New(db, testMigrations)
+	require.NoError(t, err)
+
+	// A dry run never touches the schema
+	pending, err := migrator.Pending(context.Background())
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	assert.Equal(t, "1", pending[0].Version)
+	assert.Equal(t, "2", pending[1].Version)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,35 @@
+// Package migrate applies versioned SQL migrations to a PostgreSQL database
+package migrate
+
+// config holds the configuration for a Migrator
+type config struct {
+	table       string
+	installedBy string
+}
+
+// defaultConfig returns the default configuration for a Migrator. The history
+// table name matches flyway.table in db/flyway.conf, so databases migrated by
+// Flyway are picked up as is.
+func defaultConfig() *config {
+	return &config{
+		table: "schema_version",
+	}
+}
+
+// Option is a function that modifies config
+type Option func(*config)
+
+// WithTable sets the name of the schema history table
+func WithTable(table string) Option {
+	return func(c *config) {
+		c.table = table
+	}
+}
+
+// WithInstalledBy sets the user recorded in the schema history table. It
+// defaults to the current database user.
+func WithInstalledBy(installedBy string) Option {
+	return func(c *config) {
+		c.installedBy = installedBy
+	}
+}
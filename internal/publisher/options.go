@@ -0,0 +1,57 @@
+// Package publisher publishes scheduled blogs once their publish time has come
+package publisher
+
+import (
+	"io"
+	"log"
+	"time"
+)
+
+// config holds the configuration for a Publisher
+type config struct {
+	interval  time.Duration
+	batchSize int32
+	logger    *log.Logger
+	now       func() time.Time
+}
+
+// defaultConfig returns the default configuration for a Publisher
+func defaultConfig() *config {
+	return &config{
+		interval:  time.Minute,
+		batchSize: 100,
+		logger:    log.New(io.Discard, "", 0),
+		now:       time.Now,
+	}
+}
+
+// Option is a function that modifies config
+type Option func(*config)
+
+// WithInterval sets how often the publisher checks for due blogs
+func WithInterval(interval time.Duration) Option {
+	return func(c *config) {
+		c.interval = interval
+	}
+}
+
+// WithBatchSize sets how many blogs are published per database round trip
+func WithBatchSize(batchSize int32) Option {
+	return func(c *config) {
+		c.batchSize = batchSize
+	}
+}
+
+// WithLogger sets the logger used to report published blogs and failures
+func WithLogger(logger *log.Logger) Option {
+	return func(c *config) {
+		c.logger = logger
+	}
+}
+
+// WithClock sets the function returning the current time, for tests
+func WithClock(now func() time.Time) Option {
+	return func(c *config) {
+		c.now = now
+	}
+}
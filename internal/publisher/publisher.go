@@ -0,0 +1,76 @@
+// Package publisher publishes scheduled blogs once their publish time has come
+package publisher
+
+import (
+	"context"
+	"time"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+)
+
+// AuditActor names the publisher in the audit events of the blogs it publishes
+const AuditActor = "system:publisher"
+
+// Publisher periodically publishes scheduled blogs that are due. Several
+// publishers may share a store, e.g. one per server replica, as the store
+// guarantees that every blog is published only once.
+type Publisher struct {
+	store datastore.Store
+	cfg   *config
+}
+
+// New creates a Publisher for the given store
+func New(store datastore.Store, opts ...Option) *Publisher {
+	cfg := defaultConfig()
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return &Publisher{
+		store: store,
+		cfg:   cfg,
+	}
+}
+
+// Run publishes due blogs every interval until ctx is canceled. Failures are
+// logged and retried on the next tick.
+func (p *Publisher) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.cfg.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.PublishDue(ctx); err != nil && ctx.Err() == nil {
+			p.cfg.logger.Printf("Failed to publish scheduled blogs: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PublishDue publishes all blogs that are due now, a batch at a time, and
+// returns how many it published
+func (p *Publisher) PublishDue(ctx context.Context) (int, error) {
+	ctx = datastore.NewAuditContext(ctx, datastore.AuditInfo{Actor: AuditActor})
+	now := p.cfg.now()
+	total := 0
+	for {
+		ids, err := p.store.PublishScheduled(ctx, now, p.cfg.batchSize)
+		if err != nil {
+			return total, err
+		}
+
+		for _, id := range ids {
+			p.cfg.logger.Printf("Published scheduled blog %s", id)
+		}
+		total += len(ids)
+
+		// A partial batch means nothing else is due
+		if len(ids) < int(p.cfg.batchSize) {
+			return total, nil
+		}
+	}
+}
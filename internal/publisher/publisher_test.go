@@ -0,0 +1,103 @@
+package publisher_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/datastore/memory"
+	"github.com/agruetz/prosigliere/internal/datastore/mocks"
+	"github.com/agruetz/prosigliere/internal/publisher"
+)
+
+func TestPublishDue(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	now := time.Now()
+
+	// More due blogs than fit in one batch
+	var due []datastore.ID
+	for i := 0; i < 5; i++ {
+		publishAt := now.Add(-time.Duration(i+1) * time.Minute)
+		id, err := store.Create(ctx, "Due Title", "Test Content", datastore.StatusScheduled, &publishAt, nil)
+		require.NoError(t, err)
+		due = append(due, id)
+	}
+	future := now.Add(time.Hour)
+	futureID, err := store.Create(ctx, "Future Title", "Test Content", datastore.StatusScheduled, &future, nil)
+	require.NoError(t, err)
+
+	p := publisher.New(store,
+		publisher.WithBatchSize(2),
+		publisher.WithClock(func() time.Time { return now }),
+	)
+
+	published, err := p.PublishDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 5, published)
+
+	for _, id := range due {
+		blog, err := store.Get(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, datastore.StatusPublished, blog.Status)
+	}
+
+	blog, err := store.Get(ctx, futureID)
+	require.NoError(t, err)
+	assert.Equal(t, datastore.StatusScheduled, blog.Status)
+
+	// The publisher is named in the audit log
+	events, _, err := store.ListAuditEvents(ctx, datastore.AuditFilter{Actor: publisher.AuditActor}, 10, "")
+	require.NoError(t, err)
+	assert.Len(t, events, 5)
+
+	// Nothing is left to publish until the clock moves on
+	published, err = p.PublishDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, published)
+}
+
+func TestPublishDueError(t *testing.T) {
+	mockStore := mocks.NewStore(t)
+	mockStore.On("PublishScheduled", mock.Anything, mock.Anything, int32(100)).
+		Return(nil, datastore.Unavailable(errors.New("connection refused")))
+
+	published, err := publisher.New(mockStore).PublishDue(context.Background())
+	assert.ErrorIs(t, err, datastore.ErrUnavailable)
+	assert.Equal(t, 0, published)
+}
+
+func TestRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	store := memory.New()
+
+	publishAt := time.Now().Add(50 * time.Millisecond)
+	id, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusScheduled, &publishAt, nil)
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		publisher.New(store, publisher.WithInterval(10*time.Millisecond)).Run(ctx)
+	}()
+
+	// The blog is published on a later tick once it becomes due
+	assert.Eventually(t, func() bool {
+		blog, err := store.Get(ctx, id)
+		return err == nil && blog.Status == datastore.StatusPublished
+	}, time.Second, 10*time.Millisecond)
+
+	// Canceling the context stops the publisher
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("publisher did not stop after cancellation")
+	}
+}
@@ -0,0 +1,67 @@
+// Package purger permanently removes blogs that have been in the trash for
+// longer than the retention period
+package purger
+
+import (
+	"io"
+	"log"
+	"time"
+)
+
+// config holds the configuration for a Purger
+type config struct {
+	interval  time.Duration
+	retention time.Duration
+	batchSize int32
+	logger    *log.Logger
+	now       func() time.Time
+}
+
+// defaultConfig returns the default configuration for a Purger
+func defaultConfig() *config {
+	return &config{
+		interval:  time.Hour,
+		retention: 30 * 24 * time.Hour,
+		batchSize: 100,
+		logger:    log.New(io.Discard, "", 0),
+		now:       time.Now,
+	}
+}
+
+// Option is a function that modifies config
+type Option func(*config)
+
+// WithInterval sets how often the purger checks for expired blogs
+func WithInterval(interval time.Duration) Option {
+	return func(c *config) {
+		c.interval = interval
+	}
+}
+
+// WithRetention sets how long blogs are kept in the trash before being purged
+func WithRetention(retention time.Duration) Option {
+	return func(c *config) {
+		c.retention = retention
+	}
+}
+
+// WithBatchSize sets how many blogs are purged per database round trip
+func WithBatchSize(batchSize int32) Option {
+	return func(c *config) {
+		c.batchSize = batchSize
+	}
+}
+
+// WithLogger sets the logger used to report purged blogs and failures
+func WithLogger(logger *log.Logger) Option {
+	return func(c *config) {
+		c.logger = logger
+	}
+}
+
+// WithClock sets the function returning the current time, for tests
+func WithClock(now func() time.Time) Option {
+	return func(c *config) {
+		c.now = now
+	}
+}
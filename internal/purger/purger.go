@@ -0,0 +1,77 @@
+// Package purger permanently removes blogs that have been in the trash for
+// longer than the retention period
+package purger
+
+import (
+	"context"
+	"time"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+)
+
+// AuditActor names the purger in the audit events of the blogs it purges
+const AuditActor = "system:purger"
+
+// Purger periodically purges blogs whose retention in the trash has expired,
+// along with their comments. Several purgers may share a store, as the store
+// guarantees that every blog is purged only once.
+type Purger struct {
+	store datastore.Store
+	cfg   *config
+}
+
+// New creates a Purger for the given store
+func New(store datastore.Store, opts ...Option) *Purger {
+	cfg := defaultConfig()
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return &Purger{
+		store: store,
+		cfg:   cfg,
+	}
+}
+
+// Run purges expired blogs every interval until ctx is canceled. Failures
+// are logged and retried on the next tick.
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.cfg.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.PurgeExpired(ctx); err != nil && ctx.Err() == nil {
+			p.cfg.logger.Printf("Failed to purge deleted blogs: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeExpired purges all blogs deleted longer than the retention period ago,
+// a batch at a time, and returns how many it purged
+func (p *Purger) PurgeExpired(ctx context.Context) (int, error) {
+	ctx = datastore.NewAuditContext(ctx, datastore.AuditInfo{Actor: AuditActor})
+	before := p.cfg.now().Add(-p.cfg.retention)
+	total := 0
+	for {
+		ids, err := p.store.PurgeDeleted(ctx, before, p.cfg.batchSize)
+		if err != nil {
+			return total, err
+		}
+
+		for _, id := range ids {
+			p.cfg.logger.Printf("Purged deleted blog %s", id)
+		}
+		total += len(ids)
+
+		// A partial batch means nothing else has expired
+		if len(ids) < int(p.cfg.batchSize) {
+			return total, nil
+		}
+	}
+}
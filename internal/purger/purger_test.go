@@ -0,0 +1,106 @@
+package purger_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/datastore/memory"
+	"github.com/agruetz/prosigliere/internal/datastore/mocks"
+	"github.com/agruetz/prosigliere/internal/purger"
+)
+
+func TestPurgeExpired(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+
+	// More trashed blogs than fit in one batch
+	var trashed []datastore.ID
+	for i := 0; i < 5; i++ {
+		id, err := store.Create(ctx, "Trashed Title", "Test Content", datastore.StatusPublished, nil, nil)
+		require.NoError(t, err)
+		require.NoError(t, store.Delete(ctx, id, 0))
+		trashed = append(trashed, id)
+	}
+	liveID, err := store.Create(ctx, "Live Title", "Test Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+
+	// Nothing has expired while the clock stands still
+	now := time.Now()
+	p := purger.New(store,
+		purger.WithRetention(time.Hour),
+		purger.WithBatchSize(2),
+		purger.WithClock(func() time.Time { return now }),
+	)
+
+	purged, err := p.PurgeExpired(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, purged)
+
+	// Once the retention has passed every trashed blog is purged
+	now = now.Add(2 * time.Hour)
+	purged, err = p.PurgeExpired(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 5, purged)
+
+	for _, id := range trashed {
+		_, err := store.Get(ctx, id, datastore.WithDeleted())
+		assert.ErrorIs(t, err, datastore.ErrNotFound)
+	}
+
+	_, err = store.Get(ctx, liveID)
+	require.NoError(t, err)
+
+	// The purger is named in the audit log
+	events, _, err := store.ListAuditEvents(ctx, datastore.AuditFilter{Actor: purger.AuditActor}, 10, "")
+	require.NoError(t, err)
+	assert.Len(t, events, 5)
+}
+
+func TestPurgeExpiredError(t *testing.T) {
+	mockStore := mocks.NewStore(t)
+	mockStore.On("PurgeDeleted", mock.Anything, mock.Anything, int32(100)).
+		Return(nil, datastore.Unavailable(errors.New("connection refused")))
+
+	purged, err := purger.New(mockStore).PurgeExpired(context.Background())
+	assert.ErrorIs(t, err, datastore.ErrUnavailable)
+	assert.Equal(t, 0, purged)
+}
+
+func TestRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	store := memory.New()
+
+	id, err := store.Create(ctx, "Test Title", "Test Content", datastore.StatusPublished, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, store.Delete(ctx, id, 0))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		purger.New(store,
+			purger.WithInterval(10*time.Millisecond),
+			purger.WithRetention(50*time.Millisecond),
+		).Run(ctx)
+	}()
+
+	// The blog is purged on a later tick once its retention has passed
+	assert.Eventually(t, func() bool {
+		_, err := store.Get(ctx, id, datastore.WithDeleted())
+		return errors.Is(err, datastore.ErrNotFound)
+	}, time.Second, 10*time.Millisecond)
+
+	// Canceling the context stops the purger
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("purger did not stop after cancellation")
+	}
+}
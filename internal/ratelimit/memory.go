@@ -0,0 +1,109 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often buckets that have filled up again are dropped
+const sweepInterval = time.Minute
+
+// config holds the configuration for in-memory buckets
+type config struct {
+	now func() time.Time
+}
+
+// defaultConfig returns the default configuration for in-memory buckets
+func defaultConfig() *config {
+	return &config{
+		now: time.Now,
+	}
+}
+
+// Option is a function that modifies config
+type Option func(*config)
+
+// WithClock sets the function returning the current time, for tests
+func WithClock(now func() time.Time) Option {
+	return func(c *config) {
+		c.now = now
+	}
+}
+
+// bucket is the state of a token bucket
+type bucket struct {
+	tokens float64
+	at     time.Time
+	full   time.Time
+}
+
+// Memory keeps token buckets in memory. Buckets that have filled up again
+// are dropped, since they start full anyway.
+type Memory struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewMemory creates empty in-memory buckets
+func NewMemory(opts ...Option) *Memory {
+	cfg := defaultConfig()
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return &Memory{
+		buckets:   make(map[string]*bucket),
+		lastSweep: cfg.now(),
+		now:       cfg.now,
+	}
+}
+
+// Take implements Buckets
+func (m *Memory) Take(ctx context.Context, key string, limit Limit) (Result, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.sweep(now)
+
+	interval := limit.Interval()
+	burst := float64(limit.Burst)
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &bucket{tokens: burst, at: now}
+		m.buckets[key] = b
+	}
+	if elapsed := now.Sub(b.at); elapsed > 0 {
+		b.tokens = math.Min(burst, b.tokens+float64(elapsed)/float64(interval))
+		b.at = now
+	}
+
+	result := Result{Limit: limit.Burst}
+	if b.tokens >= 1 {
+		b.tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = time.Duration((1 - b.tokens) * float64(interval))
+	}
+	result.Remaining = int(b.tokens)
+	result.Reset = time.Duration((burst - b.tokens) * float64(interval))
+	b.full = now.Add(result.Reset)
+	return result, nil
+}
+
+// sweep drops the buckets that have filled up again, at most once every
+// sweepInterval
+func (m *Memory) sweep(now time.Time) {
+	if now.Sub(m.lastSweep) < sweepInterval {
+		return
+	}
+	m.lastSweep = now
+	for key, b := range m.buckets {
+		if !b.full.After(now) {
+			delete(m.buckets, key)
+		}
+	}
+}
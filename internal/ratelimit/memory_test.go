@@ -0,0 +1,67 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryTake(t *testing.T) {
+	now := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	buckets := NewMemory(WithClock(func() time.Time { return now }))
+	limit := Limit{Methods: []string{"*"}, Requests: 1, Per: 10 * time.Second, Burst: 3}
+	ctx := context.Background()
+
+	// New buckets start full
+	for remaining := 2; remaining >= 0; remaining-- {
+		result, err := buckets.Take(ctx, "alice", limit)
+		require.NoError(t, err)
+		assert.Equal(t, Result{Allowed: true, Limit: 3, Remaining: remaining, Reset: time.Duration(3-remaining) * 10 * time.Second}, result)
+	}
+
+	result, err := buckets.Take(ctx, "alice", limit)
+	require.NoError(t, err)
+	assert.Equal(t, Result{Limit: 3, Reset: 30 * time.Second, RetryAfter: 10 * time.Second}, result)
+
+	// Part of a token is not enough
+	now = now.Add(4 * time.Second)
+	result, err = buckets.Take(ctx, "alice", limit)
+	require.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, 6*time.Second, result.RetryAfter)
+
+	now = now.Add(6 * time.Second)
+	result, err = buckets.Take(ctx, "alice", limit)
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 0, result.Remaining)
+
+	// Buckets do not fill beyond their burst
+	now = now.Add(time.Hour)
+	result, err = buckets.Take(ctx, "alice", limit)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Remaining)
+}
+
+func TestMemorySweep(t *testing.T) {
+	now := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	buckets := NewMemory(WithClock(func() time.Time { return now }))
+	ctx := context.Background()
+
+	_, err := buckets.Take(ctx, "alice", Limit{Requests: 1, Per: time.Second, Burst: 1})
+	require.NoError(t, err)
+	_, err = buckets.Take(ctx, "bob", Limit{Requests: 1, Per: time.Hour, Burst: 1})
+	require.NoError(t, err)
+	assert.Len(t, buckets.buckets, 2)
+
+	// Full buckets are dropped once a sweep is due
+	now = now.Add(sweepInterval)
+	_, err = buckets.Take(ctx, "carol", Limit{Requests: 1, Per: time.Second, Burst: 1})
+	require.NoError(t, err)
+	assert.Len(t, buckets.buckets, 2)
+	assert.Contains(t, buckets.buckets, "bob")
+	assert.Contains(t, buckets.buckets, "carol")
+}
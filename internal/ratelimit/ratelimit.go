@@ -0,0 +1,173 @@
+// Package ratelimit limits how often callers may call the methods of the
+// services, with a token bucket per caller and limit.
+package ratelimit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Limit allows a number of requests per period, in bursts of up to Burst
+// requests
+type Limit struct {
+	// Methods are full gRPC method names such as /blog.v1.Blogs/Create.
+	// A name ending in /* covers every method of a service, and * covers
+	// every method.
+	Methods []string `yaml:"methods"`
+
+	// Requests is how many requests are allowed per period
+	Requests int `yaml:"requests"`
+
+	// Per is the period, a second if left out
+	Per time.Duration `yaml:"per"`
+
+	// Burst is how many requests may be made at once, Requests if left out
+	Burst int `yaml:"burst"`
+}
+
+// Interval returns how long the bucket of the limit takes to refill a token
+func (l Limit) Interval() time.Duration {
+	return l.Per / time.Duration(l.Requests)
+}
+
+// Result is the outcome of taking a token from a bucket
+type Result struct {
+	// Allowed reports whether a token was taken
+	Allowed bool
+
+	// Limit is how many tokens the bucket holds when full
+	Limit int
+
+	// Remaining is how many tokens are left in the bucket
+	Remaining int
+
+	// Reset is how long the bucket takes to fill up again
+	Reset time.Duration
+
+	// RetryAfter is how long to wait until a token is available, zero if
+	// one was taken
+	RetryAfter time.Duration
+}
+
+// Buckets holds the token buckets of callers. The in-memory implementation
+// suits a single server; servers running as several replicas share their
+// buckets through an implementation backed by a shared store.
+type Buckets interface {
+	// Take takes a token from the bucket under key, which holds up to
+	// limit.Burst tokens and refills one every limit.Interval(). Buckets
+	// that do not exist yet start full.
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// Limiter applies a set of limits to callers, each caller having a bucket
+// per limit
+type Limiter struct {
+	limits  []Limit
+	buckets Buckets
+}
+
+// New creates a limiter keeping its buckets in buckets. A call is limited by
+// the first limit covering its method, and methods no limit covers are not
+// limited.
+func New(buckets Buckets, limits ...Limit) (*Limiter, error) {
+	for i := range limits {
+		if err := validateLimit(&limits[i]); err != nil {
+			return nil, fmt.Errorf("limit %d: %w", i+1, err)
+		}
+	}
+	return &Limiter{limits: limits, buckets: buckets}, nil
+}
+
+// Load creates a limiter from a YAML or JSON file
+func Load(path string, buckets Buckets) (*Limiter, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rate limits: %w", err)
+	}
+	return Parse(data, buckets)
+}
+
+// Parse creates a limiter from a YAML or JSON document with a list of limits
+// under limits. Unknown fields are rejected so that misspelled limits do not
+// go unnoticed.
+func Parse(data []byte, buckets Buckets) (*Limiter, error) {
+	var doc struct {
+		Limits []Limit `yaml:"limits"`
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&doc); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse rate limits: %w", err)
+	}
+	return New(buckets, doc.Limits...)
+}
+
+// validateLimit checks that a limit can match something and fills in its
+// defaults
+func validateLimit(limit *Limit) error {
+	if len(limit.Methods) == 0 {
+		return errors.New("no methods")
+	}
+	for _, method := range limit.Methods {
+		if method != "*" && (!strings.HasPrefix(method, "/") || strings.Count(method, "/") != 2) {
+			return fmt.Errorf("method %q is not a full gRPC method name", method)
+		}
+	}
+	if limit.Requests <= 0 {
+		return errors.New("requests must be positive")
+	}
+	if limit.Per < 0 || limit.Burst < 0 {
+		return errors.New("per and burst cannot be negative")
+	}
+	if limit.Per == 0 {
+		limit.Per = time.Second
+	}
+	if limit.Burst == 0 {
+		limit.Burst = limit.Requests
+	}
+	if limit.Interval() <= 0 {
+		return fmt.Errorf("%d requests per %s is too many", limit.Requests, limit.Per)
+	}
+	return nil
+}
+
+// covers reports whether a limit applies to a full method name
+func (l Limit) covers(fullMethod string) bool {
+	for _, method := range l.Methods {
+		switch {
+		case method == "*", method == fullMethod:
+			return true
+		case strings.HasSuffix(method, "/*"):
+			if strings.HasPrefix(fullMethod, strings.TrimSuffix(method, "*")) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Allow takes a token for a call of a method by the caller identified by
+// key. ok is false if no limit covers the method.
+func (l *Limiter) Allow(ctx context.Context, fullMethod, key string) (result Result, ok bool, err error) {
+	for i, limit := range l.limits {
+		if !limit.covers(fullMethod) {
+			continue
+		}
+		// Calls of every method a limit covers share the bucket
+		result, err := l.buckets.Take(ctx, strconv.Itoa(i)+"/"+key, limit)
+		if err != nil {
+			return Result{}, false, fmt.Errorf("failed to take token: %w", err)
+		}
+		return result, true, nil
+	}
+	return Result{}, false, nil
+}
@@ -0,0 +1,198 @@
+package ratelimit_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/agruetz/prosigliere/internal/ratelimit"
+)
+
+const testLimits = `
+limits:
+  - methods: [/blog.v1.Blogs/AddComment]
+    requests: 2
+    per: 1m
+  - methods: [/blog.v1.Blogs/Create, /blog.v1.Blogs/Update]
+    requests: 1
+    per: 1h
+    burst: 2
+  - methods: [/blog.v1.Admin/*]
+    requests: 100
+`
+
+// failingBuckets is a shared bucket store that is unavailable
+type failingBuckets struct{}
+
+func (failingBuckets) Take(ctx context.Context, key string, limit ratelimit.Limit) (ratelimit.Result, error) {
+	return ratelimit.Result{}, errors.New("connection refused")
+}
+
+func TestLimiterAllow(t *testing.T) {
+	now := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	limiter, err := ratelimit.Parse([]byte(testLimits), ratelimit.NewMemory(ratelimit.WithClock(func() time.Time { return now })))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// Methods no limit covers are not limited
+	_, ok, err := limiter.Allow(ctx, "/blog.v1.Blogs/Get", "alice")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// Callers have buckets of their own
+	for _, key := range []string{"alice", "alice", "bob"} {
+		result, ok, err := limiter.Allow(ctx, "/blog.v1.Blogs/AddComment", key)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.True(t, result.Allowed, key)
+	}
+	result, _, err := limiter.Allow(ctx, "/blog.v1.Blogs/AddComment", "alice")
+	require.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, 2, result.Limit)
+	assert.Equal(t, 0, result.Remaining)
+	assert.Equal(t, 30*time.Second, result.RetryAfter)
+	assert.Equal(t, time.Minute, result.Reset)
+
+	// Methods of a limit share its bucket, but not those of other limits
+	result, _, err = limiter.Allow(ctx, "/blog.v1.Blogs/Create", "alice")
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 1, result.Remaining)
+	result, _, err = limiter.Allow(ctx, "/blog.v1.Blogs/Update", "alice")
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 0, result.Remaining)
+	result, _, err = limiter.Allow(ctx, "/blog.v1.Blogs/Create", "alice")
+	require.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, time.Hour, result.RetryAfter)
+
+	// Service wildcards
+	result, ok, err = limiter.Allow(ctx, "/blog.v1.Admin/ListAPIKeys", "alice")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 100, result.Limit)
+
+	// Buckets refill over time
+	now = now.Add(30 * time.Second)
+	result, _, err = limiter.Allow(ctx, "/blog.v1.Blogs/AddComment", "alice")
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+}
+
+func TestLimiterAllowError(t *testing.T) {
+	limiter, err := ratelimit.Parse([]byte(testLimits), failingBuckets{})
+	require.NoError(t, err)
+
+	_, _, err = limiter.Allow(context.Background(), "/blog.v1.Blogs/AddComment", "alice")
+	assert.ErrorContains(t, err, "failed to take token: connection refused")
+}
+
+func TestParse(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name     string
+		limits   string
+		errorMsg string
+	}{
+		{
+			name:   "JSON",
+			limits: `{"limits": [{"methods": ["*"], "requests": 10, "per": "1m"}]}`,
+		},
+		{
+			name:   "empty",
+			limits: "",
+		},
+		{
+			name:     "unknown field",
+			limits:   "limits:\n  - methods: [\"*\"]\n    rate: 10\n",
+			errorMsg: "failed to parse rate limits",
+		},
+		{
+			name:     "no methods",
+			limits:   "limits:\n  - requests: 10\n",
+			errorMsg: "limit 1: no methods",
+		},
+		{
+			name:     "short method name",
+			limits:   "limits:\n  - methods: [Create]\n    requests: 10\n",
+			errorMsg: `limit 1: method "Create" is not a full gRPC method name`,
+		},
+		{
+			name:     "no requests",
+			limits:   "limits:\n  - methods: [\"*\"]\n",
+			errorMsg: "limit 1: requests must be positive",
+		},
+		{
+			name:     "negative burst",
+			limits:   "limits:\n  - methods: [\"*\"]\n    requests: 10\n    burst: -1\n",
+			errorMsg: "limit 1: per and burst cannot be negative",
+		},
+		{
+			name:     "too many requests",
+			limits:   "limits:\n  - methods: [\"*\"]\n    requests: 10\n    per: 1ns\n",
+			errorMsg: "limit 1: 10 requests per 1ns is too many",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter, err := ratelimit.Parse([]byte(tt.limits), ratelimit.NewMemory())
+			if tt.errorMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, limiter)
+		})
+	}
+}
+
+func TestLimitDefaults(t *testing.T) {
+	limiter, err := ratelimit.Parse([]byte("limits:\n  - methods: [\"*\"]\n    requests: 5\n"), ratelimit.NewMemory())
+	require.NoError(t, err)
+
+	// A second and a burst of the requests
+	result, _, err := limiter.Allow(context.Background(), "/blog.v1.Blogs/Get", "alice")
+	require.NoError(t, err)
+	assert.Equal(t, 5, result.Limit)
+	assert.Equal(t, 4, result.Remaining)
+	assert.Equal(t, 200*time.Millisecond, result.Reset)
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "limits.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testLimits), 0o600))
+
+	limiter, err := ratelimit.Load(path, ratelimit.NewMemory())
+	require.NoError(t, err)
+	_, ok, err := limiter.Allow(context.Background(), "/blog.v1.Blogs/AddComment", "alice")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	_, err = ratelimit.Load(filepath.Join(t.TempDir(), "missing.yaml"), ratelimit.NewMemory())
+	assert.ErrorContains(t, err, "failed to read rate limits")
+}
+
+func TestLoadExample(t *testing.T) {
+	limiter, err := ratelimit.Load("../../cmd/server/ratelimits.example.yaml", ratelimit.NewMemory())
+	require.NoError(t, err)
+
+	result, ok, err := limiter.Allow(context.Background(), "/blog.v1.Blogs/AddComment", "alice")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 3, result.Limit)
+
+	result, ok, err = limiter.Allow(context.Background(), "/blog.v1.Blogs/Get", "alice")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 50, result.Limit)
+}
@@ -0,0 +1,182 @@
+// Package search parses full-text search queries and matches them against
+// text. Matching follows the PostgreSQL full-text search without stemming or
+// stop words, for stores that cannot rely on the database.
+package search
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+)
+
+// Snippet settings, matching the ts_headline defaults
+const (
+	snippetLead     = 5  // words shown before the first match
+	snippetMaxWords = 35 // words shown in total
+)
+
+// Token is a word of a text
+type Token struct {
+	Word  string // the word in lower case
+	Start int    // byte offset of the word in the text
+	End   int    // byte offset just past the word
+}
+
+// Tokenize splits text into words of letters and digits
+func Tokenize(text string) []Token {
+	var tokens []Token
+	start := -1
+	for i, r := range text {
+		isWord := unicode.IsLetter(r) || unicode.IsDigit(r)
+		switch {
+		case isWord && start < 0:
+			start = i
+		case !isWord && start >= 0:
+			tokens = append(tokens, newToken(text, start, i))
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, newToken(text, start, len(text)))
+	}
+	return tokens
+}
+
+// newToken returns the token for text[start:end]
+func newToken(text string, start, end int) Token {
+	return Token{Word: strings.ToLower(text[start:end]), Start: start, End: end}
+}
+
+// Parse parses a search query into the terms a text must contain. Words in
+// double quotes form a phrase, and a trailing * makes the last word of a word
+// or phrase a prefix. Other punctuation separates words, so "e-mail" is the
+// phrase "e mail". Queries without any words have no terms.
+func Parse(q string) []datastore.SearchTerm {
+	var terms []datastore.SearchTerm
+	for q != "" {
+		var chunk string
+		if rest, ok := strings.CutPrefix(q, `"`); ok {
+			chunk, q, _ = strings.Cut(rest, `"`)
+			if rest, ok := strings.CutPrefix(q, "*"); ok {
+				chunk, q = chunk+"*", rest
+			}
+		} else {
+			end := strings.IndexFunc(q, func(r rune) bool { return unicode.IsSpace(r) || r == '"' })
+			if end < 0 {
+				end = len(q)
+			}
+			chunk, q = q[:end], strings.TrimLeftFunc(q[end:], unicode.IsSpace)
+		}
+
+		tokens := Tokenize(chunk)
+		if len(tokens) == 0 {
+			continue
+		}
+		term := datastore.SearchTerm{
+			Words:  make([]string, len(tokens)),
+			Prefix: strings.HasSuffix(strings.TrimRightFunc(chunk, unicode.IsSpace), "*"),
+		}
+		for i, token := range tokens {
+			term.Words[i] = token.Word
+		}
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+// Match returns the indexes of the tokens where term starts
+func Match(tokens []Token, term datastore.SearchTerm) []int {
+	var matches []int
+	for i := range tokens {
+		if matchesAt(tokens, i, term) {
+			matches = append(matches, i)
+		}
+	}
+	return matches
+}
+
+// matchesAt reports whether term starts at tokens[i]
+func matchesAt(tokens []Token, i int, term datastore.SearchTerm) bool {
+	if len(term.Words) == 0 || i+len(term.Words) > len(tokens) {
+		return false
+	}
+	for j, word := range term.Words {
+		got := tokens[i+j].Word
+		if term.Prefix && j == len(term.Words)-1 {
+			if !strings.HasPrefix(got, word) {
+				return false
+			}
+		} else if got != word {
+			return false
+		}
+	}
+	return true
+}
+
+// Snippet returns an excerpt of text starting shortly before the first match
+// of the terms, with every matched word highlighted. Text without matches
+// gives an excerpt from its start.
+func Snippet(text string, terms []datastore.SearchTerm) string {
+	tokens := Tokenize(text)
+	if len(tokens) == 0 {
+		return text
+	}
+
+	matched := make([]bool, len(tokens))
+	first := len(tokens)
+	for _, term := range terms {
+		for _, i := range Match(tokens, term) {
+			first = min(first, i)
+			for j := range term.Words {
+				matched[i+j] = true
+			}
+		}
+	}
+	if first == len(tokens) {
+		first = 0
+	}
+	start := max(0, first-snippetLead)
+	end := min(len(tokens), start+snippetMaxWords)
+
+	var b strings.Builder
+	if start == 0 {
+		b.WriteString(text[:tokens[0].Start])
+	}
+	for i := start; i < end; i++ {
+		if i > start {
+			b.WriteString(text[tokens[i-1].End:tokens[i].Start])
+		}
+		word := text[tokens[i].Start:tokens[i].End]
+		if matched[i] {
+			word = datastore.SnippetStart + word + datastore.SnippetStop
+		}
+		b.WriteString(word)
+	}
+	if end == len(tokens) {
+		b.WriteString(text[tokens[end-1].End:])
+	}
+	return b.String()
+}
+
+// PageToken returns the page token for the results after a result with the
+// given rank and ID
+func PageToken(rank float32, id datastore.ID) string {
+	return strconv.FormatFloat(float64(rank), 'g', -1, 32) + "/" + string(id)
+}
+
+// ParsePageToken returns the rank and ID of the result a page token was
+// created for
+func ParsePageToken(token string) (float32, datastore.ID, error) {
+	rankText, id, ok := strings.Cut(token, "/")
+	if !ok || id == "" {
+		return 0, "", datastore.Invalid(datastore.ResourceBlog, "page_token", fmt.Errorf("invalid page token %q", token))
+	}
+	rank, err := strconv.ParseFloat(rankText, 32)
+	if err != nil {
+		return 0, "", datastore.Invalid(datastore.ResourceBlog, "page_token", fmt.Errorf("invalid page token %q", token))
+	}
+	return float32(rank), datastore.ID(id), nil
+}
@@ -0,0 +1,123 @@
+package search_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/search"
+)
+
+func TestTokenize(t *testing.T) {
+	tokens := search.Tokenize("Hello, Wörld! e-mail 42")
+	assert.Equal(t, []search.Token{
+		{Word: "hello", Start: 0, End: 5},
+		{Word: "wörld", Start: 7, End: 13},
+		{Word: "e", Start: 15, End: 16},
+		{Word: "mail", Start: 17, End: 21},
+		{Word: "42", Start: 22, End: 24},
+	}, tokens)
+}
+
+func TestParse(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name     string
+		q        string
+		expected []datastore.SearchTerm
+	}{
+		{
+			name:     "empty",
+			q:        "  ",
+			expected: nil,
+		},
+		{
+			name: "words",
+			q:    "Grow  Tomatoes",
+			expected: []datastore.SearchTerm{
+				{Words: []string{"grow"}},
+				{Words: []string{"tomatoes"}},
+			},
+		},
+		{
+			name: "prefix",
+			q:    "tom*",
+			expected: []datastore.SearchTerm{
+				{Words: []string{"tom"}, Prefix: true},
+			},
+		},
+		{
+			name: "phrase",
+			q:    `"grow tomatoes" pots`,
+			expected: []datastore.SearchTerm{
+				{Words: []string{"grow", "tomatoes"}},
+				{Words: []string{"pots"}},
+			},
+		},
+		{
+			name: "phrase prefix",
+			q:    `"grow tom"*`,
+			expected: []datastore.SearchTerm{
+				{Words: []string{"grow", "tom"}, Prefix: true},
+			},
+		},
+		{
+			name: "unterminated phrase",
+			q:    `pots "grow tomatoes`,
+			expected: []datastore.SearchTerm{
+				{Words: []string{"pots"}},
+				{Words: []string{"grow", "tomatoes"}},
+			},
+		},
+		{
+			name: "punctuation",
+			q:    "e-mail !!",
+			expected: []datastore.SearchTerm{
+				{Words: []string{"e", "mail"}},
+			},
+		},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, search.Parse(tt.q))
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tokens := search.Tokenize("How to grow tomatoes, and more tomatoes")
+
+	assert.Equal(t, []int{3, 6}, search.Match(tokens, datastore.SearchTerm{Words: []string{"tomatoes"}}))
+	assert.Equal(t, []int{2}, search.Match(tokens, datastore.SearchTerm{Words: []string{"grow", "tom"}, Prefix: true}))
+	assert.Empty(t, search.Match(tokens, datastore.SearchTerm{Words: []string{"grow", "tom"}}))
+	assert.Empty(t, search.Match(tokens, datastore.SearchTerm{Words: []string{"tomatoes", "grow"}}))
+}
+
+func TestSnippet(t *testing.T) {
+	terms := search.Parse(`"grow tomatoes"`)
+
+	assert.Equal(t, "How to <b>grow</b> <b>tomatoes</b> in pots.", search.Snippet("How to grow tomatoes in pots.", terms))
+	assert.Equal(t, "Nothing to see.", search.Snippet("Nothing to see.", terms))
+
+	// Long texts are cut down to the words around the first match
+	long := "one two three four five six seven eight grow tomatoes"
+	assert.Equal(t, "four five six seven eight <b>grow</b> <b>tomatoes</b>", search.Snippet(long, terms))
+}
+
+func TestPageToken(t *testing.T) {
+	token := search.PageToken(0.6079271, "123e4567-e89b-12d3-a456-426614174000")
+
+	rank, id, err := search.ParsePageToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, float32(0.6079271), rank)
+	assert.Equal(t, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), id)
+
+	for _, token := range []string{"invalid", "abc/123", "0.5/"} {
+		_, _, err := search.ParsePageToken(token)
+		assert.ErrorIs(t, err, datastore.ErrInvalid, token)
+	}
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+
+	"github.com/agruetz/prosigliere/internal/auth"
+	"github.com/agruetz/prosigliere/internal/authz"
+	"github.com/agruetz/prosigliere/internal/datastore"
+	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
+)
+
+// WithAccessControl hides blogs that are not published, and blogs in the
+// trash, from callers who may not update them. With a policy, callers may
+// update a blog if the policy allows them to call Update on it, such as
+// editors and the authors owning it. Without one, only the principal owning
+// a blog may. Services without access control show every blog to everybody,
+// as every caller may change them anyway.
+func WithAccessControl(policy *authz.Policy) Option {
+	return func(s *BlogService) {
+		s.accessControl = true
+		s.policy = policy
+	}
+}
+
+// mayEdit reports whether the caller may see the blogs owned by owner that
+// are hidden from readers. An empty owner asks about the blogs of others.
+func (s *BlogService) mayEdit(ctx context.Context, owner string) bool {
+	if !s.accessControl {
+		return true
+	}
+	principal, _ := auth.FromContext(ctx)
+	if s.policy == nil {
+		return principal != nil && owner != "" && principal.Subject == owner
+	}
+	err := s.policy.Authorize(ctx, blogpb.Blogs_Update_FullMethodName, principal, func(context.Context) (string, error) {
+		return owner, nil
+	})
+	return err == nil
+}
+
+// hidden reports whether a blog is hidden from readers
+func hidden(blog *datastore.Blog) bool {
+	return blog.Status != datastore.StatusPublished || blog.DeletedAt != nil
+}
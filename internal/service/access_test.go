@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/agruetz/prosigliere/internal/auth"
+	"github.com/agruetz/prosigliere/internal/authz"
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/datastore/mocks"
+	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
+)
+
+func TestBlogService_MayEdit(t *testing.T) {
+	policy, err := authz.New(
+		authz.Rule{Methods: []string{"/blog.v1.Blogs/*"}, Roles: []string{"editor"}},
+		authz.Rule{Methods: []string{"/blog.v1.Blogs/Update"}, Roles: []string{"author"}, Owner: true},
+	)
+	require.NoError(t, err)
+
+	anonymous := context.Background()
+	alice := auth.NewContext(anonymous, &auth.Principal{Subject: "alice", Roles: []string{"author"}})
+	editor := auth.NewContext(anonymous, &auth.Principal{Subject: "erin", Roles: []string{"editor"}})
+
+	tests := []struct {
+		name     string
+		opts     []Option
+		ctx      context.Context
+		owner    string
+		expected bool
+	}{
+		{name: "without access control", ctx: anonymous, owner: "alice", expected: true},
+		{name: "anonymous without policy", opts: []Option{WithAccessControl(nil)}, ctx: anonymous, owner: "alice"},
+		{name: "owner without policy", opts: []Option{WithAccessControl(nil)}, ctx: alice, owner: "alice", expected: true},
+		{name: "authenticated without policy", opts: []Option{WithAccessControl(nil)}, ctx: alice, owner: "bob"},
+		{name: "blogs of others without policy", opts: []Option{WithAccessControl(nil)}, ctx: alice},
+		{name: "anonymous with policy", opts: []Option{WithAccessControl(policy)}, ctx: anonymous, owner: "alice"},
+		{name: "author of own blog", opts: []Option{WithAccessControl(policy)}, ctx: alice, owner: "alice", expected: true},
+		{name: "author of other blog", opts: []Option{WithAccessControl(policy)}, ctx: alice, owner: "bob"},
+		{name: "author of blogs of others", opts: []Option{WithAccessControl(policy)}, ctx: alice},
+		{name: "editor", opts: []Option{WithAccessControl(policy)}, ctx: editor, owner: "alice", expected: true},
+		{name: "editor of blogs of others", opts: []Option{WithAccessControl(policy)}, ctx: editor, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewBlogService(mocks.NewStore(t), tt.opts...)
+			assert.Equal(t, tt.expected, service.mayEdit(tt.ctx, tt.owner))
+		})
+	}
+}
+
+func TestBlogService_GetHidden(t *testing.T) {
+	testTime := time.Now().UTC()
+	draft := &datastore.Blog{ID: datastore.ID("123e4567-e89b-12d3-a456-426614174000"), Slug: "draft", Owner: "alice", Status: datastore.StatusDraft}
+	trashed := &datastore.Blog{ID: datastore.ID("123e4567-e89b-12d3-a456-426614174001"), Slug: "trashed", Owner: "alice", Status: datastore.StatusPublished, DeletedAt: &testTime}
+
+	mockStore := mocks.NewStore(t)
+	mockStore.On("Get", mock.Anything, draft.ID, mock.Anything, mock.Anything).Return(draft, nil)
+	mockStore.On("Get", mock.Anything, trashed.ID, mock.Anything, mock.Anything).Return(trashed, nil)
+	mockStore.On("GetBySlug", mock.Anything, draft.Slug, mock.Anything).Return(draft, nil)
+
+	service := NewBlogService(mockStore, WithAccessControl(nil))
+	anonymous := context.Background()
+	alice := auth.NewContext(anonymous, &auth.Principal{Subject: "alice"})
+
+	// Readers are told hidden blogs do not exist
+	for _, blog := range []*datastore.Blog{draft, trashed} {
+		_, err := service.Get(anonymous, &blogpb.GetReq{Id: &blogpb.UUID{Value: string(blog.ID)}, ShowDeleted: true})
+		assert.Equal(t, status.Error(codes.NotFound, "failed to get blog: blog not found").Error(), err.Error())
+	}
+	_, err := service.GetBySlug(anonymous, &blogpb.GetBySlugReq{Slug: draft.Slug})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Editors see them
+	resp, err := service.Get(alice, &blogpb.GetReq{Id: &blogpb.UUID{Value: string(draft.ID)}, ShowDeleted: true})
+	require.NoError(t, err)
+	assert.Equal(t, string(draft.ID), resp.Blog.Id.Value)
+	bySlug, err := service.GetBySlug(alice, &blogpb.GetBySlugReq{Slug: draft.Slug})
+	require.NoError(t, err)
+	assert.Equal(t, string(draft.ID), bySlug.Blog.Id.Value)
+}
+
+func TestBlogService_ListHidden(t *testing.T) {
+	readers := mock.MatchedBy(func(filter datastore.ListFilter) bool {
+		return filter.Status == datastore.StatusPublished && filter.Deleted == datastore.ExcludeDeleted
+	})
+	drafts := mock.MatchedBy(func(filter datastore.ListFilter) bool {
+		return filter.Status == datastore.StatusDraft && filter.Deleted == datastore.IncludeDeleted
+	})
+
+	mockStore := mocks.NewStore(t)
+	mockStore.On("List", mock.Anything, mock.Anything, mock.Anything, readers).
+		Return([]*datastore.BlogSummary{}, "", nil).Once()
+	mockStore.On("List", mock.Anything, mock.Anything, mock.Anything, drafts).
+		Return([]*datastore.BlogSummary{}, "", nil).Once()
+	mockStore.On("ListTags", mock.Anything, datastore.StatusPublished).
+		Return([]*datastore.TagCount{}, nil).Once()
+	mockStore.On("ListTags", mock.Anything, datastore.StatusDraft).
+		Return([]*datastore.TagCount{}, nil).Once()
+
+	policy, err := authz.New(authz.Rule{Methods: []string{"/blog.v1.Blogs/*"}, Roles: []string{"editor"}})
+	require.NoError(t, err)
+	service := NewBlogService(mockStore, WithAccessControl(policy))
+	anonymous := context.Background()
+	editor := auth.NewContext(anonymous, &auth.Principal{Subject: "erin", Roles: []string{"editor"}})
+
+	// Readers asking for drafts and the trash only get published blogs, while
+	// editors get what they ask for
+	for _, ctx := range []context.Context{anonymous, editor} {
+		_, err := service.List(ctx, &blogpb.ListReq{Status: blogpb.BlogStatus_BLOG_STATUS_DRAFT, ShowDeleted: true})
+		require.NoError(t, err)
+		_, err = service.ListTags(ctx, &blogpb.ListTagsReq{Status: blogpb.BlogStatus_BLOG_STATUS_DRAFT})
+		require.NoError(t, err)
+	}
+}
+
+func TestBlogService_ListDeletedHidden(t *testing.T) {
+	everyone := mock.MatchedBy(func(filter datastore.ListFilter) bool {
+		return filter.Deleted == datastore.OnlyDeleted && filter.Owner == ""
+	})
+	ownedByAlice := mock.MatchedBy(func(filter datastore.ListFilter) bool {
+		return filter.Deleted == datastore.OnlyDeleted && filter.Owner == "alice"
+	})
+
+	policy, err := authz.New(authz.Rule{Methods: []string{"/blog.v1.Blogs/*"}, Roles: []string{"editor"}})
+	require.NoError(t, err)
+	anonymous := context.Background()
+	alice := auth.NewContext(anonymous, &auth.Principal{Subject: "alice"})
+	editor := auth.NewContext(anonymous, &auth.Principal{Subject: "erin", Roles: []string{"editor"}})
+
+	// Without a policy, callers only see their own blogs in the trash, and
+	// readers see none
+	mockStore := mocks.NewStore(t)
+	mockStore.On("List", mock.Anything, mock.Anything, mock.Anything, ownedByAlice).
+		Return([]*datastore.BlogSummary{}, "", nil).Once()
+	service := NewBlogService(mockStore, WithAccessControl(nil))
+	_, err = service.ListDeleted(alice, &blogpb.ListDeletedReq{})
+	require.NoError(t, err)
+	resp, err := service.ListDeleted(anonymous, &blogpb.ListDeletedReq{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Blogs)
+
+	// Editors see the whole trash
+	mockStore = mocks.NewStore(t)
+	mockStore.On("List", mock.Anything, mock.Anything, mock.Anything, everyone).
+		Return([]*datastore.BlogSummary{}, "", nil).Once()
+	service = NewBlogService(mockStore, WithAccessControl(policy))
+	_, err = service.ListDeleted(editor, &blogpb.ListDeletedReq{})
+	require.NoError(t, err)
+}
+
+func TestBlogService_CommentsHidden(t *testing.T) {
+	draft := &datastore.Blog{ID: datastore.ID("123e4567-e89b-12d3-a456-426614174000"), Owner: "alice", Status: datastore.StatusDraft}
+	commentID := datastore.ID("223e4567-e89b-12d3-a456-426614174000")
+
+	mockStore := mocks.NewStore(t)
+	mockStore.On("Get", mock.Anything, draft.ID, mock.Anything, mock.Anything).Return(draft, nil)
+	mockStore.On("GetComment", mock.Anything, draft.ID, commentID).
+		Return(&datastore.Comment{ID: commentID, BlogID: draft.ID, Content: "Comment", State: datastore.CommentStateApproved}, nil).Once()
+	mockStore.On("ListComments", mock.Anything, draft.ID, int32(10), "").
+		Return([]*datastore.Comment{}, "", nil).Once()
+	mockStore.On("AddComment", mock.Anything, draft.ID, (*datastore.ID)(nil), "Comment", "", int32(DefaultMaxCommentDepth), datastore.CommentStateApproved).
+		Return(&datastore.Comment{ID: commentID, BlogID: draft.ID, Content: "Comment"}, nil).Once()
+
+	service := NewBlogService(mockStore, WithAccessControl(nil))
+	anonymous := context.Background()
+	bob := auth.NewContext(anonymous, &auth.Principal{Subject: "bob"})
+	alice := auth.NewContext(anonymous, &auth.Principal{Subject: "alice"})
+
+	// Readers are told the comments of hidden blogs do not exist, and cannot
+	// add any
+	for _, ctx := range []context.Context{anonymous, bob} {
+		_, err := service.GetComment(ctx, &blogpb.GetCommentReq{Id: &blogpb.UUID{Value: string(draft.ID)}, CommentId: &blogpb.UUID{Value: string(commentID)}})
+		assert.Equal(t, status.Error(codes.NotFound, "failed to get comment: blog not found").Error(), err.Error())
+		_, err = service.ListComments(ctx, &blogpb.ListCommentsReq{Id: &blogpb.UUID{Value: string(draft.ID)}})
+		assert.Equal(t, status.Error(codes.NotFound, "failed to list comments: blog not found").Error(), err.Error())
+		_, err = service.AddComment(ctx, &blogpb.AddCommentReq{Id: &blogpb.UUID{Value: string(draft.ID)}, Content: "Comment"})
+		assert.Equal(t, status.Error(codes.FailedPrecondition, "failed to add comment: blog is not published"), err)
+	}
+
+	// Editors read and add them
+	_, err := service.GetComment(alice, &blogpb.GetCommentReq{Id: &blogpb.UUID{Value: string(draft.ID)}, CommentId: &blogpb.UUID{Value: string(commentID)}})
+	require.NoError(t, err)
+	_, err = service.ListComments(alice, &blogpb.ListCommentsReq{Id: &blogpb.UUID{Value: string(draft.ID)}})
+	require.NoError(t, err)
+	_, err = service.AddComment(alice, &blogpb.AddCommentReq{Id: &blogpb.UUID{Value: string(draft.ID)}, Content: "Comment"})
+	require.NoError(t, err)
+}
+
+func TestBlogService_AuthorOwnership(t *testing.T) {
+	blogID := datastore.ID("123e4567-e89b-12d3-a456-426614174000")
+	alicesUser := &datastore.User{ID: datastore.ID("223e4567-e89b-12d3-a456-426614174000"), DisplayName: "Alice", Owner: "alice"}
+	bobsUser := &datastore.User{ID: datastore.ID("323e4567-e89b-12d3-a456-426614174000"), DisplayName: "Bob", Owner: "bob"}
+
+	mockStore := mocks.NewStore(t)
+	mockStore.On("GetUser", mock.Anything, alicesUser.ID).Return(alicesUser, nil)
+	mockStore.On("GetUser", mock.Anything, bobsUser.ID).Return(bobsUser, nil)
+	mockStore.On("Create", mock.Anything, "Title", "Content", datastore.StatusPublished, (*time.Time)(nil), []string(nil), mock.Anything, mock.Anything).
+		Return(blogID, nil).Once()
+
+	service := NewBlogService(mockStore, WithAccessControl(nil))
+	anonymous := context.Background()
+	alice := auth.NewContext(anonymous, &auth.Principal{Subject: "alice"})
+
+	// Callers can only write as the users they own
+	_, err := service.Create(alice, &blogpb.CreateReq{Title: "Title", Content: "Content", AuthorId: &blogpb.UUID{Value: string(alicesUser.ID)}})
+	require.NoError(t, err)
+	_, err = service.Create(alice, &blogpb.CreateReq{Title: "Title", Content: "Content", AuthorId: &blogpb.UUID{Value: string(bobsUser.ID)}})
+	assert.Equal(t, status.Error(codes.PermissionDenied, "failed to create blog: author is not a user of the caller").Error(), err.Error())
+	_, err = service.AddComment(alice, &blogpb.AddCommentReq{Id: &blogpb.UUID{Value: string(blogID)}, Content: "Comment", AuthorId: &blogpb.UUID{Value: string(bobsUser.ID)}})
+	assert.Equal(t, status.Error(codes.PermissionDenied, "failed to add comment: author is not a user of the caller").Error(), err.Error())
+
+	// Anonymous callers cannot write as anybody
+	_, err = service.AddComment(anonymous, &blogpb.AddCommentReq{Id: &blogpb.UUID{Value: string(blogID)}, Content: "Comment", AuthorId: &blogpb.UUID{Value: string(alicesUser.ID)}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/agruetz/prosigliere/internal/auth"
+	"github.com/agruetz/prosigliere/internal/datastore"
+	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
+)
+
+// AdminService implements the blog.v1.AdminServer interface
+type AdminService struct {
+	blogpb.UnimplementedAdminServer
+	store datastore.Store
+}
+
+// NewAdminService creates a new AdminService with the given datastore
+func NewAdminService(store datastore.Store) *AdminService {
+	return &AdminService{
+		store: store,
+	}
+}
+
+// CreateAPIKey creates an API key. Only the hash of the key is stored, so the
+// key is returned this once. Callers can only grant the key roles they hold
+// themselves.
+func (s *AdminService) CreateAPIKey(ctx context.Context, req *blogpb.CreateAPIKeyReq) (*blogpb.CreateAPIKeyResp, error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "creating api keys requires authentication")
+	}
+	for _, scope := range req.GetScopes() {
+		if !slices.Contains(principal.Roles, scope) {
+			return nil, status.Errorf(codes.PermissionDenied, "cannot grant the role %s, which the caller does not hold", scope)
+		}
+	}
+
+	key, prefix, hash, err := auth.GenerateAPIKey()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create api key: %v", err)
+	}
+
+	var expiresAt *time.Time
+	if req.GetExpiresAt() != nil {
+		expiresAtVal := req.GetExpiresAt().AsTime()
+		expiresAt = &expiresAtVal
+	}
+
+	apiKey, err := s.store.CreateAPIKey(ctx, req.GetName(), prefix, hash, req.GetScopes(), expiresAt)
+	if err != nil {
+		return nil, storeError(err, "failed to create api key")
+	}
+
+	return &blogpb.CreateAPIKeyResp{
+		ApiKey: toProtoAPIKey(apiKey),
+		Key:    key,
+	}, nil
+}
+
+// ListAPIKeys lists the API keys, newest first, without the keys themselves
+func (s *AdminService) ListAPIKeys(ctx context.Context, req *blogpb.ListAPIKeysReq) (*blogpb.ListAPIKeysResp, error) {
+	keys, err := s.store.ListAPIKeys(ctx, req.GetShowRevoked())
+	if err != nil {
+		return nil, storeError(err, "failed to list api keys")
+	}
+
+	pbKeys := make([]*blogpb.APIKey, 0, len(keys))
+	for _, key := range keys {
+		pbKeys = append(pbKeys, toProtoAPIKey(key))
+	}
+
+	return &blogpb.ListAPIKeysResp{
+		ApiKeys: pbKeys,
+	}, nil
+}
+
+// RevokeAPIKey revokes an API key
+func (s *AdminService) RevokeAPIKey(ctx context.Context, req *blogpb.RevokeAPIKeyReq) (*emptypb.Empty, error) {
+	if req.GetId() == nil {
+		return nil, status.Error(codes.InvalidArgument, "api key ID is required")
+	}
+
+	if err := s.store.RevokeAPIKey(ctx, datastore.ID(req.GetId().GetValue())); err != nil {
+		return nil, storeError(err, "failed to revoke api key")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListAuditEvents lists the changes made to blogs and comments, oldest first
+func (s *AdminService) ListAuditEvents(ctx context.Context, req *blogpb.ListAuditEventsReq) (*blogpb.ListAuditEventsResp, error) {
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = 10 // Default page size
+	}
+	if pageSize > 100 {
+		pageSize = 100 // Maximum page size
+	}
+
+	filter := datastore.AuditFilter{
+		Resource:   storeAuditResources[req.GetResource()],
+		ResourceID: datastore.ID(req.GetResourceId().GetValue()),
+		Actor:      req.GetActor(),
+	}
+	if req.GetStartTime() != nil {
+		since := req.GetStartTime().AsTime()
+		filter.Since = &since
+	}
+	if req.GetEndTime() != nil {
+		until := req.GetEndTime().AsTime()
+		filter.Until = &until
+	}
+
+	events, nextPageToken, err := s.store.ListAuditEvents(ctx, filter, pageSize, req.GetPageToken())
+	if err != nil {
+		return nil, storeError(err, "failed to list audit events")
+	}
+
+	pbEvents := make([]*blogpb.AuditEvent, len(events))
+	for i, event := range events {
+		if pbEvents[i], err = toProtoAuditEvent(event); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert audit event: %v", err)
+		}
+	}
+
+	return &blogpb.ListAuditEventsResp{
+		AuditEvents:   pbEvents,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// PurgeBlog permanently removes a blog and its comments from the trash
+func (s *AdminService) PurgeBlog(ctx context.Context, req *blogpb.PurgeBlogReq) (*emptypb.Empty, error) {
+	if req.GetId() == nil {
+		return nil, status.Error(codes.InvalidArgument, "blog ID is required")
+	}
+
+	id := datastore.ID(req.GetId().GetValue())
+	err := s.store.Purge(ctx, id)
+	if err != nil {
+		return nil, storeError(err, "failed to purge blog")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// storeAuditResources maps API audit resources to datastore resource names.
+// The unspecified resource maps to the empty name, which matches every
+// resource.
+var storeAuditResources = map[blogpb.AuditResource]string{
+	blogpb.AuditResource_AUDIT_RESOURCE_BLOG:    datastore.ResourceBlog,
+	blogpb.AuditResource_AUDIT_RESOURCE_COMMENT: datastore.ResourceComment,
+}
+
+// protoAuditActions maps datastore audit actions to API audit actions
+var protoAuditActions = map[datastore.AuditAction]blogpb.AuditAction{
+	datastore.AuditCreate:    blogpb.AuditAction_AUDIT_ACTION_CREATE,
+	datastore.AuditUpdate:    blogpb.AuditAction_AUDIT_ACTION_UPDATE,
+	datastore.AuditDelete:    blogpb.AuditAction_AUDIT_ACTION_DELETE,
+	datastore.AuditModerate:  blogpb.AuditAction_AUDIT_ACTION_MODERATE,
+	datastore.AuditUndelete:  blogpb.AuditAction_AUDIT_ACTION_UNDELETE,
+	datastore.AuditPurge:     blogpb.AuditAction_AUDIT_ACTION_PURGE,
+	datastore.AuditPublish:   blogpb.AuditAction_AUDIT_ACTION_PUBLISH,
+	datastore.AuditUnpublish: blogpb.AuditAction_AUDIT_ACTION_UNPUBLISH,
+	datastore.AuditRestore:   blogpb.AuditAction_AUDIT_ACTION_RESTORE,
+}
+
+// toProtoAuditEvent converts a datastore audit event to its protobuf message
+func toProtoAuditEvent(event *datastore.AuditEvent) (*blogpb.AuditEvent, error) {
+	pbEvent := &blogpb.AuditEvent{
+		Id: &blogpb.UUID{
+			Value: string(event.ID),
+		},
+		CreatedAt: timestamppb.New(event.CreatedAt),
+		Actor:     event.Actor,
+		Method:    event.Method,
+		Action:    protoAuditActions[event.Action],
+		ResourceId: &blogpb.UUID{
+			Value: string(event.ResourceID),
+		},
+		RequestId: event.RequestID,
+		ClientIp:  event.ClientIP,
+	}
+	for pbResource, resource := range storeAuditResources {
+		if resource == event.Resource {
+			pbEvent.Resource = pbResource
+		}
+	}
+
+	var err error
+	if pbEvent.Before, err = toProtoSnapshot(event.Before); err != nil {
+		return nil, err
+	}
+	if pbEvent.After, err = toProtoSnapshot(event.After); err != nil {
+		return nil, err
+	}
+	return pbEvent, nil
+}
+
+// toProtoSnapshot converts the snapshot of a resource recorded by an audit
+// event to a Struct, or nil if there is none
+func toProtoSnapshot(data json.RawMessage) (*structpb.Struct, error) {
+	if data == nil {
+		return nil, nil
+	}
+	snapshot := &structpb.Struct{}
+	if err := protojson.Unmarshal(data, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// toProtoAPIKey converts a datastore API key to its protobuf message, leaving
+// out its hash
+func toProtoAPIKey(key *datastore.APIKey) *blogpb.APIKey {
+	pbKey := &blogpb.APIKey{
+		Id: &blogpb.UUID{
+			Value: string(key.ID),
+		},
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Scopes:    key.Scopes,
+		CreatedAt: timestamppb.New(key.CreatedAt),
+	}
+	if key.ExpiresAt != nil {
+		pbKey.ExpiresAt = timestamppb.New(*key.ExpiresAt)
+	}
+	if key.LastUsedAt != nil {
+		pbKey.LastUsedAt = timestamppb.New(*key.LastUsedAt)
+	}
+	if key.RevokedAt != nil {
+		pbKey.RevokedAt = timestamppb.New(*key.RevokedAt)
+	}
+	return pbKey
+}
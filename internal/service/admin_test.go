@@ -0,0 +1,362 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/agruetz/prosigliere/internal/auth"
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/datastore/mocks"
+	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
+)
+
+func TestAdminService_CreateAPIKey(t *testing.T) {
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	expiresAt := createdAt.Add(24 * time.Hour)
+
+	var hash string
+	mockStore := mocks.NewStore(t)
+	mockStore.On("CreateAPIKey", mock.Anything, "ingest", mock.AnythingOfType("string"), mock.AnythingOfType("string"), []string{"author"}, &expiresAt).
+		Run(func(args mock.Arguments) {
+			hash = args.String(3)
+		}).
+		Return(func(ctx context.Context, name, prefix, hash string, scopes []string, expiresAt *time.Time) *datastore.APIKey {
+			return &datastore.APIKey{ID: "123e4567-e89b-12d3-a456-426614174000", Name: name, Prefix: prefix, Hash: hash, Scopes: scopes, CreatedAt: createdAt, ExpiresAt: expiresAt}
+		}, nil)
+
+	service := NewAdminService(mockStore)
+	ctx := auth.NewContext(context.Background(), &auth.Principal{Subject: "alice", Roles: []string{"admin", "author"}})
+	resp, err := service.CreateAPIKey(ctx, &blogpb.CreateAPIKeyReq{
+		Name:      "ingest",
+		Scopes:    []string{"author"},
+		ExpiresAt: timestamppb.New(expiresAt),
+	})
+	require.NoError(t, err)
+
+	// The key is returned once, and only its hash is stored
+	assert.True(t, strings.HasPrefix(resp.GetKey(), resp.GetApiKey().GetPrefix()))
+	assert.Equal(t, auth.HashAPIKey(resp.GetKey()), hash)
+	assert.Equal(t, "123e4567-e89b-12d3-a456-426614174000", resp.GetApiKey().GetId().GetValue())
+	assert.Equal(t, "ingest", resp.GetApiKey().GetName())
+	assert.Equal(t, []string{"author"}, resp.GetApiKey().GetScopes())
+	assert.True(t, expiresAt.Equal(resp.GetApiKey().GetExpiresAt().AsTime()))
+	assert.Nil(t, resp.GetApiKey().GetLastUsedAt())
+	assert.Nil(t, resp.GetApiKey().GetRevokedAt())
+}
+
+func TestAdminService_CreateAPIKeyError(t *testing.T) {
+	mockStore := mocks.NewStore(t)
+	mockStore.On("CreateAPIKey", mock.Anything, "ingest", mock.Anything, mock.Anything, []string(nil), (*time.Time)(nil)).
+		Return(nil, errors.New("database error"))
+
+	service := NewAdminService(mockStore)
+	ctx := auth.NewContext(context.Background(), &auth.Principal{Subject: "alice"})
+	resp, err := service.CreateAPIKey(ctx, &blogpb.CreateAPIKeyReq{Name: "ingest"})
+	assert.Nil(t, resp)
+	assert.Equal(t, status.Error(codes.Internal, "failed to create api key: database error").Error(), err.Error())
+}
+
+func TestAdminService_CreateAPIKeyScopes(t *testing.T) {
+	service := NewAdminService(mocks.NewStore(t))
+	alice := auth.NewContext(context.Background(), &auth.Principal{Subject: "alice", Roles: []string{"author"}})
+
+	// Callers cannot grant roles they do not hold, and anonymous callers
+	// cannot grant any
+	_, err := service.CreateAPIKey(alice, &blogpb.CreateAPIKeyReq{Name: "ingest", Scopes: []string{"author", "admin"}})
+	assert.Equal(t, status.Error(codes.PermissionDenied, "cannot grant the role admin, which the caller does not hold").Error(), err.Error())
+	_, err = service.CreateAPIKey(context.Background(), &blogpb.CreateAPIKeyReq{Name: "ingest"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestAdminService_ListAPIKeys(t *testing.T) {
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		req           *blogpb.ListAPIKeysReq
+		setupMock     func(mock *mocks.Store)
+		expected      []string
+		expectedError error
+	}{
+		{
+			name: "active keys",
+			req:  &blogpb.ListAPIKeysReq{},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("ListAPIKeys", mock.Anything, false).
+					Return([]*datastore.APIKey{
+						{ID: "key-2", Name: "backup", Prefix: "psk_0002", Hash: "hash-2", Scopes: []string{}, CreatedAt: createdAt, LastUsedAt: &createdAt},
+						{ID: "key-1", Name: "ingest", Prefix: "psk_0001", Hash: "hash-1", Scopes: []string{"author"}, CreatedAt: createdAt},
+					}, nil)
+			},
+			expected: []string{"key-2", "key-1"},
+		},
+		{
+			name: "with revoked keys",
+			req:  &blogpb.ListAPIKeysReq{ShowRevoked: true},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("ListAPIKeys", mock.Anything, true).
+					Return([]*datastore.APIKey{
+						{ID: "key-1", Name: "ingest", Prefix: "psk_0001", Hash: "hash-1", CreatedAt: createdAt, RevokedAt: &createdAt},
+					}, nil)
+			},
+			expected: []string{"key-1"},
+		},
+		{
+			name: "store error",
+			req:  &blogpb.ListAPIKeysReq{},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("ListAPIKeys", mock.Anything, false).
+					Return(nil, datastore.Unavailable(errors.New("connection refused")))
+			},
+			expectedError: status.Error(codes.Unavailable, "failed to list api keys: unavailable: connection refused"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewAdminService(mockStore)
+			resp, err := service.ListAPIKeys(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+				assert.Nil(t, resp)
+				return
+			}
+			require.NoError(t, err)
+			var ids []string
+			for _, key := range resp.GetApiKeys() {
+				ids = append(ids, key.GetId().GetValue())
+			}
+			assert.Equal(t, tt.expected, ids)
+		})
+	}
+}
+
+func TestAdminService_RevokeAPIKey(t *testing.T) {
+	keyID := "123e4567-e89b-12d3-a456-426614174000"
+
+	tests := []struct {
+		name          string
+		req           *blogpb.RevokeAPIKeyReq
+		setupMock     func(mock *mocks.Store)
+		expectedError error
+	}{
+		{
+			name: "successful revocation",
+			req:  &blogpb.RevokeAPIKeyReq{Id: &blogpb.UUID{Value: keyID}},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("RevokeAPIKey", mock.Anything, datastore.ID(keyID)).Return(nil)
+			},
+		},
+		{
+			name:          "missing ID",
+			req:           &blogpb.RevokeAPIKeyReq{},
+			setupMock:     func(mockStore *mocks.Store) {},
+			expectedError: status.Error(codes.InvalidArgument, "api key ID is required"),
+		},
+		{
+			name: "key not found",
+			req:  &blogpb.RevokeAPIKeyReq{Id: &blogpb.UUID{Value: keyID}},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("RevokeAPIKey", mock.Anything, datastore.ID(keyID)).
+					Return(datastore.NotFound(datastore.ResourceAPIKey, datastore.ID(keyID)))
+			},
+			expectedError: status.Error(codes.NotFound, "failed to revoke api key: api key not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewAdminService(mockStore)
+			_, err := service.RevokeAPIKey(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAdminService_ListAuditEvents(t *testing.T) {
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	since := createdAt.Add(-time.Hour)
+	until := createdAt.Add(time.Hour)
+	blogID := "123e4567-e89b-12d3-a456-426614174000"
+	event := &datastore.AuditEvent{
+		ID:         "223e4567-e89b-12d3-a456-426614174000",
+		CreatedAt:  createdAt,
+		Actor:      "alice",
+		Method:     "/blog.v1.BlogService/UpdateBlog",
+		Action:     datastore.AuditUpdate,
+		Resource:   datastore.ResourceBlog,
+		ResourceID: datastore.ID(blogID),
+		Before:     []byte(`{"title":"Old Title","version":1}`),
+		After:      []byte(`{"title":"New Title","version":2}`),
+		RequestID:  "req-1",
+		ClientIP:   "203.0.113.7",
+	}
+
+	tests := []struct {
+		name          string
+		req           *blogpb.ListAuditEventsReq
+		setupMock     func(mock *mocks.Store)
+		expectedToken string
+		expectedError error
+	}{
+		{
+			name: "default page",
+			req:  &blogpb.ListAuditEventsReq{},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("ListAuditEvents", mock.Anything, datastore.AuditFilter{}, int32(10), "").
+					Return([]*datastore.AuditEvent{event}, "next", nil)
+			},
+			expectedToken: "next",
+		},
+		{
+			name: "filtered",
+			req: &blogpb.ListAuditEventsReq{
+				Resource:   blogpb.AuditResource_AUDIT_RESOURCE_BLOG,
+				ResourceId: &blogpb.UUID{Value: blogID},
+				Actor:      "alice",
+				StartTime:  timestamppb.New(since),
+				EndTime:    timestamppb.New(until),
+				PageSize:   500,
+				PageToken:  "token",
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				filter := datastore.AuditFilter{Resource: datastore.ResourceBlog, ResourceID: datastore.ID(blogID), Actor: "alice", Since: &since, Until: &until}
+				mockStore.On("ListAuditEvents", mock.Anything, filter, int32(100), "token").
+					Return([]*datastore.AuditEvent{event}, "", nil)
+			},
+		},
+		{
+			name: "store error",
+			req:  &blogpb.ListAuditEventsReq{PageToken: "invalid"},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("ListAuditEvents", mock.Anything, datastore.AuditFilter{}, int32(10), "invalid").
+					Return(nil, "", datastore.Invalid(datastore.ResourceAuditEvent, "page_token", errors.New(`invalid page token "invalid"`)))
+			},
+			expectedError: status.Error(codes.InvalidArgument, `failed to list audit events: audit event invalid (page_token): invalid page token "invalid"`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewAdminService(mockStore)
+			resp, err := service.ListAuditEvents(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+				assert.Nil(t, resp)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedToken, resp.GetNextPageToken())
+			require.Len(t, resp.GetAuditEvents(), 1)
+
+			pbEvent := resp.GetAuditEvents()[0]
+			assert.Equal(t, string(event.ID), pbEvent.GetId().GetValue())
+			assert.True(t, createdAt.Equal(pbEvent.GetCreatedAt().AsTime()))
+			assert.Equal(t, "alice", pbEvent.GetActor())
+			assert.Equal(t, "/blog.v1.BlogService/UpdateBlog", pbEvent.GetMethod())
+			assert.Equal(t, blogpb.AuditAction_AUDIT_ACTION_UPDATE, pbEvent.GetAction())
+			assert.Equal(t, blogpb.AuditResource_AUDIT_RESOURCE_BLOG, pbEvent.GetResource())
+			assert.Equal(t, blogID, pbEvent.GetResourceId().GetValue())
+			assert.Equal(t, "Old Title", pbEvent.GetBefore().GetFields()["title"].GetStringValue())
+			assert.Equal(t, float64(2), pbEvent.GetAfter().GetFields()["version"].GetNumberValue())
+			assert.Equal(t, "req-1", pbEvent.GetRequestId())
+			assert.Equal(t, "203.0.113.7", pbEvent.GetClientIp())
+		})
+	}
+}
+
+func TestAdminService_PurgeBlog(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *blogpb.PurgeBlogReq
+		setupMock   func(mock *mocks.Store)
+		expectedErr error
+	}{
+		{
+			name: "successful purge",
+			req: &blogpb.PurgeBlogReq{
+				Id: &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Purge", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000")).
+					Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "missing ID",
+			req:  &blogpb.PurgeBlogReq{},
+			setupMock: func(mockStore *mocks.Store) {
+				// No mock setup needed
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "blog ID is required"),
+		},
+		{
+			name: "blog not in trash",
+			req: &blogpb.PurgeBlogReq{
+				Id: &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Purge", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000")).
+					Return(datastore.NotFound(datastore.ResourceBlog, "123e4567-e89b-12d3-a456-426614174000"))
+			},
+			expectedErr: status.Error(codes.NotFound, "failed to purge blog: blog not found"),
+		},
+		{
+			name: "store error",
+			req: &blogpb.PurgeBlogReq{
+				Id: &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Purge", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000")).
+					Return(errors.New("purge error"))
+			},
+			expectedErr: status.Error(codes.Internal, "failed to purge blog: purge error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewAdminService(mockStore)
+			resp, err := service.PurgeBlog(context.Background(), tt.req)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErr.Error(), err.Error())
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+			}
+		})
+	}
+}
@@ -3,41 +3,110 @@ package service
 
 import (
 	"context"
+	"strconv"
+	"time"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/agruetz/prosigliere/internal/auth"
+	"github.com/agruetz/prosigliere/internal/authz"
 	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/filter"
+	"github.com/agruetz/prosigliere/internal/search"
 	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
 )
 
+// DefaultMaxCommentDepth is how deep replies to comments nest unless
+// configured otherwise
+const DefaultMaxCommentDepth = 5
+
+// defaultMaxComments is how many comments a blog embeds unless asked
+// otherwise
+const defaultMaxComments = 100
+
 // BlogService implements the blog.v1.BlogsServer interface
 type BlogService struct {
 	blogpb.UnimplementedBlogsServer
-	store datastore.Store
+	store            datastore.Store
+	maxCommentDepth  int32
+	moderateComments bool
+	commentFilter    *filter.Pipeline
+	accessControl    bool
+	policy           *authz.Policy
+}
+
+// Option configures a BlogService
+type Option func(*BlogService)
+
+// WithMaxCommentDepth sets how deep replies to comments may nest. A depth of
+// 0 allows no replies.
+func WithMaxCommentDepth(depth int32) Option {
+	return func(s *BlogService) {
+		s.maxCommentDepth = depth
+	}
+}
+
+// WithCommentModeration sets whether new comments wait for a moderator's
+// approval on blogs that follow the default comment policy
+func WithCommentModeration(enabled bool) Option {
+	return func(s *BlogService) {
+		s.moderateComments = enabled
+	}
+}
+
+// WithCommentFilter sets the filters new comments run through. A filter
+// asking for a state overrides the comment policy of the blog.
+func WithCommentFilter(pipeline *filter.Pipeline) Option {
+	return func(s *BlogService) {
+		s.commentFilter = pipeline
+	}
 }
 
 // NewBlogService creates a new BlogService with the given datastore
-func NewBlogService(store datastore.Store) *BlogService {
-	return &BlogService{
-		store: store,
+func NewBlogService(store datastore.Store, opts ...Option) *BlogService {
+	s := &BlogService{
+		store:           store,
+		maxCommentDepth: DefaultMaxCommentDepth,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
 // Create creates a new blog
 func (s *BlogService) Create(ctx context.Context, req *blogpb.CreateReq) (*blogpb.CreateResp, error) {
-	// Validate inputs
-	if req.GetTitle() == "" {
-		return nil, status.Error(codes.InvalidArgument, "title is required")
+	// Blogs are published right away unless asked otherwise
+	status := datastore.StatusPublished
+	var publishAt *time.Time
+	if req.GetPublishAt() != nil {
+		status = datastore.StatusScheduled
+		publishAtVal := req.GetPublishAt().AsTime()
+		publishAt = &publishAtVal
+	}
+	if req.GetStatus() != blogpb.BlogStatus_BLOG_STATUS_UNSPECIFIED {
+		status = toStoreStatus(req.GetStatus())
 	}
-	if req.GetContent() == "" {
-		return nil, status.Error(codes.InvalidArgument, "content is required")
+
+	// Blogs are owned by whoever created them
+	var opts []datastore.CreateOption
+	if principal, ok := auth.FromContext(ctx); ok {
+		opts = append(opts, datastore.WithOwner(principal.Subject))
+	}
+	if req.GetAuthorId() != nil {
+		authorID := datastore.ID(req.GetAuthorId().GetValue())
+		if err := s.checkAuthor(ctx, authorID, "failed to create blog"); err != nil {
+			return nil, err
+		}
+		opts = append(opts, datastore.WithAuthor(authorID))
 	}
 
-	id, err := s.store.Create(ctx, req.GetTitle(), req.GetContent())
+	id, err := s.store.Create(ctx, req.GetTitle(), req.GetContent(), status, publishAt, req.GetTags(), opts...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create blog: %v", err)
+		return nil, storeError(err, "failed to create blog")
 	}
 
 	return &blogpb.CreateResp{
@@ -53,40 +122,111 @@ func (s *BlogService) Get(ctx context.Context, req *blogpb.GetReq) (*blogpb.GetR
 		return nil, status.Error(codes.InvalidArgument, "blog ID is required")
 	}
 
+	if err := validateReadMask(req.GetReadMask()); err != nil {
+		return nil, err
+	}
+
 	id := datastore.ID(req.GetId().GetValue())
-	blog, err := s.store.Get(ctx, id)
-	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "failed to get blog: %v", err)
-	}
-
-	// Create a slice to hold valid comments
-	comments := make([]*blogpb.Comment, 0, len(blog.Comments))
-	for _, comment := range blog.Comments {
-		// Only add comments with valid IDs
-		if comment.ID != "" {
-			comments = append(comments, &blogpb.Comment{
-				Id:        &blogpb.UUID{Value: string(comment.ID)},
-				Content:   comment.Content,
-				Author:    comment.Author,
-				CreatedAt: timestamppb.New(comment.CreatedAt),
-			})
-		}
+	opts := readOptions(req.GetReadMask())
+	opts = append(opts, datastore.WithCommentLimit(maxComments(req.GetMaxComments())))
+	if req.GetShowDeleted() {
+		opts = append(opts, datastore.WithDeleted())
+	}
+	blog, err := s.store.Get(ctx, id, opts...)
+	if err != nil {
+		return nil, storeError(err, "failed to get blog")
+	}
+	if hidden(blog) && !s.mayEdit(ctx, blog.Owner) {
+		return nil, storeError(datastore.NotFound(datastore.ResourceBlog, id), "failed to get blog")
+	}
+
+	pbBlog := toProtoBlog(blog, req.GetCommentView())
+	applyReadMask(pbBlog, req.GetReadMask())
+	if err := s.embedAuthors(ctx, pbBlog.GetAuthorProfile(), pbBlog.GetComments()...); err != nil {
+		return nil, err
 	}
 
 	return &blogpb.GetResp{
-		Blog: &blogpb.Blog{
-			Id: &blogpb.UUID{
-				Value: string(blog.ID),
-			},
-			Title:     blog.Title,
-			Content:   blog.Content,
-			CreatedAt: timestamppb.New(blog.CreatedAt),
-			UpdatedAt: timestamppb.New(blog.UpdatedAt),
-			Comments:  comments,
-		},
+		Blog: pbBlog,
 	}, nil
 }
 
+// GetBySlug retrieves a blog by its current or a previous slug
+func (s *BlogService) GetBySlug(ctx context.Context, req *blogpb.GetBySlugReq) (*blogpb.GetBySlugResp, error) {
+	if err := validateReadMask(req.GetReadMask()); err != nil {
+		return nil, err
+	}
+
+	opts := readOptions(req.GetReadMask())
+	opts = append(opts, datastore.WithCommentLimit(maxComments(req.GetMaxComments())))
+	if req.GetShowDeleted() {
+		opts = append(opts, datastore.WithDeleted())
+	}
+	blog, err := s.store.GetBySlug(ctx, req.GetSlug(), opts...)
+	if err != nil {
+		return nil, storeError(err, "failed to get blog")
+	}
+	if hidden(blog) && !s.mayEdit(ctx, blog.Owner) {
+		return nil, storeError(datastore.NotFound(datastore.ResourceBlog, datastore.ID(req.GetSlug())), "failed to get blog")
+	}
+
+	pbBlog := toProtoBlog(blog, req.GetCommentView())
+	applyReadMask(pbBlog, req.GetReadMask())
+	if err := s.embedAuthors(ctx, pbBlog.GetAuthorProfile(), pbBlog.GetComments()...); err != nil {
+		return nil, err
+	}
+
+	resp := &blogpb.GetBySlugResp{
+		Blog: pbBlog,
+	}
+	if blog.Slug != req.GetSlug() {
+		resp.RedirectSlug = blog.Slug
+	}
+	return resp, nil
+}
+
+// toProtoBlog converts a datastore blog to its protobuf message, with the
+// comments arranged by view
+func toProtoBlog(blog *datastore.Blog, view blogpb.CommentView) *blogpb.Blog {
+	pbBlog := &blogpb.Blog{
+		Id: &blogpb.UUID{
+			Value: string(blog.ID),
+		},
+		Title:         blog.Title,
+		Content:       blog.Content,
+		CreatedAt:     timestamppb.New(blog.CreatedAt),
+		UpdatedAt:     timestamppb.New(blog.UpdatedAt),
+		Comments:      toProtoComments(blog.Comments, view),
+		Status:        toProtoStatus(blog.Status),
+		Etag:          toEtag(blog.Version),
+		Tags:          blog.Tags,
+		Slug:          blog.Slug,
+		CommentCount:  blog.CommentCount,
+		CommentPolicy: toProtoCommentPolicy(blog.CommentPolicy),
+		Owner:         blog.Owner,
+		AuthorProfile: authorStub(blog.AuthorID),
+	}
+	if blog.PublishedAt != nil {
+		pbBlog.PublishedAt = timestamppb.New(*blog.PublishedAt)
+	}
+	if blog.PublishAt != nil {
+		pbBlog.PublishAt = timestamppb.New(*blog.PublishAt)
+	}
+	if blog.DeletedAt != nil {
+		pbBlog.DeletedAt = timestamppb.New(*blog.DeletedAt)
+	}
+	return pbBlog
+}
+
+// maxComments returns how many comments to embed in a blog, given the
+// requested maximum
+func maxComments(requested int32) int32 {
+	if requested <= 0 {
+		return defaultMaxComments
+	}
+	return requested
+}
+
 // Update updates an existing blog
 func (s *BlogService) Update(ctx context.Context, req *blogpb.UpdateReq) (*emptypb.Empty, error) {
 	if req.GetId() == nil {
@@ -94,36 +234,38 @@ func (s *BlogService) Update(ctx context.Context, req *blogpb.UpdateReq) (*empty
 	}
 
 	id := datastore.ID(req.GetId().GetValue())
-	var title, content *string
-
-	// Handle optional fields
-	if req.Title != nil {
-		titleVal := req.GetTitle()
-		title = &titleVal
+	patch, err := blogPatch(req)
+	if err != nil {
+		return nil, err
 	}
-	if req.Content != nil {
-		contentVal := req.GetContent()
-		content = &contentVal
+	version, err := toVersion(req.GetEtag())
+	if err != nil {
+		return nil, err
 	}
 
-	err := s.store.Update(ctx, id, title, content)
+	err = s.store.Update(ctx, id, patch, editor(ctx, req.GetEditor()), version)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update blog: %v", err)
+		return nil, storeError(err, "failed to update blog")
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
-// Delete deletes a blog
+// Delete moves a blog to the trash
 func (s *BlogService) Delete(ctx context.Context, req *blogpb.DeleteReq) (*emptypb.Empty, error) {
 	if req.GetId() == nil {
 		return nil, status.Error(codes.InvalidArgument, "blog ID is required")
 	}
 
 	id := datastore.ID(req.GetId().GetValue())
-	err := s.store.Delete(ctx, id)
+	version, err := toVersion(req.GetEtag())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.store.Delete(ctx, id, version)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete blog: %v", err)
+		return nil, storeError(err, "failed to delete blog")
 	}
 
 	return &emptypb.Empty{}, nil
@@ -139,47 +281,302 @@ func (s *BlogService) List(ctx context.Context, req *blogpb.ListReq) (*blogpb.Li
 		pageSize = 100 // Maximum page size
 	}
 
-	summaries, nextPageToken, err := s.store.List(ctx, pageSize, req.GetPageToken())
+	// Only published blogs are listed unless asked otherwise
+	filter := datastore.ListFilter{Status: datastore.StatusPublished}
+	if req.GetStatus() != blogpb.BlogStatus_BLOG_STATUS_UNSPECIFIED {
+		filter.Status = toStoreStatus(req.GetStatus())
+	}
+	if req.GetShowDeleted() {
+		filter.Deleted = datastore.IncludeDeleted
+	}
+	filter.Tag = req.GetTag()
+
+	// Callers who may not edit the blogs of others only see what readers do
+	if (filter.Status != datastore.StatusPublished || filter.Deleted != datastore.ExcludeDeleted) && !s.mayEdit(ctx, "") {
+		filter.Status = datastore.StatusPublished
+		filter.Deleted = datastore.ExcludeDeleted
+	}
+
+	summaries, nextPageToken, err := s.store.List(ctx, pageSize, req.GetPageToken(), filter)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list blogs: %v", err)
+		return nil, storeError(err, "failed to list blogs")
 	}
 
-	pbSummaries := make([]*blogpb.BlogSummary, len(summaries))
-	for i, summary := range summaries {
-		pbSummaries[i] = &blogpb.BlogSummary{
-			Id: &blogpb.UUID{
-				Value: string(summary.ID),
-			},
-			Title:        summary.Title,
-			CommentCount: summary.CommentCount,
+	return &blogpb.ListResp{
+		Blogs:         toProtoSummaries(summaries),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// ListTags lists the tags of blogs with how many blogs have each
+func (s *BlogService) ListTags(ctx context.Context, req *blogpb.ListTagsReq) (*blogpb.ListTagsResp, error) {
+	// Only published blogs are counted unless an editor asks otherwise
+	status := datastore.StatusPublished
+	if req.GetStatus() != blogpb.BlogStatus_BLOG_STATUS_UNSPECIFIED && s.mayEdit(ctx, "") {
+		status = toStoreStatus(req.GetStatus())
+	}
+
+	tags, err := s.store.ListTags(ctx, status)
+	if err != nil {
+		return nil, storeError(err, "failed to list tags")
+	}
+
+	pbTags := make([]*blogpb.TagCount, len(tags))
+	for i, tag := range tags {
+		pbTags[i] = &blogpb.TagCount{
+			Name:      tag.Name,
+			PostCount: tag.BlogCount,
 		}
 	}
 
-	return &blogpb.ListResp{
-		Blogs:         pbSummaries,
+	return &blogpb.ListTagsResp{
+		Tags: pbTags,
+	}, nil
+}
+
+// Search finds published blogs by the words they contain, best matches first
+func (s *BlogService) Search(ctx context.Context, req *blogpb.SearchReq) (*blogpb.SearchResp, error) {
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = 10 // Default page size
+	}
+	if pageSize > 100 {
+		pageSize = 100 // Maximum page size
+	}
+
+	terms := search.Parse(req.GetQ())
+	if len(terms) == 0 {
+		return nil, invalidArgument("q", "q must contain at least one word")
+	}
+
+	query := datastore.SearchQuery{Terms: terms, IncludeComments: req.GetIncludeComments()}
+	results, nextPageToken, err := s.store.Search(ctx, query, pageSize, req.GetPageToken())
+	if err != nil {
+		return nil, storeError(err, "failed to search blogs")
+	}
+
+	pbResults := make([]*blogpb.SearchResult, len(results))
+	for i, result := range results {
+		pbResults[i] = &blogpb.SearchResult{
+			Blog:    toProtoSummary(&result.BlogSummary),
+			Snippet: result.Snippet,
+			Rank:    result.Rank,
+		}
+	}
+
+	return &blogpb.SearchResp{
+		Results:       pbResults,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// Undelete restores a blog from the trash
+func (s *BlogService) Undelete(ctx context.Context, req *blogpb.UndeleteReq) (*emptypb.Empty, error) {
+	if req.GetId() == nil {
+		return nil, status.Error(codes.InvalidArgument, "blog ID is required")
+	}
+
+	id := datastore.ID(req.GetId().GetValue())
+	err := s.store.Undelete(ctx, id)
+	if err != nil {
+		return nil, storeError(err, "failed to undelete blog")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListDeleted lists the blogs in the trash, whatever their status
+func (s *BlogService) ListDeleted(ctx context.Context, req *blogpb.ListDeletedReq) (*blogpb.ListDeletedResp, error) {
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = 10 // Default page size
+	}
+	if pageSize > 100 {
+		pageSize = 100 // Maximum page size
+	}
+
+	// Callers who may not edit the blogs of others only see their own
+	filter := datastore.ListFilter{Deleted: datastore.OnlyDeleted}
+	if !s.mayEdit(ctx, "") {
+		principal, ok := auth.FromContext(ctx)
+		if !ok {
+			return &blogpb.ListDeletedResp{}, nil
+		}
+		filter.Owner = principal.Subject
+	}
+
+	summaries, nextPageToken, err := s.store.List(ctx, pageSize, req.GetPageToken(), filter)
+	if err != nil {
+		return nil, storeError(err, "failed to list deleted blogs")
+	}
+
+	return &blogpb.ListDeletedResp{
+		Blogs:         toProtoSummaries(summaries),
 		NextPageToken: nextPageToken,
 	}, nil
 }
 
 // AddComment adds a comment to a blog
-func (s *BlogService) AddComment(ctx context.Context, req *blogpb.AddCommentReq) (*emptypb.Empty, error) {
+func (s *BlogService) AddComment(ctx context.Context, req *blogpb.AddCommentReq) (*blogpb.AddCommentResp, error) {
 	if req.GetId() == nil {
 		return nil, status.Error(codes.InvalidArgument, "blog ID is required")
 	}
 
-	// Validate inputs
-	if req.GetContent() == "" {
-		return nil, status.Error(codes.InvalidArgument, "comment content is required")
+	id := datastore.ID(req.GetId().GetValue())
+	var parentID *datastore.ID
+	if req.GetParentId() != nil {
+		parent := datastore.ID(req.GetParentId().GetValue())
+		parentID = &parent
+	}
+	var opts []datastore.CommentOption
+	if req.GetAuthorId() != nil {
+		authorID := datastore.ID(req.GetAuthorId().GetValue())
+		if err := s.checkAuthor(ctx, authorID, "failed to add comment"); err != nil {
+			return nil, err
+		}
+		opts = append(opts, datastore.WithCommentAuthor(authorID))
 	}
-	if req.GetAuthor() == "" {
-		return nil, status.Error(codes.InvalidArgument, "comment author is required")
+	blog, err := s.store.Get(ctx, id, datastore.WithoutContent(), datastore.WithoutComments())
+	if err != nil {
+		return nil, storeError(err, "failed to add comment")
+	}
+
+	// Only those who may see a hidden blog may comment on it
+	if hidden(blog) && !s.mayEdit(ctx, blog.Owner) {
+		return nil, status.Error(codes.FailedPrecondition, "failed to add comment: blog is not published")
+	}
+	state := s.newCommentState(blog)
+
+	// Comments the filters turn down are still stored, hidden, so moderators
+	// can review the verdicts
+	var decision filter.Decision
+	if s.commentFilter != nil {
+		decision, err = s.commentFilter.Run(ctx, &datastore.Comment{
+			BlogID:   id,
+			ParentID: parentID,
+			Content:  req.GetContent(),
+			Author:   req.GetAuthor(),
+		})
+		if err != nil {
+			return nil, storeError(err, "failed to filter comment")
+		}
+		if decision.State != "" {
+			state = decision.State
+		}
+		if len(decision.Verdicts) > 0 {
+			opts = append(opts, datastore.WithCommentVerdicts(decision.Verdicts))
+		}
+	}
+
+	comment, err := s.store.AddComment(ctx, id, parentID, req.GetContent(), req.GetAuthor(), s.maxCommentDepth, state, opts...)
+	if err != nil {
+		return nil, storeError(err, "failed to add comment")
+	}
+
+	pbComment := toProtoComment(*comment)
+	if err := s.embedAuthors(ctx, nil, pbComment); err != nil {
+		return nil, err
+	}
+
+	return &blogpb.AddCommentResp{
+		Comment: pbComment,
+	}, nil
+}
+
+// Publish makes a blog publicly listed
+func (s *BlogService) Publish(ctx context.Context, req *blogpb.PublishReq) (*emptypb.Empty, error) {
+	if req.GetId() == nil {
+		return nil, status.Error(codes.InvalidArgument, "blog ID is required")
 	}
 
 	id := datastore.ID(req.GetId().GetValue())
-	_, err := s.store.AddComment(ctx, id, req.GetContent(), req.GetAuthor())
+	err := s.store.Publish(ctx, id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to add comment: %v", err)
+		return nil, storeError(err, "failed to publish blog")
 	}
 
 	return &emptypb.Empty{}, nil
 }
+
+// Unpublish moves a published blog back to draft
+func (s *BlogService) Unpublish(ctx context.Context, req *blogpb.UnpublishReq) (*emptypb.Empty, error) {
+	if req.GetId() == nil {
+		return nil, status.Error(codes.InvalidArgument, "blog ID is required")
+	}
+
+	id := datastore.ID(req.GetId().GetValue())
+	err := s.store.Unpublish(ctx, id)
+	if err != nil {
+		return nil, storeError(err, "failed to unpublish blog")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// toProtoSummaries converts datastore blog summaries to protobuf messages
+func toProtoSummaries(summaries []*datastore.BlogSummary) []*blogpb.BlogSummary {
+	pbSummaries := make([]*blogpb.BlogSummary, len(summaries))
+	for i, summary := range summaries {
+		pbSummaries[i] = toProtoSummary(summary)
+	}
+	return pbSummaries
+}
+
+// toProtoSummary converts a datastore blog summary to a protobuf message
+func toProtoSummary(summary *datastore.BlogSummary) *blogpb.BlogSummary {
+	pbSummary := &blogpb.BlogSummary{
+		Id: &blogpb.UUID{
+			Value: string(summary.ID),
+		},
+		Title:        summary.Title,
+		CommentCount: summary.CommentCount,
+		Status:       toProtoStatus(summary.Status),
+		Slug:         summary.Slug,
+	}
+	if summary.DeletedAt != nil {
+		pbSummary.DeletedAt = timestamppb.New(*summary.DeletedAt)
+	}
+	return pbSummary
+}
+
+// toEtag converts a datastore version to the etag of a blog
+func toEtag(version int64) string {
+	return strconv.FormatInt(version, 10)
+}
+
+// toVersion converts an etag to the datastore version it stands for. The
+// empty etag converts to version 0, which matches any version.
+func toVersion(etag string) (int64, error) {
+	if etag == "" {
+		return 0, nil
+	}
+	version, err := strconv.ParseInt(etag, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "malformed etag %q", etag)
+	}
+	return version, nil
+}
+
+// storeStatuses maps API statuses to datastore statuses
+var storeStatuses = map[blogpb.BlogStatus]datastore.Status{
+	blogpb.BlogStatus_BLOG_STATUS_DRAFT:     datastore.StatusDraft,
+	blogpb.BlogStatus_BLOG_STATUS_SCHEDULED: datastore.StatusScheduled,
+	blogpb.BlogStatus_BLOG_STATUS_PUBLISHED: datastore.StatusPublished,
+	blogpb.BlogStatus_BLOG_STATUS_ARCHIVED:  datastore.StatusArchived,
+}
+
+// toStoreStatus converts an API status to a datastore status. Unknown
+// statuses convert to the empty status, which the datastore rejects.
+func toStoreStatus(status blogpb.BlogStatus) datastore.Status {
+	return storeStatuses[status]
+}
+
+// toProtoStatus converts a datastore status to an API status
+func toProtoStatus(status datastore.Status) blogpb.BlogStatus {
+	for pbStatus, storeStatus := range storeStatuses {
+		if storeStatus == status {
+			return pbStatus
+		}
+	}
+	return blogpb.BlogStatus_BLOG_STATUS_UNSPECIFIED
+}
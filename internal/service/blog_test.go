@@ -3,17 +3,23 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/agruetz/prosigliere/internal/auth"
 	"github.com/agruetz/prosigliere/internal/datastore"
 	"github.com/agruetz/prosigliere/internal/datastore/mocks"
+	"github.com/agruetz/prosigliere/internal/filter"
 	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
 )
 
@@ -23,9 +29,15 @@ func TestNewBlogService(t *testing.T) {
 
 	assert.NotNil(t, service)
 	assert.Equal(t, mockStore, service.store)
+	assert.Equal(t, int32(DefaultMaxCommentDepth), service.maxCommentDepth)
+
+	service = NewBlogService(mockStore, WithMaxCommentDepth(2))
+	assert.Equal(t, int32(2), service.maxCommentDepth)
 }
 
 func TestBlogService_Create(t *testing.T) {
+	testPublishAt := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+
 	tests := []struct {
 		name        string
 		req         *blogpb.CreateReq
@@ -40,7 +52,49 @@ func TestBlogService_Create(t *testing.T) {
 				Content: "This is a test blog content",
 			},
 			setupMock: func(mockStore *mocks.Store) {
-				mockStore.On("Create", mock.Anything, "Test Blog", "This is a test blog content").
+				mockStore.On("Create", mock.Anything, "Test Blog", "This is a test blog content", datastore.StatusPublished, (*time.Time)(nil), []string(nil)).
+					Return(datastore.ID("123e4567-e89b-12d3-a456-426614174000"), nil)
+			},
+			expectedID:  "123e4567-e89b-12d3-a456-426614174000",
+			expectedErr: nil,
+		},
+		{
+			name: "successful draft creation",
+			req: &blogpb.CreateReq{
+				Title:   "Test Blog",
+				Content: "This is a test blog content",
+				Status:  blogpb.BlogStatus_BLOG_STATUS_DRAFT,
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Create", mock.Anything, "Test Blog", "This is a test blog content", datastore.StatusDraft, (*time.Time)(nil), []string(nil)).
+					Return(datastore.ID("123e4567-e89b-12d3-a456-426614174000"), nil)
+			},
+			expectedID:  "123e4567-e89b-12d3-a456-426614174000",
+			expectedErr: nil,
+		},
+		{
+			name: "successful scheduled creation",
+			req: &blogpb.CreateReq{
+				Title:     "Test Blog",
+				Content:   "This is a test blog content",
+				PublishAt: timestamppb.New(testPublishAt),
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Create", mock.Anything, "Test Blog", "This is a test blog content", datastore.StatusScheduled, &testPublishAt, []string(nil)).
+					Return(datastore.ID("123e4567-e89b-12d3-a456-426614174000"), nil)
+			},
+			expectedID:  "123e4567-e89b-12d3-a456-426614174000",
+			expectedErr: nil,
+		},
+		{
+			name: "successful creation with tags",
+			req: &blogpb.CreateReq{
+				Title:   "Test Blog",
+				Content: "This is a test blog content",
+				Tags:    []string{"gardening", "tomatoes"},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Create", mock.Anything, "Test Blog", "This is a test blog content", datastore.StatusPublished, (*time.Time)(nil), []string{"gardening", "tomatoes"}).
 					Return(datastore.ID("123e4567-e89b-12d3-a456-426614174000"), nil)
 			},
 			expectedID:  "123e4567-e89b-12d3-a456-426614174000",
@@ -52,7 +106,7 @@ func TestBlogService_Create(t *testing.T) {
 				Content: "This is a test blog content",
 			},
 			setupMock: func(mockStore *mocks.Store) {
-				mockStore.On("Create", mock.Anything, "", "This is a test blog content").
+				mockStore.On("Create", mock.Anything, "", "This is a test blog content", datastore.StatusPublished, (*time.Time)(nil), []string(nil)).
 					Return(datastore.ID(""), errors.New("missing title"))
 			},
 			expectedID:  "",
@@ -64,7 +118,7 @@ func TestBlogService_Create(t *testing.T) {
 				Title: "Test Blog",
 			},
 			setupMock: func(mockStore *mocks.Store) {
-				mockStore.On("Create", mock.Anything, "Test Blog", "").
+				mockStore.On("Create", mock.Anything, "Test Blog", "", datastore.StatusPublished, (*time.Time)(nil), []string(nil)).
 					Return(datastore.ID(""), errors.New("missing content"))
 			},
 			expectedID:  "",
@@ -77,7 +131,7 @@ func TestBlogService_Create(t *testing.T) {
 				Content: "This is a test blog content",
 			},
 			setupMock: func(mockStore *mocks.Store) {
-				mockStore.On("Create", mock.Anything, "Test Blog", "This is a test blog content").
+				mockStore.On("Create", mock.Anything, "Test Blog", "This is a test blog content", datastore.StatusPublished, (*time.Time)(nil), []string(nil)).
 					Return(datastore.ID(""), errors.New("database error"))
 			},
 			expectedID:  "",
@@ -106,7 +160,415 @@ func TestBlogService_Create(t *testing.T) {
 	}
 }
 
+func TestBlogService_CreateOwner(t *testing.T) {
+	mockStore := mocks.NewStore(t)
+	mockStore.On("Create", mock.Anything, "Test Blog", "This is a test blog content", datastore.StatusPublished, (*time.Time)(nil), []string(nil),
+		mock.MatchedBy(func(opt datastore.CreateOption) bool {
+			return datastore.NewCreateOptions(opt).Owner == "alice"
+		})).
+		Return(datastore.ID("123e4567-e89b-12d3-a456-426614174000"), nil)
+
+	service := NewBlogService(mockStore)
+	ctx := auth.NewContext(context.Background(), &auth.Principal{Subject: "alice"})
+	resp, err := service.Create(ctx, &blogpb.CreateReq{
+		Title:   "Test Blog",
+		Content: "This is a test blog content",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "123e4567-e89b-12d3-a456-426614174000", resp.GetId().GetValue())
+}
+
+func TestBlogService_CreateAuthor(t *testing.T) {
+	authorID := datastore.ID("223e4567-e89b-12d3-a456-426614174000")
+	mockStore := mocks.NewStore(t)
+	mockStore.On("Create", mock.Anything, "Test Blog", "This is a test blog content", datastore.StatusPublished, (*time.Time)(nil), []string(nil),
+		mock.MatchedBy(func(opt datastore.CreateOption) bool {
+			author := datastore.NewCreateOptions(opt).AuthorID
+			return author != nil && *author == authorID
+		})).
+		Return(datastore.ID("123e4567-e89b-12d3-a456-426614174000"), nil)
+
+	service := NewBlogService(mockStore)
+	resp, err := service.Create(context.Background(), &blogpb.CreateReq{
+		Title:    "Test Blog",
+		Content:  "This is a test blog content",
+		AuthorId: &blogpb.UUID{Value: string(authorID)},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "123e4567-e89b-12d3-a456-426614174000", resp.GetId().GetValue())
+}
+
+func TestBlogService_GetAuthors(t *testing.T) {
+	blogID := datastore.ID("123e4567-e89b-12d3-a456-426614174000")
+	aliceID := datastore.ID("223e4567-e89b-12d3-a456-426614174000")
+	bobID := datastore.ID("323e4567-e89b-12d3-a456-426614174000")
+	goneID := datastore.ID("423e4567-e89b-12d3-a456-426614174000")
+	parentID := datastore.ID("c2")
+	blog := &datastore.Blog{
+		ID:       blogID,
+		AuthorID: &aliceID,
+		Comments: []datastore.Comment{
+			{ID: "c1", BlogID: blogID, Author: "Guest"},
+			{ID: "c2", BlogID: blogID, AuthorID: &bobID},
+			{ID: "c3", BlogID: blogID, ParentID: &parentID, Depth: 1, Author: "Alice the gardener", AuthorID: &aliceID},
+			{ID: "c4", BlogID: blogID, AuthorID: &goneID},
+		},
+	}
+
+	mockStore := mocks.NewStore(t)
+	mockStore.On("Get", mock.Anything, blogID, mock.Anything).Return(blog, nil)
+	mockStore.On("GetUsers", mock.Anything, []datastore.ID{aliceID, bobID, goneID}).
+		Return([]*datastore.User{
+			{ID: bobID, DisplayName: "Bob"},
+			{ID: aliceID, DisplayName: "Alice", Bio: "Grows tomatoes"},
+		}, nil).Once()
+
+	// Authors are looked up once, replies included
+	service := NewBlogService(mockStore)
+	resp, err := service.Get(context.Background(), &blogpb.GetReq{
+		Id:          &blogpb.UUID{Value: string(blogID)},
+		CommentView: blogpb.CommentView_COMMENT_VIEW_TREE,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Alice", resp.GetBlog().GetAuthorProfile().GetDisplayName())
+	assert.Equal(t, "Grows tomatoes", resp.GetBlog().GetAuthorProfile().GetBio())
+	comments := resp.GetBlog().GetComments()
+	require.Len(t, comments, 3)
+	assert.Equal(t, "Guest", comments[0].GetAuthor())
+	assert.Nil(t, comments[0].GetAuthorProfile())
+	assert.Equal(t, "Bob", comments[1].GetAuthor(), "comments are signed with the display name of their author")
+	assert.Equal(t, "Bob", comments[1].GetAuthorProfile().GetDisplayName())
+	require.Len(t, comments[1].GetReplies(), 1)
+	assert.Equal(t, "Alice the gardener", comments[1].GetReplies()[0].GetAuthor())
+	assert.Equal(t, "Alice", comments[1].GetReplies()[0].GetAuthorProfile().GetDisplayName())
+	assert.Equal(t, string(goneID), comments[2].GetAuthorProfile().GetId().GetValue())
+	assert.Empty(t, comments[2].GetAuthorProfile().GetDisplayName())
+}
+
+func TestBlogService_GetAuthorsError(t *testing.T) {
+	blogID := datastore.ID("123e4567-e89b-12d3-a456-426614174000")
+	authorID := datastore.ID("223e4567-e89b-12d3-a456-426614174000")
+	mockStore := mocks.NewStore(t)
+	mockStore.On("Get", mock.Anything, blogID, mock.Anything).
+		Return(&datastore.Blog{ID: blogID, AuthorID: &authorID}, nil)
+	mockStore.On("GetUsers", mock.Anything, []datastore.ID{authorID}).
+		Return(nil, datastore.Unavailable(errors.New("connection refused")))
+
+	service := NewBlogService(mockStore)
+	resp, err := service.Get(context.Background(), &blogpb.GetReq{Id: &blogpb.UUID{Value: string(blogID)}})
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Contains(t, err.Error(), "failed to get authors")
+}
+
 func TestBlogService_Get(t *testing.T) {
+	testTime := time.Now().UTC()
+	testBlog := &datastore.Blog{
+		ID:           datastore.ID("123e4567-e89b-12d3-a456-426614174000"),
+		Title:        "Test Blog",
+		Content:      "This is a test blog content",
+		CreatedAt:    testTime,
+		UpdatedAt:    testTime,
+		Status:       datastore.StatusPublished,
+		PublishedAt:  &testTime,
+		Version:      7,
+		Tags:         []string{"gardening"},
+		CommentCount: 3,
+	}
+
+	tests := []struct {
+		name        string
+		req         *blogpb.GetReq
+		setupMock   func(mock *mocks.Store)
+		expectedErr error
+	}{
+		{
+			name: "successful get",
+			req: &blogpb.GetReq{
+				Id: &blogpb.UUID{
+					Value: "123e4567-e89b-12d3-a456-426614174000",
+				},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Get", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), mock.Anything).
+					Return(testBlog, nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "missing ID",
+			req:  &blogpb.GetReq{},
+			setupMock: func(mockStore *mocks.Store) {
+				// No mock setup needed
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "blog ID is required"),
+		},
+		{
+			name: "store error",
+			req: &blogpb.GetReq{
+				Id: &blogpb.UUID{
+					Value: "123e4567-e89b-12d3-a456-426614174000",
+				},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Get", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), mock.Anything).
+					Return(nil, errors.New("database error"))
+			},
+			expectedErr: status.Error(codes.Internal, "failed to get blog: database error"),
+		},
+		{
+			name: "blog not found",
+			req: &blogpb.GetReq{
+				Id: &blogpb.UUID{
+					Value: "123e4567-e89b-12d3-a456-426614174000",
+				},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Get", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), mock.Anything).
+					Return(nil, datastore.NotFound(datastore.ResourceBlog, "123e4567-e89b-12d3-a456-426614174000"))
+			},
+			expectedErr: status.Error(codes.NotFound, "failed to get blog: blog not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewBlogService(mockStore)
+			resp, err := service.Get(context.Background(), tt.req)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErr.Error(), err.Error())
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.Equal(t, testBlog.ID, datastore.ID(resp.Blog.Id.Value))
+				assert.Equal(t, testBlog.Title, resp.Blog.Title)
+				assert.Equal(t, testBlog.Content, resp.Blog.Content)
+				assert.Equal(t, timestamppb.New(testBlog.CreatedAt).AsTime().Unix(), resp.Blog.CreatedAt.AsTime().Unix())
+				assert.Equal(t, timestamppb.New(testBlog.UpdatedAt).AsTime().Unix(), resp.Blog.UpdatedAt.AsTime().Unix())
+				assert.Equal(t, blogpb.BlogStatus_BLOG_STATUS_PUBLISHED, resp.Blog.Status)
+				assert.Equal(t, testBlog.PublishedAt.Unix(), resp.Blog.PublishedAt.AsTime().Unix())
+				assert.Nil(t, resp.Blog.PublishAt)
+				assert.Equal(t, "7", resp.Blog.Etag)
+				assert.Equal(t, []string{"gardening"}, resp.Blog.Tags)
+				assert.Equal(t, int32(3), resp.Blog.CommentCount)
+			}
+		})
+	}
+}
+
+func TestBlogService_GetReadMask(t *testing.T) {
+	testTime := time.Now().UTC()
+	testBlog := &datastore.Blog{
+		ID:          datastore.ID("123e4567-e89b-12d3-a456-426614174000"),
+		Title:       "Test Blog",
+		CreatedAt:   testTime,
+		UpdatedAt:   testTime,
+		Status:      datastore.StatusPublished,
+		PublishedAt: &testTime,
+		Version:     7,
+		Comments:    []datastore.Comment{},
+	}
+
+	mockStore := mocks.NewStore(t)
+	// Content and comments are left out, so the store is asked to skip both
+	mockStore.On("Get", mock.Anything, testBlog.ID, mock.Anything, mock.Anything, mock.Anything).
+		Return(testBlog, nil)
+
+	service := NewBlogService(mockStore)
+	resp, err := service.Get(context.Background(), &blogpb.GetReq{
+		Id:       &blogpb.UUID{Value: string(testBlog.ID)},
+		ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"id", "title", "etag"}},
+	})
+
+	assert.NoError(t, err)
+	expected := &blogpb.Blog{
+		Id:    &blogpb.UUID{Value: string(testBlog.ID)},
+		Title: "Test Blog",
+		Etag:  "7",
+	}
+	assert.True(t, proto.Equal(expected, resp.Blog), "got %v", resp.Blog)
+
+	// Unknown paths are rejected before reading the blog
+	_, err = service.Get(context.Background(), &blogpb.GetReq{
+		Id:       &blogpb.UUID{Value: string(testBlog.ID)},
+		ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"author"}},
+	})
+	assert.Equal(t, status.Error(codes.InvalidArgument, `unknown read_mask path "author"`).Error(), err.Error())
+}
+
+func TestBlogService_GetShowDeleted(t *testing.T) {
+	testTime := time.Now().UTC()
+	testBlog := &datastore.Blog{
+		ID:        datastore.ID("123e4567-e89b-12d3-a456-426614174000"),
+		Title:     "Test Blog",
+		Content:   "This is a test blog content",
+		CreatedAt: testTime,
+		UpdatedAt: testTime,
+		Status:    datastore.StatusDraft,
+		Version:   3,
+		DeletedAt: &testTime,
+	}
+
+	mockStore := mocks.NewStore(t)
+	// The store is only asked for trashed blogs when the request does
+	showsDeleted := mock.MatchedBy(func(opt datastore.GetOption) bool {
+		return datastore.NewGetOptions(opt).ShowDeleted
+	})
+	mockStore.On("Get", mock.Anything, testBlog.ID, mock.Anything, showsDeleted).
+		Return(testBlog, nil)
+	mockStore.On("Get", mock.Anything, testBlog.ID, mock.Anything).
+		Return(nil, datastore.NotFound(datastore.ResourceBlog, testBlog.ID))
+
+	service := NewBlogService(mockStore)
+	resp, err := service.Get(context.Background(), &blogpb.GetReq{
+		Id:          &blogpb.UUID{Value: string(testBlog.ID)},
+		ShowDeleted: true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, testTime, resp.Blog.DeletedAt.AsTime())
+
+	_, err = service.Get(context.Background(), &blogpb.GetReq{
+		Id: &blogpb.UUID{Value: string(testBlog.ID)},
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestBlogService_GetMaxComments(t *testing.T) {
+	testBlog := &datastore.Blog{
+		ID:     datastore.ID("123e4567-e89b-12d3-a456-426614174000"),
+		Title:  "Test Blog",
+		Status: datastore.StatusPublished,
+	}
+
+	tests := []struct {
+		name          string
+		maxComments   int32
+		expectedLimit int32
+	}{
+		{
+			name:          "default limit",
+			maxComments:   0,
+			expectedLimit: 100,
+		},
+		{
+			name:          "requested limit",
+			maxComments:   5,
+			expectedLimit: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			limits := mock.MatchedBy(func(opt datastore.GetOption) bool {
+				return datastore.NewGetOptions(opt).CommentLimit == tt.expectedLimit
+			})
+			mockStore.On("Get", mock.Anything, testBlog.ID, limits).
+				Return(testBlog, nil)
+
+			service := NewBlogService(mockStore)
+			_, err := service.Get(context.Background(), &blogpb.GetReq{
+				Id:          &blogpb.UUID{Value: string(testBlog.ID)},
+				MaxComments: tt.maxComments,
+			})
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestBlogService_GetCommentView(t *testing.T) {
+	rootID := datastore.ID("comment-1")
+	replyID := datastore.ID("comment-3")
+	missingID := datastore.ID("comment-missing")
+	testBlog := &datastore.Blog{
+		ID:     datastore.ID("123e4567-e89b-12d3-a456-426614174000"),
+		Title:  "Test Blog",
+		Status: datastore.StatusPublished,
+		// Comments come from the store oldest first
+		Comments: []datastore.Comment{
+			{ID: rootID, Content: "Root"},
+			{ID: "comment-2", Content: "Other Root"},
+			{ID: replyID, ParentID: &rootID, Depth: 1, Content: "Reply"},
+			{ID: "comment-4", ParentID: &replyID, Depth: 2, Content: "Nested Reply"},
+			{ID: "comment-5", ParentID: &rootID, Depth: 1, Content: "Second Reply"},
+			{ID: "comment-6", ParentID: &missingID, Depth: 1, Content: "Orphan"},
+		},
+	}
+
+	// comments flattens a tree of comments into "content/parent/depth/replies"
+	// strings
+	var comments func([]*blogpb.Comment) []string
+	comments = func(pbComments []*blogpb.Comment) []string {
+		var out []string
+		for _, c := range pbComments {
+			out = append(out, fmt.Sprintf("%s/%s/%d/%d", c.Content, c.GetParentId().GetValue(), c.Depth, len(c.Replies)))
+			out = append(out, comments(c.Replies)...)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name             string
+		view             blogpb.CommentView
+		expectedRoots    int
+		expectedComments []string
+	}{
+		{
+			name:          "default view is flat",
+			view:          blogpb.CommentView_COMMENT_VIEW_UNSPECIFIED,
+			expectedRoots: 6,
+			expectedComments: []string{
+				"Root//0/0", "Reply/comment-1/1/0", "Nested Reply/comment-3/2/0", "Second Reply/comment-1/1/0", "Other Root//0/0", "Orphan/comment-missing/1/0",
+			},
+		},
+		{
+			name:          "flat view",
+			view:          blogpb.CommentView_COMMENT_VIEW_FLAT,
+			expectedRoots: 6,
+			expectedComments: []string{
+				"Root//0/0", "Reply/comment-1/1/0", "Nested Reply/comment-3/2/0", "Second Reply/comment-1/1/0", "Other Root//0/0", "Orphan/comment-missing/1/0",
+			},
+		},
+		{
+			name:          "tree view",
+			view:          blogpb.CommentView_COMMENT_VIEW_TREE,
+			expectedRoots: 3,
+			expectedComments: []string{
+				"Root//0/2", "Reply/comment-1/1/1", "Nested Reply/comment-3/2/0", "Second Reply/comment-1/1/0", "Other Root//0/0", "Orphan/comment-missing/1/0",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			mockStore.On("Get", mock.Anything, testBlog.ID, mock.Anything).
+				Return(testBlog, nil)
+
+			service := NewBlogService(mockStore)
+			resp, err := service.Get(context.Background(), &blogpb.GetReq{
+				Id:          &blogpb.UUID{Value: string(testBlog.ID)},
+				CommentView: tt.view,
+			})
+			assert.NoError(t, err)
+			assert.Len(t, resp.Blog.Comments, tt.expectedRoots)
+			assert.Equal(t, tt.expectedComments, comments(resp.Blog.Comments))
+			assert.Equal(t, "comment-1", resp.Blog.Comments[0].Id.Value)
+			assert.Nil(t, resp.Blog.Comments[0].ParentId)
+		})
+	}
+}
+
+func TestBlogService_GetBySlug(t *testing.T) {
 	testTime := time.Now().UTC()
 	testBlog := &datastore.Blog{
 		ID:        datastore.ID("123e4567-e89b-12d3-a456-426614174000"),
@@ -114,47 +576,1058 @@ func TestBlogService_Get(t *testing.T) {
 		Content:   "This is a test blog content",
 		CreatedAt: testTime,
 		UpdatedAt: testTime,
+		Status:    datastore.StatusPublished,
+		Version:   2,
+		Slug:      "test-blog",
+	}
+
+	tests := []struct {
+		name             string
+		req              *blogpb.GetBySlugReq
+		setupMock        func(mock *mocks.Store)
+		expectedRedirect string
+		expectedErr      error
+	}{
+		{
+			name: "current slug",
+			req:  &blogpb.GetBySlugReq{Slug: "test-blog"},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("GetBySlug", mock.Anything, "test-blog", mock.Anything).
+					Return(testBlog, nil)
+			},
+			expectedRedirect: "",
+			expectedErr:      nil,
+		},
+		{
+			name: "previous slug",
+			req:  &blogpb.GetBySlugReq{Slug: "old-test-blog"},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("GetBySlug", mock.Anything, "old-test-blog", mock.Anything).
+					Return(testBlog, nil)
+			},
+			expectedRedirect: "test-blog",
+			expectedErr:      nil,
+		},
+		{
+			name: "previous slug without slug in read mask",
+			req: &blogpb.GetBySlugReq{
+				Slug:     "old-test-blog",
+				ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("GetBySlug", mock.Anything, "old-test-blog", mock.Anything, mock.Anything, mock.Anything).
+					Return(testBlog, nil)
+			},
+			expectedRedirect: "test-blog",
+			expectedErr:      nil,
+		},
+		{
+			name: "invalid read mask",
+			req: &blogpb.GetBySlugReq{
+				Slug:     "test-blog",
+				ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"author"}},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				// No mock setup needed
+			},
+			expectedErr: status.Error(codes.InvalidArgument, `unknown read_mask path "author"`),
+		},
+		{
+			name: "slug not found",
+			req:  &blogpb.GetBySlugReq{Slug: "missing"},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("GetBySlug", mock.Anything, "missing", mock.Anything).
+					Return(nil, datastore.NotFound(datastore.ResourceBlog, "missing"))
+			},
+			expectedErr: status.Error(codes.NotFound, "failed to get blog: blog not found"),
+		},
 	}
 
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewBlogService(mockStore)
+			resp, err := service.GetBySlug(context.Background(), tt.req)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErr.Error(), err.Error())
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, testBlog.Title, resp.Blog.Title)
+				assert.Equal(t, tt.expectedRedirect, resp.RedirectSlug)
+				if tt.req.ReadMask == nil {
+					assert.Equal(t, testBlog.Slug, resp.Blog.Slug)
+				}
+			}
+		})
+	}
+}
+
+func TestBlogService_Update(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *blogpb.UpdateReq
+		setupMock   func(mock *mocks.Store)
+		expectedErr error
+	}{
+		{
+			name: "successful update with both fields",
+			req: &blogpb.UpdateReq{
+				Id:      &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Title:   stringPtr("Updated Title"),
+				Content: stringPtr("Updated Content"),
+				Editor:  "alice",
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				title := "Updated Title"
+				content := "Updated Content"
+				mockStore.On("Update", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), datastore.BlogPatch{Title: &title, Content: &content}, "alice", int64(0)).
+					Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "successful update with title only",
+			req: &blogpb.UpdateReq{
+				Id:    &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Title: stringPtr("Updated Title"),
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				title := "Updated Title"
+				mockStore.On("Update", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), datastore.BlogPatch{Title: &title}, "", int64(0)).
+					Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "successful update with content only",
+			req: &blogpb.UpdateReq{
+				Id:      &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Content: stringPtr("Updated Content"),
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				content := "Updated Content"
+				mockStore.On("Update", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), datastore.BlogPatch{Content: &content}, "", int64(0)).
+					Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "successful update with status only",
+			req: &blogpb.UpdateReq{
+				Id:     &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Status: blogpb.BlogStatus_BLOG_STATUS_ARCHIVED.Enum(),
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				archived := datastore.StatusArchived
+				mockStore.On("Update", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), datastore.BlogPatch{Status: &archived}, "", int64(0)).
+					Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "successful update with publish time",
+			req: &blogpb.UpdateReq{
+				Id:        &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				PublishAt: timestamppb.New(time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)),
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				publishAt := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+				mockStore.On("Update", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), datastore.BlogPatch{PublishAt: &publishAt}, "", int64(0)).
+					Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "successful update with tags",
+			req: &blogpb.UpdateReq{
+				Id:   &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Tags: []string{"gardening"},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				tags := []string{"gardening"}
+				mockStore.On("Update", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), datastore.BlogPatch{Tags: &tags}, "", int64(0)).
+					Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "update mask clears tags",
+			req: &blogpb.UpdateReq{
+				Id:         &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tags"}},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				var tags []string
+				mockStore.On("Update", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), datastore.BlogPatch{Tags: &tags}, "", int64(0)).
+					Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "successful update with slug",
+			req: &blogpb.UpdateReq{
+				Id:   &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Slug: stringPtr("new-slug"),
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				slug := "new-slug"
+				mockStore.On("Update", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), datastore.BlogPatch{Slug: &slug}, "", int64(0)).
+					Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "slug taken",
+			req: &blogpb.UpdateReq{
+				Id:   &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Slug: stringPtr("taken"),
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				slug := "taken"
+				mockStore.On("Update", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), datastore.BlogPatch{Slug: &slug}, "", int64(0)).
+					Return(datastore.Conflict(datastore.ResourceBlog, "123e4567-e89b-12d3-a456-426614174000", errors.New(`slug "taken" is taken`)))
+			},
+			expectedErr: status.Error(codes.AlreadyExists, `failed to update blog: blog conflict: slug "taken" is taken`),
+		},
+		{
+			name: "successful update with comment policy",
+			req: &blogpb.UpdateReq{
+				Id:            &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				CommentPolicy: blogpb.CommentPolicy_COMMENT_POLICY_MODERATED.Enum(),
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				policy := datastore.CommentPolicyModerated
+				mockStore.On("Update", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), datastore.BlogPatch{CommentPolicy: &policy}, "", int64(0)).
+					Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "reset comment policy through update mask",
+			req: &blogpb.UpdateReq{
+				Id:         &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"comment_policy"}},
+				// Setting the policy to unspecified returns the blog to the
+				// server default
+				CommentPolicy: blogpb.CommentPolicy_COMMENT_POLICY_UNSPECIFIED.Enum(),
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				policy := datastore.CommentPolicyDefault
+				mockStore.On("Update", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), datastore.BlogPatch{CommentPolicy: &policy}, "", int64(0)).
+					Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "update mask limits the update",
+			req: &blogpb.UpdateReq{
+				Id:         &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Title:      stringPtr("Updated Title"),
+				Content:    stringPtr("Ignored Content"),
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				title := "Updated Title"
+				mockStore.On("Update", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), datastore.BlogPatch{Title: &title}, "", int64(0)).
+					Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "update mask names unset field",
+			req: &blogpb.UpdateReq{
+				Id:         &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Title:      stringPtr("Updated Title"),
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title", "content"}},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				// No mock setup needed
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "content is in update_mask but not set"),
+		},
+		{
+			name: "unsupported update mask path",
+			req: &blogpb.UpdateReq{
+				Id:         &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Editor:     "alice",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"editor"}},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				// No mock setup needed
+			},
+			expectedErr: status.Error(codes.InvalidArgument, `unsupported update_mask path "editor"`),
+		},
+		{
+			name: "successful update with etag",
+			req: &blogpb.UpdateReq{
+				Id:    &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Title: stringPtr("Updated Title"),
+				Etag:  "3",
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				title := "Updated Title"
+				mockStore.On("Update", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), datastore.BlogPatch{Title: &title}, "", int64(3)).
+					Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "malformed etag",
+			req: &blogpb.UpdateReq{
+				Id:    &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Title: stringPtr("Updated Title"),
+				Etag:  "x",
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				// No mock setup needed
+			},
+			expectedErr: status.Error(codes.InvalidArgument, `malformed etag "x"`),
+		},
+		{
+			name: "version mismatch",
+			req: &blogpb.UpdateReq{
+				Id:    &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Title: stringPtr("Updated Title"),
+				Etag:  "2",
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				title := "Updated Title"
+				mockStore.On("Update", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), datastore.BlogPatch{Title: &title}, "", int64(2)).
+					Return(datastore.VersionMismatch(datastore.ResourceBlog, "123e4567-e89b-12d3-a456-426614174000"))
+			},
+			expectedErr: status.Error(codes.Aborted, "failed to update blog: blog version mismatch"),
+		},
+		{
+			name: "missing ID",
+			req:  &blogpb.UpdateReq{},
+			setupMock: func(mockStore *mocks.Store) {
+				// No mock setup needed
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "blog ID is required"),
+		},
+		{
+			name: "store error",
+			req: &blogpb.UpdateReq{
+				Id:      &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Content: stringPtr("Updated Content"),
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				content := "Updated Content"
+				mockStore.On("Update", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), datastore.BlogPatch{Content: &content}, "", int64(0)).
+					Return(errors.New("update error"))
+			},
+			expectedErr: status.Error(codes.Internal, "failed to update blog: update error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewBlogService(mockStore)
+			resp, err := service.Update(context.Background(), tt.req)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErr.Error(), err.Error())
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+			}
+		})
+	}
+}
+
+func TestBlogService_Delete(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *blogpb.DeleteReq
+		setupMock   func(mock *mocks.Store)
+		expectedErr error
+	}{
+		{
+			name: "successful delete",
+			req: &blogpb.DeleteReq{
+				Id: &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Delete", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), int64(0)).
+					Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "successful delete with etag",
+			req: &blogpb.DeleteReq{
+				Id:   &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Etag: "5",
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Delete", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), int64(5)).
+					Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "version mismatch",
+			req: &blogpb.DeleteReq{
+				Id:   &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Etag: "4",
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Delete", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), int64(4)).
+					Return(datastore.VersionMismatch(datastore.ResourceBlog, "123e4567-e89b-12d3-a456-426614174000"))
+			},
+			expectedErr: status.Error(codes.Aborted, "failed to delete blog: blog version mismatch"),
+		},
+		{
+			name: "missing ID",
+			req:  &blogpb.DeleteReq{},
+			setupMock: func(mockStore *mocks.Store) {
+				// No mock setup needed
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "blog ID is required"),
+		},
+		{
+			name: "store error",
+			req: &blogpb.DeleteReq{
+				Id: &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Delete", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), int64(0)).
+					Return(errors.New("delete error"))
+			},
+			expectedErr: status.Error(codes.Internal, "failed to delete blog: delete error"),
+		},
+		{
+			name: "blog not found",
+			req: &blogpb.DeleteReq{
+				Id: &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Delete", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), int64(0)).
+					Return(datastore.NotFound(datastore.ResourceBlog, "123e4567-e89b-12d3-a456-426614174000"))
+			},
+			expectedErr: status.Error(codes.NotFound, "failed to delete blog: blog not found"),
+		},
+		{
+			name: "database unavailable",
+			req: &blogpb.DeleteReq{
+				Id: &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Delete", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), int64(0)).
+					Return(datastore.Unavailable(errors.New("connection refused")))
+			},
+			expectedErr: status.Error(codes.Unavailable, "failed to delete blog: unavailable: connection refused"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewBlogService(mockStore)
+			resp, err := service.Delete(context.Background(), tt.req)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErr.Error(), err.Error())
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+			}
+		})
+	}
+}
+
+func TestBlogService_List(t *testing.T) {
+	testDeletedAt := time.Now().UTC()
+	testSummaries := []*datastore.BlogSummary{
+		{
+			ID:           datastore.ID("123e4567-e89b-12d3-a456-426614174000"),
+			Title:        "Test Blog 1",
+			CommentCount: 5,
+		},
+		{
+			ID:           datastore.ID("223e4567-e89b-12d3-a456-426614174000"),
+			Title:        "Test Blog 2",
+			CommentCount: 3,
+		},
+	}
+
+	tests := []struct {
+		name           string
+		req            *blogpb.ListReq
+		setupMock      func(mock *mocks.Store)
+		expectedCount  int
+		expectedToken  string
+		expectedErr    error
+		expectedValues func(resp *blogpb.ListResp)
+	}{
+		{
+			name: "successful list with default page size",
+			req:  &blogpb.ListReq{},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("List", mock.Anything, int32(10), "", datastore.ListFilter{Status: datastore.StatusPublished}).
+					Return(testSummaries, "next-token", nil)
+			},
+			expectedCount: 2,
+			expectedToken: "next-token",
+			expectedErr:   nil,
+			expectedValues: func(resp *blogpb.ListResp) {
+				assert.Equal(t, "Test Blog 1", resp.Blogs[0].Title)
+				assert.Equal(t, int32(5), resp.Blogs[0].CommentCount)
+				assert.Equal(t, "Test Blog 2", resp.Blogs[1].Title)
+				assert.Equal(t, int32(3), resp.Blogs[1].CommentCount)
+			},
+		},
+		{
+			name: "successful list with custom page size",
+			req:  &blogpb.ListReq{PageSize: 20},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("List", mock.Anything, int32(20), "", datastore.ListFilter{Status: datastore.StatusPublished}).
+					Return(testSummaries, "next-token", nil)
+			},
+			expectedCount: 2,
+			expectedToken: "next-token",
+			expectedErr:   nil,
+			expectedValues: func(resp *blogpb.ListResp) {
+				assert.Equal(t, "Test Blog 1", resp.Blogs[0].Title)
+				assert.Equal(t, int32(5), resp.Blogs[0].CommentCount)
+			},
+		},
+		{
+			name: "successful list with page token",
+			req:  &blogpb.ListReq{PageToken: "token-1"},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("List", mock.Anything, int32(10), "token-1", datastore.ListFilter{Status: datastore.StatusPublished}).
+					Return(testSummaries, "next-token", nil)
+			},
+			expectedCount: 2,
+			expectedToken: "next-token",
+			expectedErr:   nil,
+			expectedValues: func(resp *blogpb.ListResp) {
+				assert.Equal(t, "Test Blog 1", resp.Blogs[0].Title)
+			},
+		},
+		{
+			name: "page size too large",
+			req:  &blogpb.ListReq{PageSize: 200},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("List", mock.Anything, int32(100), "", datastore.ListFilter{Status: datastore.StatusPublished}).
+					Return(testSummaries, "next-token", nil)
+			},
+			expectedCount: 2,
+			expectedToken: "next-token",
+			expectedErr:   nil,
+		},
+		{
+			name: "invalid page size",
+			req:  &blogpb.ListReq{PageSize: -10},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("List", mock.Anything, int32(10), "", datastore.ListFilter{Status: datastore.StatusPublished}).
+					Return(testSummaries, "next-token", nil)
+			},
+			expectedCount: 2,
+			expectedToken: "next-token",
+			expectedErr:   nil,
+		},
+		{
+			name: "successful list with status filter",
+			req:  &blogpb.ListReq{Status: blogpb.BlogStatus_BLOG_STATUS_DRAFT},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("List", mock.Anything, int32(10), "", datastore.ListFilter{Status: datastore.StatusDraft}).
+					Return([]*datastore.BlogSummary{
+						{
+							ID:     datastore.ID("123e4567-e89b-12d3-a456-426614174000"),
+							Title:  "Draft Blog",
+							Status: datastore.StatusDraft,
+						},
+					}, "", nil)
+			},
+			expectedCount: 1,
+			expectedToken: "",
+			expectedErr:   nil,
+			expectedValues: func(resp *blogpb.ListResp) {
+				assert.Equal(t, "Draft Blog", resp.Blogs[0].Title)
+				assert.Equal(t, blogpb.BlogStatus_BLOG_STATUS_DRAFT, resp.Blogs[0].Status)
+			},
+		},
+		{
+			name: "successful list with tag filter",
+			req:  &blogpb.ListReq{Tag: "gardening"},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("List", mock.Anything, int32(10), "", datastore.ListFilter{Status: datastore.StatusPublished, Tag: "gardening"}).
+					Return(testSummaries, "", nil)
+			},
+			expectedCount: 2,
+			expectedToken: "",
+			expectedErr:   nil,
+		},
+		{
+			name: "successful list including deleted blogs",
+			req:  &blogpb.ListReq{ShowDeleted: true},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("List", mock.Anything, int32(10), "", datastore.ListFilter{Status: datastore.StatusPublished, Deleted: datastore.IncludeDeleted}).
+					Return([]*datastore.BlogSummary{
+						{
+							ID:        datastore.ID("123e4567-e89b-12d3-a456-426614174000"),
+							Title:     "Deleted Blog",
+							Status:    datastore.StatusPublished,
+							DeletedAt: &testDeletedAt,
+						},
+					}, "", nil)
+			},
+			expectedCount: 1,
+			expectedToken: "",
+			expectedErr:   nil,
+			expectedValues: func(resp *blogpb.ListResp) {
+				assert.Equal(t, testDeletedAt, resp.Blogs[0].DeletedAt.AsTime())
+			},
+		},
+		{
+			name: "invalid page token",
+			req:  &blogpb.ListReq{PageToken: "invalid-token"},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("List", mock.Anything, int32(10), "invalid-token", datastore.ListFilter{Status: datastore.StatusPublished}).
+					Return(nil, "", errors.New("invalid page token"))
+			},
+			expectedCount: 0,
+			expectedToken: "",
+			expectedErr:   status.Error(codes.Internal, "failed to list blogs: invalid page token"),
+		},
+		{
+			name: "store error",
+			req:  &blogpb.ListReq{},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("List", mock.Anything, int32(10), "", datastore.ListFilter{Status: datastore.StatusPublished}).
+					Return(nil, "", errors.New("list error"))
+			},
+			expectedCount: 0,
+			expectedToken: "",
+			expectedErr:   status.Error(codes.Internal, "failed to list blogs: list error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewBlogService(mockStore)
+			resp, err := service.List(context.Background(), tt.req)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErr.Error(), err.Error())
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.Equal(t, tt.expectedCount, len(resp.Blogs))
+				assert.Equal(t, tt.expectedToken, resp.NextPageToken)
+				if tt.expectedValues != nil {
+					tt.expectedValues(resp)
+				}
+			}
+		})
+	}
+}
+
+func TestBlogService_AddComment(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *blogpb.AddCommentReq
+		opts        []Option
+		setupMock   func(mock *mocks.Store)
+		expectedErr error
+	}{
+		{
+			name: "successful add comment",
+			req: &blogpb.AddCommentReq{
+				Id:      &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Content: "Test comment",
+				Author:  "Test Author",
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Get", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), mock.Anything, mock.Anything).
+					Return(&datastore.Blog{}, nil)
+				mockStore.On("AddComment", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), (*datastore.ID)(nil), "Test comment", "Test Author", int32(DefaultMaxCommentDepth), datastore.CommentStateApproved).
+					Return(&datastore.Comment{ID: "comment-id"}, nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "missing ID",
+			req: &blogpb.AddCommentReq{
+				Content: "Test comment",
+				Author:  "Test Author",
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				// No mock setup needed
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "blog ID is required"),
+		},
+		{
+			name: "missing Content",
+			req: &blogpb.AddCommentReq{
+				Id:     &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Author: "Test Author",
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Get", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), mock.Anything, mock.Anything).
+					Return(&datastore.Blog{}, nil)
+				mockStore.On("AddComment", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), (*datastore.ID)(nil), "", "Test Author", int32(DefaultMaxCommentDepth), datastore.CommentStateApproved).
+					Return(&datastore.Comment{ID: "comment-id"}, nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "missing Author",
+			req: &blogpb.AddCommentReq{
+				Id:      &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Content: "Test comment",
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Get", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), mock.Anything, mock.Anything).
+					Return(&datastore.Blog{}, nil)
+				mockStore.On("AddComment", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), (*datastore.ID)(nil), "Test comment", "", int32(DefaultMaxCommentDepth), datastore.CommentStateApproved).
+					Return(&datastore.Comment{ID: "comment-id"}, nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "store error",
+			req: &blogpb.AddCommentReq{
+				Id:      &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Content: "Test comment",
+				Author:  "Test Author",
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Get", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), mock.Anything, mock.Anything).
+					Return(&datastore.Blog{}, nil)
+				mockStore.On("AddComment", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), (*datastore.ID)(nil), "Test comment", "Test Author", int32(DefaultMaxCommentDepth), datastore.CommentStateApproved).
+					Return(nil, errors.New("comment error"))
+			},
+			expectedErr: status.Error(codes.Internal, "failed to add comment: comment error"),
+		},
+		{
+			name: "successful reply",
+			req: &blogpb.AddCommentReq{
+				Id:       &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Content:  "Test reply",
+				Author:   "Test Author",
+				ParentId: &blogpb.UUID{Value: "223e4567-e89b-12d3-a456-426614174000"},
+			},
+			opts: []Option{WithMaxCommentDepth(2)},
+			setupMock: func(mockStore *mocks.Store) {
+				parentID := datastore.ID("223e4567-e89b-12d3-a456-426614174000")
+				mockStore.On("Get", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), mock.Anything, mock.Anything).
+					Return(&datastore.Blog{}, nil)
+				mockStore.On("AddComment", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), &parentID, "Test reply", "Test Author", int32(2), datastore.CommentStateApproved).
+					Return(&datastore.Comment{ID: "comment-id"}, nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "reply too deep",
+			req: &blogpb.AddCommentReq{
+				Id:       &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Content:  "Test reply",
+				Author:   "Test Author",
+				ParentId: &blogpb.UUID{Value: "223e4567-e89b-12d3-a456-426614174000"},
+			},
+			opts: []Option{WithMaxCommentDepth(0)},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Get", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), mock.Anything, mock.Anything).
+					Return(&datastore.Blog{}, nil)
+				mockStore.On("AddComment", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), mock.Anything, "Test reply", "Test Author", int32(0), datastore.CommentStateApproved).
+					Return(nil, datastore.Invalid(datastore.ResourceComment, "parent_id", errors.New("replies nest at most 0 deep")))
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "failed to add comment: comment invalid (parent_id): replies nest at most 0 deep"),
+		},
+		{
+			name: "parent not found",
+			req: &blogpb.AddCommentReq{
+				Id:       &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Content:  "Test reply",
+				Author:   "Test Author",
+				ParentId: &blogpb.UUID{Value: "223e4567-e89b-12d3-a456-426614174000"},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Get", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), mock.Anything, mock.Anything).
+					Return(&datastore.Blog{}, nil)
+				mockStore.On("AddComment", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), mock.Anything, "Test reply", "Test Author", int32(DefaultMaxCommentDepth), datastore.CommentStateApproved).
+					Return(nil, datastore.NotFound(datastore.ResourceComment, "223e4567-e89b-12d3-a456-426614174000"))
+			},
+			expectedErr: status.Error(codes.NotFound, "failed to add comment: comment not found"),
+		},
+		{
+			name: "moderated by default",
+			req: &blogpb.AddCommentReq{
+				Id:      &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Content: "Test comment",
+				Author:  "Test Author",
+			},
+			opts: []Option{WithCommentModeration(true)},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Get", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), mock.Anything, mock.Anything).
+					Return(&datastore.Blog{}, nil)
+				mockStore.On("AddComment", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), (*datastore.ID)(nil), "Test comment", "Test Author", int32(DefaultMaxCommentDepth), datastore.CommentStatePending).
+					Return(&datastore.Comment{ID: "comment-id"}, nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "open blog under default moderation",
+			req: &blogpb.AddCommentReq{
+				Id:      &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Content: "Test comment",
+				Author:  "Test Author",
+			},
+			opts: []Option{WithCommentModeration(true)},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Get", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), mock.Anything, mock.Anything).
+					Return(&datastore.Blog{CommentPolicy: datastore.CommentPolicyOpen}, nil)
+				mockStore.On("AddComment", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), (*datastore.ID)(nil), "Test comment", "Test Author", int32(DefaultMaxCommentDepth), datastore.CommentStateApproved).
+					Return(&datastore.Comment{ID: "comment-id"}, nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "moderated blog",
+			req: &blogpb.AddCommentReq{
+				Id:      &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Content: "Test comment",
+				Author:  "Test Author",
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Get", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), mock.Anything, mock.Anything).
+					Return(&datastore.Blog{CommentPolicy: datastore.CommentPolicyModerated}, nil)
+				mockStore.On("AddComment", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), (*datastore.ID)(nil), "Test comment", "Test Author", int32(DefaultMaxCommentDepth), datastore.CommentStatePending).
+					Return(&datastore.Comment{ID: "comment-id"}, nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "blog not found",
+			req: &blogpb.AddCommentReq{
+				Id:      &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Content: "Test comment",
+				Author:  "Test Author",
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Get", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), mock.Anything, mock.Anything).
+					Return(nil, datastore.NotFound(datastore.ResourceBlog, "123e4567-e89b-12d3-a456-426614174000"))
+			},
+			expectedErr: status.Error(codes.NotFound, "failed to add comment: blog not found"),
+		},
+		{
+			name: "filter passes",
+			req: &blogpb.AddCommentReq{
+				Id:      &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Content: "Test comment",
+				Author:  "Test Author",
+			},
+			opts: []Option{WithCommentModeration(true), WithCommentFilter(filter.New(filter.NewLinkLimit(1)))},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Get", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), mock.Anything, mock.Anything).
+					Return(&datastore.Blog{}, nil)
+				mockStore.On("AddComment", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), (*datastore.ID)(nil), "Test comment", "Test Author", int32(DefaultMaxCommentDepth), datastore.CommentStatePending, hasVerdicts([]datastore.CommentVerdict{
+					{Classifier: "links"},
+				})).
+					Return(&datastore.Comment{ID: "comment-id"}, nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "filter holds comment on open blog",
+			req: &blogpb.AddCommentReq{
+				Id:      &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Content: "See https://a.example and https://b.example",
+				Author:  "Test Author",
+			},
+			opts: []Option{WithCommentFilter(filter.New(filter.NewLinkLimit(1)))},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Get", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), mock.Anything, mock.Anything).
+					Return(&datastore.Blog{CommentPolicy: datastore.CommentPolicyOpen}, nil)
+				mockStore.On("AddComment", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), (*datastore.ID)(nil), "See https://a.example and https://b.example", "Test Author", int32(DefaultMaxCommentDepth), datastore.CommentStatePending, hasVerdicts([]datastore.CommentVerdict{
+					{Classifier: "links", State: datastore.CommentStatePending, Reason: "has 2 links, at most 1 allowed", Score: 2},
+				})).
+					Return(&datastore.Comment{ID: "comment-id"}, nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "filter rejects comment",
+			req: &blogpb.AddCommentReq{
+				Id:      &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Content: "Cheap watches",
+				Author:  "Test Author",
+			},
+			opts: []Option{WithCommentFilter(filter.New(filter.NewBlockedWords([]string{"watches"}), filter.NewLinkLimit(1)))},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Get", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), mock.Anything, mock.Anything).
+					Return(&datastore.Blog{}, nil)
+				mockStore.On("AddComment", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), (*datastore.ID)(nil), "Cheap watches", "Test Author", int32(DefaultMaxCommentDepth), datastore.CommentStateRejected, hasVerdicts([]datastore.CommentVerdict{
+					{Classifier: "blocked-words", State: datastore.CommentStateRejected, Reason: `contains blocked word "watches"`, Score: 1},
+				})).
+					Return(&datastore.Comment{ID: "comment-id"}, nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "adding filtered comment fails",
+			req: &blogpb.AddCommentReq{
+				Id:      &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				Content: "Test comment",
+				Author:  "Test Author",
+			},
+			opts: []Option{WithCommentFilter(filter.New(filter.NewLinkLimit(1)))},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Get", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), mock.Anything, mock.Anything).
+					Return(&datastore.Blog{}, nil)
+				mockStore.On("AddComment", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), (*datastore.ID)(nil), "Test comment", "Test Author", int32(DefaultMaxCommentDepth), datastore.CommentStateApproved, hasVerdicts([]datastore.CommentVerdict{
+					{Classifier: "links"},
+				})).
+					Return(nil, errors.New("database error"))
+			},
+			expectedErr: status.Error(codes.Internal, "failed to add comment: database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewBlogService(mockStore, tt.opts...)
+			resp, err := service.AddComment(context.Background(), tt.req)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErr.Error(), err.Error())
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.Equal(t, "comment-id", resp.Comment.Id.Value)
+			}
+		})
+	}
+}
+
+// hasVerdicts matches the comment option recording verdicts
+func hasVerdicts(verdicts []datastore.CommentVerdict) any {
+	return mock.MatchedBy(func(opt datastore.CommentOption) bool {
+		return assert.ObjectsAreEqual(verdicts, datastore.NewCommentOptions(opt).Verdicts)
+	})
+}
+
+func TestBlogService_AddCommentAuthor(t *testing.T) {
+	blogID := datastore.ID("123e4567-e89b-12d3-a456-426614174000")
+	authorID := datastore.ID("223e4567-e89b-12d3-a456-426614174000")
+	mockStore := mocks.NewStore(t)
+	mockStore.On("Get", mock.Anything, blogID, mock.Anything, mock.Anything).
+		Return(&datastore.Blog{}, nil)
+	mockStore.On("AddComment", mock.Anything, blogID, (*datastore.ID)(nil), "Signed comment", "", int32(DefaultMaxCommentDepth), datastore.CommentStateApproved,
+		mock.MatchedBy(func(opt datastore.CommentOption) bool {
+			author := datastore.NewCommentOptions(opt).AuthorID
+			return author != nil && *author == authorID
+		})).
+		Return(&datastore.Comment{ID: "c1", BlogID: blogID, Content: "Signed comment", AuthorID: &authorID}, nil)
+	mockStore.On("GetUsers", mock.Anything, []datastore.ID{authorID}).
+		Return([]*datastore.User{{ID: authorID, DisplayName: "Alice"}}, nil)
+
+	service := NewBlogService(mockStore)
+	resp, err := service.AddComment(context.Background(), &blogpb.AddCommentReq{
+		Id:       &blogpb.UUID{Value: string(blogID)},
+		Content:  "Signed comment",
+		AuthorId: &blogpb.UUID{Value: string(authorID)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Alice", resp.GetComment().GetAuthor())
+	assert.Equal(t, "Alice", resp.GetComment().GetAuthorProfile().GetDisplayName())
+}
+
+func TestBlogService_AddCommentFilterError(t *testing.T) {
+	blogID := datastore.ID("123e4567-e89b-12d3-a456-426614174000")
+	mockStore := mocks.NewStore(t)
+	mockStore.On("Get", mock.Anything, blogID, mock.Anything, mock.Anything).
+		Return(&datastore.Blog{}, nil)
+	mockStore.On("ListRecentComments", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, datastore.Unavailable(errors.New("connection refused")))
+
+	// The comment is not added when a filter fails
+	service := NewBlogService(mockStore, WithCommentFilter(filter.New(filter.NewDuplicates(mockStore, time.Hour))))
+	resp, err := service.AddComment(context.Background(), &blogpb.AddCommentReq{
+		Id:      &blogpb.UUID{Value: string(blogID)},
+		Content: "Test comment for the filters",
+		Author:  "Test Author",
+	})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Contains(t, err.Error(), "failed to filter comment: duplicates filter failed")
+}
+
+func TestBlogService_Publish(t *testing.T) {
 	tests := []struct {
 		name        string
-		req         *blogpb.GetReq
+		req         *blogpb.PublishReq
 		setupMock   func(mock *mocks.Store)
 		expectedErr error
 	}{
 		{
-			name: "successful get",
-			req: &blogpb.GetReq{
-				Id: &blogpb.UUID{
-					Value: "123e4567-e89b-12d3-a456-426614174000",
-				},
+			name: "successful publish",
+			req: &blogpb.PublishReq{
+				Id: &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
 			},
 			setupMock: func(mockStore *mocks.Store) {
-				mockStore.On("Get", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000")).
-					Return(testBlog, nil)
+				mockStore.On("Publish", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000")).
+					Return(nil)
 			},
 			expectedErr: nil,
 		},
 		{
 			name: "missing ID",
-			req:  &blogpb.GetReq{},
+			req:  &blogpb.PublishReq{},
 			setupMock: func(mockStore *mocks.Store) {
 				// No mock setup needed
 			},
 			expectedErr: status.Error(codes.InvalidArgument, "blog ID is required"),
 		},
+		{
+			name: "blog not found",
+			req: &blogpb.PublishReq{
+				Id: &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Publish", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000")).
+					Return(datastore.NotFound(datastore.ResourceBlog, "123e4567-e89b-12d3-a456-426614174000"))
+			},
+			expectedErr: status.Error(codes.NotFound, "failed to publish blog: blog not found"),
+		},
 		{
 			name: "store error",
-			req: &blogpb.GetReq{
-				Id: &blogpb.UUID{
-					Value: "123e4567-e89b-12d3-a456-426614174000",
-				},
+			req: &blogpb.PublishReq{
+				Id: &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
 			},
 			setupMock: func(mockStore *mocks.Store) {
-				mockStore.On("Get", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000")).
-					Return(nil, errors.New("not found"))
+				mockStore.On("Publish", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000")).
+					Return(errors.New("publish error"))
 			},
-			expectedErr: status.Error(codes.NotFound, "failed to get blog: not found"),
+			expectedErr: status.Error(codes.Internal, "failed to publish blog: publish error"),
 		},
 	}
 
@@ -164,7 +1637,7 @@ func TestBlogService_Get(t *testing.T) {
 			tt.setupMock(mockStore)
 
 			service := NewBlogService(mockStore)
-			resp, err := service.Get(context.Background(), tt.req)
+			resp, err := service.Publish(context.Background(), tt.req)
 
 			if tt.expectedErr != nil {
 				assert.Error(t, err)
@@ -173,84 +1646,47 @@ func TestBlogService_Get(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, resp)
-				assert.Equal(t, testBlog.ID, datastore.ID(resp.Blog.Id.Value))
-				assert.Equal(t, testBlog.Title, resp.Blog.Title)
-				assert.Equal(t, testBlog.Content, resp.Blog.Content)
-				assert.Equal(t, timestamppb.New(testBlog.CreatedAt).AsTime().Unix(), resp.Blog.CreatedAt.AsTime().Unix())
-				assert.Equal(t, timestamppb.New(testBlog.UpdatedAt).AsTime().Unix(), resp.Blog.UpdatedAt.AsTime().Unix())
 			}
 		})
 	}
 }
 
-func TestBlogService_Update(t *testing.T) {
+func TestBlogService_Unpublish(t *testing.T) {
 	tests := []struct {
 		name        string
-		req         *blogpb.UpdateReq
+		req         *blogpb.UnpublishReq
 		setupMock   func(mock *mocks.Store)
 		expectedErr error
 	}{
 		{
-			name: "successful update with both fields",
-			req: &blogpb.UpdateReq{
-				Id:      &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
-				Title:   stringPtr("Updated Title"),
-				Content: stringPtr("Updated Content"),
-			},
-			setupMock: func(mockStore *mocks.Store) {
-				title := "Updated Title"
-				content := "Updated Content"
-				mockStore.On("Update", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), &title, &content).
-					Return(nil)
-			},
-			expectedErr: nil,
-		},
-		{
-			name: "successful update with title only",
-			req: &blogpb.UpdateReq{
-				Id:    &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
-				Title: stringPtr("Updated Title"),
-			},
-			setupMock: func(mockStore *mocks.Store) {
-				title := "Updated Title"
-				mockStore.On("Update", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), &title, (*string)(nil)).
-					Return(nil)
-			},
-			expectedErr: nil,
-		},
-		{
-			name: "successful update with content only",
-			req: &blogpb.UpdateReq{
-				Id:      &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
-				Content: stringPtr("Updated Content"),
+			name: "successful unpublish",
+			req: &blogpb.UnpublishReq{
+				Id: &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
 			},
 			setupMock: func(mockStore *mocks.Store) {
-				content := "Updated Content"
-				mockStore.On("Update", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), (*string)(nil), &content).
+				mockStore.On("Unpublish", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000")).
 					Return(nil)
 			},
 			expectedErr: nil,
 		},
 		{
 			name: "missing ID",
-			req:  &blogpb.UpdateReq{},
+			req:  &blogpb.UnpublishReq{},
 			setupMock: func(mockStore *mocks.Store) {
 				// No mock setup needed
 			},
 			expectedErr: status.Error(codes.InvalidArgument, "blog ID is required"),
 		},
 		{
-			name: "store error",
-			req: &blogpb.UpdateReq{
-				Id:      &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
-				Content: stringPtr("Updated Content"),
+			name: "blog not found",
+			req: &blogpb.UnpublishReq{
+				Id: &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
 			},
 			setupMock: func(mockStore *mocks.Store) {
-				content := "Updated Content"
-				mockStore.On("Update", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), (*string)(nil), &content).
-					Return(errors.New("update error"))
+				mockStore.On("Unpublish", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000")).
+					Return(datastore.NotFound(datastore.ResourceBlog, "123e4567-e89b-12d3-a456-426614174000"))
 			},
-			expectedErr: status.Error(codes.Internal, "failed to update blog: update error"),
+			expectedErr: status.Error(codes.NotFound, "failed to unpublish blog: blog not found"),
 		},
 	}
 
@@ -260,7 +1696,7 @@ func TestBlogService_Update(t *testing.T) {
 			tt.setupMock(mockStore)
 
 			service := NewBlogService(mockStore)
-			resp, err := service.Update(context.Background(), tt.req)
+			resp, err := service.Unpublish(context.Background(), tt.req)
 
 			if tt.expectedErr != nil {
 				assert.Error(t, err)
@@ -274,42 +1710,58 @@ func TestBlogService_Update(t *testing.T) {
 	}
 }
 
-func TestBlogService_Delete(t *testing.T) {
+// Helper function to create string pointers
+func stringPtr(s string) *string {
+	return &s
+}
+
+func TestBlogService_Undelete(t *testing.T) {
 	tests := []struct {
 		name        string
-		req         *blogpb.DeleteReq
+		req         *blogpb.UndeleteReq
 		setupMock   func(mock *mocks.Store)
 		expectedErr error
 	}{
 		{
-			name: "successful delete",
-			req: &blogpb.DeleteReq{
+			name: "successful undelete",
+			req: &blogpb.UndeleteReq{
 				Id: &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
 			},
 			setupMock: func(mockStore *mocks.Store) {
-				mockStore.On("Delete", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000")).
+				mockStore.On("Undelete", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000")).
 					Return(nil)
 			},
 			expectedErr: nil,
 		},
 		{
 			name: "missing ID",
-			req:  &blogpb.DeleteReq{},
+			req:  &blogpb.UndeleteReq{},
 			setupMock: func(mockStore *mocks.Store) {
 				// No mock setup needed
 			},
 			expectedErr: status.Error(codes.InvalidArgument, "blog ID is required"),
 		},
+		{
+			name: "blog not in trash",
+			req: &blogpb.UndeleteReq{
+				Id: &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Undelete", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000")).
+					Return(datastore.NotFound(datastore.ResourceBlog, "123e4567-e89b-12d3-a456-426614174000"))
+			},
+			expectedErr: status.Error(codes.NotFound, "failed to undelete blog: blog not found"),
+		},
 		{
 			name: "store error",
-			req: &blogpb.DeleteReq{
+			req: &blogpb.UndeleteReq{
 				Id: &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
 			},
 			setupMock: func(mockStore *mocks.Store) {
-				mockStore.On("Delete", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000")).
-					Return(errors.New("delete error"))
+				mockStore.On("Undelete", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000")).
+					Return(errors.New("undelete error"))
 			},
-			expectedErr: status.Error(codes.Internal, "failed to delete blog: delete error"),
+			expectedErr: status.Error(codes.Internal, "failed to undelete blog: undelete error"),
 		},
 	}
 
@@ -319,7 +1771,7 @@ func TestBlogService_Delete(t *testing.T) {
 			tt.setupMock(mockStore)
 
 			service := NewBlogService(mockStore)
-			resp, err := service.Delete(context.Background(), tt.req)
+			resp, err := service.Undelete(context.Background(), tt.req)
 
 			if tt.expectedErr != nil {
 				assert.Error(t, err)
@@ -333,118 +1785,127 @@ func TestBlogService_Delete(t *testing.T) {
 	}
 }
 
-func TestBlogService_List(t *testing.T) {
+func TestBlogService_ListDeleted(t *testing.T) {
+	testDeletedAt := time.Now().UTC()
 	testSummaries := []*datastore.BlogSummary{
 		{
-			ID:           datastore.ID("123e4567-e89b-12d3-a456-426614174000"),
-			Title:        "Test Blog 1",
-			CommentCount: 5,
-		},
-		{
-			ID:           datastore.ID("223e4567-e89b-12d3-a456-426614174000"),
-			Title:        "Test Blog 2",
-			CommentCount: 3,
+			ID:        datastore.ID("123e4567-e89b-12d3-a456-426614174000"),
+			Title:     "Deleted Blog",
+			Status:    datastore.StatusDraft,
+			DeletedAt: &testDeletedAt,
 		},
 	}
+	trash := datastore.ListFilter{Deleted: datastore.OnlyDeleted}
 
 	tests := []struct {
-		name           string
-		req            *blogpb.ListReq
-		setupMock      func(mock *mocks.Store)
-		expectedCount  int
-		expectedToken  string
-		expectedErr    error
-		expectedValues func(resp *blogpb.ListResp)
+		name          string
+		req           *blogpb.ListDeletedReq
+		setupMock     func(mock *mocks.Store)
+		expectedCount int
+		expectedToken string
+		expectedErr   error
 	}{
 		{
 			name: "successful list with default page size",
-			req:  &blogpb.ListReq{},
-			setupMock: func(mockStore *mocks.Store) {
-				mockStore.On("List", mock.Anything, int32(10), "").
-					Return(testSummaries, "next-token", nil)
-			},
-			expectedCount: 2,
-			expectedToken: "next-token",
-			expectedErr:   nil,
-			expectedValues: func(resp *blogpb.ListResp) {
-				assert.Equal(t, "Test Blog 1", resp.Blogs[0].Title)
-				assert.Equal(t, int32(5), resp.Blogs[0].CommentCount)
-				assert.Equal(t, "Test Blog 2", resp.Blogs[1].Title)
-				assert.Equal(t, int32(3), resp.Blogs[1].CommentCount)
-			},
-		},
-		{
-			name: "successful list with custom page size",
-			req:  &blogpb.ListReq{PageSize: 20},
+			req:  &blogpb.ListDeletedReq{},
 			setupMock: func(mockStore *mocks.Store) {
-				mockStore.On("List", mock.Anything, int32(20), "").
+				mockStore.On("List", mock.Anything, int32(10), "", trash).
 					Return(testSummaries, "next-token", nil)
 			},
-			expectedCount: 2,
+			expectedCount: 1,
 			expectedToken: "next-token",
 			expectedErr:   nil,
-			expectedValues: func(resp *blogpb.ListResp) {
-				assert.Equal(t, "Test Blog 1", resp.Blogs[0].Title)
-				assert.Equal(t, int32(5), resp.Blogs[0].CommentCount)
-			},
 		},
 		{
 			name: "successful list with page token",
-			req:  &blogpb.ListReq{PageToken: "token-1"},
+			req:  &blogpb.ListDeletedReq{PageSize: 5, PageToken: "token-1"},
 			setupMock: func(mockStore *mocks.Store) {
-				mockStore.On("List", mock.Anything, int32(10), "token-1").
-					Return(testSummaries, "next-token", nil)
+				mockStore.On("List", mock.Anything, int32(5), "token-1", trash).
+					Return(testSummaries, "", nil)
 			},
-			expectedCount: 2,
-			expectedToken: "next-token",
+			expectedCount: 1,
+			expectedToken: "",
 			expectedErr:   nil,
-			expectedValues: func(resp *blogpb.ListResp) {
-				assert.Equal(t, "Test Blog 1", resp.Blogs[0].Title)
-			},
 		},
 		{
-			name: "page size too large",
-			req:  &blogpb.ListReq{PageSize: 200},
+			name: "store error",
+			req:  &blogpb.ListDeletedReq{},
 			setupMock: func(mockStore *mocks.Store) {
-				mockStore.On("List", mock.Anything, int32(100), "").
-					Return(testSummaries, "next-token", nil)
+				mockStore.On("List", mock.Anything, int32(10), "", trash).
+					Return(nil, "", errors.New("list error"))
 			},
-			expectedCount: 2,
-			expectedToken: "next-token",
-			expectedErr:   nil,
+			expectedErr: status.Error(codes.Internal, "failed to list deleted blogs: list error"),
 		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewBlogService(mockStore)
+			resp, err := service.ListDeleted(context.Background(), tt.req)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErr.Error(), err.Error())
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.Equal(t, tt.expectedCount, len(resp.Blogs))
+				assert.Equal(t, tt.expectedToken, resp.NextPageToken)
+				assert.Equal(t, "Deleted Blog", resp.Blogs[0].Title)
+				assert.Equal(t, testDeletedAt, resp.Blogs[0].DeletedAt.AsTime())
+			}
+		})
+	}
+}
+
+func TestBlogService_ListTags(t *testing.T) {
+	testTags := []*datastore.TagCount{
+		{Name: "gardening", BlogCount: 3},
+		{Name: "tomatoes", BlogCount: 1},
+	}
+
+	tests := []struct {
+		name         string
+		req          *blogpb.ListTagsReq
+		setupMock    func(mock *mocks.Store)
+		expectedTags []*blogpb.TagCount
+		expectedErr  error
+	}{
 		{
-			name: "invalid page size",
-			req:  &blogpb.ListReq{PageSize: -10},
+			name: "successful list of published blogs",
+			req:  &blogpb.ListTagsReq{},
 			setupMock: func(mockStore *mocks.Store) {
-				mockStore.On("List", mock.Anything, int32(10), "").
-					Return(testSummaries, "next-token", nil)
+				mockStore.On("ListTags", mock.Anything, datastore.StatusPublished).
+					Return(testTags, nil)
 			},
-			expectedCount: 2,
-			expectedToken: "next-token",
-			expectedErr:   nil,
+			expectedTags: []*blogpb.TagCount{
+				{Name: "gardening", PostCount: 3},
+				{Name: "tomatoes", PostCount: 1},
+			},
+			expectedErr: nil,
 		},
 		{
-			name: "invalid page token",
-			req:  &blogpb.ListReq{PageToken: "invalid-token"},
+			name: "successful list with status filter",
+			req:  &blogpb.ListTagsReq{Status: blogpb.BlogStatus_BLOG_STATUS_DRAFT},
 			setupMock: func(mockStore *mocks.Store) {
-				mockStore.On("List", mock.Anything, int32(10), "invalid-token").
-					Return(nil, "", errors.New("invalid page token"))
+				mockStore.On("ListTags", mock.Anything, datastore.StatusDraft).
+					Return([]*datastore.TagCount{}, nil)
 			},
-			expectedCount: 0,
-			expectedToken: "",
-			expectedErr:   status.Error(codes.Internal, "failed to list blogs: invalid page token"),
+			expectedTags: []*blogpb.TagCount{},
+			expectedErr:  nil,
 		},
 		{
 			name: "store error",
-			req:  &blogpb.ListReq{},
+			req:  &blogpb.ListTagsReq{},
 			setupMock: func(mockStore *mocks.Store) {
-				mockStore.On("List", mock.Anything, int32(10), "").
-					Return(nil, "", errors.New("list error"))
+				mockStore.On("ListTags", mock.Anything, datastore.StatusPublished).
+					Return(nil, errors.New("database error"))
 			},
-			expectedCount: 0,
-			expectedToken: "",
-			expectedErr:   status.Error(codes.Internal, "failed to list blogs: list error"),
+			expectedErr: status.Error(codes.Internal, "failed to list tags: database error"),
 		},
 	}
 
@@ -454,7 +1915,7 @@ func TestBlogService_List(t *testing.T) {
 			tt.setupMock(mockStore)
 
 			service := NewBlogService(mockStore)
-			resp, err := service.List(context.Background(), tt.req)
+			resp, err := service.ListTags(context.Background(), tt.req)
 
 			if tt.expectedErr != nil {
 				assert.Error(t, err)
@@ -462,84 +1923,93 @@ func TestBlogService_List(t *testing.T) {
 				assert.Nil(t, resp)
 			} else {
 				assert.NoError(t, err)
-				assert.NotNil(t, resp)
-				assert.Equal(t, tt.expectedCount, len(resp.Blogs))
-				assert.Equal(t, tt.expectedToken, resp.NextPageToken)
-				if tt.expectedValues != nil {
-					tt.expectedValues(resp)
+				assert.Equal(t, len(tt.expectedTags), len(resp.Tags))
+				for i, expected := range tt.expectedTags {
+					assert.Equal(t, expected.Name, resp.Tags[i].Name)
+					assert.Equal(t, expected.PostCount, resp.Tags[i].PostCount)
 				}
 			}
 		})
 	}
 }
 
-func TestBlogService_AddComment(t *testing.T) {
+func TestBlogService_Search(t *testing.T) {
+	testResults := []*datastore.SearchResult{
+		{
+			BlogSummary: datastore.BlogSummary{
+				ID:           datastore.ID("123e4567-e89b-12d3-a456-426614174000"),
+				Title:        "Gardening Tips",
+				CommentCount: 2,
+				Status:       datastore.StatusPublished,
+			},
+			Rank:    0.6,
+			Snippet: "How to grow <b>tomatoes</b>",
+		},
+	}
+	tomatoes := datastore.SearchQuery{Terms: []datastore.SearchTerm{{Words: []string{"tomatoes"}}}}
+
 	tests := []struct {
-		name        string
-		req         *blogpb.AddCommentReq
-		setupMock   func(mock *mocks.Store)
-		expectedErr error
+		name          string
+		req           *blogpb.SearchReq
+		setupMock     func(mock *mocks.Store)
+		expectedCount int
+		expectedToken string
+		expectedErr   error
 	}{
 		{
-			name: "successful add comment",
-			req: &blogpb.AddCommentReq{
-				Id:      &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
-				Content: "Test comment",
-				Author:  "Test Author",
-			},
+			name: "successful search with default page size",
+			req:  &blogpb.SearchReq{Q: "Tomatoes"},
 			setupMock: func(mockStore *mocks.Store) {
-				mockStore.On("AddComment", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), "Test comment", "Test Author").
-					Return(datastore.ID("comment-id"), nil)
+				mockStore.On("Search", mock.Anything, tomatoes, int32(10), "").
+					Return(testResults, "next-token", nil)
 			},
-			expectedErr: nil,
+			expectedCount: 1,
+			expectedToken: "next-token",
+			expectedErr:   nil,
 		},
 		{
-			name: "missing ID",
-			req: &blogpb.AddCommentReq{
-				Content: "Test comment",
-				Author:  "Test Author",
-			},
+			name: "phrase and prefix including comments",
+			req:  &blogpb.SearchReq{Q: `"grow tom"* pots`, PageSize: 5, PageToken: "token-1", IncludeComments: true},
 			setupMock: func(mockStore *mocks.Store) {
-				// No mock setup needed
+				query := datastore.SearchQuery{
+					Terms: []datastore.SearchTerm{
+						{Words: []string{"grow", "tom"}, Prefix: true},
+						{Words: []string{"pots"}},
+					},
+					IncludeComments: true,
+				}
+				mockStore.On("Search", mock.Anything, query, int32(5), "token-1").
+					Return(testResults, "", nil)
 			},
-			expectedErr: status.Error(codes.InvalidArgument, "blog ID is required"),
+			expectedCount: 1,
+			expectedToken: "",
+			expectedErr:   nil,
 		},
 		{
-			name: "missing Content",
-			req: &blogpb.AddCommentReq{
-				Id:     &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
-				Author: "Test Author",
-			},
+			name: "query without words",
+			req:  &blogpb.SearchReq{Q: "!!"},
 			setupMock: func(mockStore *mocks.Store) {
-				mockStore.On("AddComment", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), "", "Test Author").
-					Return(datastore.ID("comment-id"), nil)
+				// No mock setup needed
 			},
-			expectedErr: nil,
+			expectedErr: status.Error(codes.InvalidArgument, "q must contain at least one word"),
 		},
 		{
-			name: "missing Author",
-			req: &blogpb.AddCommentReq{
-				Id:      &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
-				Content: "Test comment",
-			},
+			name: "invalid page token",
+			req:  &blogpb.SearchReq{Q: "tomatoes", PageToken: "invalid"},
 			setupMock: func(mockStore *mocks.Store) {
-				mockStore.On("AddComment", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), "Test comment", "").
-					Return(datastore.ID("comment-id"), nil)
+				mockStore.On("Search", mock.Anything, tomatoes, int32(10), "invalid").
+					Return(nil, "", datastore.Invalid(datastore.ResourceBlog, "page_token", errors.New(`invalid page token "invalid"`)))
 			},
-			expectedErr: nil,
+			expectedErr: status.Error(codes.InvalidArgument, `failed to search blogs: blog invalid (page_token): invalid page token "invalid"`),
 		},
 		{
 			name: "store error",
-			req: &blogpb.AddCommentReq{
-				Id:      &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
-				Content: "Test comment",
-				Author:  "Test Author",
-			},
+			req:  &blogpb.SearchReq{Q: "tomatoes"},
 			setupMock: func(mockStore *mocks.Store) {
-				mockStore.On("AddComment", mock.Anything, datastore.ID("123e4567-e89b-12d3-a456-426614174000"), "Test comment", "Test Author").
-					Return(datastore.ID(""), errors.New("comment error"))
+				mockStore.On("Search", mock.Anything, tomatoes, int32(10), "").
+					Return(nil, "", errors.New("search error"))
 			},
-			expectedErr: status.Error(codes.Internal, "failed to add comment: comment error"),
+			expectedErr: status.Error(codes.Internal, "failed to search blogs: search error"),
 		},
 	}
 
@@ -549,7 +2019,7 @@ func TestBlogService_AddComment(t *testing.T) {
 			tt.setupMock(mockStore)
 
 			service := NewBlogService(mockStore)
-			resp, err := service.AddComment(context.Background(), tt.req)
+			resp, err := service.Search(context.Background(), tt.req)
 
 			if tt.expectedErr != nil {
 				assert.Error(t, err)
@@ -558,12 +2028,13 @@ func TestBlogService_AddComment(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, resp)
+				assert.Equal(t, tt.expectedCount, len(resp.Results))
+				assert.Equal(t, tt.expectedToken, resp.NextPageToken)
+				assert.Equal(t, "Gardening Tips", resp.Results[0].Blog.Title)
+				assert.Equal(t, int32(2), resp.Results[0].Blog.CommentCount)
+				assert.Equal(t, "How to grow <b>tomatoes</b>", resp.Results[0].Snippet)
+				assert.Equal(t, float32(0.6), resp.Results[0].Rank)
 			}
 		})
 	}
 }
-
-// Helper function to create string pointers
-func stringPtr(s string) *string {
-	return &s
-}
@@ -0,0 +1,350 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
+)
+
+// GetComment retrieves a single comment of a blog
+func (s *BlogService) GetComment(ctx context.Context, req *blogpb.GetCommentReq) (*blogpb.GetCommentResp, error) {
+	if req.GetId() == nil || req.GetCommentId() == nil {
+		return nil, status.Error(codes.InvalidArgument, "blog ID and comment ID are required")
+	}
+
+	blogID := datastore.ID(req.GetId().GetValue())
+	id := datastore.ID(req.GetCommentId().GetValue())
+	if err := s.checkVisible(ctx, blogID); err != nil {
+		return nil, storeError(err, "failed to get comment")
+	}
+	comment, err := s.store.GetComment(ctx, blogID, id)
+	if err != nil {
+		return nil, storeError(err, "failed to get comment")
+	}
+
+	pbComment := toProtoComment(*comment)
+	if err := s.embedAuthors(ctx, nil, pbComment); err != nil {
+		return nil, err
+	}
+
+	return &blogpb.GetCommentResp{
+		Comment: pbComment,
+	}, nil
+}
+
+// UpdateComment edits the content of a comment of a blog
+func (s *BlogService) UpdateComment(ctx context.Context, req *blogpb.UpdateCommentReq) (*emptypb.Empty, error) {
+	if req.GetId() == nil || req.GetCommentId() == nil {
+		return nil, status.Error(codes.InvalidArgument, "blog ID and comment ID are required")
+	}
+
+	blogID := datastore.ID(req.GetId().GetValue())
+	id := datastore.ID(req.GetCommentId().GetValue())
+	if err := s.store.UpdateComment(ctx, blogID, id, req.GetContent()); err != nil {
+		return nil, storeError(err, "failed to update comment")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteComment deletes a comment of a blog along with its replies
+func (s *BlogService) DeleteComment(ctx context.Context, req *blogpb.DeleteCommentReq) (*emptypb.Empty, error) {
+	if req.GetId() == nil || req.GetCommentId() == nil {
+		return nil, status.Error(codes.InvalidArgument, "blog ID and comment ID are required")
+	}
+
+	blogID := datastore.ID(req.GetId().GetValue())
+	id := datastore.ID(req.GetCommentId().GetValue())
+	if err := s.store.DeleteComment(ctx, blogID, id); err != nil {
+		return nil, storeError(err, "failed to delete comment")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListComments lists the comments of a blog, oldest first
+func (s *BlogService) ListComments(ctx context.Context, req *blogpb.ListCommentsReq) (*blogpb.ListCommentsResp, error) {
+	if req.GetId() == nil {
+		return nil, status.Error(codes.InvalidArgument, "blog ID is required")
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = 10 // Default page size
+	}
+	if pageSize > 100 {
+		pageSize = 100 // Maximum page size
+	}
+
+	id := datastore.ID(req.GetId().GetValue())
+	if err := s.checkVisible(ctx, id); err != nil {
+		return nil, storeError(err, "failed to list comments")
+	}
+	comments, nextPageToken, err := s.store.ListComments(ctx, id, pageSize, req.GetPageToken())
+	if err != nil {
+		return nil, storeError(err, "failed to list comments")
+	}
+
+	pbComments := make([]*blogpb.Comment, len(comments))
+	for i, comment := range comments {
+		pbComments[i] = toProtoComment(*comment)
+	}
+	if err := s.embedAuthors(ctx, nil, pbComments...); err != nil {
+		return nil, err
+	}
+
+	return &blogpb.ListCommentsResp{
+		Comments:      pbComments,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// ListPendingComments lists the comments waiting for moderation, of every
+// blog or of a single blog, oldest first
+func (s *BlogService) ListPendingComments(ctx context.Context, req *blogpb.ListPendingCommentsReq) (*blogpb.ListPendingCommentsResp, error) {
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = 10 // Default page size
+	}
+	if pageSize > 100 {
+		pageSize = 100 // Maximum page size
+	}
+
+	var blogID *datastore.ID
+	if req.GetId() != nil {
+		id := datastore.ID(req.GetId().GetValue())
+		blogID = &id
+	}
+	comments, nextPageToken, err := s.store.ListPendingComments(ctx, blogID, pageSize, req.GetPageToken())
+	if err != nil {
+		return nil, storeError(err, "failed to list pending comments")
+	}
+
+	pbComments := make([]*blogpb.Comment, len(comments))
+	for i, comment := range comments {
+		pbComments[i] = toProtoComment(*comment)
+	}
+
+	return &blogpb.ListPendingCommentsResp{
+		Comments:      pbComments,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// ModerateComment approves or rejects a comment of a blog, or marks it as
+// spam
+func (s *BlogService) ModerateComment(ctx context.Context, req *blogpb.ModerateCommentReq) (*emptypb.Empty, error) {
+	if req.GetId() == nil || req.GetCommentId() == nil {
+		return nil, status.Error(codes.InvalidArgument, "blog ID and comment ID are required")
+	}
+
+	blogID := datastore.ID(req.GetId().GetValue())
+	id := datastore.ID(req.GetCommentId().GetValue())
+	state := toStoreCommentState(req.GetState())
+	if err := s.store.ModerateComment(ctx, blogID, id, state, req.GetReason()); err != nil {
+		return nil, storeError(err, "failed to moderate comment")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListCommentVerdicts lists what the comment filters made of a comment when
+// it was added
+func (s *BlogService) ListCommentVerdicts(ctx context.Context, req *blogpb.ListCommentVerdictsReq) (*blogpb.ListCommentVerdictsResp, error) {
+	if req.GetId() == nil || req.GetCommentId() == nil {
+		return nil, status.Error(codes.InvalidArgument, "blog ID and comment ID are required")
+	}
+
+	blogID := datastore.ID(req.GetId().GetValue())
+	id := datastore.ID(req.GetCommentId().GetValue())
+	verdicts, err := s.store.ListCommentVerdicts(ctx, blogID, id)
+	if err != nil {
+		return nil, storeError(err, "failed to list comment verdicts")
+	}
+
+	pbVerdicts := make([]*blogpb.CommentVerdict, 0, len(verdicts))
+	for _, verdict := range verdicts {
+		pbVerdicts = append(pbVerdicts, toProtoVerdict(*verdict))
+	}
+
+	return &blogpb.ListCommentVerdictsResp{
+		Verdicts: pbVerdicts,
+	}, nil
+}
+
+// checkVisible fails with a not found error if a blog is hidden from the
+// caller, so its comments are too
+func (s *BlogService) checkVisible(ctx context.Context, blogID datastore.ID) error {
+	if !s.accessControl {
+		return nil
+	}
+	blog, err := s.store.Get(ctx, blogID, datastore.WithoutContent(), datastore.WithoutComments())
+	if err != nil {
+		return err
+	}
+	if hidden(blog) && !s.mayEdit(ctx, blog.Owner) {
+		return datastore.NotFound(datastore.ResourceBlog, blogID)
+	}
+	return nil
+}
+
+// newCommentState returns the state of a new comment of a blog, which waits
+// for approval if the blog's comment policy, or the server default, says so
+func (s *BlogService) newCommentState(blog *datastore.Blog) datastore.CommentState {
+	moderated := s.moderateComments
+	switch blog.CommentPolicy {
+	case datastore.CommentPolicyOpen:
+		moderated = false
+	case datastore.CommentPolicyModerated:
+		moderated = true
+	}
+	if moderated {
+		return datastore.CommentStatePending
+	}
+	return datastore.CommentStateApproved
+}
+
+// toProtoComments converts the comments of a blog, oldest first, to protobuf
+// messages arranged by view. The flat view lists replies right after their
+// parent, while the tree view nests them under it. Replies whose parent is
+// not among the comments are treated as top-level comments.
+func toProtoComments(comments []datastore.Comment, view blogpb.CommentView) []*blogpb.Comment {
+	pbComments := make(map[datastore.ID]*blogpb.Comment, len(comments))
+	for _, comment := range comments {
+		// Only add comments with valid IDs
+		if comment.ID != "" {
+			pbComments[comment.ID] = toProtoComment(comment)
+		}
+	}
+
+	roots := make([]*blogpb.Comment, 0, len(pbComments))
+	for _, comment := range comments {
+		pbComment, ok := pbComments[comment.ID]
+		if !ok {
+			continue
+		}
+		if comment.ParentID != nil {
+			if parent, ok := pbComments[*comment.ParentID]; ok {
+				parent.Replies = append(parent.Replies, pbComment)
+				continue
+			}
+		}
+		roots = append(roots, pbComment)
+	}
+	if view == blogpb.CommentView_COMMENT_VIEW_TREE {
+		return roots
+	}
+
+	// Walk the tree depth first to put every reply after its parent
+	flat := make([]*blogpb.Comment, 0, len(pbComments))
+	var walk func([]*blogpb.Comment)
+	walk = func(thread []*blogpb.Comment) {
+		for _, pbComment := range thread {
+			replies := pbComment.Replies
+			pbComment.Replies = nil
+			flat = append(flat, pbComment)
+			walk(replies)
+		}
+	}
+	walk(roots)
+	return flat
+}
+
+// toProtoComment converts a datastore comment to its protobuf message
+func toProtoComment(comment datastore.Comment) *blogpb.Comment {
+	pbComment := &blogpb.Comment{
+		Id:               &blogpb.UUID{Value: string(comment.ID)},
+		Content:          comment.Content,
+		Author:           comment.Author,
+		CreatedAt:        timestamppb.New(comment.CreatedAt),
+		Depth:            comment.Depth,
+		BlogId:           &blogpb.UUID{Value: string(comment.BlogID)},
+		State:            toProtoCommentState(comment.State),
+		ModerationReason: comment.ModerationReason,
+		AuthorProfile:    authorStub(comment.AuthorID),
+	}
+	if comment.ParentID != nil {
+		pbComment.ParentId = &blogpb.UUID{Value: string(*comment.ParentID)}
+	}
+	if comment.UpdatedAt != nil {
+		pbComment.UpdatedAt = timestamppb.New(*comment.UpdatedAt)
+	}
+	if comment.ModeratedAt != nil {
+		pbComment.ModeratedAt = timestamppb.New(*comment.ModeratedAt)
+	}
+	return pbComment
+}
+
+// toProtoVerdict converts a datastore comment verdict to its protobuf
+// message
+func toProtoVerdict(verdict datastore.CommentVerdict) *blogpb.CommentVerdict {
+	return &blogpb.CommentVerdict{
+		Classifier: verdict.Classifier,
+		State:      toProtoCommentState(verdict.State),
+		Reason:     verdict.Reason,
+		Score:      verdict.Score,
+		CreatedAt:  timestamppb.New(verdict.CreatedAt),
+	}
+}
+
+// storeCommentStates maps API comment states to datastore comment states
+var storeCommentStates = map[blogpb.CommentState]datastore.CommentState{
+	blogpb.CommentState_COMMENT_STATE_PENDING:  datastore.CommentStatePending,
+	blogpb.CommentState_COMMENT_STATE_APPROVED: datastore.CommentStateApproved,
+	blogpb.CommentState_COMMENT_STATE_REJECTED: datastore.CommentStateRejected,
+	blogpb.CommentState_COMMENT_STATE_SPAM:     datastore.CommentStateSpam,
+}
+
+// toStoreCommentState converts an API comment state to a datastore comment
+// state. Unknown states convert to the empty state, which the datastore
+// rejects.
+func toStoreCommentState(state blogpb.CommentState) datastore.CommentState {
+	return storeCommentStates[state]
+}
+
+// toProtoCommentState converts a datastore comment state to an API comment
+// state
+func toProtoCommentState(state datastore.CommentState) blogpb.CommentState {
+	for pbState, storeState := range storeCommentStates {
+		if storeState == state {
+			return pbState
+		}
+	}
+	return blogpb.CommentState_COMMENT_STATE_UNSPECIFIED
+}
+
+// storeCommentPolicies maps API comment policies to datastore comment
+// policies
+var storeCommentPolicies = map[blogpb.CommentPolicy]datastore.CommentPolicy{
+	blogpb.CommentPolicy_COMMENT_POLICY_UNSPECIFIED: datastore.CommentPolicyDefault,
+	blogpb.CommentPolicy_COMMENT_POLICY_OPEN:        datastore.CommentPolicyOpen,
+	blogpb.CommentPolicy_COMMENT_POLICY_MODERATED:   datastore.CommentPolicyModerated,
+}
+
+// toStoreCommentPolicy converts an API comment policy to a datastore comment
+// policy. Unknown policies convert to an invalid policy, which the datastore
+// rejects.
+func toStoreCommentPolicy(policy blogpb.CommentPolicy) datastore.CommentPolicy {
+	storePolicy, ok := storeCommentPolicies[policy]
+	if !ok {
+		return datastore.CommentPolicy(policy.String())
+	}
+	return storePolicy
+}
+
+// toProtoCommentPolicy converts a datastore comment policy to an API comment
+// policy
+func toProtoCommentPolicy(policy datastore.CommentPolicy) blogpb.CommentPolicy {
+	for pbPolicy, storePolicy := range storeCommentPolicies {
+		if storePolicy == policy {
+			return pbPolicy
+		}
+	}
+	return blogpb.CommentPolicy_COMMENT_POLICY_UNSPECIFIED
+}
@@ -0,0 +1,596 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/datastore/mocks"
+	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
+)
+
+func TestBlogService_GetComment(t *testing.T) {
+	now := time.Now()
+	blogID := datastore.ID("123e4567-e89b-12d3-a456-426614174000")
+	commentID := datastore.ID("223e4567-e89b-12d3-a456-426614174000")
+
+	tests := []struct {
+		name         string
+		req          *blogpb.GetCommentReq
+		setupMock    func(mock *mocks.Store)
+		expectedResp *blogpb.GetCommentResp
+		expectedErr  error
+	}{
+		{
+			name: "successful get",
+			req: &blogpb.GetCommentReq{
+				Id:        &blogpb.UUID{Value: string(blogID)},
+				CommentId: &blogpb.UUID{Value: string(commentID)},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("GetComment", mock.Anything, blogID, commentID).
+					Return(&datastore.Comment{ID: commentID, BlogID: blogID, Content: "Edited", Author: "alice", CreatedAt: now, UpdatedAt: &now, State: datastore.CommentStateApproved}, nil)
+			},
+			expectedResp: &blogpb.GetCommentResp{
+				Comment: &blogpb.Comment{
+					Id:        &blogpb.UUID{Value: string(commentID)},
+					BlogId:    &blogpb.UUID{Value: string(blogID)},
+					Content:   "Edited",
+					Author:    "alice",
+					CreatedAt: timestamppb.New(now),
+					UpdatedAt: timestamppb.New(now),
+					State:     blogpb.CommentState_COMMENT_STATE_APPROVED,
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "missing comment ID",
+			req: &blogpb.GetCommentReq{
+				Id: &blogpb.UUID{Value: string(blogID)},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				// No mock setup needed
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "blog ID and comment ID are required"),
+		},
+		{
+			name: "comment not found",
+			req: &blogpb.GetCommentReq{
+				Id:        &blogpb.UUID{Value: string(blogID)},
+				CommentId: &blogpb.UUID{Value: string(commentID)},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("GetComment", mock.Anything, blogID, commentID).
+					Return(nil, datastore.NotFound(datastore.ResourceComment, commentID))
+			},
+			expectedErr: status.Error(codes.NotFound, "failed to get comment: comment not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewBlogService(mockStore)
+			resp, err := service.GetComment(context.Background(), tt.req)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErr.Error(), err.Error())
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResp, resp)
+			}
+		})
+	}
+}
+
+func TestBlogService_UpdateComment(t *testing.T) {
+	blogID := datastore.ID("123e4567-e89b-12d3-a456-426614174000")
+	commentID := datastore.ID("223e4567-e89b-12d3-a456-426614174000")
+
+	tests := []struct {
+		name        string
+		req         *blogpb.UpdateCommentReq
+		setupMock   func(mock *mocks.Store)
+		expectedErr error
+	}{
+		{
+			name: "successful update",
+			req: &blogpb.UpdateCommentReq{
+				Id:        &blogpb.UUID{Value: string(blogID)},
+				CommentId: &blogpb.UUID{Value: string(commentID)},
+				Content:   "Edited",
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("UpdateComment", mock.Anything, blogID, commentID, "Edited").
+					Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "missing ID",
+			req: &blogpb.UpdateCommentReq{
+				CommentId: &blogpb.UUID{Value: string(commentID)},
+				Content:   "Edited",
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				// No mock setup needed
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "blog ID and comment ID are required"),
+		},
+		{
+			name: "comment not found",
+			req: &blogpb.UpdateCommentReq{
+				Id:        &blogpb.UUID{Value: string(blogID)},
+				CommentId: &blogpb.UUID{Value: string(commentID)},
+				Content:   "Edited",
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("UpdateComment", mock.Anything, blogID, commentID, "Edited").
+					Return(datastore.NotFound(datastore.ResourceComment, commentID))
+			},
+			expectedErr: status.Error(codes.NotFound, "failed to update comment: comment not found"),
+		},
+		{
+			name: "store error",
+			req: &blogpb.UpdateCommentReq{
+				Id:        &blogpb.UUID{Value: string(blogID)},
+				CommentId: &blogpb.UUID{Value: string(commentID)},
+				Content:   "Edited",
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("UpdateComment", mock.Anything, blogID, commentID, "Edited").
+					Return(errors.New("update error"))
+			},
+			expectedErr: status.Error(codes.Internal, "failed to update comment: update error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewBlogService(mockStore)
+			resp, err := service.UpdateComment(context.Background(), tt.req)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErr.Error(), err.Error())
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, &emptypb.Empty{}, resp)
+			}
+		})
+	}
+}
+
+func TestBlogService_DeleteComment(t *testing.T) {
+	blogID := datastore.ID("123e4567-e89b-12d3-a456-426614174000")
+	commentID := datastore.ID("223e4567-e89b-12d3-a456-426614174000")
+
+	tests := []struct {
+		name        string
+		req         *blogpb.DeleteCommentReq
+		setupMock   func(mock *mocks.Store)
+		expectedErr error
+	}{
+		{
+			name: "successful delete",
+			req: &blogpb.DeleteCommentReq{
+				Id:        &blogpb.UUID{Value: string(blogID)},
+				CommentId: &blogpb.UUID{Value: string(commentID)},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("DeleteComment", mock.Anything, blogID, commentID).
+					Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "missing comment ID",
+			req: &blogpb.DeleteCommentReq{
+				Id: &blogpb.UUID{Value: string(blogID)},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				// No mock setup needed
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "blog ID and comment ID are required"),
+		},
+		{
+			name: "comment not found",
+			req: &blogpb.DeleteCommentReq{
+				Id:        &blogpb.UUID{Value: string(blogID)},
+				CommentId: &blogpb.UUID{Value: string(commentID)},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("DeleteComment", mock.Anything, blogID, commentID).
+					Return(datastore.NotFound(datastore.ResourceComment, commentID))
+			},
+			expectedErr: status.Error(codes.NotFound, "failed to delete comment: comment not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewBlogService(mockStore)
+			resp, err := service.DeleteComment(context.Background(), tt.req)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErr.Error(), err.Error())
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, &emptypb.Empty{}, resp)
+			}
+		})
+	}
+}
+
+func TestBlogService_ListComments(t *testing.T) {
+	now := time.Now()
+	blogID := datastore.ID("123e4567-e89b-12d3-a456-426614174000")
+	rootID := datastore.ID("comment-1")
+
+	tests := []struct {
+		name         string
+		req          *blogpb.ListCommentsReq
+		setupMock    func(mock *mocks.Store)
+		expectedResp *blogpb.ListCommentsResp
+		expectedErr  error
+	}{
+		{
+			name: "successful list with default page size",
+			req: &blogpb.ListCommentsReq{
+				Id: &blogpb.UUID{Value: string(blogID)},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				comments := []*datastore.Comment{
+					{ID: rootID, BlogID: blogID, Content: "Root", Author: "alice", CreatedAt: now, State: datastore.CommentStateApproved},
+					{ID: "comment-2", BlogID: blogID, ParentID: &rootID, Depth: 1, Content: "Reply", Author: "bob", CreatedAt: now, State: datastore.CommentStateApproved},
+				}
+				mockStore.On("ListComments", mock.Anything, blogID, int32(10), "").
+					Return(comments, "next-token", nil)
+			},
+			expectedResp: &blogpb.ListCommentsResp{
+				Comments: []*blogpb.Comment{
+					{Id: &blogpb.UUID{Value: "comment-1"}, BlogId: &blogpb.UUID{Value: string(blogID)}, Content: "Root", Author: "alice", CreatedAt: timestamppb.New(now), State: blogpb.CommentState_COMMENT_STATE_APPROVED},
+					{Id: &blogpb.UUID{Value: "comment-2"}, BlogId: &blogpb.UUID{Value: string(blogID)}, ParentId: &blogpb.UUID{Value: "comment-1"}, Depth: 1, Content: "Reply", Author: "bob", CreatedAt: timestamppb.New(now), State: blogpb.CommentState_COMMENT_STATE_APPROVED},
+				},
+				NextPageToken: "next-token",
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "page size is capped",
+			req: &blogpb.ListCommentsReq{
+				Id:        &blogpb.UUID{Value: string(blogID)},
+				PageSize:  500,
+				PageToken: "next-token",
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("ListComments", mock.Anything, blogID, int32(100), "next-token").
+					Return([]*datastore.Comment{}, "", nil)
+			},
+			expectedResp: &blogpb.ListCommentsResp{
+				Comments: []*blogpb.Comment{},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "missing ID",
+			req:  &blogpb.ListCommentsReq{},
+			setupMock: func(mockStore *mocks.Store) {
+				// No mock setup needed
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "blog ID is required"),
+		},
+		{
+			name: "malformed page token",
+			req: &blogpb.ListCommentsReq{
+				Id:        &blogpb.UUID{Value: string(blogID)},
+				PageToken: "invalid-token",
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("ListComments", mock.Anything, blogID, int32(10), "invalid-token").
+					Return(nil, "", datastore.Invalid(datastore.ResourceComment, "page_token", errors.New(`invalid page token "invalid-token"`)))
+			},
+			expectedErr: status.Error(codes.InvalidArgument, `failed to list comments: comment invalid (page_token): invalid page token "invalid-token"`),
+		},
+		{
+			name: "blog not found",
+			req: &blogpb.ListCommentsReq{
+				Id: &blogpb.UUID{Value: string(blogID)},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("ListComments", mock.Anything, blogID, int32(10), "").
+					Return(nil, "", datastore.NotFound(datastore.ResourceBlog, blogID))
+			},
+			expectedErr: status.Error(codes.NotFound, "failed to list comments: blog not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewBlogService(mockStore)
+			resp, err := service.ListComments(context.Background(), tt.req)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErr.Error(), err.Error())
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResp, resp)
+			}
+		})
+	}
+}
+
+func TestBlogService_ListPendingComments(t *testing.T) {
+	now := time.Now()
+	blogID := datastore.ID("123e4567-e89b-12d3-a456-426614174000")
+
+	tests := []struct {
+		name         string
+		req          *blogpb.ListPendingCommentsReq
+		setupMock    func(mock *mocks.Store)
+		expectedResp *blogpb.ListPendingCommentsResp
+		expectedErr  error
+	}{
+		{
+			name: "successful list across blogs",
+			req:  &blogpb.ListPendingCommentsReq{},
+			setupMock: func(mockStore *mocks.Store) {
+				comments := []*datastore.Comment{
+					{ID: "comment-1", BlogID: blogID, Content: "Buy watches", Author: "mallory", CreatedAt: now, State: datastore.CommentStatePending},
+				}
+				mockStore.On("ListPendingComments", mock.Anything, (*datastore.ID)(nil), int32(10), "").
+					Return(comments, "next-token", nil)
+			},
+			expectedResp: &blogpb.ListPendingCommentsResp{
+				Comments: []*blogpb.Comment{
+					{Id: &blogpb.UUID{Value: "comment-1"}, BlogId: &blogpb.UUID{Value: string(blogID)}, Content: "Buy watches", Author: "mallory", CreatedAt: timestamppb.New(now), State: blogpb.CommentState_COMMENT_STATE_PENDING},
+				},
+				NextPageToken: "next-token",
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "page size is capped for one blog",
+			req: &blogpb.ListPendingCommentsReq{
+				Id:        &blogpb.UUID{Value: string(blogID)},
+				PageSize:  500,
+				PageToken: "next-token",
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("ListPendingComments", mock.Anything, &blogID, int32(100), "next-token").
+					Return([]*datastore.Comment{}, "", nil)
+			},
+			expectedResp: &blogpb.ListPendingCommentsResp{
+				Comments: []*blogpb.Comment{},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "blog not found",
+			req: &blogpb.ListPendingCommentsReq{
+				Id: &blogpb.UUID{Value: string(blogID)},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("ListPendingComments", mock.Anything, &blogID, int32(10), "").
+					Return(nil, "", datastore.NotFound(datastore.ResourceBlog, blogID))
+			},
+			expectedErr: status.Error(codes.NotFound, "failed to list pending comments: blog not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewBlogService(mockStore)
+			resp, err := service.ListPendingComments(context.Background(), tt.req)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErr.Error(), err.Error())
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResp, resp)
+			}
+		})
+	}
+}
+
+func TestBlogService_ModerateComment(t *testing.T) {
+	blogID := datastore.ID("123e4567-e89b-12d3-a456-426614174000")
+	commentID := datastore.ID("223e4567-e89b-12d3-a456-426614174000")
+
+	tests := []struct {
+		name        string
+		req         *blogpb.ModerateCommentReq
+		setupMock   func(mock *mocks.Store)
+		expectedErr error
+	}{
+		{
+			name: "successful moderation",
+			req: &blogpb.ModerateCommentReq{
+				Id:        &blogpb.UUID{Value: string(blogID)},
+				CommentId: &blogpb.UUID{Value: string(commentID)},
+				State:     blogpb.CommentState_COMMENT_STATE_SPAM,
+				Reason:    "Sells watches",
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("ModerateComment", mock.Anything, blogID, commentID, datastore.CommentStateSpam, "Sells watches").
+					Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "missing comment ID",
+			req: &blogpb.ModerateCommentReq{
+				Id:    &blogpb.UUID{Value: string(blogID)},
+				State: blogpb.CommentState_COMMENT_STATE_APPROVED,
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				// No mock setup needed
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "blog ID and comment ID are required"),
+		},
+		{
+			name: "unspecified state",
+			req: &blogpb.ModerateCommentReq{
+				Id:        &blogpb.UUID{Value: string(blogID)},
+				CommentId: &blogpb.UUID{Value: string(commentID)},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("ModerateComment", mock.Anything, blogID, commentID, datastore.CommentState(""), "").
+					Return(datastore.Invalid(datastore.ResourceComment, "state", errors.New(`unknown comment state ""`)))
+			},
+			expectedErr: status.Error(codes.InvalidArgument, `failed to moderate comment: comment invalid (state): unknown comment state ""`),
+		},
+		{
+			name: "comment not found",
+			req: &blogpb.ModerateCommentReq{
+				Id:        &blogpb.UUID{Value: string(blogID)},
+				CommentId: &blogpb.UUID{Value: string(commentID)},
+				State:     blogpb.CommentState_COMMENT_STATE_APPROVED,
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("ModerateComment", mock.Anything, blogID, commentID, datastore.CommentStateApproved, "").
+					Return(datastore.NotFound(datastore.ResourceComment, commentID))
+			},
+			expectedErr: status.Error(codes.NotFound, "failed to moderate comment: comment not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewBlogService(mockStore)
+			resp, err := service.ModerateComment(context.Background(), tt.req)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErr.Error(), err.Error())
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, &emptypb.Empty{}, resp)
+			}
+		})
+	}
+}
+
+func TestBlogService_ListCommentVerdicts(t *testing.T) {
+	blogID := datastore.ID("123e4567-e89b-12d3-a456-426614174000")
+	commentID := datastore.ID("223e4567-e89b-12d3-a456-426614174000")
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		req         *blogpb.ListCommentVerdictsReq
+		setupMock   func(mock *mocks.Store)
+		expected    *blogpb.ListCommentVerdictsResp
+		expectedErr error
+	}{
+		{
+			name: "successful list",
+			req: &blogpb.ListCommentVerdictsReq{
+				Id:        &blogpb.UUID{Value: string(blogID)},
+				CommentId: &blogpb.UUID{Value: string(commentID)},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("ListCommentVerdicts", mock.Anything, blogID, commentID).
+					Return([]*datastore.CommentVerdict{
+						{CommentID: commentID, Classifier: "links", Score: 1, CreatedAt: createdAt},
+						{CommentID: commentID, Classifier: "duplicates", State: datastore.CommentStateSpam, Reason: "duplicate of comment 323e4567-e89b-12d3-a456-426614174000", Score: 1, CreatedAt: createdAt},
+					}, nil)
+			},
+			expected: &blogpb.ListCommentVerdictsResp{
+				Verdicts: []*blogpb.CommentVerdict{
+					{
+						Classifier: "links",
+						State:      blogpb.CommentState_COMMENT_STATE_UNSPECIFIED,
+						Score:      1,
+						CreatedAt:  timestamppb.New(createdAt),
+					},
+					{
+						Classifier: "duplicates",
+						State:      blogpb.CommentState_COMMENT_STATE_SPAM,
+						Reason:     "duplicate of comment 323e4567-e89b-12d3-a456-426614174000",
+						Score:      1,
+						CreatedAt:  timestamppb.New(createdAt),
+					},
+				},
+			},
+		},
+		{
+			name: "missing comment ID",
+			req: &blogpb.ListCommentVerdictsReq{
+				Id: &blogpb.UUID{Value: string(blogID)},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				// No mock setup needed
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "blog ID and comment ID are required"),
+		},
+		{
+			name: "comment not found",
+			req: &blogpb.ListCommentVerdictsReq{
+				Id:        &blogpb.UUID{Value: string(blogID)},
+				CommentId: &blogpb.UUID{Value: string(commentID)},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("ListCommentVerdicts", mock.Anything, blogID, commentID).
+					Return(nil, datastore.NotFound(datastore.ResourceComment, commentID))
+			},
+			expectedErr: status.Error(codes.NotFound, "failed to list comment verdicts: comment not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewBlogService(mockStore)
+			resp, err := service.ListCommentVerdicts(context.Background(), tt.req)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErr.Error(), err.Error())
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+// Package service provides implementations of the gRPC services
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
+)
+
+// resourceTypes maps datastore resource names to their API message types
+var resourceTypes = map[string]string{
+	datastore.ResourceBlog:     string((&blogpb.Blog{}).ProtoReflect().Descriptor().FullName()),
+	datastore.ResourceComment:  string((&blogpb.Comment{}).ProtoReflect().Descriptor().FullName()),
+	datastore.ResourceRevision: string((&blogpb.Revision{}).ProtoReflect().Descriptor().FullName()),
+	datastore.ResourceAPIKey:   string((&blogpb.APIKey{}).ProtoReflect().Descriptor().FullName()),
+	datastore.ResourceUser:     string((&blogpb.User{}).ProtoReflect().Descriptor().FullName()),
+}
+
+// storeError translates an error returned by the datastore into a gRPC status
+// error. The message describes the failed operation and prefixes the status
+// description. Datastore error kinds map onto their gRPC counterparts and
+// carry ResourceInfo or BadRequest details; anything else is Internal.
+func storeError(err error, msg string) error {
+	desc := fmt.Sprintf("%s: %v", msg, err)
+
+	var dsErr *datastore.Error
+	if !errors.As(err, &dsErr) {
+		dsErr = &datastore.Error{}
+	}
+
+	switch {
+	case errors.Is(err, datastore.ErrNotFound):
+		return statusWithDetails(codes.NotFound, desc, resourceInfo(dsErr))
+	case errors.Is(err, datastore.ErrConflict):
+		return statusWithDetails(codes.AlreadyExists, desc, resourceInfo(dsErr))
+	case errors.Is(err, datastore.ErrInvalid):
+		return statusWithDetails(codes.InvalidArgument, desc, badRequest(dsErr.Field, dsErr.Error()))
+	case errors.Is(err, datastore.ErrVersionMismatch):
+		return statusWithDetails(codes.Aborted, desc, resourceInfo(dsErr))
+	case errors.Is(err, datastore.ErrUnavailable):
+		return status.Error(codes.Unavailable, desc)
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, desc)
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, desc)
+	default:
+		return status.Error(codes.Internal, desc)
+	}
+}
+
+// resourceInfo builds a ResourceInfo detail describing the resource of a datastore error
+func resourceInfo(dsErr *datastore.Error) *errdetails.ResourceInfo {
+	resourceType, ok := resourceTypes[dsErr.Resource]
+	if !ok {
+		resourceType = dsErr.Resource
+	}
+	return &errdetails.ResourceInfo{
+		ResourceType: resourceType,
+		ResourceName: string(dsErr.ID),
+		Description:  dsErr.Error(),
+	}
+}
+
+// invalidArgument creates an InvalidArgument status error for a request field,
+// carrying a BadRequest detail like the validation interceptor does
+func invalidArgument(field, desc string) error {
+	return statusWithDetails(codes.InvalidArgument, desc, badRequest(field, desc))
+}
+
+// badRequest builds a BadRequest detail with a single field violation
+func badRequest(field, desc string) *errdetails.BadRequest {
+	return &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{
+				Field:       field,
+				Description: desc,
+			},
+		},
+	}
+}
+
+// statusWithDetails creates a status error carrying the given details. If the
+// details cannot be attached the plain status error is returned.
+func statusWithDetails(code codes.Code, desc string, details ...protoadapt.MessageV1) error {
+	st := status.New(code, desc)
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+)
+
+func TestStoreError(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		expectedCode codes.Code
+		checkDetails func(t *testing.T, details []any)
+	}{
+		{
+			name:         "not found",
+			err:          datastore.NotFound(datastore.ResourceBlog, "123e4567-e89b-12d3-a456-426614174000"),
+			expectedCode: codes.NotFound,
+			checkDetails: func(t *testing.T, details []any) {
+				require.Len(t, details, 1)
+				info, ok := details[0].(*errdetails.ResourceInfo)
+				require.True(t, ok)
+				assert.Equal(t, "blog.v1.Blog", info.ResourceType)
+				assert.Equal(t, "123e4567-e89b-12d3-a456-426614174000", info.ResourceName)
+			},
+		},
+		{
+			name:         "wrapped not found",
+			err:          fmt.Errorf("failed to get blog: %w", datastore.NotFound(datastore.ResourceComment, "comment-id")),
+			expectedCode: codes.NotFound,
+			checkDetails: func(t *testing.T, details []any) {
+				require.Len(t, details, 1)
+				info, ok := details[0].(*errdetails.ResourceInfo)
+				require.True(t, ok)
+				assert.Equal(t, "blog.v1.Comment", info.ResourceType)
+				assert.Equal(t, "comment-id", info.ResourceName)
+			},
+		},
+		{
+			name:         "conflict",
+			err:          datastore.Conflict(datastore.ResourceBlog, "blog-id", errors.New("duplicate key")),
+			expectedCode: codes.AlreadyExists,
+			checkDetails: func(t *testing.T, details []any) {
+				require.Len(t, details, 1)
+				_, ok := details[0].(*errdetails.ResourceInfo)
+				assert.True(t, ok)
+			},
+		},
+		{
+			name:         "invalid",
+			err:          datastore.Invalid(datastore.ResourceBlog, "title", errors.New("check constraint violated")),
+			expectedCode: codes.InvalidArgument,
+			checkDetails: func(t *testing.T, details []any) {
+				require.Len(t, details, 1)
+				badRequest, ok := details[0].(*errdetails.BadRequest)
+				require.True(t, ok)
+				require.Len(t, badRequest.FieldViolations, 1)
+				assert.Equal(t, "title", badRequest.FieldViolations[0].Field)
+			},
+		},
+		{
+			name:         "version mismatch",
+			err:          datastore.VersionMismatch(datastore.ResourceBlog, "blog-id"),
+			expectedCode: codes.Aborted,
+			checkDetails: func(t *testing.T, details []any) {
+				require.Len(t, details, 1)
+				info, ok := details[0].(*errdetails.ResourceInfo)
+				require.True(t, ok)
+				assert.Equal(t, "blog-id", info.ResourceName)
+			},
+		},
+		{
+			name:         "unavailable",
+			err:          datastore.Unavailable(errors.New("connection refused")),
+			expectedCode: codes.Unavailable,
+		},
+		{
+			name:         "canceled",
+			err:          fmt.Errorf("failed to get blog: %w", context.Canceled),
+			expectedCode: codes.Canceled,
+		},
+		{
+			name:         "deadline exceeded",
+			err:          context.DeadlineExceeded,
+			expectedCode: codes.DeadlineExceeded,
+		},
+		{
+			name:         "unknown error",
+			err:          errors.New("something went wrong"),
+			expectedCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := storeError(tt.err, "operation failed")
+
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, tt.expectedCode, st.Code())
+			assert.Equal(t, "operation failed: "+tt.err.Error(), st.Message())
+			if tt.checkDetails != nil {
+				tt.checkDetails(t, st.Details())
+			} else {
+				assert.Empty(t, st.Details())
+			}
+		})
+	}
+}
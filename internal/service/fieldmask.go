@@ -0,0 +1,228 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
+)
+
+// blogPatch builds the datastore patch for an update request. Following
+// AIP-134, a request without an update mask updates every field it sets.
+func blogPatch(req *blogpb.UpdateReq) (datastore.BlogPatch, error) {
+	var patch datastore.BlogPatch
+
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		if req.Title != nil {
+			paths = append(paths, "title")
+		}
+		if req.Content != nil {
+			paths = append(paths, "content")
+		}
+		if req.Status != nil {
+			paths = append(paths, "status")
+		}
+		if req.PublishAt != nil {
+			paths = append(paths, "publish_at")
+		}
+		if len(req.Tags) > 0 {
+			paths = append(paths, "tags")
+		}
+		if req.Slug != nil {
+			paths = append(paths, "slug")
+		}
+		if req.CommentPolicy != nil {
+			paths = append(paths, "comment_policy")
+		}
+	}
+
+	for _, path := range paths {
+		switch path {
+		case "title":
+			if req.Title == nil {
+				return patch, unsetMaskField(path)
+			}
+			title := req.GetTitle()
+			patch.Title = &title
+		case "content":
+			if req.Content == nil {
+				return patch, unsetMaskField(path)
+			}
+			content := req.GetContent()
+			patch.Content = &content
+		case "status":
+			if req.Status == nil {
+				return patch, unsetMaskField(path)
+			}
+			status := toStoreStatus(req.GetStatus())
+			patch.Status = &status
+		case "publish_at":
+			if req.PublishAt == nil {
+				return patch, unsetMaskField(path)
+			}
+			publishAt := req.GetPublishAt().AsTime()
+			patch.PublishAt = &publishAt
+		case "tags":
+			// An empty list clears the tags, as repeated fields cannot be unset
+			tags := req.GetTags()
+			patch.Tags = &tags
+		case "slug":
+			if req.Slug == nil {
+				return patch, unsetMaskField(path)
+			}
+			slug := req.GetSlug()
+			patch.Slug = &slug
+		case "comment_policy":
+			if req.CommentPolicy == nil {
+				return patch, unsetMaskField(path)
+			}
+			policy := toStoreCommentPolicy(req.GetCommentPolicy())
+			patch.CommentPolicy = &policy
+		default:
+			return patch, invalidArgument("update_mask", fmt.Sprintf("unsupported update_mask path %q", path))
+		}
+	}
+
+	return patch, nil
+}
+
+// userPatch builds the datastore patch for a user update request, following
+// the same rules as blogPatch
+func userPatch(req *blogpb.UpdateUserReq) (datastore.UserPatch, error) {
+	var patch datastore.UserPatch
+
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		if req.DisplayName != nil {
+			paths = append(paths, "display_name")
+		}
+		if req.Bio != nil {
+			paths = append(paths, "bio")
+		}
+		if req.AvatarUrl != nil {
+			paths = append(paths, "avatar_url")
+		}
+	}
+
+	for _, path := range paths {
+		switch path {
+		case "display_name":
+			if req.DisplayName == nil {
+				return patch, unsetMaskField(path)
+			}
+			displayName := req.GetDisplayName()
+			patch.DisplayName = &displayName
+		case "bio":
+			if req.Bio == nil {
+				return patch, unsetMaskField(path)
+			}
+			bio := req.GetBio()
+			patch.Bio = &bio
+		case "avatar_url":
+			if req.AvatarUrl == nil {
+				return patch, unsetMaskField(path)
+			}
+			avatarURL := req.GetAvatarUrl()
+			patch.AvatarURL = &avatarURL
+		default:
+			return patch, invalidArgument("update_mask", fmt.Sprintf("unsupported update_mask path %q", path))
+		}
+	}
+
+	return patch, nil
+}
+
+// unsetMaskField reports a field named by the update mask but not set, which
+// would clear it
+func unsetMaskField(path string) error {
+	return invalidArgument(path, fmt.Sprintf("%s is in update_mask but not set", path))
+}
+
+// readsAll reports whether a read mask selects the whole blog
+func readsAll(mask *fieldmaskpb.FieldMask) bool {
+	return len(mask.GetPaths()) == 0 || slices.Contains(mask.GetPaths(), "*")
+}
+
+// validateReadMask checks that every path of a read mask names a blog field
+func validateReadMask(mask *fieldmaskpb.FieldMask) error {
+	if readsAll(mask) {
+		return nil
+	}
+	for _, path := range mask.GetPaths() {
+		if !(&fieldmaskpb.FieldMask{Paths: []string{path}}).IsValid(&blogpb.Blog{}) {
+			return invalidArgument("read_mask", fmt.Sprintf("unknown read_mask path %q", path))
+		}
+	}
+	return nil
+}
+
+// readOptions returns the datastore options that skip reading what a read
+// mask leaves out
+func readOptions(mask *fieldmaskpb.FieldMask) []datastore.GetOption {
+	if readsAll(mask) {
+		return nil
+	}
+
+	var opts []datastore.GetOption
+	if !selectsField(mask, "content") {
+		opts = append(opts, datastore.WithoutContent())
+	}
+	if !selectsField(mask, "comments") {
+		opts = append(opts, datastore.WithoutComments())
+	}
+	return opts
+}
+
+// selectsField reports whether a read mask selects any part of a top-level field
+func selectsField(mask *fieldmaskpb.FieldMask, field string) bool {
+	for _, path := range mask.GetPaths() {
+		if path == field || strings.HasPrefix(path, field+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// applyReadMask clears the fields of a blog that a read mask leaves out
+func applyReadMask(blog *blogpb.Blog, mask *fieldmaskpb.FieldMask) {
+	if readsAll(mask) {
+		return
+	}
+	prune(blog.ProtoReflect(), mask.GetPaths())
+}
+
+// prune clears the fields of m not selected by paths. Nested paths only go
+// through singular message fields, as checked by validateReadMask.
+func prune(m protoreflect.Message, paths []string) {
+	whole := make(map[protoreflect.Name]bool)
+	nested := make(map[protoreflect.Name][]string)
+	for _, path := range paths {
+		name, rest, found := strings.Cut(path, ".")
+		if found {
+			nested[protoreflect.Name(name)] = append(nested[protoreflect.Name(name)], rest)
+		} else {
+			whole[protoreflect.Name(name)] = true
+		}
+	}
+
+	var cleared []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case whole[fd.Name()]:
+		case len(nested[fd.Name()]) > 0:
+			prune(v.Message(), nested[fd.Name()])
+		default:
+			cleared = append(cleared, fd)
+		}
+		return true
+	})
+	for _, fd := range cleared {
+		m.Clear(fd)
+	}
+}
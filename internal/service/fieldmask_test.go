@@ -0,0 +1,112 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
+)
+
+func TestReadOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		paths    []string
+		expected datastore.GetOptions
+	}{
+		{
+			name:     "no mask",
+			paths:    nil,
+			expected: datastore.GetOptions{},
+		},
+		{
+			name:     "wildcard",
+			paths:    []string{"*"},
+			expected: datastore.GetOptions{},
+		},
+		{
+			name:     "title only",
+			paths:    []string{"title"},
+			expected: datastore.GetOptions{SkipContent: true, SkipComments: true},
+		},
+		{
+			name:     "content",
+			paths:    []string{"title", "content"},
+			expected: datastore.GetOptions{SkipComments: true},
+		},
+		{
+			name:     "comments",
+			paths:    []string{"comments"},
+			expected: datastore.GetOptions{SkipContent: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mask := &fieldmaskpb.FieldMask{Paths: tt.paths}
+			assert.Equal(t, tt.expected, datastore.NewGetOptions(readOptions(mask)...))
+		})
+	}
+}
+
+func TestApplyReadMask(t *testing.T) {
+	now := timestamppb.Now()
+	newBlog := func() *blogpb.Blog {
+		return &blogpb.Blog{
+			Id:        &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+			Title:     "Test Blog",
+			Content:   "This is a test blog content",
+			CreatedAt: now,
+			Comments:  []*blogpb.Comment{{Content: "Test comment", Author: "Test author"}},
+			Status:    blogpb.BlogStatus_BLOG_STATUS_PUBLISHED,
+			Etag:      "3",
+		}
+	}
+
+	tests := []struct {
+		name     string
+		paths    []string
+		expected *blogpb.Blog
+	}{
+		{
+			name:     "no mask",
+			paths:    nil,
+			expected: newBlog(),
+		},
+		{
+			name:  "top-level fields",
+			paths: []string{"title", "status"},
+			expected: &blogpb.Blog{
+				Title:  "Test Blog",
+				Status: blogpb.BlogStatus_BLOG_STATUS_PUBLISHED,
+			},
+		},
+		{
+			name:  "nested fields",
+			paths: []string{"id.value", "created_at.seconds"},
+			expected: &blogpb.Blog{
+				Id:        &blogpb.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"},
+				CreatedAt: &timestamppb.Timestamp{Seconds: now.GetSeconds()},
+			},
+		},
+		{
+			name:  "repeated field",
+			paths: []string{"comments"},
+			expected: &blogpb.Blog{
+				Comments: []*blogpb.Comment{{Content: "Test comment", Author: "Test author"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blog := newBlog()
+			applyReadMask(blog, &fieldmaskpb.FieldMask{Paths: tt.paths})
+			assert.True(t, proto.Equal(tt.expected, blog), "got %v", blog)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
+)
+
+// publicReadMethods are the methods of the Blogs and Users services that only
+// read what is shown to readers. Listing the trash, the moderation queue,
+// filter verdicts and revisions reads too, but what they show is for editors,
+// authors and moderators.
+var publicReadMethods = map[string]bool{
+	blogpb.Blogs_Get_FullMethodName:          true,
+	blogpb.Blogs_GetBySlug_FullMethodName:    true,
+	blogpb.Blogs_List_FullMethodName:         true,
+	blogpb.Blogs_ListTags_FullMethodName:     true,
+	blogpb.Blogs_Search_FullMethodName:       true,
+	blogpb.Blogs_GetComment_FullMethodName:   true,
+	blogpb.Blogs_ListComments_FullMethodName: true,
+	blogpb.Users_Get_FullMethodName:          true,
+	blogpb.Users_List_FullMethodName:         true,
+}
+
+// IsPublicRead reports whether a full gRPC method name is a method that only
+// reads what is shown to readers
+func IsPublicRead(fullMethod string) bool {
+	return publicReadMethods[fullMethod]
+}
+
+// IsAddComment reports whether a full gRPC method name is the Blogs method
+// that adds comments
+func IsAddComment(fullMethod string) bool {
+	return fullMethod == blogpb.Blogs_AddComment_FullMethodName
+}
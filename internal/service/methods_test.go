@@ -0,0 +1,28 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
+)
+
+func TestIsPublicRead(t *testing.T) {
+	assert.True(t, IsPublicRead(blogpb.Blogs_Get_FullMethodName))
+	assert.True(t, IsPublicRead(blogpb.Blogs_ListComments_FullMethodName))
+	assert.False(t, IsPublicRead(blogpb.Blogs_Create_FullMethodName))
+	assert.False(t, IsPublicRead(blogpb.Blogs_Delete_FullMethodName))
+	assert.False(t, IsPublicRead(blogpb.Blogs_AddComment_FullMethodName))
+	assert.False(t, IsPublicRead(blogpb.Blogs_ListDeleted_FullMethodName))
+	assert.False(t, IsPublicRead(blogpb.Blogs_ListPendingComments_FullMethodName))
+	assert.False(t, IsPublicRead(blogpb.Blogs_ListRevisions_FullMethodName))
+	assert.False(t, IsPublicRead(blogpb.Blogs_GetRevision_FullMethodName))
+	assert.False(t, IsPublicRead(blogpb.Blogs_DiffRevisions_FullMethodName))
+	assert.True(t, IsPublicRead(blogpb.Users_Get_FullMethodName))
+	assert.False(t, IsPublicRead(blogpb.Users_Update_FullMethodName))
+	assert.False(t, IsPublicRead("/grpc.reflection.v1.ServerReflection/ServerReflectionInfo"))
+
+	assert.True(t, IsAddComment(blogpb.Blogs_AddComment_FullMethodName))
+	assert.False(t, IsAddComment(blogpb.Blogs_UpdateComment_FullMethodName))
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
+)
+
+// blogRequest is a request that names the blog it touches
+type blogRequest interface {
+	GetId() *blogpb.UUID
+}
+
+// Owner returns the subject of the owner of the blog a request touches,
+// including blogs in the trash. Requests that do not name an existing blog,
+// and blogs created anonymously, have no owner.
+func (s *BlogService) Owner(ctx context.Context, req any) (string, error) {
+	blogReq, ok := req.(blogRequest)
+	if !ok || blogReq.GetId() == nil {
+		return "", nil
+	}
+
+	id := datastore.ID(blogReq.GetId().GetValue())
+	blog, err := s.store.Get(ctx, id, datastore.WithoutContent(), datastore.WithoutComments(), datastore.WithDeleted())
+	if errors.Is(err, datastore.ErrNotFound) || errors.Is(err, datastore.ErrInvalid) {
+		return "", nil
+	}
+	if err != nil {
+		return "", storeError(err, "failed to look up blog owner")
+	}
+	return blog.Owner, nil
+}
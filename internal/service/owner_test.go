@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/datastore/mocks"
+	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
+)
+
+func TestBlogService_Owner(t *testing.T) {
+	blogID := "123e4567-e89b-12d3-a456-426614174000"
+
+	tests := []struct {
+		name          string
+		req           any
+		setupMock     func(mock *mocks.Store)
+		expected      string
+		expectedError error
+	}{
+		{
+			name: "owned blog",
+			req:  &blogpb.UpdateReq{Id: &blogpb.UUID{Value: blogID}},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Get", mock.Anything, datastore.ID(blogID), mock.Anything, mock.Anything, mock.Anything).
+					Return(&datastore.Blog{ID: datastore.ID(blogID), Owner: "alice"}, nil)
+			},
+			expected: "alice",
+		},
+		{
+			name: "comment on owned blog",
+			req:  &blogpb.ModerateCommentReq{Id: &blogpb.UUID{Value: blogID}},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Get", mock.Anything, datastore.ID(blogID), mock.Anything, mock.Anything, mock.Anything).
+					Return(&datastore.Blog{ID: datastore.ID(blogID), Owner: "alice"}, nil)
+			},
+			expected: "alice",
+		},
+		{
+			name: "missing blog",
+			req:  &blogpb.DeleteReq{Id: &blogpb.UUID{Value: blogID}},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Get", mock.Anything, datastore.ID(blogID), mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, datastore.NotFound(datastore.ResourceBlog, datastore.ID(blogID)))
+			},
+		},
+		{
+			name:      "request without blog",
+			req:       &blogpb.CreateReq{Title: "Test Blog"},
+			setupMock: func(mockStore *mocks.Store) {},
+		},
+		{
+			name:      "request without ID",
+			req:       &blogpb.UpdateReq{},
+			setupMock: func(mockStore *mocks.Store) {},
+		},
+		{
+			name: "store error",
+			req:  &blogpb.UpdateReq{Id: &blogpb.UUID{Value: blogID}},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("Get", mock.Anything, datastore.ID(blogID), mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, errors.New("database error"))
+			},
+			expectedError: status.Error(codes.Internal, "failed to look up blog owner: database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewBlogService(mockStore)
+			owner, err := service.Owner(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, owner)
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/agruetz/prosigliere/internal/auth"
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/diff"
+	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
+)
+
+// ListRevisions lists the previous versions of a blog, newest first
+func (s *BlogService) ListRevisions(ctx context.Context, req *blogpb.ListRevisionsReq) (*blogpb.ListRevisionsResp, error) {
+	if req.GetId() == nil {
+		return nil, status.Error(codes.InvalidArgument, "blog ID is required")
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = 10 // Default page size
+	}
+	if pageSize > 100 {
+		pageSize = 100 // Maximum page size
+	}
+
+	id := datastore.ID(req.GetId().GetValue())
+	revisions, nextPageToken, err := s.store.ListRevisions(ctx, id, pageSize, req.GetPageToken())
+	if err != nil {
+		return nil, storeError(err, "failed to list revisions")
+	}
+
+	pbRevisions := make([]*blogpb.Revision, len(revisions))
+	for i, revision := range revisions {
+		pbRevisions[i] = toProtoRevision(revision)
+	}
+
+	return &blogpb.ListRevisionsResp{
+		Revisions:     pbRevisions,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// GetRevision retrieves a previous version of a blog
+func (s *BlogService) GetRevision(ctx context.Context, req *blogpb.GetRevisionReq) (*blogpb.GetRevisionResp, error) {
+	if req.GetId() == nil {
+		return nil, status.Error(codes.InvalidArgument, "blog ID is required")
+	}
+
+	id := datastore.ID(req.GetId().GetValue())
+	revision, err := s.store.GetRevision(ctx, id, req.GetRevision())
+	if err != nil {
+		return nil, storeError(err, "failed to get revision")
+	}
+
+	return &blogpb.GetRevisionResp{
+		Revision: toProtoRevision(revision),
+	}, nil
+}
+
+// DiffRevisions compares a revision with a later revision or the current
+// version of a blog
+func (s *BlogService) DiffRevisions(ctx context.Context, req *blogpb.DiffRevisionsReq) (*blogpb.DiffRevisionsResp, error) {
+	if req.GetId() == nil {
+		return nil, status.Error(codes.InvalidArgument, "blog ID is required")
+	}
+
+	id := datastore.ID(req.GetId().GetValue())
+	from, err := s.store.GetRevision(ctx, id, req.GetFromRevision())
+	if err != nil {
+		return nil, storeError(err, "failed to get revision")
+	}
+
+	// Compare with the current version unless a revision is given
+	var toTitle, toContent string
+	if req.GetToRevision() == 0 {
+		blog, err := s.store.Get(ctx, id)
+		if err != nil {
+			return nil, storeError(err, "failed to get blog")
+		}
+		toTitle, toContent = blog.Title, blog.Content
+	} else {
+		to, err := s.store.GetRevision(ctx, id, req.GetToRevision())
+		if err != nil {
+			return nil, storeError(err, "failed to get revision")
+		}
+		toTitle, toContent = to.Title, to.Content
+	}
+
+	compare := diff.Lines
+	if req.GetMode() == blogpb.DiffMode_DIFF_MODE_WORD {
+		compare = diff.Words
+	}
+
+	return &blogpb.DiffRevisionsResp{
+		Title:   toProtoChunks(compare(from.Title, toTitle)),
+		Content: toProtoChunks(compare(from.Content, toContent)),
+	}, nil
+}
+
+// RestoreRevision sets the title and content of a blog back to a revision
+func (s *BlogService) RestoreRevision(ctx context.Context, req *blogpb.RestoreRevisionReq) (*emptypb.Empty, error) {
+	if req.GetId() == nil {
+		return nil, status.Error(codes.InvalidArgument, "blog ID is required")
+	}
+
+	id := datastore.ID(req.GetId().GetValue())
+	err := s.store.RestoreRevision(ctx, id, req.GetRevision(), editor(ctx, req.GetEditor()))
+	if err != nil {
+		return nil, storeError(err, "failed to restore revision")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// editor returns who to record as the editor of a change, the subject of the
+// authenticated principal, or the editor named by the request without one
+func editor(ctx context.Context, requested string) string {
+	if principal, ok := auth.FromContext(ctx); ok {
+		return principal.Subject
+	}
+	return requested
+}
+
+// toProtoRevision converts a datastore revision to an API revision
+func toProtoRevision(revision *datastore.Revision) *blogpb.Revision {
+	return &blogpb.Revision{
+		BlogId: &blogpb.UUID{
+			Value: string(revision.BlogID),
+		},
+		Number:    revision.Number,
+		Title:     revision.Title,
+		Content:   revision.Content,
+		Editor:    revision.Editor,
+		CreatedAt: timestamppb.New(revision.CreatedAt),
+	}
+}
+
+// diffOps maps diff operations to API diff operations
+var diffOps = map[diff.Op]blogpb.DiffOp{
+	diff.Equal:  blogpb.DiffOp_DIFF_OP_EQUAL,
+	diff.Insert: blogpb.DiffOp_DIFF_OP_INSERT,
+	diff.Delete: blogpb.DiffOp_DIFF_OP_DELETE,
+}
+
+// toProtoChunks converts diff chunks to API diff chunks
+func toProtoChunks(chunks []diff.Chunk) []*blogpb.DiffChunk {
+	pbChunks := make([]*blogpb.DiffChunk, len(chunks))
+	for i, chunk := range chunks {
+		pbChunks[i] = &blogpb.DiffChunk{
+			Op:   diffOps[chunk.Op],
+			Text: chunk.Text,
+		}
+	}
+	return pbChunks
+}
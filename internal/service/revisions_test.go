@@ -0,0 +1,420 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/agruetz/prosigliere/internal/auth"
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/datastore/mocks"
+	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
+)
+
+func TestBlogService_ListRevisions(t *testing.T) {
+	now := time.Now()
+	blogID := datastore.ID("123e4567-e89b-12d3-a456-426614174000")
+
+	tests := []struct {
+		name         string
+		req          *blogpb.ListRevisionsReq
+		setupMock    func(mock *mocks.Store)
+		expectedResp *blogpb.ListRevisionsResp
+		expectedErr  error
+	}{
+		{
+			name: "successful list with default page size",
+			req: &blogpb.ListRevisionsReq{
+				Id: &blogpb.UUID{Value: string(blogID)},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				revisions := []*datastore.Revision{
+					{BlogID: blogID, Number: 2, Title: "Title 2", Content: "Content 2", Editor: "bob", CreatedAt: now},
+					{BlogID: blogID, Number: 1, Title: "Title 1", Content: "Content 1", Editor: "alice", CreatedAt: now},
+				}
+				mockStore.On("ListRevisions", mock.Anything, blogID, int32(10), "").
+					Return(revisions, "1", nil)
+			},
+			expectedResp: &blogpb.ListRevisionsResp{
+				Revisions: []*blogpb.Revision{
+					{BlogId: &blogpb.UUID{Value: string(blogID)}, Number: 2, Title: "Title 2", Content: "Content 2", Editor: "bob", CreatedAt: timestamppb.New(now)},
+					{BlogId: &blogpb.UUID{Value: string(blogID)}, Number: 1, Title: "Title 1", Content: "Content 1", Editor: "alice", CreatedAt: timestamppb.New(now)},
+				},
+				NextPageToken: "1",
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "page size is capped",
+			req: &blogpb.ListRevisionsReq{
+				Id:        &blogpb.UUID{Value: string(blogID)},
+				PageSize:  500,
+				PageToken: "3",
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("ListRevisions", mock.Anything, blogID, int32(100), "3").
+					Return([]*datastore.Revision{}, "", nil)
+			},
+			expectedResp: &blogpb.ListRevisionsResp{
+				Revisions: []*blogpb.Revision{},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "missing ID",
+			req:  &blogpb.ListRevisionsReq{},
+			setupMock: func(mockStore *mocks.Store) {
+				// No mock setup needed
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "blog ID is required"),
+		},
+		{
+			name: "blog not found",
+			req: &blogpb.ListRevisionsReq{
+				Id: &blogpb.UUID{Value: string(blogID)},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("ListRevisions", mock.Anything, blogID, int32(10), "").
+					Return(nil, "", datastore.NotFound(datastore.ResourceBlog, blogID))
+			},
+			expectedErr: status.Error(codes.NotFound, "failed to list revisions: blog not found"),
+		},
+		{
+			name: "store error",
+			req: &blogpb.ListRevisionsReq{
+				Id: &blogpb.UUID{Value: string(blogID)},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("ListRevisions", mock.Anything, blogID, int32(10), "").
+					Return(nil, "", errors.New("list error"))
+			},
+			expectedErr: status.Error(codes.Internal, "failed to list revisions: list error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewBlogService(mockStore)
+			resp, err := service.ListRevisions(context.Background(), tt.req)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErr.Error(), err.Error())
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResp, resp)
+			}
+		})
+	}
+}
+
+func TestBlogService_GetRevision(t *testing.T) {
+	now := time.Now()
+	blogID := datastore.ID("123e4567-e89b-12d3-a456-426614174000")
+
+	tests := []struct {
+		name         string
+		req          *blogpb.GetRevisionReq
+		setupMock    func(mock *mocks.Store)
+		expectedResp *blogpb.GetRevisionResp
+		expectedErr  error
+	}{
+		{
+			name: "successful get",
+			req: &blogpb.GetRevisionReq{
+				Id:       &blogpb.UUID{Value: string(blogID)},
+				Revision: 1,
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("GetRevision", mock.Anything, blogID, int32(1)).
+					Return(&datastore.Revision{BlogID: blogID, Number: 1, Title: "Old Title", Content: "Old Content", Editor: "alice", CreatedAt: now}, nil)
+			},
+			expectedResp: &blogpb.GetRevisionResp{
+				Revision: &blogpb.Revision{
+					BlogId:    &blogpb.UUID{Value: string(blogID)},
+					Number:    1,
+					Title:     "Old Title",
+					Content:   "Old Content",
+					Editor:    "alice",
+					CreatedAt: timestamppb.New(now),
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "missing ID",
+			req:  &blogpb.GetRevisionReq{Revision: 1},
+			setupMock: func(mockStore *mocks.Store) {
+				// No mock setup needed
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "blog ID is required"),
+		},
+		{
+			name: "revision not found",
+			req: &blogpb.GetRevisionReq{
+				Id:       &blogpb.UUID{Value: string(blogID)},
+				Revision: 1,
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("GetRevision", mock.Anything, blogID, int32(1)).
+					Return(nil, datastore.NotFound(datastore.ResourceRevision, datastore.RevisionID(blogID, 1)))
+			},
+			expectedErr: status.Error(codes.NotFound, "failed to get revision: revision not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewBlogService(mockStore)
+			resp, err := service.GetRevision(context.Background(), tt.req)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErr.Error(), err.Error())
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResp, resp)
+			}
+		})
+	}
+}
+
+func TestBlogService_DiffRevisions(t *testing.T) {
+	blogID := datastore.ID("123e4567-e89b-12d3-a456-426614174000")
+	first := &datastore.Revision{BlogID: blogID, Number: 1, Title: "Old Title", Content: "one\ntwo\n"}
+	second := &datastore.Revision{BlogID: blogID, Number: 2, Title: "New Title", Content: "one\n2\n"}
+
+	tests := []struct {
+		name         string
+		req          *blogpb.DiffRevisionsReq
+		setupMock    func(mock *mocks.Store)
+		expectedResp *blogpb.DiffRevisionsResp
+		expectedErr  error
+	}{
+		{
+			name: "diff with current version by line",
+			req: &blogpb.DiffRevisionsReq{
+				Id:           &blogpb.UUID{Value: string(blogID)},
+				FromRevision: 1,
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("GetRevision", mock.Anything, blogID, int32(1)).Return(first, nil)
+				mockStore.On("Get", mock.Anything, blogID).
+					Return(&datastore.Blog{ID: blogID, Title: "Old Title", Content: "one\ntwo\nthree\n"}, nil)
+			},
+			expectedResp: &blogpb.DiffRevisionsResp{
+				Title: []*blogpb.DiffChunk{
+					{Op: blogpb.DiffOp_DIFF_OP_EQUAL, Text: "Old Title"},
+				},
+				Content: []*blogpb.DiffChunk{
+					{Op: blogpb.DiffOp_DIFF_OP_EQUAL, Text: "one\ntwo\n"},
+					{Op: blogpb.DiffOp_DIFF_OP_INSERT, Text: "three\n"},
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "diff with revision by word",
+			req: &blogpb.DiffRevisionsReq{
+				Id:           &blogpb.UUID{Value: string(blogID)},
+				FromRevision: 1,
+				ToRevision:   2,
+				Mode:         blogpb.DiffMode_DIFF_MODE_WORD,
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("GetRevision", mock.Anything, blogID, int32(1)).Return(first, nil)
+				mockStore.On("GetRevision", mock.Anything, blogID, int32(2)).Return(second, nil)
+			},
+			expectedResp: &blogpb.DiffRevisionsResp{
+				Title: []*blogpb.DiffChunk{
+					{Op: blogpb.DiffOp_DIFF_OP_DELETE, Text: "Old"},
+					{Op: blogpb.DiffOp_DIFF_OP_INSERT, Text: "New"},
+					{Op: blogpb.DiffOp_DIFF_OP_EQUAL, Text: " Title"},
+				},
+				Content: []*blogpb.DiffChunk{
+					{Op: blogpb.DiffOp_DIFF_OP_EQUAL, Text: "one\n"},
+					{Op: blogpb.DiffOp_DIFF_OP_DELETE, Text: "two"},
+					{Op: blogpb.DiffOp_DIFF_OP_INSERT, Text: "2"},
+					{Op: blogpb.DiffOp_DIFF_OP_EQUAL, Text: "\n"},
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "missing ID",
+			req:  &blogpb.DiffRevisionsReq{FromRevision: 1},
+			setupMock: func(mockStore *mocks.Store) {
+				// No mock setup needed
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "blog ID is required"),
+		},
+		{
+			name: "from revision not found",
+			req: &blogpb.DiffRevisionsReq{
+				Id:           &blogpb.UUID{Value: string(blogID)},
+				FromRevision: 3,
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("GetRevision", mock.Anything, blogID, int32(3)).
+					Return(nil, datastore.NotFound(datastore.ResourceRevision, datastore.RevisionID(blogID, 3)))
+			},
+			expectedErr: status.Error(codes.NotFound, "failed to get revision: revision not found"),
+		},
+		{
+			name: "to revision not found",
+			req: &blogpb.DiffRevisionsReq{
+				Id:           &blogpb.UUID{Value: string(blogID)},
+				FromRevision: 1,
+				ToRevision:   3,
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("GetRevision", mock.Anything, blogID, int32(1)).Return(first, nil)
+				mockStore.On("GetRevision", mock.Anything, blogID, int32(3)).
+					Return(nil, datastore.NotFound(datastore.ResourceRevision, datastore.RevisionID(blogID, 3)))
+			},
+			expectedErr: status.Error(codes.NotFound, "failed to get revision: revision not found"),
+		},
+		{
+			name: "store error getting current version",
+			req: &blogpb.DiffRevisionsReq{
+				Id:           &blogpb.UUID{Value: string(blogID)},
+				FromRevision: 1,
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("GetRevision", mock.Anything, blogID, int32(1)).Return(first, nil)
+				mockStore.On("Get", mock.Anything, blogID).Return(nil, errors.New("get error"))
+			},
+			expectedErr: status.Error(codes.Internal, "failed to get blog: get error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewBlogService(mockStore)
+			resp, err := service.DiffRevisions(context.Background(), tt.req)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErr.Error(), err.Error())
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResp, resp)
+			}
+		})
+	}
+}
+
+func TestBlogService_RestoreRevision(t *testing.T) {
+	blogID := datastore.ID("123e4567-e89b-12d3-a456-426614174000")
+
+	tests := []struct {
+		name        string
+		req         *blogpb.RestoreRevisionReq
+		setupMock   func(mock *mocks.Store)
+		expectedErr error
+	}{
+		{
+			name: "successful restore",
+			req: &blogpb.RestoreRevisionReq{
+				Id:       &blogpb.UUID{Value: string(blogID)},
+				Revision: 1,
+				Editor:   "alice",
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("RestoreRevision", mock.Anything, blogID, int32(1), "alice").
+					Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "missing ID",
+			req:  &blogpb.RestoreRevisionReq{Revision: 1},
+			setupMock: func(mockStore *mocks.Store) {
+				// No mock setup needed
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "blog ID is required"),
+		},
+		{
+			name: "revision not found",
+			req: &blogpb.RestoreRevisionReq{
+				Id:       &blogpb.UUID{Value: string(blogID)},
+				Revision: 1,
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("RestoreRevision", mock.Anything, blogID, int32(1), "").
+					Return(datastore.NotFound(datastore.ResourceRevision, datastore.RevisionID(blogID, 1)))
+			},
+			expectedErr: status.Error(codes.NotFound, "failed to restore revision: revision not found"),
+		},
+		{
+			name: "store error",
+			req: &blogpb.RestoreRevisionReq{
+				Id:       &blogpb.UUID{Value: string(blogID)},
+				Revision: 1,
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("RestoreRevision", mock.Anything, blogID, int32(1), "").
+					Return(errors.New("restore error"))
+			},
+			expectedErr: status.Error(codes.Internal, "failed to restore revision: restore error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewBlogService(mockStore)
+			resp, err := service.RestoreRevision(context.Background(), tt.req)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErr.Error(), err.Error())
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+			}
+		})
+	}
+}
+
+func TestBlogService_RevisionEditor(t *testing.T) {
+	blogID := datastore.ID("123e4567-e89b-12d3-a456-426614174000")
+	title := "Updated Title"
+
+	mockStore := mocks.NewStore(t)
+	mockStore.On("Update", mock.Anything, blogID, datastore.BlogPatch{Title: &title}, "alice", int64(0)).
+		Return(nil).Once()
+	mockStore.On("RestoreRevision", mock.Anything, blogID, int32(1), "alice").
+		Return(nil).Once()
+
+	// Authenticated callers are recorded as the editor, whoever they name
+	service := NewBlogService(mockStore)
+	ctx := auth.NewContext(context.Background(), &auth.Principal{Subject: "alice"})
+	_, err := service.Update(ctx, &blogpb.UpdateReq{Id: &blogpb.UUID{Value: string(blogID)}, Title: &title, Editor: "mallory"})
+	require.NoError(t, err)
+	_, err = service.RestoreRevision(ctx, &blogpb.RestoreRevisionReq{Id: &blogpb.UUID{Value: string(blogID)}, Revision: 1, Editor: "mallory"})
+	require.NoError(t, err)
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/agruetz/prosigliere/internal/auth"
+	"github.com/agruetz/prosigliere/internal/datastore"
+	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
+)
+
+// UserService implements the blog.v1.UsersServer interface
+type UserService struct {
+	blogpb.UnimplementedUsersServer
+	store datastore.Store
+}
+
+// NewUserService creates a new UserService with the given datastore
+func NewUserService(store datastore.Store) *UserService {
+	return &UserService{
+		store: store,
+	}
+}
+
+// Create creates a user, owned by whoever created it
+func (s *UserService) Create(ctx context.Context, req *blogpb.CreateUserReq) (*blogpb.CreateUserResp, error) {
+	var opts []datastore.UserOption
+	if principal, ok := auth.FromContext(ctx); ok {
+		opts = append(opts, datastore.WithUserOwner(principal.Subject))
+	}
+	user, err := s.store.CreateUser(ctx, req.GetDisplayName(), req.GetBio(), req.GetAvatarUrl(), opts...)
+	if err != nil {
+		return nil, storeError(err, "failed to create user")
+	}
+
+	return &blogpb.CreateUserResp{
+		User: toProtoUser(user),
+	}, nil
+}
+
+// Get retrieves a user by ID
+func (s *UserService) Get(ctx context.Context, req *blogpb.GetUserReq) (*blogpb.GetUserResp, error) {
+	if req.GetId() == nil {
+		return nil, status.Error(codes.InvalidArgument, "user ID is required")
+	}
+
+	user, err := s.store.GetUser(ctx, datastore.ID(req.GetId().GetValue()))
+	if err != nil {
+		return nil, storeError(err, "failed to get user")
+	}
+
+	return &blogpb.GetUserResp{
+		User: toProtoUser(user),
+	}, nil
+}
+
+// Update updates the profile of a user
+func (s *UserService) Update(ctx context.Context, req *blogpb.UpdateUserReq) (*emptypb.Empty, error) {
+	if req.GetId() == nil {
+		return nil, status.Error(codes.InvalidArgument, "user ID is required")
+	}
+
+	patch, err := userPatch(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.store.UpdateUser(ctx, datastore.ID(req.GetId().GetValue()), patch); err != nil {
+		return nil, storeError(err, "failed to update user")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// List lists users with pagination, oldest first
+func (s *UserService) List(ctx context.Context, req *blogpb.ListUsersReq) (*blogpb.ListUsersResp, error) {
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = 10 // Default page size
+	}
+	if pageSize > 100 {
+		pageSize = 100 // Maximum page size
+	}
+
+	users, nextPageToken, err := s.store.ListUsers(ctx, pageSize, req.GetPageToken())
+	if err != nil {
+		return nil, storeError(err, "failed to list users")
+	}
+
+	pbUsers := make([]*blogpb.User, len(users))
+	for i, user := range users {
+		pbUsers[i] = toProtoUser(user)
+	}
+
+	return &blogpb.ListUsersResp{
+		Users:         pbUsers,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// toProtoUser converts a datastore user to its protobuf message
+func toProtoUser(user *datastore.User) *blogpb.User {
+	return &blogpb.User{
+		Id: &blogpb.UUID{
+			Value: string(user.ID),
+		},
+		DisplayName: user.DisplayName,
+		Bio:         user.Bio,
+		AvatarUrl:   user.AvatarURL,
+		CreatedAt:   timestamppb.New(user.CreatedAt),
+		UpdatedAt:   timestamppb.New(user.UpdatedAt),
+	}
+}
+
+// authorStub returns the profile of an author holding only its ID, for
+// embedAuthors to fill in, or nil if there is no author
+func authorStub(id *datastore.ID) *blogpb.User {
+	if id == nil {
+		return nil
+	}
+	return &blogpb.User{Id: &blogpb.UUID{Value: string(*id)}}
+}
+
+// checkAuthor fails with PermissionDenied unless the caller owns the user
+// they write as. Services without access control let every caller write as
+// any user.
+func (s *BlogService) checkAuthor(ctx context.Context, id datastore.ID, msg string) error {
+	if !s.accessControl {
+		return nil
+	}
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "%s: anonymous callers cannot write as a user", msg)
+	}
+	user, err := s.store.GetUser(ctx, id)
+	if err != nil {
+		return storeError(err, msg)
+	}
+	if user.Owner != principal.Subject {
+		return status.Errorf(codes.PermissionDenied, "%s: author is not a user of the caller", msg)
+	}
+	return nil
+}
+
+// embedAuthors fills in the author profiles of a blog and its comments,
+// replies included, with a single lookup. Comments by authors who left the
+// free-text author empty are signed with their display name. Profiles of
+// users that no longer exist are left holding only their ID.
+func (s *BlogService) embedAuthors(ctx context.Context, blogAuthor *blogpb.User, comments ...*blogpb.Comment) error {
+	profiles := []*blogpb.User{}
+	if blogAuthor != nil {
+		profiles = append(profiles, blogAuthor)
+	}
+	var signed []*blogpb.Comment
+	var walk func([]*blogpb.Comment)
+	walk = func(thread []*blogpb.Comment) {
+		for _, comment := range thread {
+			if comment.GetAuthorProfile() != nil {
+				profiles = append(profiles, comment.GetAuthorProfile())
+				signed = append(signed, comment)
+			}
+			walk(comment.GetReplies())
+		}
+	}
+	walk(comments)
+	if len(profiles) == 0 {
+		return nil
+	}
+
+	var ids []datastore.ID
+	for _, profile := range profiles {
+		if id := datastore.ID(profile.GetId().GetValue()); !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	users, err := s.store.GetUsers(ctx, ids)
+	if err != nil {
+		return storeError(err, "failed to get authors")
+	}
+	byID := make(map[datastore.ID]*datastore.User, len(users))
+	for _, user := range users {
+		byID[user.ID] = user
+	}
+
+	for _, profile := range profiles {
+		if user, ok := byID[datastore.ID(profile.GetId().GetValue())]; ok {
+			proto.Merge(profile, toProtoUser(user))
+		}
+	}
+	for _, comment := range signed {
+		if comment.GetAuthor() == "" {
+			comment.Author = comment.GetAuthorProfile().GetDisplayName()
+		}
+	}
+	return nil
+}
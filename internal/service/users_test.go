@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/agruetz/prosigliere/internal/auth"
+	"github.com/agruetz/prosigliere/internal/datastore"
+	"github.com/agruetz/prosigliere/internal/datastore/mocks"
+	blogpb "github.com/agruetz/prosigliere/protos/v1/blog"
+)
+
+func TestUserService_Create(t *testing.T) {
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	mockStore := mocks.NewStore(t)
+	mockStore.On("CreateUser", mock.Anything, "Alice", "Grows tomatoes", "https://example.com/alice.png").
+		Return(&datastore.User{
+			ID:          "123e4567-e89b-12d3-a456-426614174000",
+			DisplayName: "Alice",
+			Bio:         "Grows tomatoes",
+			AvatarURL:   "https://example.com/alice.png",
+			CreatedAt:   createdAt,
+			UpdatedAt:   createdAt,
+		}, nil)
+
+	service := NewUserService(mockStore)
+	resp, err := service.Create(context.Background(), &blogpb.CreateUserReq{
+		DisplayName: "Alice",
+		Bio:         "Grows tomatoes",
+		AvatarUrl:   "https://example.com/alice.png",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "123e4567-e89b-12d3-a456-426614174000", resp.GetUser().GetId().GetValue())
+	assert.Equal(t, "Alice", resp.GetUser().GetDisplayName())
+	assert.Equal(t, "Grows tomatoes", resp.GetUser().GetBio())
+	assert.Equal(t, "https://example.com/alice.png", resp.GetUser().GetAvatarUrl())
+	assert.True(t, createdAt.Equal(resp.GetUser().GetCreatedAt().AsTime()))
+}
+
+func TestUserService_CreateError(t *testing.T) {
+	mockStore := mocks.NewStore(t)
+	mockStore.On("CreateUser", mock.Anything, "Alice", "", "").
+		Return(nil, errors.New("database error"))
+
+	service := NewUserService(mockStore)
+	resp, err := service.Create(context.Background(), &blogpb.CreateUserReq{DisplayName: "Alice"})
+	assert.Nil(t, resp)
+	assert.Equal(t, status.Error(codes.Internal, "failed to create user: database error").Error(), err.Error())
+}
+
+func TestUserService_CreateOwner(t *testing.T) {
+	mockStore := mocks.NewStore(t)
+	mockStore.On("CreateUser", mock.Anything, "Alice", "", "",
+		mock.MatchedBy(func(opt datastore.UserOption) bool {
+			return datastore.NewUserOptions(opt).Owner == "alice"
+		})).
+		Return(&datastore.User{ID: "123e4567-e89b-12d3-a456-426614174000", DisplayName: "Alice", Owner: "alice"}, nil)
+
+	// Users are owned by whoever created them
+	service := NewUserService(mockStore)
+	ctx := auth.NewContext(context.Background(), &auth.Principal{Subject: "alice"})
+	_, err := service.Create(ctx, &blogpb.CreateUserReq{DisplayName: "Alice"})
+	require.NoError(t, err)
+}
+
+func TestUserService_Get(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174000"
+
+	tests := []struct {
+		name          string
+		req           *blogpb.GetUserReq
+		setupMock     func(mock *mocks.Store)
+		expectedError error
+	}{
+		{
+			name: "successful retrieval",
+			req:  &blogpb.GetUserReq{Id: &blogpb.UUID{Value: userID}},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("GetUser", mock.Anything, datastore.ID(userID)).
+					Return(&datastore.User{ID: datastore.ID(userID), DisplayName: "Alice"}, nil)
+			},
+		},
+		{
+			name:          "missing ID",
+			req:           &blogpb.GetUserReq{},
+			setupMock:     func(mockStore *mocks.Store) {},
+			expectedError: status.Error(codes.InvalidArgument, "user ID is required"),
+		},
+		{
+			name: "user not found",
+			req:  &blogpb.GetUserReq{Id: &blogpb.UUID{Value: userID}},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("GetUser", mock.Anything, datastore.ID(userID)).
+					Return(nil, datastore.NotFound(datastore.ResourceUser, datastore.ID(userID)))
+			},
+			expectedError: status.Error(codes.NotFound, "failed to get user: user not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewUserService(mockStore)
+			resp, err := service.Get(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "Alice", resp.GetUser().GetDisplayName())
+		})
+	}
+}
+
+func TestUserService_Update(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174000"
+	displayName := "Alice"
+	bio := ""
+
+	tests := []struct {
+		name          string
+		req           *blogpb.UpdateUserReq
+		setupMock     func(mock *mocks.Store)
+		expectedError error
+	}{
+		{
+			name: "fields set on the request",
+			req:  &blogpb.UpdateUserReq{Id: &blogpb.UUID{Value: userID}, DisplayName: &displayName, Bio: &bio},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("UpdateUser", mock.Anything, datastore.ID(userID), datastore.UserPatch{DisplayName: &displayName, Bio: &bio}).
+					Return(nil)
+			},
+		},
+		{
+			name: "fields in the update mask",
+			req: &blogpb.UpdateUserReq{
+				Id:          &blogpb.UUID{Value: userID},
+				DisplayName: &displayName,
+				Bio:         &bio,
+				UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"bio"}},
+			},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("UpdateUser", mock.Anything, datastore.ID(userID), datastore.UserPatch{Bio: &bio}).
+					Return(nil)
+			},
+		},
+		{
+			name: "update mask field not set",
+			req: &blogpb.UpdateUserReq{
+				Id:         &blogpb.UUID{Value: userID},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"avatar_url"}},
+			},
+			setupMock:     func(mockStore *mocks.Store) {},
+			expectedError: status.Error(codes.InvalidArgument, "avatar_url is in update_mask but not set"),
+		},
+		{
+			name:          "missing ID",
+			req:           &blogpb.UpdateUserReq{DisplayName: &displayName},
+			setupMock:     func(mockStore *mocks.Store) {},
+			expectedError: status.Error(codes.InvalidArgument, "user ID is required"),
+		},
+		{
+			name: "user not found",
+			req:  &blogpb.UpdateUserReq{Id: &blogpb.UUID{Value: userID}, DisplayName: &displayName},
+			setupMock: func(mockStore *mocks.Store) {
+				mockStore.On("UpdateUser", mock.Anything, datastore.ID(userID), mock.Anything).
+					Return(datastore.NotFound(datastore.ResourceUser, datastore.ID(userID)))
+			},
+			expectedError: status.Error(codes.NotFound, "failed to update user: user not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			tt.setupMock(mockStore)
+
+			service := NewUserService(mockStore)
+			_, err := service.Update(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestUserService_List(t *testing.T) {
+	tests := []struct {
+		name             string
+		req              *blogpb.ListUsersReq
+		expectedPageSize int32
+	}{
+		{
+			name:             "default page size",
+			req:              &blogpb.ListUsersReq{},
+			expectedPageSize: 10,
+		},
+		{
+			name:             "maximum page size",
+			req:              &blogpb.ListUsersReq{PageSize: 500, PageToken: "token"},
+			expectedPageSize: 100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mocks.NewStore(t)
+			mockStore.On("ListUsers", mock.Anything, tt.expectedPageSize, tt.req.GetPageToken()).
+				Return([]*datastore.User{{ID: "123e4567-e89b-12d3-a456-426614174000", DisplayName: "Alice"}}, "next", nil)
+
+			service := NewUserService(mockStore)
+			resp, err := service.List(context.Background(), tt.req)
+			require.NoError(t, err)
+
+			require.Len(t, resp.GetUsers(), 1)
+			assert.Equal(t, "Alice", resp.GetUsers()[0].GetDisplayName())
+			assert.Equal(t, "next", resp.GetNextPageToken())
+		})
+	}
+}
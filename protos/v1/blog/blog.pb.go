@@ -12,6 +12,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -25,6 +26,345 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// BlogStatus is the lifecycle state of a blog
+type BlogStatus int32
+
+const (
+	// Unspecified status, treated as published when creating a blog
+	BlogStatus_BLOG_STATUS_UNSPECIFIED BlogStatus = 0
+	// The blog is being edited and is not publicly listed
+	BlogStatus_BLOG_STATUS_DRAFT BlogStatus = 1
+	// The blog is published automatically at its publish_at time
+	BlogStatus_BLOG_STATUS_SCHEDULED BlogStatus = 2
+	// The blog is publicly listed
+	BlogStatus_BLOG_STATUS_PUBLISHED BlogStatus = 3
+	// The blog has been taken down but is kept for reference
+	BlogStatus_BLOG_STATUS_ARCHIVED BlogStatus = 4
+)
+
+// Enum value maps for BlogStatus.
+var (
+	BlogStatus_name = map[int32]string{
+		0: "BLOG_STATUS_UNSPECIFIED",
+		1: "BLOG_STATUS_DRAFT",
+		2: "BLOG_STATUS_SCHEDULED",
+		3: "BLOG_STATUS_PUBLISHED",
+		4: "BLOG_STATUS_ARCHIVED",
+	}
+	BlogStatus_value = map[string]int32{
+		"BLOG_STATUS_UNSPECIFIED": 0,
+		"BLOG_STATUS_DRAFT":       1,
+		"BLOG_STATUS_SCHEDULED":   2,
+		"BLOG_STATUS_PUBLISHED":   3,
+		"BLOG_STATUS_ARCHIVED":    4,
+	}
+)
+
+func (x BlogStatus) Enum() *BlogStatus {
+	p := new(BlogStatus)
+	*p = x
+	return p
+}
+
+func (x BlogStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BlogStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_blog_v1_blog_proto_enumTypes[0].Descriptor()
+}
+
+func (BlogStatus) Type() protoreflect.EnumType {
+	return &file_protos_blog_v1_blog_proto_enumTypes[0]
+}
+
+func (x BlogStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BlogStatus.Descriptor instead.
+func (BlogStatus) EnumDescriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{0}
+}
+
+// CommentState is the moderation state of a comment
+type CommentState int32
+
+const (
+	// Unspecified state
+	CommentState_COMMENT_STATE_UNSPECIFIED CommentState = 0
+	// The comment waits for a moderator and is not shown
+	CommentState_COMMENT_STATE_PENDING CommentState = 1
+	// The comment is shown
+	CommentState_COMMENT_STATE_APPROVED CommentState = 2
+	// A moderator turned the comment down
+	CommentState_COMMENT_STATE_REJECTED CommentState = 3
+	// The comment is spam
+	CommentState_COMMENT_STATE_SPAM CommentState = 4
+)
+
+// Enum value maps for CommentState.
+var (
+	CommentState_name = map[int32]string{
+		0: "COMMENT_STATE_UNSPECIFIED",
+		1: "COMMENT_STATE_PENDING",
+		2: "COMMENT_STATE_APPROVED",
+		3: "COMMENT_STATE_REJECTED",
+		4: "COMMENT_STATE_SPAM",
+	}
+	CommentState_value = map[string]int32{
+		"COMMENT_STATE_UNSPECIFIED": 0,
+		"COMMENT_STATE_PENDING":     1,
+		"COMMENT_STATE_APPROVED":    2,
+		"COMMENT_STATE_REJECTED":    3,
+		"COMMENT_STATE_SPAM":        4,
+	}
+)
+
+func (x CommentState) Enum() *CommentState {
+	p := new(CommentState)
+	*p = x
+	return p
+}
+
+func (x CommentState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommentState) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_blog_v1_blog_proto_enumTypes[1].Descriptor()
+}
+
+func (CommentState) Type() protoreflect.EnumType {
+	return &file_protos_blog_v1_blog_proto_enumTypes[1]
+}
+
+func (x CommentState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommentState.Descriptor instead.
+func (CommentState) EnumDescriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{1}
+}
+
+// CommentPolicy is whether new comments on a blog need approval
+type CommentPolicy int32
+
+const (
+	// Unspecified policy, which follows the server default
+	CommentPolicy_COMMENT_POLICY_UNSPECIFIED CommentPolicy = 0
+	// New comments are approved right away
+	CommentPolicy_COMMENT_POLICY_OPEN CommentPolicy = 1
+	// New comments wait for a moderator
+	CommentPolicy_COMMENT_POLICY_MODERATED CommentPolicy = 2
+)
+
+// Enum value maps for CommentPolicy.
+var (
+	CommentPolicy_name = map[int32]string{
+		0: "COMMENT_POLICY_UNSPECIFIED",
+		1: "COMMENT_POLICY_OPEN",
+		2: "COMMENT_POLICY_MODERATED",
+	}
+	CommentPolicy_value = map[string]int32{
+		"COMMENT_POLICY_UNSPECIFIED": 0,
+		"COMMENT_POLICY_OPEN":        1,
+		"COMMENT_POLICY_MODERATED":   2,
+	}
+)
+
+func (x CommentPolicy) Enum() *CommentPolicy {
+	p := new(CommentPolicy)
+	*p = x
+	return p
+}
+
+func (x CommentPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommentPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_blog_v1_blog_proto_enumTypes[2].Descriptor()
+}
+
+func (CommentPolicy) Type() protoreflect.EnumType {
+	return &file_protos_blog_v1_blog_proto_enumTypes[2]
+}
+
+func (x CommentPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommentPolicy.Descriptor instead.
+func (CommentPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{2}
+}
+
+// CommentView is how the comments of a blog are returned
+type CommentView int32
+
+const (
+	// Unspecified view, treated as flat
+	CommentView_COMMENT_VIEW_UNSPECIFIED CommentView = 0
+	// Every comment in one list in thread order, with replies following their
+	// parent, and depth telling how far to indent them
+	CommentView_COMMENT_VIEW_FLAT CommentView = 1
+	// Top-level comments only, with their replies nested under them
+	CommentView_COMMENT_VIEW_TREE CommentView = 2
+)
+
+// Enum value maps for CommentView.
+var (
+	CommentView_name = map[int32]string{
+		0: "COMMENT_VIEW_UNSPECIFIED",
+		1: "COMMENT_VIEW_FLAT",
+		2: "COMMENT_VIEW_TREE",
+	}
+	CommentView_value = map[string]int32{
+		"COMMENT_VIEW_UNSPECIFIED": 0,
+		"COMMENT_VIEW_FLAT":        1,
+		"COMMENT_VIEW_TREE":        2,
+	}
+)
+
+func (x CommentView) Enum() *CommentView {
+	p := new(CommentView)
+	*p = x
+	return p
+}
+
+func (x CommentView) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommentView) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_blog_v1_blog_proto_enumTypes[3].Descriptor()
+}
+
+func (CommentView) Type() protoreflect.EnumType {
+	return &file_protos_blog_v1_blog_proto_enumTypes[3]
+}
+
+func (x CommentView) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommentView.Descriptor instead.
+func (CommentView) EnumDescriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{3}
+}
+
+// DiffMode is the unit a diff compares text in
+type DiffMode int32
+
+const (
+	// Unspecified mode, treated as line
+	DiffMode_DIFF_MODE_UNSPECIFIED DiffMode = 0
+	// Compare whole lines
+	DiffMode_DIFF_MODE_LINE DiffMode = 1
+	// Compare words and the whitespace between them
+	DiffMode_DIFF_MODE_WORD DiffMode = 2
+)
+
+// Enum value maps for DiffMode.
+var (
+	DiffMode_name = map[int32]string{
+		0: "DIFF_MODE_UNSPECIFIED",
+		1: "DIFF_MODE_LINE",
+		2: "DIFF_MODE_WORD",
+	}
+	DiffMode_value = map[string]int32{
+		"DIFF_MODE_UNSPECIFIED": 0,
+		"DIFF_MODE_LINE":        1,
+		"DIFF_MODE_WORD":        2,
+	}
+)
+
+func (x DiffMode) Enum() *DiffMode {
+	p := new(DiffMode)
+	*p = x
+	return p
+}
+
+func (x DiffMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiffMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_blog_v1_blog_proto_enumTypes[4].Descriptor()
+}
+
+func (DiffMode) Type() protoreflect.EnumType {
+	return &file_protos_blog_v1_blog_proto_enumTypes[4]
+}
+
+func (x DiffMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiffMode.Descriptor instead.
+func (DiffMode) EnumDescriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{4}
+}
+
+// DiffOp is the kind of change a diff chunk represents
+type DiffOp int32
+
+const (
+	// Unspecified operation
+	DiffOp_DIFF_OP_UNSPECIFIED DiffOp = 0
+	// The text is in both versions
+	DiffOp_DIFF_OP_EQUAL DiffOp = 1
+	// The text was added in the newer version
+	DiffOp_DIFF_OP_INSERT DiffOp = 2
+	// The text was removed from the older version
+	DiffOp_DIFF_OP_DELETE DiffOp = 3
+)
+
+// Enum value maps for DiffOp.
+var (
+	DiffOp_name = map[int32]string{
+		0: "DIFF_OP_UNSPECIFIED",
+		1: "DIFF_OP_EQUAL",
+		2: "DIFF_OP_INSERT",
+		3: "DIFF_OP_DELETE",
+	}
+	DiffOp_value = map[string]int32{
+		"DIFF_OP_UNSPECIFIED": 0,
+		"DIFF_OP_EQUAL":       1,
+		"DIFF_OP_INSERT":      2,
+		"DIFF_OP_DELETE":      3,
+	}
+)
+
+func (x DiffOp) Enum() *DiffOp {
+	p := new(DiffOp)
+	*p = x
+	return p
+}
+
+func (x DiffOp) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiffOp) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_blog_v1_blog_proto_enumTypes[5].Descriptor()
+}
+
+func (DiffOp) Type() protoreflect.EnumType {
+	return &file_protos_blog_v1_blog_proto_enumTypes[5]
+}
+
+func (x DiffOp) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiffOp.Descriptor instead.
+func (DiffOp) EnumDescriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{5}
+}
+
 // UUID represents a universally unique identifier
 type UUID struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -85,7 +425,34 @@ type Blog struct {
 	// Last update timestamp
 	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	// Comments on the blog
-	Comments      []*Comment `protobuf:"bytes,6,rep,name=comments,proto3" json:"comments,omitempty"`
+	Comments []*Comment `protobuf:"bytes,6,rep,name=comments,proto3" json:"comments,omitempty"`
+	// Lifecycle status of the blog
+	Status BlogStatus `protobuf:"varint,7,opt,name=status,proto3,enum=blog.v1.BlogStatus" json:"status,omitempty"`
+	// Time the blog was last published, unset if it never was
+	PublishedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	// Time a scheduled blog will be published, only set while scheduled
+	PublishAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	// Opaque version of the blog, which changes whenever the blog does but not
+	// when comments are added. Pass it to Update or Delete to make sure the
+	// blog has not changed since it was read.
+	Etag string `protobuf:"bytes,10,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Time the blog was moved to the trash, only set while it is there
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// Topics of the blog, sorted by name
+	Tags []string `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Unique human-readable identifier of the blog, generated from the title
+	// when the blog is created
+	Slug string `protobuf:"bytes,13,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Number of approved comments on the blog, which may be more than are
+	// returned in comments
+	CommentCount int32 `protobuf:"varint,14,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	// Whether new comments on the blog need approval
+	CommentPolicy CommentPolicy `protobuf:"varint,15,opt,name=comment_policy,json=commentPolicy,proto3,enum=blog.v1.CommentPolicy" json:"comment_policy,omitempty"`
+	// Subject of the principal that created the blog, empty if it was created
+	// anonymously
+	Owner string `protobuf:"bytes,16,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Profile of the user who wrote the blog, unset if no author was given
+	AuthorProfile *User `protobuf:"bytes,17,opt,name=author_profile,json=authorProfile,proto3" json:"author_profile,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -162,6 +529,83 @@ func (x *Blog) GetComments() []*Comment {
 	return nil
 }
 
+func (x *Blog) GetStatus() BlogStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BlogStatus_BLOG_STATUS_UNSPECIFIED
+}
+
+func (x *Blog) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *Blog) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *Blog) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Blog) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Blog) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Blog) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Blog) GetCommentCount() int32 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *Blog) GetCommentPolicy() CommentPolicy {
+	if x != nil {
+		return x.CommentPolicy
+	}
+	return CommentPolicy_COMMENT_POLICY_UNSPECIFIED
+}
+
+func (x *Blog) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Blog) GetAuthorProfile() *User {
+	if x != nil {
+		return x.AuthorProfile
+	}
+	return nil
+}
+
 // Comment represents a comment on a blog
 type Comment struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -169,10 +613,33 @@ type Comment struct {
 	Id *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// Content of the comment
 	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	// Author of the comment
+	// Name of the author of the comment. Comments by users without a name of
+	// their own are named by the display name of the user.
 	Author string `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
 	// Creation timestamp
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// ID of the comment this one replies to, unset for top-level comments
+	ParentId *UUID `protobuf:"bytes,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Nesting depth of the comment, 0 for top-level comments and one more than
+	// the parent for replies
+	Depth int32 `protobuf:"varint,6,opt,name=depth,proto3" json:"depth,omitempty"`
+	// Replies to the comment, oldest first. Only set when the comments are
+	// returned as a tree.
+	Replies []*Comment `protobuf:"bytes,7,rep,name=replies,proto3" json:"replies,omitempty"`
+	// Time the comment was last edited, unset if it never was
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// ID of the blog the comment is on
+	BlogId *UUID `protobuf:"bytes,9,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	// Moderation state of the comment. Only approved comments are shown by Get
+	// and ListComments.
+	State CommentState `protobuf:"varint,10,opt,name=state,proto3,enum=blog.v1.CommentState" json:"state,omitempty"`
+	// Reason a moderator gave for the state of the comment
+	ModerationReason string `protobuf:"bytes,11,opt,name=moderation_reason,json=moderationReason,proto3" json:"moderation_reason,omitempty"`
+	// Time the comment was last moderated, unset if it never was
+	ModeratedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=moderated_at,json=moderatedAt,proto3" json:"moderated_at,omitempty"`
+	// Profile of the user who wrote the comment, unset for comments by guests,
+	// who are only named by author
+	AuthorProfile *User `protobuf:"bytes,13,opt,name=author_profile,json=authorProfile,proto3" json:"author_profile,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -235,31 +702,102 @@ func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-// Request to create a new blog
-type CreateReq struct {
+func (x *Comment) GetParentId() *UUID {
+	if x != nil {
+		return x.ParentId
+	}
+	return nil
+}
+
+func (x *Comment) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *Comment) GetReplies() []*Comment {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *Comment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetBlogId() *UUID {
+	if x != nil {
+		return x.BlogId
+	}
+	return nil
+}
+
+func (x *Comment) GetState() CommentState {
+	if x != nil {
+		return x.State
+	}
+	return CommentState_COMMENT_STATE_UNSPECIFIED
+}
+
+func (x *Comment) GetModerationReason() string {
+	if x != nil {
+		return x.ModerationReason
+	}
+	return ""
+}
+
+func (x *Comment) GetModeratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ModeratedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetAuthorProfile() *User {
+	if x != nil {
+		return x.AuthorProfile
+	}
+	return nil
+}
+
+// User is the public profile of an author of blogs and comments
+type User struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Title of the blog post
-	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	// Content of the blog post
-	Content       string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// Unique identifier for the user
+	Id *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name the user is shown by
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Short description of the user
+	Bio string `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	// URL of the picture of the user
+	AvatarUrl string `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	// Creation timestamp
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateReq) Reset() {
-	*x = CreateReq{}
+func (x *User) Reset() {
+	*x = User{}
 	mi := &file_protos_blog_v1_blog_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateReq) String() string {
+func (x *User) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateReq) ProtoMessage() {}
+func (*User) ProtoMessage() {}
 
-func (x *CreateReq) ProtoReflect() protoreflect.Message {
+func (x *User) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_blog_v1_blog_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -271,27 +809,147 @@ func (x *CreateReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateReq.ProtoReflect.Descriptor instead.
-func (*CreateReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
 	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CreateReq) GetTitle() string {
+func (x *User) GetId() *UUID {
 	if x != nil {
-		return x.Title
+		return x.Id
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateReq) GetContent() string {
+func (x *User) GetDisplayName() string {
 	if x != nil {
-		return x.Content
+		return x.DisplayName
 	}
 	return ""
 }
 
-// Response for creating a blog
-type CreateResp struct {
+func (x *User) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *User) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Request to create a new blog
+type CreateReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Title of the blog post
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Content of the blog post
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// Initial status of the blog post, defaults to published, or to scheduled
+	// if publish_at is set
+	Status BlogStatus `protobuf:"varint,3,opt,name=status,proto3,enum=blog.v1.BlogStatus" json:"status,omitempty"`
+	// Time to publish the blog post at
+	PublishAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	// Topics of the blog post, lower case words joined by dashes
+	Tags []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	// ID of the user writing the blog post (optional)
+	AuthorId      *UUID `protobuf:"bytes,6,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReq) Reset() {
+	*x = CreateReq{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReq) ProtoMessage() {}
+
+func (x *CreateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReq.ProtoReflect.Descriptor instead.
+func (*CreateReq) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateReq) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateReq) GetStatus() BlogStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BlogStatus_BLOG_STATUS_UNSPECIFIED
+}
+
+func (x *CreateReq) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *CreateReq) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateReq) GetAuthorId() *UUID {
+	if x != nil {
+		return x.AuthorId
+	}
+	return nil
+}
+
+// Response for creating a blog
+type CreateResp struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Unique identifier for the created blog
 	Id            *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -301,7 +959,7 @@ type CreateResp struct {
 
 func (x *CreateResp) Reset() {
 	*x = CreateResp{}
-	mi := &file_protos_blog_v1_blog_proto_msgTypes[4]
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -313,7 +971,192 @@ func (x *CreateResp) String() string {
 func (*CreateResp) ProtoMessage() {}
 
 func (x *CreateResp) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blog_v1_blog_proto_msgTypes[4]
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateResp.ProtoReflect.Descriptor instead.
+func (*CreateResp) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateResp) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+// Request to get a blog by ID
+type GetReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the blog to retrieve
+	Id *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Fields of the blog to return (optional). Paths are relative to Blog, e.g.
+	// "title,status". Leaving out content and comments skips reading them.
+	// Unset or "*" returns every field.
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	// Also return the blog if it is in the trash
+	ShowDeleted bool `protobuf:"varint,3,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
+	// How to return the comments, defaults to flat
+	CommentView CommentView `protobuf:"varint,4,opt,name=comment_view,json=commentView,proto3,enum=blog.v1.CommentView" json:"comment_view,omitempty"`
+	// Maximum number of comments to return, oldest first (optional). Defaults
+	// to 100, use ListComments to page through the rest.
+	MaxComments   int32 `protobuf:"varint,5,opt,name=max_comments,json=maxComments,proto3" json:"max_comments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReq) Reset() {
+	*x = GetReq{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReq) ProtoMessage() {}
+
+func (x *GetReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReq.ProtoReflect.Descriptor instead.
+func (*GetReq) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetReq) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *GetReq) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+func (x *GetReq) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
+func (x *GetReq) GetCommentView() CommentView {
+	if x != nil {
+		return x.CommentView
+	}
+	return CommentView_COMMENT_VIEW_UNSPECIFIED
+}
+
+func (x *GetReq) GetMaxComments() int32 {
+	if x != nil {
+		return x.MaxComments
+	}
+	return 0
+}
+
+// Response for getting a blog
+type GetResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The retrieved blog
+	Blog          *Blog `protobuf:"bytes,1,opt,name=blog,proto3" json:"blog,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResp) Reset() {
+	*x = GetResp{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResp) ProtoMessage() {}
+
+func (x *GetResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResp.ProtoReflect.Descriptor instead.
+func (*GetResp) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetResp) GetBlog() *Blog {
+	if x != nil {
+		return x.Blog
+	}
+	return nil
+}
+
+// Request to get a blog by its slug
+type GetBySlugReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Current or previous slug of the blog to retrieve
+	Slug string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Fields of the blog to return (optional), as for Get
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	// Also return the blog if it is in the trash
+	ShowDeleted bool `protobuf:"varint,3,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
+	// How to return the comments, defaults to flat
+	CommentView CommentView `protobuf:"varint,4,opt,name=comment_view,json=commentView,proto3,enum=blog.v1.CommentView" json:"comment_view,omitempty"`
+	// Maximum number of comments to return, as for Get
+	MaxComments   int32 `protobuf:"varint,5,opt,name=max_comments,json=maxComments,proto3" json:"max_comments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBySlugReq) Reset() {
+	*x = GetBySlugReq{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBySlugReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBySlugReq) ProtoMessage() {}
+
+func (x *GetBySlugReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -321,45 +1164,2087 @@ func (x *CreateResp) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBySlugReq.ProtoReflect.Descriptor instead.
+func (*GetBySlugReq) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetBySlugReq) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *GetBySlugReq) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+func (x *GetBySlugReq) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
+func (x *GetBySlugReq) GetCommentView() CommentView {
+	if x != nil {
+		return x.CommentView
+	}
+	return CommentView_COMMENT_VIEW_UNSPECIFIED
+}
+
+func (x *GetBySlugReq) GetMaxComments() int32 {
+	if x != nil {
+		return x.MaxComments
+	}
+	return 0
+}
+
+// Response for getting a blog by its slug
+type GetBySlugResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The retrieved blog
+	Blog *Blog `protobuf:"bytes,1,opt,name=blog,proto3" json:"blog,omitempty"`
+	// Current slug of the blog if the requested slug is a previous one, which
+	// should be redirected to it. The gateway answers such requests with 301
+	// Moved Permanently.
+	RedirectSlug  string `protobuf:"bytes,2,opt,name=redirect_slug,json=redirectSlug,proto3" json:"redirect_slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBySlugResp) Reset() {
+	*x = GetBySlugResp{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBySlugResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBySlugResp) ProtoMessage() {}
+
+func (x *GetBySlugResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBySlugResp.ProtoReflect.Descriptor instead.
+func (*GetBySlugResp) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetBySlugResp) GetBlog() *Blog {
+	if x != nil {
+		return x.Blog
+	}
+	return nil
+}
+
+func (x *GetBySlugResp) GetRedirectSlug() string {
+	if x != nil {
+		return x.RedirectSlug
+	}
+	return ""
+}
+
+// Request to update a blog
+type UpdateReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the blog to update
+	Id *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// New title for the blog (optional)
+	Title *string `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	// New content for the blog (optional)
+	Content *string `protobuf:"bytes,3,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	// New status for the blog (optional)
+	Status *BlogStatus `protobuf:"varint,4,opt,name=status,proto3,enum=blog.v1.BlogStatus,oneof" json:"status,omitempty"`
+	// New time to publish the blog at, which schedules the blog (optional)
+	PublishAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	// Name of the person making the edit, recorded in the revision history
+	// unless the caller is authenticated, whose subject is recorded instead
+	Editor string `protobuf:"bytes,6,opt,name=editor,proto3" json:"editor,omitempty"`
+	// Only update the blog if its etag still matches (optional). Set from the
+	// If-Match header over HTTP.
+	Etag string `protobuf:"bytes,7,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Fields to update (optional). Fields set on the request but missing from
+	// the mask are ignored. If unset, every field set on the request is
+	// updated. Fields cannot be cleared, so every path in the mask must be set
+	// on the request. Full replacement with "*" is not supported.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,8,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// New tags for the blog, replacing all of its tags (optional). Tags can
+	// only be cleared by naming them in update_mask.
+	Tags []string `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	// New slug for the blog (optional). The previous slug keeps leading to the
+	// blog. Fails with ALREADY_EXISTS if another blog has ever used the slug.
+	Slug *string `protobuf:"bytes,10,opt,name=slug,proto3,oneof" json:"slug,omitempty"`
+	// New comment policy for the blog (optional). Unspecified makes the blog
+	// follow the server default again.
+	CommentPolicy *CommentPolicy `protobuf:"varint,11,opt,name=comment_policy,json=commentPolicy,proto3,enum=blog.v1.CommentPolicy,oneof" json:"comment_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReq) Reset() {
+	*x = UpdateReq{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReq) ProtoMessage() {}
+
+func (x *UpdateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReq.ProtoReflect.Descriptor instead.
+func (*UpdateReq) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateReq) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *UpdateReq) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateReq) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+func (x *UpdateReq) GetStatus() BlogStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return BlogStatus_BLOG_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateReq) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *UpdateReq) GetEditor() string {
+	if x != nil {
+		return x.Editor
+	}
+	return ""
+}
+
+func (x *UpdateReq) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *UpdateReq) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateReq) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateReq) GetSlug() string {
+	if x != nil && x.Slug != nil {
+		return *x.Slug
+	}
+	return ""
+}
+
+func (x *UpdateReq) GetCommentPolicy() CommentPolicy {
+	if x != nil && x.CommentPolicy != nil {
+		return *x.CommentPolicy
+	}
+	return CommentPolicy_COMMENT_POLICY_UNSPECIFIED
+}
+
+// Request to delete a blog
+type DeleteReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the blog to delete
+	Id *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Only delete the blog if its etag still matches (optional). Set from the
+	// If-Match header over HTTP.
+	Etag          string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReq) Reset() {
+	*x = DeleteReq{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReq) ProtoMessage() {}
+
+func (x *DeleteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReq.ProtoReflect.Descriptor instead.
+func (*DeleteReq) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteReq) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *DeleteReq) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// Request to list blogs with pagination
+type ListReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of blogs to return
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token for pagination
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only list blogs with this status, defaults to published
+	Status BlogStatus `protobuf:"varint,3,opt,name=status,proto3,enum=blog.v1.BlogStatus" json:"status,omitempty"`
+	// Also list blogs in the trash
+	ShowDeleted bool `protobuf:"varint,4,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
+	// Only list blogs with this tag
+	Tag           string `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReq) Reset() {
+	*x = ListReq{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReq) ProtoMessage() {}
+
+func (x *ListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReq.ProtoReflect.Descriptor instead.
+func (*ListReq) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListReq) GetStatus() BlogStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BlogStatus_BLOG_STATUS_UNSPECIFIED
+}
+
+func (x *ListReq) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
+func (x *ListReq) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+// Response for listing blogs with their titles and comment counts
+type ListResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of blog entries with title and comment count
+	Blogs []*BlogSummary `protobuf:"bytes,1,rep,name=blogs,proto3" json:"blogs,omitempty"`
+	// Token for retrieving the next page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResp) Reset() {
+	*x = ListResp{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResp) ProtoMessage() {}
+
+func (x *ListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResp.ProtoReflect.Descriptor instead.
+func (*ListResp) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListResp) GetBlogs() []*BlogSummary {
+	if x != nil {
+		return x.Blogs
+	}
+	return nil
+}
+
+func (x *ListResp) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Summary of a blog containing title and comment count
+type BlogSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the blog
+	Id *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Title of the blog
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Number of comments on the blog
+	CommentCount int32 `protobuf:"varint,3,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	// Lifecycle status of the blog
+	Status BlogStatus `protobuf:"varint,4,opt,name=status,proto3,enum=blog.v1.BlogStatus" json:"status,omitempty"`
+	// Time the blog was moved to the trash, only set while it is there
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// Current slug of the blog
+	Slug          string `protobuf:"bytes,6,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlogSummary) Reset() {
+	*x = BlogSummary{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlogSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlogSummary) ProtoMessage() {}
+
+func (x *BlogSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlogSummary.ProtoReflect.Descriptor instead.
+func (*BlogSummary) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BlogSummary) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *BlogSummary) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BlogSummary) GetCommentCount() int32 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *BlogSummary) GetStatus() BlogStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BlogStatus_BLOG_STATUS_UNSPECIFIED
+}
+
+func (x *BlogSummary) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *BlogSummary) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+// Request to list the tags of blogs
+type ListTagsReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only count blogs with this status, defaults to published
+	Status        BlogStatus `protobuf:"varint,1,opt,name=status,proto3,enum=blog.v1.BlogStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsReq) Reset() {
+	*x = ListTagsReq{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsReq) ProtoMessage() {}
+
+func (x *ListTagsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsReq.ProtoReflect.Descriptor instead.
+func (*ListTagsReq) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListTagsReq) GetStatus() BlogStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BlogStatus_BLOG_STATUS_UNSPECIFIED
+}
+
+// TagCount is a tag with the number of blogs that have it
+type TagCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the tag
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Number of blogs with the tag
+	PostCount     int32 `protobuf:"varint,2,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagCount) Reset() {
+	*x = TagCount{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagCount) ProtoMessage() {}
+
+func (x *TagCount) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagCount.ProtoReflect.Descriptor instead.
+func (*TagCount) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TagCount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagCount) GetPostCount() int32 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+// Response for listing the tags of blogs
+type ListTagsResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tags with at least one blog, sorted by name
+	Tags          []*TagCount `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResp) Reset() {
+	*x = ListTagsResp{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResp) ProtoMessage() {}
+
+func (x *ListTagsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResp.ProtoReflect.Descriptor instead.
+func (*ListTagsResp) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListTagsResp) GetTags() []*TagCount {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// Request to search the published blogs
+type SearchReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Words the blogs must contain. Words in double quotes must appear as a
+	// phrase, and a trailing * matches words starting with the given text,
+	// e.g. "grow tomat"* pots
+	Q string `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	// Maximum number of results to return
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token for pagination
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Also match the text of comments
+	IncludeComments bool `protobuf:"varint,4,opt,name=include_comments,json=includeComments,proto3" json:"include_comments,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SearchReq) Reset() {
+	*x = SearchReq{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchReq) ProtoMessage() {}
+
+func (x *SearchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchReq.ProtoReflect.Descriptor instead.
+func (*SearchReq) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SearchReq) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *SearchReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchReq) GetIncludeComments() bool {
+	if x != nil {
+		return x.IncludeComments
+	}
+	return false
+}
+
+// SearchResult is a blog matching a search
+type SearchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Summary of the blog
+	Blog *BlogSummary `protobuf:"bytes,1,opt,name=blog,proto3" json:"blog,omitempty"`
+	// Excerpt of the matched content or comment, with the matched words
+	// wrapped in <b> and </b>
+	Snippet string `protobuf:"bytes,2,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	// Relevance of the match, higher is better
+	Rank          float32 `protobuf:"fixed32,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SearchResult) GetBlog() *BlogSummary {
+	if x != nil {
+		return x.Blog
+	}
+	return nil
+}
+
+func (x *SearchResult) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *SearchResult) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+// Response for searching blogs
+type SearchResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Matching blogs, best matches first
+	Results []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Token for retrieving the next page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResp) Reset() {
+	*x = SearchResp{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResp) ProtoMessage() {}
+
+func (x *SearchResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResp.ProtoReflect.Descriptor instead.
+func (*SearchResp) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SearchResp) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchResp) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Request to add a comment to a blog
+type AddCommentReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the blog to comment on
+	Id *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Content of the comment
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// Name of the author of the comment, required for guests. Users are named
+	// by their display name if left out.
+	Author string `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	// ID of the comment to reply to (optional), which must be on the same blog
+	ParentId *UUID `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// ID of the user writing the comment, unset for guests
+	AuthorId      *UUID `protobuf:"bytes,5,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCommentReq) Reset() {
+	*x = AddCommentReq{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCommentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommentReq) ProtoMessage() {}
+
+func (x *AddCommentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommentReq.ProtoReflect.Descriptor instead.
+func (*AddCommentReq) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AddCommentReq) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *AddCommentReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *AddCommentReq) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *AddCommentReq) GetParentId() *UUID {
+	if x != nil {
+		return x.ParentId
+	}
+	return nil
+}
+
+func (x *AddCommentReq) GetAuthorId() *UUID {
+	if x != nil {
+		return x.AuthorId
+	}
+	return nil
+}
+
+// Response for adding a comment to a blog
+type AddCommentResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The added comment
+	Comment       *Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCommentResp) Reset() {
+	*x = AddCommentResp{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCommentResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommentResp) ProtoMessage() {}
+
+func (x *AddCommentResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommentResp.ProtoReflect.Descriptor instead.
+func (*AddCommentResp) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AddCommentResp) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+// Request to get a comment
+type GetCommentReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the blog
+	Id *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the comment to retrieve
+	CommentId     *UUID `protobuf:"bytes,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommentReq) Reset() {
+	*x = GetCommentReq{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentReq) ProtoMessage() {}
+
+func (x *GetCommentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentReq.ProtoReflect.Descriptor instead.
+func (*GetCommentReq) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetCommentReq) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *GetCommentReq) GetCommentId() *UUID {
+	if x != nil {
+		return x.CommentId
+	}
+	return nil
+}
+
+// Response for getting a comment
+type GetCommentResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The retrieved comment
+	Comment       *Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommentResp) Reset() {
+	*x = GetCommentResp{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentResp) ProtoMessage() {}
+
+func (x *GetCommentResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentResp.ProtoReflect.Descriptor instead.
+func (*GetCommentResp) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetCommentResp) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+// Request to edit a comment
+type UpdateCommentReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the blog
+	Id *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the comment to edit
+	CommentId *UUID `protobuf:"bytes,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// New content of the comment
+	Content       string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCommentReq) Reset() {
+	*x = UpdateCommentReq{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCommentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommentReq) ProtoMessage() {}
+
+func (x *UpdateCommentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommentReq.ProtoReflect.Descriptor instead.
+func (*UpdateCommentReq) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateCommentReq) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *UpdateCommentReq) GetCommentId() *UUID {
+	if x != nil {
+		return x.CommentId
+	}
+	return nil
+}
+
+func (x *UpdateCommentReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// Request to delete a comment
+type DeleteCommentReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the blog
+	Id *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the comment to delete
+	CommentId     *UUID `protobuf:"bytes,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentReq) Reset() {
+	*x = DeleteCommentReq{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentReq) ProtoMessage() {}
+
+func (x *DeleteCommentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentReq.ProtoReflect.Descriptor instead.
+func (*DeleteCommentReq) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteCommentReq) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *DeleteCommentReq) GetCommentId() *UUID {
+	if x != nil {
+		return x.CommentId
+	}
+	return nil
+}
+
+// Request to list the comments of a blog
+type ListCommentsReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the blog
+	Id *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Maximum number of comments to return
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token for pagination
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsReq) Reset() {
+	*x = ListCommentsReq{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsReq) ProtoMessage() {}
+
+func (x *ListCommentsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsReq.ProtoReflect.Descriptor instead.
+func (*ListCommentsReq) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListCommentsReq) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ListCommentsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCommentsReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Response for listing the comments of a blog
+type ListCommentsResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Comments of the blog, oldest first
+	Comments []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	// Token for retrieving the next page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsResp) Reset() {
+	*x = ListCommentsResp{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsResp) ProtoMessage() {}
+
+func (x *ListCommentsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsResp.ProtoReflect.Descriptor instead.
+func (*ListCommentsResp) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListCommentsResp) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListCommentsResp) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Request to list the comments waiting for a moderator
+type ListPendingCommentsReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only list the comments of this blog (optional)
+	Id *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Maximum number of comments to return
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token for pagination
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingCommentsReq) Reset() {
+	*x = ListPendingCommentsReq{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingCommentsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingCommentsReq) ProtoMessage() {}
+
+func (x *ListPendingCommentsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingCommentsReq.ProtoReflect.Descriptor instead.
+func (*ListPendingCommentsReq) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListPendingCommentsReq) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ListPendingCommentsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPendingCommentsReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Response for listing the comments waiting for a moderator
+type ListPendingCommentsResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pending comments, oldest first
+	Comments []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	// Token for retrieving the next page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingCommentsResp) Reset() {
+	*x = ListPendingCommentsResp{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingCommentsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingCommentsResp) ProtoMessage() {}
+
+func (x *ListPendingCommentsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingCommentsResp.ProtoReflect.Descriptor instead.
+func (*ListPendingCommentsResp) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListPendingCommentsResp) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListPendingCommentsResp) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Request to approve or turn down a comment
+type ModerateCommentReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the blog
+	Id *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the comment to moderate
+	CommentId *UUID `protobuf:"bytes,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// New state of the comment
+	State CommentState `protobuf:"varint,3,opt,name=state,proto3,enum=blog.v1.CommentState" json:"state,omitempty"`
+	// Why the comment was given the state (optional)
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateCommentReq) Reset() {
+	*x = ModerateCommentReq{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateCommentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateCommentReq) ProtoMessage() {}
+
+func (x *ModerateCommentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateCommentReq.ProtoReflect.Descriptor instead.
+func (*ModerateCommentReq) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ModerateCommentReq) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ModerateCommentReq) GetCommentId() *UUID {
+	if x != nil {
+		return x.CommentId
+	}
+	return nil
+}
+
+func (x *ModerateCommentReq) GetState() CommentState {
+	if x != nil {
+		return x.State
+	}
+	return CommentState_COMMENT_STATE_UNSPECIFIED
+}
+
+func (x *ModerateCommentReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// CommentVerdict records what a comment filter made of a new comment
+type CommentVerdict struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the filter
+	Classifier string `protobuf:"bytes,1,opt,name=classifier,proto3" json:"classifier,omitempty"`
+	// State the filter asked for, unspecified if it had no objection
+	State CommentState `protobuf:"varint,2,opt,name=state,proto3,enum=blog.v1.CommentState" json:"state,omitempty"`
+	// Why the filter asked for the state
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Confidence of the filter, its meaning depends on the filter
+	Score float64 `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	// Time the comment was filtered
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommentVerdict) Reset() {
+	*x = CommentVerdict{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentVerdict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentVerdict) ProtoMessage() {}
+
+func (x *CommentVerdict) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentVerdict.ProtoReflect.Descriptor instead.
+func (*CommentVerdict) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CommentVerdict) GetClassifier() string {
+	if x != nil {
+		return x.Classifier
+	}
+	return ""
+}
+
+func (x *CommentVerdict) GetState() CommentState {
+	if x != nil {
+		return x.State
+	}
+	return CommentState_COMMENT_STATE_UNSPECIFIED
+}
+
+func (x *CommentVerdict) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CommentVerdict) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *CommentVerdict) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Request to list the filter verdicts on a comment
+type ListCommentVerdictsReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the blog
+	Id *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the comment
+	CommentId     *UUID `protobuf:"bytes,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentVerdictsReq) Reset() {
+	*x = ListCommentVerdictsReq{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentVerdictsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentVerdictsReq) ProtoMessage() {}
+
+func (x *ListCommentVerdictsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentVerdictsReq.ProtoReflect.Descriptor instead.
+func (*ListCommentVerdictsReq) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListCommentVerdictsReq) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ListCommentVerdictsReq) GetCommentId() *UUID {
+	if x != nil {
+		return x.CommentId
+	}
+	return nil
+}
+
+// Response for listing the filter verdicts on a comment
+type ListCommentVerdictsResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Verdicts in the order the filters ran
+	Verdicts      []*CommentVerdict `protobuf:"bytes,1,rep,name=verdicts,proto3" json:"verdicts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentVerdictsResp) Reset() {
+	*x = ListCommentVerdictsResp{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentVerdictsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentVerdictsResp) ProtoMessage() {}
+
+func (x *ListCommentVerdictsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentVerdictsResp.ProtoReflect.Descriptor instead.
+func (*ListCommentVerdictsResp) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListCommentVerdictsResp) GetVerdicts() []*CommentVerdict {
+	if x != nil {
+		return x.Verdicts
+	}
+	return nil
+}
+
+// Request to restore a blog from the trash
+type UndeleteReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the blog to restore
+	Id            *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeleteReq) Reset() {
+	*x = UndeleteReq{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeleteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteReq) ProtoMessage() {}
+
+func (x *UndeleteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteReq.ProtoReflect.Descriptor instead.
+func (*UndeleteReq) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UndeleteReq) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+// Request to list the blogs in the trash
+type ListDeletedReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of blogs to return
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token for pagination
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedReq) Reset() {
+	*x = ListDeletedReq{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedReq) ProtoMessage() {}
+
+func (x *ListDeletedReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedReq.ProtoReflect.Descriptor instead.
+func (*ListDeletedReq) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListDeletedReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeletedReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Response for listing the blogs in the trash
+type ListDeletedResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Blogs in the trash
+	Blogs []*BlogSummary `protobuf:"bytes,1,rep,name=blogs,proto3" json:"blogs,omitempty"`
+	// Token for retrieving the next page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedResp) Reset() {
+	*x = ListDeletedResp{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedResp) ProtoMessage() {}
+
+func (x *ListDeletedResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedResp.ProtoReflect.Descriptor instead.
+func (*ListDeletedResp) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListDeletedResp) GetBlogs() []*BlogSummary {
+	if x != nil {
+		return x.Blogs
+	}
+	return nil
+}
+
+func (x *ListDeletedResp) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Request to publish a blog
+type PublishReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the blog to publish
+	Id            *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishReq) Reset() {
+	*x = PublishReq{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishReq) ProtoMessage() {}
+
+func (x *PublishReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishReq.ProtoReflect.Descriptor instead.
+func (*PublishReq) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PublishReq) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+// Request to unpublish a blog
+type UnpublishReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the blog to unpublish
+	Id            *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishReq) Reset() {
+	*x = UnpublishReq{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishReq) ProtoMessage() {}
+
+func (x *UnpublishReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishReq.ProtoReflect.Descriptor instead.
+func (*UnpublishReq) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UnpublishReq) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+// Revision is a previous version of a blog, recorded when an update replaced
+// its title or content
+type Revision struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the blog the revision belongs to
+	BlogId *UUID `protobuf:"bytes,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	// Number of the revision, counting up from 1 for each blog
+	Number int32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	// Title of the blog before the update
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// Content of the blog before the update
+	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// Name of the person who made the update
+	Editor string `protobuf:"bytes,5,opt,name=editor,proto3" json:"editor,omitempty"`
+	// Time of the update that replaced this version
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Revision) Reset() {
+	*x = Revision{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Revision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Revision) ProtoMessage() {}
+
+func (x *Revision) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Revision.ProtoReflect.Descriptor instead.
+func (*Revision) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Revision) GetBlogId() *UUID {
+	if x != nil {
+		return x.BlogId
+	}
+	return nil
+}
+
+func (x *Revision) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Revision) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Revision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
 }
 
-// Deprecated: Use CreateResp.ProtoReflect.Descriptor instead.
-func (*CreateResp) Descriptor() ([]byte, []int) {
-	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{4}
+func (x *Revision) GetEditor() string {
+	if x != nil {
+		return x.Editor
+	}
+	return ""
 }
 
-func (x *CreateResp) GetId() *UUID {
+func (x *Revision) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.CreatedAt
 	}
 	return nil
 }
 
-// Request to get a blog by ID
-type GetReq struct {
+// Request to list the revisions of a blog, newest first
+type ListRevisionsReq struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID of the blog to retrieve
-	Id            *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the blog
+	Id *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Maximum number of revisions to return
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token for pagination
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReq) Reset() {
-	*x = GetReq{}
-	mi := &file_protos_blog_v1_blog_proto_msgTypes[5]
+func (x *ListRevisionsReq) Reset() {
+	*x = ListRevisionsReq{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReq) String() string {
+func (x *ListRevisionsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReq) ProtoMessage() {}
+func (*ListRevisionsReq) ProtoMessage() {}
 
-func (x *GetReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blog_v1_blog_proto_msgTypes[5]
+func (x *ListRevisionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -370,42 +3255,58 @@ func (x *GetReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReq.ProtoReflect.Descriptor instead.
-func (*GetReq) Descriptor() ([]byte, []int) {
-	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListRevisionsReq.ProtoReflect.Descriptor instead.
+func (*ListRevisionsReq) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetReq) GetId() *UUID {
+func (x *ListRevisionsReq) GetId() *UUID {
 	if x != nil {
 		return x.Id
 	}
 	return nil
 }
 
-// Response for getting a blog
-type GetResp struct {
+func (x *ListRevisionsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRevisionsReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Response for listing the revisions of a blog
+type ListRevisionsResp struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The retrieved blog
-	Blog          *Blog `protobuf:"bytes,1,opt,name=blog,proto3" json:"blog,omitempty"`
+	// Revisions of the blog, newest first
+	Revisions []*Revision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	// Token for retrieving the next page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetResp) Reset() {
-	*x = GetResp{}
-	mi := &file_protos_blog_v1_blog_proto_msgTypes[6]
+func (x *ListRevisionsResp) Reset() {
+	*x = ListRevisionsResp{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetResp) String() string {
+func (x *ListRevisionsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetResp) ProtoMessage() {}
+func (*ListRevisionsResp) ProtoMessage() {}
 
-func (x *GetResp) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blog_v1_blog_proto_msgTypes[6]
+func (x *ListRevisionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,46 +3317,51 @@ func (x *GetResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetResp.ProtoReflect.Descriptor instead.
-func (*GetResp) Descriptor() ([]byte, []int) {
-	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListRevisionsResp.ProtoReflect.Descriptor instead.
+func (*ListRevisionsResp) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetResp) GetBlog() *Blog {
+func (x *ListRevisionsResp) GetRevisions() []*Revision {
 	if x != nil {
-		return x.Blog
+		return x.Revisions
 	}
 	return nil
 }
 
-// Request to update a blog
-type UpdateReq struct {
+func (x *ListRevisionsResp) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Request to get a single revision of a blog
+type GetRevisionReq struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID of the blog to update
+	// ID of the blog
 	Id *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// New title for the blog (optional)
-	Title *string `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	// New content for the blog (optional)
-	Content       *string `protobuf:"bytes,3,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	// Number of the revision
+	Revision      int32 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateReq) Reset() {
-	*x = UpdateReq{}
-	mi := &file_protos_blog_v1_blog_proto_msgTypes[7]
+func (x *GetRevisionReq) Reset() {
+	*x = GetRevisionReq{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateReq) String() string {
+func (x *GetRevisionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateReq) ProtoMessage() {}
+func (*GetRevisionReq) ProtoMessage() {}
 
-func (x *UpdateReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blog_v1_blog_proto_msgTypes[7]
+func (x *GetRevisionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,56 +3372,49 @@ func (x *UpdateReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateReq.ProtoReflect.Descriptor instead.
-func (*UpdateReq) Descriptor() ([]byte, []int) {
-	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetRevisionReq.ProtoReflect.Descriptor instead.
+func (*GetRevisionReq) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *UpdateReq) GetId() *UUID {
+func (x *GetRevisionReq) GetId() *UUID {
 	if x != nil {
 		return x.Id
 	}
 	return nil
 }
 
-func (x *UpdateReq) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
-	}
-	return ""
-}
-
-func (x *UpdateReq) GetContent() string {
-	if x != nil && x.Content != nil {
-		return *x.Content
+func (x *GetRevisionReq) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
 	}
-	return ""
+	return 0
 }
 
-// Request to delete a blog
-type DeleteReq struct {
+// Response containing a revision
+type GetRevisionResp struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID of the blog to delete
-	Id            *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The requested revision
+	Revision      *Revision `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteReq) Reset() {
-	*x = DeleteReq{}
-	mi := &file_protos_blog_v1_blog_proto_msgTypes[8]
+func (x *GetRevisionResp) Reset() {
+	*x = GetRevisionResp{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteReq) String() string {
+func (x *GetRevisionResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteReq) ProtoMessage() {}
+func (*GetRevisionResp) ProtoMessage() {}
 
-func (x *DeleteReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blog_v1_blog_proto_msgTypes[8]
+func (x *GetRevisionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -526,44 +3425,44 @@ func (x *DeleteReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteReq.ProtoReflect.Descriptor instead.
-func (*DeleteReq) Descriptor() ([]byte, []int) {
-	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetRevisionResp.ProtoReflect.Descriptor instead.
+func (*GetRevisionResp) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *DeleteReq) GetId() *UUID {
+func (x *GetRevisionResp) GetRevision() *Revision {
 	if x != nil {
-		return x.Id
+		return x.Revision
 	}
 	return nil
 }
 
-// Request to list blogs with pagination
-type ListReq struct {
+// DiffChunk is a run of text with the same change
+type DiffChunk struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Maximum number of blogs to return
-	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Token for pagination
-	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Kind of change
+	Op DiffOp `protobuf:"varint,1,opt,name=op,proto3,enum=blog.v1.DiffOp" json:"op,omitempty"`
+	// Text of the chunk, including line breaks
+	Text          string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListReq) Reset() {
-	*x = ListReq{}
-	mi := &file_protos_blog_v1_blog_proto_msgTypes[9]
+func (x *DiffChunk) Reset() {
+	*x = DiffChunk{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListReq) String() string {
+func (x *DiffChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReq) ProtoMessage() {}
+func (*DiffChunk) ProtoMessage() {}
 
-func (x *ListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blog_v1_blog_proto_msgTypes[9]
+func (x *DiffChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -574,51 +3473,55 @@ func (x *ListReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReq.ProtoReflect.Descriptor instead.
-func (*ListReq) Descriptor() ([]byte, []int) {
-	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use DiffChunk.ProtoReflect.Descriptor instead.
+func (*DiffChunk) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ListReq) GetPageSize() int32 {
+func (x *DiffChunk) GetOp() DiffOp {
 	if x != nil {
-		return x.PageSize
+		return x.Op
 	}
-	return 0
+	return DiffOp_DIFF_OP_UNSPECIFIED
 }
 
-func (x *ListReq) GetPageToken() string {
+func (x *DiffChunk) GetText() string {
 	if x != nil {
-		return x.PageToken
+		return x.Text
 	}
 	return ""
 }
 
-// Response for listing blogs with their titles and comment counts
-type ListResp struct {
+// Request to compare two versions of a blog
+type DiffRevisionsReq struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of blog entries with title and comment count
-	Blogs []*BlogSummary `protobuf:"bytes,1,rep,name=blogs,proto3" json:"blogs,omitempty"`
-	// Token for retrieving the next page
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// ID of the blog
+	Id *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Number of the older revision
+	FromRevision int32 `protobuf:"varint,2,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	// Number of the newer revision, defaults to the current version of the blog
+	ToRevision int32 `protobuf:"varint,3,opt,name=to_revision,json=toRevision,proto3" json:"to_revision,omitempty"`
+	// Unit to compare text in, defaults to line
+	Mode          DiffMode `protobuf:"varint,4,opt,name=mode,proto3,enum=blog.v1.DiffMode" json:"mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListResp) Reset() {
-	*x = ListResp{}
-	mi := &file_protos_blog_v1_blog_proto_msgTypes[10]
+func (x *DiffRevisionsReq) Reset() {
+	*x = DiffRevisionsReq{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListResp) String() string {
+func (x *DiffRevisionsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListResp) ProtoMessage() {}
+func (*DiffRevisionsReq) ProtoMessage() {}
 
-func (x *ListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blog_v1_blog_proto_msgTypes[10]
+func (x *DiffRevisionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,53 +3532,67 @@ func (x *ListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListResp.ProtoReflect.Descriptor instead.
-func (*ListResp) Descriptor() ([]byte, []int) {
-	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use DiffRevisionsReq.ProtoReflect.Descriptor instead.
+func (*DiffRevisionsReq) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ListResp) GetBlogs() []*BlogSummary {
+func (x *DiffRevisionsReq) GetId() *UUID {
 	if x != nil {
-		return x.Blogs
+		return x.Id
 	}
 	return nil
 }
 
-func (x *ListResp) GetNextPageToken() string {
+func (x *DiffRevisionsReq) GetFromRevision() int32 {
 	if x != nil {
-		return x.NextPageToken
+		return x.FromRevision
 	}
-	return ""
+	return 0
 }
 
-// Summary of a blog containing title and comment count
-type BlogSummary struct {
+func (x *DiffRevisionsReq) GetToRevision() int32 {
+	if x != nil {
+		return x.ToRevision
+	}
+	return 0
+}
+
+func (x *DiffRevisionsReq) GetMode() DiffMode {
+	if x != nil {
+		return x.Mode
+	}
+	return DiffMode_DIFF_MODE_UNSPECIFIED
+}
+
+// Response describing the changes between two versions of a blog. Joining
+// the equal and delete chunks gives the older text, and joining the equal and
+// insert chunks gives the newer text.
+type DiffRevisionsResp struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID of the blog
-	Id *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Title of the blog
-	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	// Number of comments on the blog
-	CommentCount  int32 `protobuf:"varint,3,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	// Changes to the title
+	Title []*DiffChunk `protobuf:"bytes,1,rep,name=title,proto3" json:"title,omitempty"`
+	// Changes to the content
+	Content       []*DiffChunk `protobuf:"bytes,2,rep,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BlogSummary) Reset() {
-	*x = BlogSummary{}
-	mi := &file_protos_blog_v1_blog_proto_msgTypes[11]
+func (x *DiffRevisionsResp) Reset() {
+	*x = DiffRevisionsResp{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BlogSummary) String() string {
+func (x *DiffRevisionsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlogSummary) ProtoMessage() {}
+func (*DiffRevisionsResp) ProtoMessage() {}
 
-func (x *BlogSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blog_v1_blog_proto_msgTypes[11]
+func (x *DiffRevisionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -686,60 +3603,54 @@ func (x *BlogSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlogSummary.ProtoReflect.Descriptor instead.
-func (*BlogSummary) Descriptor() ([]byte, []int) {
-	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *BlogSummary) GetId() *UUID {
-	if x != nil {
-		return x.Id
-	}
-	return nil
+// Deprecated: Use DiffRevisionsResp.ProtoReflect.Descriptor instead.
+func (*DiffRevisionsResp) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *BlogSummary) GetTitle() string {
+func (x *DiffRevisionsResp) GetTitle() []*DiffChunk {
 	if x != nil {
 		return x.Title
 	}
-	return ""
+	return nil
 }
 
-func (x *BlogSummary) GetCommentCount() int32 {
+func (x *DiffRevisionsResp) GetContent() []*DiffChunk {
 	if x != nil {
-		return x.CommentCount
+		return x.Content
 	}
-	return 0
+	return nil
 }
 
-// Request to add a comment to a blog
-type AddCommentReq struct {
+// Request to restore a blog to a previous revision
+type RestoreRevisionReq struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID of the blog to comment on
+	// ID of the blog
 	Id *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Content of the comment
-	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	// Author of the comment
-	Author        string `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	// Number of the revision to restore
+	Revision int32 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Name of the person restoring the revision, recorded in the revision history
+	// unless the caller is authenticated, whose subject is recorded instead
+	Editor        string `protobuf:"bytes,3,opt,name=editor,proto3" json:"editor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddCommentReq) Reset() {
-	*x = AddCommentReq{}
-	mi := &file_protos_blog_v1_blog_proto_msgTypes[12]
+func (x *RestoreRevisionReq) Reset() {
+	*x = RestoreRevisionReq{}
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddCommentReq) String() string {
+func (x *RestoreRevisionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCommentReq) ProtoMessage() {}
+func (*RestoreRevisionReq) ProtoMessage() {}
 
-func (x *AddCommentReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blog_v1_blog_proto_msgTypes[12]
+func (x *RestoreRevisionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blog_v1_blog_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -750,28 +3661,28 @@ func (x *AddCommentReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCommentReq.ProtoReflect.Descriptor instead.
-func (*AddCommentReq) Descriptor() ([]byte, []int) {
-	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use RestoreRevisionReq.ProtoReflect.Descriptor instead.
+func (*RestoreRevisionReq) Descriptor() ([]byte, []int) {
+	return file_protos_blog_v1_blog_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *AddCommentReq) GetId() *UUID {
+func (x *RestoreRevisionReq) GetId() *UUID {
 	if x != nil {
 		return x.Id
 	}
 	return nil
 }
 
-func (x *AddCommentReq) GetContent() string {
+func (x *RestoreRevisionReq) GetRevision() int32 {
 	if x != nil {
-		return x.Content
+		return x.Revision
 	}
-	return ""
+	return 0
 }
 
-func (x *AddCommentReq) GetAuthor() string {
+func (x *RestoreRevisionReq) GetEditor() string {
 	if x != nil {
-		return x.Author
+		return x.Editor
 	}
 	return ""
 }
@@ -780,9 +3691,9 @@ var File_protos_blog_v1_blog_proto protoreflect.FileDescriptor
 
 const file_protos_blog_v1_blog_proto_rawDesc = "" +
 	"\n" +
-	"\x19protos/blog/v1/blog.proto\x12\ablog.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\"c\n" +
+	"\x19protos/blog/v1/blog.proto\x12\ablog.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\"c\n" +
 	"\x04UUID\x12[\n" +
-	"\x05value\x18\x01 \x01(\tBE\xbaHBr@2>^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$R\x05value\"\xa6\x02\n" +
+	"\x05value\x18\x01 \x01(\tBE\xbaHBr@2>^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$R\x05value\"\xf4\x05\n" +
 	"\x04Blog\x12\x1d\n" +
 	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDR\x02id\x125\n" +
 	"\x05title\x18\x02 \x01(\tB\x1f\xbaH\x1cr\x1a\x10\x01\x18d2\x14^[\\w\\s\\-\\.,:;!?()]+$R\x05title\x12$\n" +
@@ -792,59 +3703,324 @@ const file_protos_blog_v1_blog_proto_rawDesc = "" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12,\n" +
-	"\bcomments\x18\x06 \x03(\v2\x10.blog.v1.CommentR\bcomments\"\xac\x01\n" +
+	"\bcomments\x18\x06 \x03(\v2\x10.blog.v1.CommentR\bcomments\x12+\n" +
+	"\x06status\x18\a \x01(\x0e2\x13.blog.v1.BlogStatusR\x06status\x12=\n" +
+	"\fpublished_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\x129\n" +
+	"\n" +
+	"publish_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12\x12\n" +
+	"\x04etag\x18\n" +
+	" \x01(\tR\x04etag\x129\n" +
+	"\n" +
+	"deleted_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x12\n" +
+	"\x04tags\x18\f \x03(\tR\x04tags\x12\x12\n" +
+	"\x04slug\x18\r \x01(\tR\x04slug\x12#\n" +
+	"\rcomment_count\x18\x0e \x01(\x05R\fcommentCount\x12=\n" +
+	"\x0ecomment_policy\x18\x0f \x01(\x0e2\x16.blog.v1.CommentPolicyR\rcommentPolicy\x12\x14\n" +
+	"\x05owner\x18\x10 \x01(\tR\x05owner\x124\n" +
+	"\x0eauthor_profile\x18\x11 \x01(\v2\r.blog.v1.UserR\rauthorProfile\"\xca\x04\n" +
 	"\aComment\x12\x1d\n" +
 	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDR\x02id\x12$\n" +
 	"\acontent\x18\x02 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xe8\aR\acontent\x12!\n" +
-	"\x06author\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\x06author\x129\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xe8\aR\acontent\x12\x1f\n" +
+	"\x06author\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x182R\x06author\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12*\n" +
+	"\tparent_id\x18\x05 \x01(\v2\r.blog.v1.UUIDR\bparentId\x12\x14\n" +
+	"\x05depth\x18\x06 \x01(\x05R\x05depth\x12*\n" +
+	"\areplies\x18\a \x03(\v2\x10.blog.v1.CommentR\areplies\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12&\n" +
+	"\ablog_id\x18\t \x01(\v2\r.blog.v1.UUIDR\x06blogId\x12+\n" +
+	"\x05state\x18\n" +
+	" \x01(\x0e2\x15.blog.v1.CommentStateR\x05state\x12+\n" +
+	"\x11moderation_reason\x18\v \x01(\tR\x10moderationReason\x12=\n" +
+	"\fmoderated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vmoderatedAt\x124\n" +
+	"\x0eauthor_profile\x18\r \x01(\v2\r.blog.v1.UserR\rauthorProfile\"\xef\x01\n" +
+	"\x04User\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDR\x02id\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x10\n" +
+	"\x03bio\x18\x03 \x01(\tR\x03bio\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"h\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xee\x03\n" +
 	"\tCreateReq\x125\n" +
 	"\x05title\x18\x01 \x01(\tB\x1f\xbaH\x1cr\x1a\x10\x01\x18d2\x14^[\\w\\s\\-\\.,:;!?()]+$R\x05title\x12$\n" +
 	"\acontent\x18\x02 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\x90NR\acontent\"+\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x90NR\acontent\x125\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x13.blog.v1.BlogStatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06status\x129\n" +
+	"\n" +
+	"publish_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12>\n" +
+	"\x04tags\x18\x05 \x03(\tB*\xbaH'\x92\x01$\x10\n" +
+	"\x18\x01\"\x1er\x1c\x1822\x18^[a-z0-9]+(-[a-z0-9]+)*$R\x04tags\x12*\n" +
+	"\tauthor_id\x18\x06 \x01(\v2\r.blog.v1.UUIDR\bauthorId:\xa5\x01\xbaH\xa1\x01\x1a\x9e\x01\n" +
+	"\x15create_req.publish_at\x12Dpublish_at is required for scheduled blogs and only allowed for them\x1a?has(this.publish_at) ? this.status in [0, 2] : this.status != 2\"+\n" +
 	"\n" +
 	"CreateResp\x12\x1d\n" +
-	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDR\x02id\"'\n" +
-	"\x06GetReq\x12\x1d\n" +
-	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDR\x02id\",\n" +
+	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDR\x02id\"\xfd\x01\n" +
+	"\x06GetReq\x12%\n" +
+	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDB\x06\xbaH\x03\xc8\x01\x01R\x02id\x127\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\x12!\n" +
+	"\fshow_deleted\x18\x03 \x01(\bR\vshowDeleted\x12A\n" +
+	"\fcomment_view\x18\x04 \x01(\x0e2\x14.blog.v1.CommentViewB\b\xbaH\x05\x82\x01\x02\x10\x01R\vcommentView\x12-\n" +
+	"\fmax_comments\x18\x05 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xe8\a(\x00R\vmaxComments\",\n" +
 	"\aGetResp\x12!\n" +
-	"\x04blog\x18\x01 \x01(\v2\r.blog.v1.BlogR\x04blog\"\xa7\x01\n" +
-	"\tUpdateReq\x12\x1d\n" +
-	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDR\x02id\x12:\n" +
+	"\x04blog\x18\x01 \x01(\v2\r.blog.v1.BlogR\x04blog\"\x95\x02\n" +
+	"\fGetBySlugReq\x127\n" +
+	"\x04slug\x18\x01 \x01(\tB#\xbaH r\x1e\x10\x01\x18d2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\x04slug\x127\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\x12!\n" +
+	"\fshow_deleted\x18\x03 \x01(\bR\vshowDeleted\x12A\n" +
+	"\fcomment_view\x18\x04 \x01(\x0e2\x14.blog.v1.CommentViewB\b\xbaH\x05\x82\x01\x02\x10\x01R\vcommentView\x12-\n" +
+	"\fmax_comments\x18\x05 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xe8\a(\x00R\vmaxComments\"W\n" +
+	"\rGetBySlugResp\x12!\n" +
+	"\x04blog\x18\x01 \x01(\v2\r.blog.v1.BlogR\x04blog\x12#\n" +
+	"\rredirect_slug\x18\x02 \x01(\tR\fredirectSlug\"\xa4\b\n" +
+	"\tUpdateReq\x12%\n" +
+	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDB\x06\xbaH\x03\xc8\x01\x01R\x02id\x12:\n" +
 	"\x05title\x18\x02 \x01(\tB\x1f\xbaH\x1cr\x1a\x10\x01\x18d2\x14^[\\w\\s\\-\\.,:;!?()]+$H\x00R\x05title\x88\x01\x01\x12)\n" +
 	"\acontent\x18\x03 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\x90NH\x01R\acontent\x88\x01\x01B\b\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x90NH\x01R\acontent\x88\x01\x01\x12<\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x13.blog.v1.BlogStatusB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00H\x02R\x06status\x88\x01\x01\x129\n" +
+	"\n" +
+	"publish_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12\x1f\n" +
+	"\x06editor\x18\x06 \x01(\tB\a\xbaH\x04r\x02\x182R\x06editor\x120\n" +
+	"\x04etag\x18\a \x01(\tB\x1c\xbaH\x19r\x172\x15^([1-9][0-9]{0,17})?$R\x04etag\x12\xa2\x02\n" +
+	"\vupdate_mask\x18\b \x01(\v2\x1a.google.protobuf.FieldMaskB\xe4\x01\xbaH\xe0\x01\xba\x01\xdc\x01\n" +
+	"\x16update_req.update_mask\x12Zupdate_mask paths must be title, content, status, publish_at, tags, slug or comment_policy\x1afthis.paths.all(p, p in ['title', 'content', 'status', 'publish_at', 'tags', 'slug', 'comment_policy'])R\n" +
+	"updateMask\x12>\n" +
+	"\x04tags\x18\t \x03(\tB*\xbaH'\x92\x01$\x10\n" +
+	"\x18\x01\"\x1er\x1c\x1822\x18^[a-z0-9]+(-[a-z0-9]+)*$R\x04tags\x12<\n" +
+	"\x04slug\x18\n" +
+	" \x01(\tB#\xbaH r\x1e\x10\x01\x18d2\x18^[a-z0-9]+(-[a-z0-9]+)*$H\x03R\x04slug\x88\x01\x01\x12L\n" +
+	"\x0ecomment_policy\x18\v \x01(\x0e2\x16.blog.v1.CommentPolicyB\b\xbaH\x05\x82\x01\x02\x10\x01H\x04R\rcommentPolicy\x88\x01\x01:\x8e\x01\xbaH\x8a\x01\x1a\x87\x01\n" +
+	"\x15update_req.publish_at\x12.publish_at is only allowed for scheduled blogs\x1a>!has(this.publish_at) || !has(this.status) || this.status == 2B\b\n" +
 	"\x06_titleB\n" +
 	"\n" +
-	"\b_content\"*\n" +
-	"\tDeleteReq\x12\x1d\n" +
-	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDR\x02id\"P\n" +
-	"\aListReq\x12&\n" +
-	"\tpage_size\x18\x01 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d \x00R\bpageSize\x12\x1d\n" +
+	"\b_contentB\t\n" +
+	"\a_statusB\a\n" +
+	"\x05_slugB\x11\n" +
+	"\x0f_comment_policy\"d\n" +
+	"\tDeleteReq\x12%\n" +
+	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDB\x06\xbaH\x03\xc8\x01\x01R\x02id\x120\n" +
+	"\x04etag\x18\x02 \x01(\tB\x1c\xbaH\x19r\x172\x15^([1-9][0-9]{0,17})?$R\x04etag\"\xc8\x01\n" +
+	"\aListReq\x12)\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\f\xbaH\t\xd8\x01\x01\x1a\x04\x18d \x00R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"^\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x125\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x13.blog.v1.BlogStatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06status\x12!\n" +
+	"\fshow_deleted\x18\x04 \x01(\bR\vshowDeleted\x12\x19\n" +
+	"\x03tag\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x182R\x03tag\"^\n" +
 	"\bListResp\x12*\n" +
 	"\x05blogs\x18\x01 \x03(\v2\x14.blog.v1.BlogSummaryR\x05blogs\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"g\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xe3\x01\n" +
 	"\vBlogSummary\x12\x1d\n" +
 	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12#\n" +
-	"\rcomment_count\x18\x03 \x01(\x05R\fcommentCount\"w\n" +
-	"\rAddCommentReq\x12\x1d\n" +
-	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDR\x02id\x12$\n" +
+	"\rcomment_count\x18\x03 \x01(\x05R\fcommentCount\x12+\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x13.blog.v1.BlogStatusR\x06status\x129\n" +
+	"\n" +
+	"deleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x12\n" +
+	"\x04slug\x18\x06 \x01(\tR\x04slug\"D\n" +
+	"\vListTagsReq\x125\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x13.blog.v1.BlogStatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06status\"=\n" +
+	"\bTagCount\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"post_count\x18\x02 \x01(\x05R\tpostCount\"5\n" +
+	"\fListTagsResp\x12%\n" +
+	"\x04tags\x18\x01 \x03(\v2\x11.blog.v1.TagCountR\x04tags\"\x9a\x01\n" +
+	"\tSearchReq\x12\x18\n" +
+	"\x01q\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01R\x01q\x12)\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\f\xbaH\t\xd8\x01\x01\x1a\x04\x18d \x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12)\n" +
+	"\x10include_comments\x18\x04 \x01(\bR\x0fincludeComments\"f\n" +
+	"\fSearchResult\x12(\n" +
+	"\x04blog\x18\x01 \x01(\v2\x14.blog.v1.BlogSummaryR\x04blog\x12\x18\n" +
+	"\asnippet\x18\x02 \x01(\tR\asnippet\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\x02R\x04rank\"e\n" +
+	"\n" +
+	"SearchResp\x12/\n" +
+	"\aresults\x18\x01 \x03(\v2\x15.blog.v1.SearchResultR\aresults\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xbf\x02\n" +
+	"\rAddCommentReq\x12%\n" +
+	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDB\x06\xbaH\x03\xc8\x01\x01R\x02id\x12$\n" +
 	"\acontent\x18\x02 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xe8\aR\acontent\x12!\n" +
-	"\x06author\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\x06author2\xea\x03\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xe8\aR\acontent\x12\x1f\n" +
+	"\x06author\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x182R\x06author\x12*\n" +
+	"\tparent_id\x18\x04 \x01(\v2\r.blog.v1.UUIDR\bparentId\x12*\n" +
+	"\tauthor_id\x18\x05 \x01(\v2\r.blog.v1.UUIDR\bauthorId:h\xbaHe\x1ac\n" +
+	"\x16add_comment_req.author\x12\x1fauthor or author_id is required\x1a(this.author != '' || has(this.author_id)\"<\n" +
+	"\x0eAddCommentResp\x12*\n" +
+	"\acomment\x18\x01 \x01(\v2\x10.blog.v1.CommentR\acomment\"l\n" +
+	"\rGetCommentReq\x12%\n" +
+	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDB\x06\xbaH\x03\xc8\x01\x01R\x02id\x124\n" +
+	"\n" +
+	"comment_id\x18\x02 \x01(\v2\r.blog.v1.UUIDB\x06\xbaH\x03\xc8\x01\x01R\tcommentId\"<\n" +
+	"\x0eGetCommentResp\x12*\n" +
+	"\acomment\x18\x01 \x01(\v2\x10.blog.v1.CommentR\acomment\"\x95\x01\n" +
+	"\x10UpdateCommentReq\x12%\n" +
+	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDB\x06\xbaH\x03\xc8\x01\x01R\x02id\x124\n" +
+	"\n" +
+	"comment_id\x18\x02 \x01(\v2\r.blog.v1.UUIDB\x06\xbaH\x03\xc8\x01\x01R\tcommentId\x12$\n" +
+	"\acontent\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xe8\aR\acontent\"o\n" +
+	"\x10DeleteCommentReq\x12%\n" +
+	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDB\x06\xbaH\x03\xc8\x01\x01R\x02id\x124\n" +
+	"\n" +
+	"comment_id\x18\x02 \x01(\v2\r.blog.v1.UUIDB\x06\xbaH\x03\xc8\x01\x01R\tcommentId\"\x82\x01\n" +
+	"\x0fListCommentsReq\x12%\n" +
+	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDB\x06\xbaH\x03\xc8\x01\x01R\x02id\x12)\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\f\xbaH\t\xd8\x01\x01\x1a\x04\x18d \x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"h\n" +
+	"\x10ListCommentsResp\x12,\n" +
+	"\bcomments\x18\x01 \x03(\v2\x10.blog.v1.CommentR\bcomments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x81\x01\n" +
+	"\x16ListPendingCommentsReq\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDR\x02id\x12)\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\f\xbaH\t\xd8\x01\x01\x1a\x04\x18d \x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"o\n" +
+	"\x17ListPendingCommentsResp\x12,\n" +
+	"\bcomments\x18\x01 \x03(\v2\x10.blog.v1.CommentR\bcomments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xce\x01\n" +
+	"\x12ModerateCommentReq\x12%\n" +
+	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDB\x06\xbaH\x03\xc8\x01\x01R\x02id\x124\n" +
+	"\n" +
+	"comment_id\x18\x02 \x01(\v2\r.blog.v1.UUIDB\x06\xbaH\x03\xc8\x01\x01R\tcommentId\x129\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x15.blog.v1.CommentStateB\f\xbaH\t\x82\x01\x06\x18\x02\x18\x03\x18\x04R\x05state\x12 \n" +
+	"\x06reason\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\x06reason\"\xc6\x01\n" +
+	"\x0eCommentVerdict\x12\x1e\n" +
+	"\n" +
+	"classifier\x18\x01 \x01(\tR\n" +
+	"classifier\x12+\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x15.blog.v1.CommentStateR\x05state\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x01R\x05score\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"u\n" +
+	"\x16ListCommentVerdictsReq\x12%\n" +
+	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDB\x06\xbaH\x03\xc8\x01\x01R\x02id\x124\n" +
+	"\n" +
+	"comment_id\x18\x02 \x01(\v2\r.blog.v1.UUIDB\x06\xbaH\x03\xc8\x01\x01R\tcommentId\"N\n" +
+	"\x17ListCommentVerdictsResp\x123\n" +
+	"\bverdicts\x18\x01 \x03(\v2\x17.blog.v1.CommentVerdictR\bverdicts\"4\n" +
+	"\vUndeleteReq\x12%\n" +
+	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDB\x06\xbaH\x03\xc8\x01\x01R\x02id\"Z\n" +
+	"\x0eListDeletedReq\x12)\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\f\xbaH\t\xd8\x01\x01\x1a\x04\x18d \x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"e\n" +
+	"\x0fListDeletedResp\x12*\n" +
+	"\x05blogs\x18\x01 \x03(\v2\x14.blog.v1.BlogSummaryR\x05blogs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"3\n" +
+	"\n" +
+	"PublishReq\x12%\n" +
+	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDB\x06\xbaH\x03\xc8\x01\x01R\x02id\"5\n" +
+	"\fUnpublishReq\x12%\n" +
+	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDB\x06\xbaH\x03\xc8\x01\x01R\x02id\"\xcd\x01\n" +
+	"\bRevision\x12&\n" +
+	"\ablog_id\x18\x01 \x01(\v2\r.blog.v1.UUIDR\x06blogId\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\x05R\x06number\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x16\n" +
+	"\x06editor\x18\x05 \x01(\tR\x06editor\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x83\x01\n" +
+	"\x10ListRevisionsReq\x12%\n" +
+	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDB\x06\xbaH\x03\xc8\x01\x01R\x02id\x12)\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\f\xbaH\t\xd8\x01\x01\x1a\x04\x18d \x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"l\n" +
+	"\x11ListRevisionsResp\x12/\n" +
+	"\trevisions\x18\x01 \x03(\v2\x11.blog.v1.RevisionR\trevisions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\\\n" +
+	"\x0eGetRevisionReq\x12%\n" +
+	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDB\x06\xbaH\x03\xc8\x01\x01R\x02id\x12#\n" +
+	"\brevision\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\brevision\"@\n" +
+	"\x0fGetRevisionResp\x12-\n" +
+	"\brevision\x18\x01 \x01(\v2\x11.blog.v1.RevisionR\brevision\"@\n" +
+	"\tDiffChunk\x12\x1f\n" +
+	"\x02op\x18\x01 \x01(\x0e2\x0f.blog.v1.DiffOpR\x02op\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"\xc2\x01\n" +
+	"\x10DiffRevisionsReq\x12%\n" +
+	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDB\x06\xbaH\x03\xc8\x01\x01R\x02id\x12,\n" +
+	"\rfrom_revision\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\ffromRevision\x12(\n" +
+	"\vto_revision\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\n" +
+	"toRevision\x12/\n" +
+	"\x04mode\x18\x04 \x01(\x0e2\x11.blog.v1.DiffModeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04mode\"k\n" +
+	"\x11DiffRevisionsResp\x12(\n" +
+	"\x05title\x18\x01 \x03(\v2\x12.blog.v1.DiffChunkR\x05title\x12,\n" +
+	"\acontent\x18\x02 \x03(\v2\x12.blog.v1.DiffChunkR\acontent\"\x81\x01\n" +
+	"\x12RestoreRevisionReq\x12%\n" +
+	"\x02id\x18\x01 \x01(\v2\r.blog.v1.UUIDB\x06\xbaH\x03\xc8\x01\x01R\x02id\x12#\n" +
+	"\brevision\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\brevision\x12\x1f\n" +
+	"\x06editor\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x182R\x06editor*\x90\x01\n" +
+	"\n" +
+	"BlogStatus\x12\x1b\n" +
+	"\x17BLOG_STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11BLOG_STATUS_DRAFT\x10\x01\x12\x19\n" +
+	"\x15BLOG_STATUS_SCHEDULED\x10\x02\x12\x19\n" +
+	"\x15BLOG_STATUS_PUBLISHED\x10\x03\x12\x18\n" +
+	"\x14BLOG_STATUS_ARCHIVED\x10\x04*\x98\x01\n" +
+	"\fCommentState\x12\x1d\n" +
+	"\x19COMMENT_STATE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15COMMENT_STATE_PENDING\x10\x01\x12\x1a\n" +
+	"\x16COMMENT_STATE_APPROVED\x10\x02\x12\x1a\n" +
+	"\x16COMMENT_STATE_REJECTED\x10\x03\x12\x16\n" +
+	"\x12COMMENT_STATE_SPAM\x10\x04*f\n" +
+	"\rCommentPolicy\x12\x1e\n" +
+	"\x1aCOMMENT_POLICY_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13COMMENT_POLICY_OPEN\x10\x01\x12\x1c\n" +
+	"\x18COMMENT_POLICY_MODERATED\x10\x02*Y\n" +
+	"\vCommentView\x12\x1c\n" +
+	"\x18COMMENT_VIEW_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11COMMENT_VIEW_FLAT\x10\x01\x12\x15\n" +
+	"\x11COMMENT_VIEW_TREE\x10\x02*M\n" +
+	"\bDiffMode\x12\x19\n" +
+	"\x15DIFF_MODE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eDIFF_MODE_LINE\x10\x01\x12\x12\n" +
+	"\x0eDIFF_MODE_WORD\x10\x02*\\\n" +
+	"\x06DiffOp\x12\x17\n" +
+	"\x13DIFF_OP_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rDIFF_OP_EQUAL\x10\x01\x12\x12\n" +
+	"\x0eDIFF_OP_INSERT\x10\x02\x12\x12\n" +
+	"\x0eDIFF_OP_DELETE\x10\x032\xaf\x14\n" +
 	"\x05Blogs\x12G\n" +
 	"\x06Create\x12\x12.blog.v1.CreateReq\x1a\x13.blog.v1.CreateResp\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/posts\x12F\n" +
-	"\x03Get\x12\x0f.blog.v1.GetReq\x1a\x10.blog.v1.GetResp\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/posts/{id.value}\x12U\n" +
+	"\x03Get\x12\x0f.blog.v1.GetReq\x1a\x10.blog.v1.GetResp\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/posts/{id.value}\x12\\\n" +
+	"\tGetBySlug\x12\x15.blog.v1.GetBySlugReq\x1a\x16.blog.v1.GetBySlugResp\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/posts/by-slug/{slug}\x12U\n" +
 	"\x06Update\x12\x12.blog.v1.UpdateReq\x1a\x16.google.protobuf.Empty\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*2\x14/v1/posts/{id.value}\x12R\n" +
 	"\x06Delete\x12\x12.blog.v1.DeleteReq\x1a\x16.google.protobuf.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/v1/posts/{id.value}\x12>\n" +
-	"\x04List\x12\x10.blog.v1.ListReq\x1a\x11.blog.v1.ListResp\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/posts\x12e\n" +
+	"\x04List\x12\x10.blog.v1.ListReq\x1a\x11.blog.v1.ListResp\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/posts\x12I\n" +
+	"\bListTags\x12\x14.blog.v1.ListTagsReq\x1a\x15.blog.v1.ListTagsResp\"\x10\x82\xd3\xe4\x93\x02\n" +
+	"\x12\b/v1/tags\x12K\n" +
+	"\x06Search\x12\x12.blog.v1.SearchReq\x1a\x13.blog.v1.SearchResp\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/posts:search\x12b\n" +
+	"\bUndelete\x12\x14.blog.v1.UndeleteReq\x1a\x16.google.protobuf.Empty\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/posts/{id.value}:undelete\x12_\n" +
+	"\vListDeleted\x12\x17.blog.v1.ListDeletedReq\x1a\x18.blog.v1.ListDeletedResp\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/posts:listDeleted\x12\x8a\x01\n" +
+	"\n" +
+	"AddComment\x12\x16.blog.v1.AddCommentReq\x1a\x17.blog.v1.AddCommentResp\"K\x82\xd3\xe4\x93\x02E:\x01*Z!:\x01*\"\x1c/v1/posts/{id.value}/comment\"\x1d/v1/posts/{id.value}/comments\x12w\n" +
 	"\n" +
-	"AddComment\x12\x16.blog.v1.AddCommentReq\x1a\x16.google.protobuf.Empty\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/posts/{id.value}/commentB/Z-github.com/agruetz/prosigliere/protos/v1/blogb\x06proto3"
+	"GetComment\x12\x16.blog.v1.GetCommentReq\x1a\x17.blog.v1.GetCommentResp\"8\x82\xd3\xe4\x93\x022\x120/v1/posts/{id.value}/comments/{comment_id.value}\x12\x7f\n" +
+	"\rUpdateComment\x12\x19.blog.v1.UpdateCommentReq\x1a\x16.google.protobuf.Empty\";\x82\xd3\xe4\x93\x025:\x01*20/v1/posts/{id.value}/comments/{comment_id.value}\x12|\n" +
+	"\rDeleteComment\x12\x19.blog.v1.DeleteCommentReq\x1a\x16.google.protobuf.Empty\"8\x82\xd3\xe4\x93\x022*0/v1/posts/{id.value}/comments/{comment_id.value}\x12j\n" +
+	"\fListComments\x12\x18.blog.v1.ListCommentsReq\x1a\x19.blog.v1.ListCommentsResp\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/posts/{id.value}/comments\x12\xa7\x01\n" +
+	"\x13ListPendingComments\x12\x1f.blog.v1.ListPendingCommentsReq\x1a .blog.v1.ListPendingCommentsResp\"M\x82\xd3\xe4\x93\x02GZ+\x12)/v1/posts/{id.value}/comments:listPending\x12\x18/v1/comments:listPending\x12\x8c\x01\n" +
+	"\x0fModerateComment\x12\x1b.blog.v1.ModerateCommentReq\x1a\x16.google.protobuf.Empty\"D\x82\xd3\xe4\x93\x02>:\x01*\"9/v1/posts/{id.value}/comments/{comment_id.value}:moderate\x12\x9b\x01\n" +
+	"\x13ListCommentVerdicts\x12\x1f.blog.v1.ListCommentVerdictsReq\x1a .blog.v1.ListCommentVerdictsResp\"A\x82\xd3\xe4\x93\x02;\x129/v1/posts/{id.value}/comments/{comment_id.value}/verdicts\x12_\n" +
+	"\aPublish\x12\x13.blog.v1.PublishReq\x1a\x16.google.protobuf.Empty\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/posts/{id.value}:publish\x12e\n" +
+	"\tUnpublish\x12\x15.blog.v1.UnpublishReq\x1a\x16.google.protobuf.Empty\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/posts/{id.value}:unpublish\x12n\n" +
+	"\rListRevisions\x12\x19.blog.v1.ListRevisionsReq\x1a\x1a.blog.v1.ListRevisionsResp\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/posts/{id.value}/revisions\x12s\n" +
+	"\vGetRevision\x12\x17.blog.v1.GetRevisionReq\x1a\x18.blog.v1.GetRevisionResp\"1\x82\xd3\xe4\x93\x02+\x12)/v1/posts/{id.value}/revisions/{revision}\x12s\n" +
+	"\rDiffRevisions\x12\x19.blog.v1.DiffRevisionsReq\x1a\x1a.blog.v1.DiffRevisionsResp\"+\x82\xd3\xe4\x93\x02%\x12#/v1/posts/{id.value}/revisions:diff\x12\x84\x01\n" +
+	"\x0fRestoreRevision\x12\x1b.blog.v1.RestoreRevisionReq\x1a\x16.google.protobuf.Empty\"<\x82\xd3\xe4\x93\x026:\x01*\"1/v1/posts/{id.value}/revisions/{revision}:restoreB/Z-github.com/agruetz/prosigliere/protos/v1/blogb\x06proto3"
 
 var (
 	file_protos_blog_v1_blog_proto_rawDescOnce sync.Once
@@ -858,56 +4034,209 @@ func file_protos_blog_v1_blog_proto_rawDescGZIP() []byte {
 	return file_protos_blog_v1_blog_proto_rawDescData
 }
 
-var file_protos_blog_v1_blog_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_protos_blog_v1_blog_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_protos_blog_v1_blog_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
 var file_protos_blog_v1_blog_proto_goTypes = []any{
-	(*UUID)(nil),                  // 0: blog.v1.UUID
-	(*Blog)(nil),                  // 1: blog.v1.Blog
-	(*Comment)(nil),               // 2: blog.v1.Comment
-	(*CreateReq)(nil),             // 3: blog.v1.CreateReq
-	(*CreateResp)(nil),            // 4: blog.v1.CreateResp
-	(*GetReq)(nil),                // 5: blog.v1.GetReq
-	(*GetResp)(nil),               // 6: blog.v1.GetResp
-	(*UpdateReq)(nil),             // 7: blog.v1.UpdateReq
-	(*DeleteReq)(nil),             // 8: blog.v1.DeleteReq
-	(*ListReq)(nil),               // 9: blog.v1.ListReq
-	(*ListResp)(nil),              // 10: blog.v1.ListResp
-	(*BlogSummary)(nil),           // 11: blog.v1.BlogSummary
-	(*AddCommentReq)(nil),         // 12: blog.v1.AddCommentReq
-	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 14: google.protobuf.Empty
+	(BlogStatus)(0),                 // 0: blog.v1.BlogStatus
+	(CommentState)(0),               // 1: blog.v1.CommentState
+	(CommentPolicy)(0),              // 2: blog.v1.CommentPolicy
+	(CommentView)(0),                // 3: blog.v1.CommentView
+	(DiffMode)(0),                   // 4: blog.v1.DiffMode
+	(DiffOp)(0),                     // 5: blog.v1.DiffOp
+	(*UUID)(nil),                    // 6: blog.v1.UUID
+	(*Blog)(nil),                    // 7: blog.v1.Blog
+	(*Comment)(nil),                 // 8: blog.v1.Comment
+	(*User)(nil),                    // 9: blog.v1.User
+	(*CreateReq)(nil),               // 10: blog.v1.CreateReq
+	(*CreateResp)(nil),              // 11: blog.v1.CreateResp
+	(*GetReq)(nil),                  // 12: blog.v1.GetReq
+	(*GetResp)(nil),                 // 13: blog.v1.GetResp
+	(*GetBySlugReq)(nil),            // 14: blog.v1.GetBySlugReq
+	(*GetBySlugResp)(nil),           // 15: blog.v1.GetBySlugResp
+	(*UpdateReq)(nil),               // 16: blog.v1.UpdateReq
+	(*DeleteReq)(nil),               // 17: blog.v1.DeleteReq
+	(*ListReq)(nil),                 // 18: blog.v1.ListReq
+	(*ListResp)(nil),                // 19: blog.v1.ListResp
+	(*BlogSummary)(nil),             // 20: blog.v1.BlogSummary
+	(*ListTagsReq)(nil),             // 21: blog.v1.ListTagsReq
+	(*TagCount)(nil),                // 22: blog.v1.TagCount
+	(*ListTagsResp)(nil),            // 23: blog.v1.ListTagsResp
+	(*SearchReq)(nil),               // 24: blog.v1.SearchReq
+	(*SearchResult)(nil),            // 25: blog.v1.SearchResult
+	(*SearchResp)(nil),              // 26: blog.v1.SearchResp
+	(*AddCommentReq)(nil),           // 27: blog.v1.AddCommentReq
+	(*AddCommentResp)(nil),          // 28: blog.v1.AddCommentResp
+	(*GetCommentReq)(nil),           // 29: blog.v1.GetCommentReq
+	(*GetCommentResp)(nil),          // 30: blog.v1.GetCommentResp
+	(*UpdateCommentReq)(nil),        // 31: blog.v1.UpdateCommentReq
+	(*DeleteCommentReq)(nil),        // 32: blog.v1.DeleteCommentReq
+	(*ListCommentsReq)(nil),         // 33: blog.v1.ListCommentsReq
+	(*ListCommentsResp)(nil),        // 34: blog.v1.ListCommentsResp
+	(*ListPendingCommentsReq)(nil),  // 35: blog.v1.ListPendingCommentsReq
+	(*ListPendingCommentsResp)(nil), // 36: blog.v1.ListPendingCommentsResp
+	(*ModerateCommentReq)(nil),      // 37: blog.v1.ModerateCommentReq
+	(*CommentVerdict)(nil),          // 38: blog.v1.CommentVerdict
+	(*ListCommentVerdictsReq)(nil),  // 39: blog.v1.ListCommentVerdictsReq
+	(*ListCommentVerdictsResp)(nil), // 40: blog.v1.ListCommentVerdictsResp
+	(*UndeleteReq)(nil),             // 41: blog.v1.UndeleteReq
+	(*ListDeletedReq)(nil),          // 42: blog.v1.ListDeletedReq
+	(*ListDeletedResp)(nil),         // 43: blog.v1.ListDeletedResp
+	(*PublishReq)(nil),              // 44: blog.v1.PublishReq
+	(*UnpublishReq)(nil),            // 45: blog.v1.UnpublishReq
+	(*Revision)(nil),                // 46: blog.v1.Revision
+	(*ListRevisionsReq)(nil),        // 47: blog.v1.ListRevisionsReq
+	(*ListRevisionsResp)(nil),       // 48: blog.v1.ListRevisionsResp
+	(*GetRevisionReq)(nil),          // 49: blog.v1.GetRevisionReq
+	(*GetRevisionResp)(nil),         // 50: blog.v1.GetRevisionResp
+	(*DiffChunk)(nil),               // 51: blog.v1.DiffChunk
+	(*DiffRevisionsReq)(nil),        // 52: blog.v1.DiffRevisionsReq
+	(*DiffRevisionsResp)(nil),       // 53: blog.v1.DiffRevisionsResp
+	(*RestoreRevisionReq)(nil),      // 54: blog.v1.RestoreRevisionReq
+	(*timestamppb.Timestamp)(nil),   // 55: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),   // 56: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),           // 57: google.protobuf.Empty
 }
 var file_protos_blog_v1_blog_proto_depIdxs = []int32{
-	0,  // 0: blog.v1.Blog.id:type_name -> blog.v1.UUID
-	13, // 1: blog.v1.Blog.created_at:type_name -> google.protobuf.Timestamp
-	13, // 2: blog.v1.Blog.updated_at:type_name -> google.protobuf.Timestamp
-	2,  // 3: blog.v1.Blog.comments:type_name -> blog.v1.Comment
-	0,  // 4: blog.v1.Comment.id:type_name -> blog.v1.UUID
-	13, // 5: blog.v1.Comment.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 6: blog.v1.CreateResp.id:type_name -> blog.v1.UUID
-	0,  // 7: blog.v1.GetReq.id:type_name -> blog.v1.UUID
-	1,  // 8: blog.v1.GetResp.blog:type_name -> blog.v1.Blog
-	0,  // 9: blog.v1.UpdateReq.id:type_name -> blog.v1.UUID
-	0,  // 10: blog.v1.DeleteReq.id:type_name -> blog.v1.UUID
-	11, // 11: blog.v1.ListResp.blogs:type_name -> blog.v1.BlogSummary
-	0,  // 12: blog.v1.BlogSummary.id:type_name -> blog.v1.UUID
-	0,  // 13: blog.v1.AddCommentReq.id:type_name -> blog.v1.UUID
-	3,  // 14: blog.v1.Blogs.Create:input_type -> blog.v1.CreateReq
-	5,  // 15: blog.v1.Blogs.Get:input_type -> blog.v1.GetReq
-	7,  // 16: blog.v1.Blogs.Update:input_type -> blog.v1.UpdateReq
-	8,  // 17: blog.v1.Blogs.Delete:input_type -> blog.v1.DeleteReq
-	9,  // 18: blog.v1.Blogs.List:input_type -> blog.v1.ListReq
-	12, // 19: blog.v1.Blogs.AddComment:input_type -> blog.v1.AddCommentReq
-	4,  // 20: blog.v1.Blogs.Create:output_type -> blog.v1.CreateResp
-	6,  // 21: blog.v1.Blogs.Get:output_type -> blog.v1.GetResp
-	14, // 22: blog.v1.Blogs.Update:output_type -> google.protobuf.Empty
-	14, // 23: blog.v1.Blogs.Delete:output_type -> google.protobuf.Empty
-	10, // 24: blog.v1.Blogs.List:output_type -> blog.v1.ListResp
-	14, // 25: blog.v1.Blogs.AddComment:output_type -> google.protobuf.Empty
-	20, // [20:26] is the sub-list for method output_type
-	14, // [14:20] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	6,   // 0: blog.v1.Blog.id:type_name -> blog.v1.UUID
+	55,  // 1: blog.v1.Blog.created_at:type_name -> google.protobuf.Timestamp
+	55,  // 2: blog.v1.Blog.updated_at:type_name -> google.protobuf.Timestamp
+	8,   // 3: blog.v1.Blog.comments:type_name -> blog.v1.Comment
+	0,   // 4: blog.v1.Blog.status:type_name -> blog.v1.BlogStatus
+	55,  // 5: blog.v1.Blog.published_at:type_name -> google.protobuf.Timestamp
+	55,  // 6: blog.v1.Blog.publish_at:type_name -> google.protobuf.Timestamp
+	55,  // 7: blog.v1.Blog.deleted_at:type_name -> google.protobuf.Timestamp
+	2,   // 8: blog.v1.Blog.comment_policy:type_name -> blog.v1.CommentPolicy
+	9,   // 9: blog.v1.Blog.author_profile:type_name -> blog.v1.User
+	6,   // 10: blog.v1.Comment.id:type_name -> blog.v1.UUID
+	55,  // 11: blog.v1.Comment.created_at:type_name -> google.protobuf.Timestamp
+	6,   // 12: blog.v1.Comment.parent_id:type_name -> blog.v1.UUID
+	8,   // 13: blog.v1.Comment.replies:type_name -> blog.v1.Comment
+	55,  // 14: blog.v1.Comment.updated_at:type_name -> google.protobuf.Timestamp
+	6,   // 15: blog.v1.Comment.blog_id:type_name -> blog.v1.UUID
+	1,   // 16: blog.v1.Comment.state:type_name -> blog.v1.CommentState
+	55,  // 17: blog.v1.Comment.moderated_at:type_name -> google.protobuf.Timestamp
+	9,   // 18: blog.v1.Comment.author_profile:type_name -> blog.v1.User
+	6,   // 19: blog.v1.User.id:type_name -> blog.v1.UUID
+	55,  // 20: blog.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	55,  // 21: blog.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	0,   // 22: blog.v1.CreateReq.status:type_name -> blog.v1.BlogStatus
+	55,  // 23: blog.v1.CreateReq.publish_at:type_name -> google.protobuf.Timestamp
+	6,   // 24: blog.v1.CreateReq.author_id:type_name -> blog.v1.UUID
+	6,   // 25: blog.v1.CreateResp.id:type_name -> blog.v1.UUID
+	6,   // 26: blog.v1.GetReq.id:type_name -> blog.v1.UUID
+	56,  // 27: blog.v1.GetReq.read_mask:type_name -> google.protobuf.FieldMask
+	3,   // 28: blog.v1.GetReq.comment_view:type_name -> blog.v1.CommentView
+	7,   // 29: blog.v1.GetResp.blog:type_name -> blog.v1.Blog
+	56,  // 30: blog.v1.GetBySlugReq.read_mask:type_name -> google.protobuf.FieldMask
+	3,   // 31: blog.v1.GetBySlugReq.comment_view:type_name -> blog.v1.CommentView
+	7,   // 32: blog.v1.GetBySlugResp.blog:type_name -> blog.v1.Blog
+	6,   // 33: blog.v1.UpdateReq.id:type_name -> blog.v1.UUID
+	0,   // 34: blog.v1.UpdateReq.status:type_name -> blog.v1.BlogStatus
+	55,  // 35: blog.v1.UpdateReq.publish_at:type_name -> google.protobuf.Timestamp
+	56,  // 36: blog.v1.UpdateReq.update_mask:type_name -> google.protobuf.FieldMask
+	2,   // 37: blog.v1.UpdateReq.comment_policy:type_name -> blog.v1.CommentPolicy
+	6,   // 38: blog.v1.DeleteReq.id:type_name -> blog.v1.UUID
+	0,   // 39: blog.v1.ListReq.status:type_name -> blog.v1.BlogStatus
+	20,  // 40: blog.v1.ListResp.blogs:type_name -> blog.v1.BlogSummary
+	6,   // 41: blog.v1.BlogSummary.id:type_name -> blog.v1.UUID
+	0,   // 42: blog.v1.BlogSummary.status:type_name -> blog.v1.BlogStatus
+	55,  // 43: blog.v1.BlogSummary.deleted_at:type_name -> google.protobuf.Timestamp
+	0,   // 44: blog.v1.ListTagsReq.status:type_name -> blog.v1.BlogStatus
+	22,  // 45: blog.v1.ListTagsResp.tags:type_name -> blog.v1.TagCount
+	20,  // 46: blog.v1.SearchResult.blog:type_name -> blog.v1.BlogSummary
+	25,  // 47: blog.v1.SearchResp.results:type_name -> blog.v1.SearchResult
+	6,   // 48: blog.v1.AddCommentReq.id:type_name -> blog.v1.UUID
+	6,   // 49: blog.v1.AddCommentReq.parent_id:type_name -> blog.v1.UUID
+	6,   // 50: blog.v1.AddCommentReq.author_id:type_name -> blog.v1.UUID
+	8,   // 51: blog.v1.AddCommentResp.comment:type_name -> blog.v1.Comment
+	6,   // 52: blog.v1.GetCommentReq.id:type_name -> blog.v1.UUID
+	6,   // 53: blog.v1.GetCommentReq.comment_id:type_name -> blog.v1.UUID
+	8,   // 54: blog.v1.GetCommentResp.comment:type_name -> blog.v1.Comment
+	6,   // 55: blog.v1.UpdateCommentReq.id:type_name -> blog.v1.UUID
+	6,   // 56: blog.v1.UpdateCommentReq.comment_id:type_name -> blog.v1.UUID
+	6,   // 57: blog.v1.DeleteCommentReq.id:type_name -> blog.v1.UUID
+	6,   // 58: blog.v1.DeleteCommentReq.comment_id:type_name -> blog.v1.UUID
+	6,   // 59: blog.v1.ListCommentsReq.id:type_name -> blog.v1.UUID
+	8,   // 60: blog.v1.ListCommentsResp.comments:type_name -> blog.v1.Comment
+	6,   // 61: blog.v1.ListPendingCommentsReq.id:type_name -> blog.v1.UUID
+	8,   // 62: blog.v1.ListPendingCommentsResp.comments:type_name -> blog.v1.Comment
+	6,   // 63: blog.v1.ModerateCommentReq.id:type_name -> blog.v1.UUID
+	6,   // 64: blog.v1.ModerateCommentReq.comment_id:type_name -> blog.v1.UUID
+	1,   // 65: blog.v1.ModerateCommentReq.state:type_name -> blog.v1.CommentState
+	1,   // 66: blog.v1.CommentVerdict.state:type_name -> blog.v1.CommentState
+	55,  // 67: blog.v1.CommentVerdict.created_at:type_name -> google.protobuf.Timestamp
+	6,   // 68: blog.v1.ListCommentVerdictsReq.id:type_name -> blog.v1.UUID
+	6,   // 69: blog.v1.ListCommentVerdictsReq.comment_id:type_name -> blog.v1.UUID
+	38,  // 70: blog.v1.ListCommentVerdictsResp.verdicts:type_name -> blog.v1.CommentVerdict
+	6,   // 71: blog.v1.UndeleteReq.id:type_name -> blog.v1.UUID
+	20,  // 72: blog.v1.ListDeletedResp.blogs:type_name -> blog.v1.BlogSummary
+	6,   // 73: blog.v1.PublishReq.id:type_name -> blog.v1.UUID
+	6,   // 74: blog.v1.UnpublishReq.id:type_name -> blog.v1.UUID
+	6,   // 75: blog.v1.Revision.blog_id:type_name -> blog.v1.UUID
+	55,  // 76: blog.v1.Revision.created_at:type_name -> google.protobuf.Timestamp
+	6,   // 77: blog.v1.ListRevisionsReq.id:type_name -> blog.v1.UUID
+	46,  // 78: blog.v1.ListRevisionsResp.revisions:type_name -> blog.v1.Revision
+	6,   // 79: blog.v1.GetRevisionReq.id:type_name -> blog.v1.UUID
+	46,  // 80: blog.v1.GetRevisionResp.revision:type_name -> blog.v1.Revision
+	5,   // 81: blog.v1.DiffChunk.op:type_name -> blog.v1.DiffOp
+	6,   // 82: blog.v1.DiffRevisionsReq.id:type_name -> blog.v1.UUID
+	4,   // 83: blog.v1.DiffRevisionsReq.mode:type_name -> blog.v1.DiffMode
+	51,  // 84: blog.v1.DiffRevisionsResp.title:type_name -> blog.v1.DiffChunk
+	51,  // 85: blog.v1.DiffRevisionsResp.content:type_name -> blog.v1.DiffChunk
+	6,   // 86: blog.v1.RestoreRevisionReq.id:type_name -> blog.v1.UUID
+	10,  // 87: blog.v1.Blogs.Create:input_type -> blog.v1.CreateReq
+	12,  // 88: blog.v1.Blogs.Get:input_type -> blog.v1.GetReq
+	14,  // 89: blog.v1.Blogs.GetBySlug:input_type -> blog.v1.GetBySlugReq
+	16,  // 90: blog.v1.Blogs.Update:input_type -> blog.v1.UpdateReq
+	17,  // 91: blog.v1.Blogs.Delete:input_type -> blog.v1.DeleteReq
+	18,  // 92: blog.v1.Blogs.List:input_type -> blog.v1.ListReq
+	21,  // 93: blog.v1.Blogs.ListTags:input_type -> blog.v1.ListTagsReq
+	24,  // 94: blog.v1.Blogs.Search:input_type -> blog.v1.SearchReq
+	41,  // 95: blog.v1.Blogs.Undelete:input_type -> blog.v1.UndeleteReq
+	42,  // 96: blog.v1.Blogs.ListDeleted:input_type -> blog.v1.ListDeletedReq
+	27,  // 97: blog.v1.Blogs.AddComment:input_type -> blog.v1.AddCommentReq
+	29,  // 98: blog.v1.Blogs.GetComment:input_type -> blog.v1.GetCommentReq
+	31,  // 99: blog.v1.Blogs.UpdateComment:input_type -> blog.v1.UpdateCommentReq
+	32,  // 100: blog.v1.Blogs.DeleteComment:input_type -> blog.v1.DeleteCommentReq
+	33,  // 101: blog.v1.Blogs.ListComments:input_type -> blog.v1.ListCommentsReq
+	35,  // 102: blog.v1.Blogs.ListPendingComments:input_type -> blog.v1.ListPendingCommentsReq
+	37,  // 103: blog.v1.Blogs.ModerateComment:input_type -> blog.v1.ModerateCommentReq
+	39,  // 104: blog.v1.Blogs.ListCommentVerdicts:input_type -> blog.v1.ListCommentVerdictsReq
+	44,  // 105: blog.v1.Blogs.Publish:input_type -> blog.v1.PublishReq
+	45,  // 106: blog.v1.Blogs.Unpublish:input_type -> blog.v1.UnpublishReq
+	47,  // 107: blog.v1.Blogs.ListRevisions:input_type -> blog.v1.ListRevisionsReq
+	49,  // 108: blog.v1.Blogs.GetRevision:input_type -> blog.v1.GetRevisionReq
+	52,  // 109: blog.v1.Blogs.DiffRevisions:input_type -> blog.v1.DiffRevisionsReq
+	54,  // 110: blog.v1.Blogs.RestoreRevision:input_type -> blog.v1.RestoreRevisionReq
+	11,  // 111: blog.v1.Blogs.Create:output_type -> blog.v1.CreateResp
+	13,  // 112: blog.v1.Blogs.Get:output_type -> blog.v1.GetResp
+	15,  // 113: blog.v1.Blogs.GetBySlug:output_type -> blog.v1.GetBySlugResp
+	57,  // 114: blog.v1.Blogs.Update:output_type -> google.protobuf.Empty
+	57,  // 115: blog.v1.Blogs.Delete:output_type -> google.protobuf.Empty
+	19,  // 116: blog.v1.Blogs.List:output_type -> blog.v1.ListResp
+	23,  // 117: blog.v1.Blogs.ListTags:output_type -> blog.v1.ListTagsResp
+	26,  // 118: blog.v1.Blogs.Search:output_type -> blog.v1.SearchResp
+	57,  // 119: blog.v1.Blogs.Undelete:output_type -> google.protobuf.Empty
+	43,  // 120: blog.v1.Blogs.ListDeleted:output_type -> blog.v1.ListDeletedResp
+	28,  // 121: blog.v1.Blogs.AddComment:output_type -> blog.v1.AddCommentResp
+	30,  // 122: blog.v1.Blogs.GetComment:output_type -> blog.v1.GetCommentResp
+	57,  // 123: blog.v1.Blogs.UpdateComment:output_type -> google.protobuf.Empty
+	57,  // 124: blog.v1.Blogs.DeleteComment:output_type -> google.protobuf.Empty
+	34,  // 125: blog.v1.Blogs.ListComments:output_type -> blog.v1.ListCommentsResp
+	36,  // 126: blog.v1.Blogs.ListPendingComments:output_type -> blog.v1.ListPendingCommentsResp
+	57,  // 127: blog.v1.Blogs.ModerateComment:output_type -> google.protobuf.Empty
+	40,  // 128: blog.v1.Blogs.ListCommentVerdicts:output_type -> blog.v1.ListCommentVerdictsResp
+	57,  // 129: blog.v1.Blogs.Publish:output_type -> google.protobuf.Empty
+	57,  // 130: blog.v1.Blogs.Unpublish:output_type -> google.protobuf.Empty
+	48,  // 131: blog.v1.Blogs.ListRevisions:output_type -> blog.v1.ListRevisionsResp
+	50,  // 132: blog.v1.Blogs.GetRevision:output_type -> blog.v1.GetRevisionResp
+	53,  // 133: blog.v1.Blogs.DiffRevisions:output_type -> blog.v1.DiffRevisionsResp
+	57,  // 134: blog.v1.Blogs.RestoreRevision:output_type -> google.protobuf.Empty
+	111, // [111:135] is the sub-list for method output_type
+	87,  // [87:111] is the sub-list for method input_type
+	87,  // [87:87] is the sub-list for extension type_name
+	87,  // [87:87] is the sub-list for extension extendee
+	0,   // [0:87] is the sub-list for field type_name
 }
 
 func init() { file_protos_blog_v1_blog_proto_init() }
@@ -915,19 +4244,20 @@ func file_protos_blog_v1_blog_proto_init() {
 	if File_protos_blog_v1_blog_proto != nil {
 		return
 	}
-	file_protos_blog_v1_blog_proto_msgTypes[7].OneofWrappers = []any{}
+	file_protos_blog_v1_blog_proto_msgTypes[10].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_protos_blog_v1_blog_proto_rawDesc), len(file_protos_blog_v1_blog_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      6,
+			NumMessages:   49,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_protos_blog_v1_blog_proto_goTypes,
 		DependencyIndexes: file_protos_blog_v1_blog_proto_depIdxs,
+		EnumInfos:         file_protos_blog_v1_blog_proto_enumTypes,
 		MessageInfos:      file_protos_blog_v1_blog_proto_msgTypes,
 	}.Build()
 	File_protos_blog_v1_blog_proto = out.File
@@ -281,19 +281,5290 @@ func (m *Blog) validate(all bool) error {
 
 	}
 
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetPublishedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BlogValidationError{
+					field:  "PublishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BlogValidationError{
+					field:  "PublishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPublishedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BlogValidationError{
+				field:  "PublishedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPublishAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BlogValidationError{
+					field:  "PublishAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BlogValidationError{
+					field:  "PublishAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPublishAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BlogValidationError{
+				field:  "PublishAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Etag
+
+	if all {
+		switch v := interface{}(m.GetDeletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BlogValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BlogValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BlogValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Slug
+
+	// no validation rules for CommentCount
+
+	// no validation rules for CommentPolicy
+
+	// no validation rules for Owner
+
+	if all {
+		switch v := interface{}(m.GetAuthorProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BlogValidationError{
+					field:  "AuthorProfile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BlogValidationError{
+					field:  "AuthorProfile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthorProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BlogValidationError{
+				field:  "AuthorProfile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BlogMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlogMultiError is an error wrapping multiple validation errors returned by
+// Blog.ValidateAll() if the designated constraints aren't met.
+type BlogMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlogMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlogMultiError) AllErrors() []error { return m }
+
+// BlogValidationError is the validation error returned by Blog.Validate if the
+// designated constraints aren't met.
+type BlogValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlogValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlogValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlogValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlogValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlogValidationError) ErrorName() string { return "BlogValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlogValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlog.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlogValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlogValidationError{}
+
+// Validate checks the field values on Comment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Comment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Comment with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CommentMultiError, or nil if none found.
+func (m *Comment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Comment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommentValidationError{
+				field:  "Id",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Content
+
+	// no validation rules for Author
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommentValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetParentId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "ParentId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "ParentId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetParentId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommentValidationError{
+				field:  "ParentId",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Depth
+
+	for idx, item := range m.GetReplies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CommentValidationError{
+						field:  fmt.Sprintf("Replies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CommentValidationError{
+						field:  fmt.Sprintf("Replies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CommentValidationError{
+					field:  fmt.Sprintf("Replies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommentValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBlogId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "BlogId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "BlogId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBlogId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommentValidationError{
+				field:  "BlogId",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for State
+
+	// no validation rules for ModerationReason
+
+	if all {
+		switch v := interface{}(m.GetModeratedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "ModeratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "ModeratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetModeratedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommentValidationError{
+				field:  "ModeratedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAuthorProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "AuthorProfile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "AuthorProfile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthorProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommentValidationError{
+				field:  "AuthorProfile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CommentMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommentMultiError is an error wrapping multiple validation errors returned
+// by Comment.ValidateAll() if the designated constraints aren't met.
+type CommentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommentMultiError) AllErrors() []error { return m }
+
+// CommentValidationError is the validation error returned by Comment.Validate
+// if the designated constraints aren't met.
+type CommentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommentValidationError) ErrorName() string { return "CommentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CommentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommentValidationError{}
+
+// Validate checks the field values on User with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *User) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on User with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in UserMultiError, or nil if none found.
+func (m *User) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *User) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "Id",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Bio
+
+	// no validation rules for AvatarUrl
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserMultiError is an error wrapping multiple validation errors returned by
+// User.ValidateAll() if the designated constraints aren't met.
+type UserMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserMultiError) AllErrors() []error { return m }
+
+// UserValidationError is the validation error returned by User.Validate if the
+// designated constraints aren't met.
+type UserValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserValidationError) ErrorName() string { return "UserValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUser.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserValidationError{}
+
+// Validate checks the field values on CreateReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CreateReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CreateReqMultiError, or nil
+// if none found.
+func (m *CreateReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for Content
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetPublishAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateReqValidationError{
+					field:  "PublishAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateReqValidationError{
+					field:  "PublishAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPublishAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateReqValidationError{
+				field:  "PublishAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAuthorId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateReqValidationError{
+					field:  "AuthorId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateReqValidationError{
+					field:  "AuthorId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthorId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateReqValidationError{
+				field:  "AuthorId",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReqMultiError is an error wrapping multiple validation errors returned
+// by CreateReq.ValidateAll() if the designated constraints aren't met.
+type CreateReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReqMultiError) AllErrors() []error { return m }
+
+// CreateReqValidationError is the validation error returned by
+// CreateReq.Validate if the designated constraints aren't met.
+type CreateReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReqValidationError) ErrorName() string { return "CreateReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReqValidationError{}
+
+// Validate checks the field values on CreateResp with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CreateResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CreateRespMultiError, or
+// nil if none found.
+func (m *CreateResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRespValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRespValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRespValidationError{
+				field:  "Id",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRespMultiError is an error wrapping multiple validation errors
+// returned by CreateResp.ValidateAll() if the designated constraints aren't met.
+type CreateRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRespMultiError) AllErrors() []error { return m }
+
+// CreateRespValidationError is the validation error returned by
+// CreateResp.Validate if the designated constraints aren't met.
+type CreateRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRespValidationError) ErrorName() string { return "CreateRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRespValidationError{}
+
+// Validate checks the field values on GetReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in GetReqMultiError, or nil if none found.
+func (m *GetReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReqValidationError{
+				field:  "Id",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReadMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReqValidationError{
+					field:  "ReadMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReqValidationError{
+					field:  "ReadMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReadMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReqValidationError{
+				field:  "ReadMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ShowDeleted
+
+	// no validation rules for CommentView
+
+	// no validation rules for MaxComments
+
+	if len(errors) > 0 {
+		return GetReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReqMultiError is an error wrapping multiple validation errors returned by
+// GetReq.ValidateAll() if the designated constraints aren't met.
+type GetReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReqMultiError) AllErrors() []error { return m }
+
+// GetReqValidationError is the validation error returned by GetReq.Validate if
+// the designated constraints aren't met.
+type GetReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReqValidationError) ErrorName() string { return "GetReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReqValidationError{}
+
+// Validate checks the field values on GetResp with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetResp with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in GetRespMultiError, or nil if none found.
+func (m *GetResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBlog()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRespValidationError{
+					field:  "Blog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRespValidationError{
+					field:  "Blog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBlog()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRespValidationError{
+				field:  "Blog",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRespMultiError is an error wrapping multiple validation errors returned
+// by GetResp.ValidateAll() if the designated constraints aren't met.
+type GetRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRespMultiError) AllErrors() []error { return m }
+
+// GetRespValidationError is the validation error returned by GetResp.Validate
+// if the designated constraints aren't met.
+type GetRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRespValidationError) ErrorName() string { return "GetRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRespValidationError{}
+
+// Validate checks the field values on GetBySlugReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetBySlugReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBySlugReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetBySlugReqMultiError, or
+// nil if none found.
+func (m *GetBySlugReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBySlugReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Slug
+
+	if all {
+		switch v := interface{}(m.GetReadMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBySlugReqValidationError{
+					field:  "ReadMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBySlugReqValidationError{
+					field:  "ReadMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReadMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBySlugReqValidationError{
+				field:  "ReadMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ShowDeleted
+
+	// no validation rules for CommentView
+
+	// no validation rules for MaxComments
+
+	if len(errors) > 0 {
+		return GetBySlugReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBySlugReqMultiError is an error wrapping multiple validation errors
+// returned by GetBySlugReq.ValidateAll() if the designated constraints aren't met.
+type GetBySlugReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBySlugReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBySlugReqMultiError) AllErrors() []error { return m }
+
+// GetBySlugReqValidationError is the validation error returned by
+// GetBySlugReq.Validate if the designated constraints aren't met.
+type GetBySlugReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBySlugReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBySlugReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBySlugReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBySlugReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBySlugReqValidationError) ErrorName() string { return "GetBySlugReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetBySlugReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBySlugReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBySlugReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBySlugReqValidationError{}
+
+// Validate checks the field values on GetBySlugResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetBySlugResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBySlugResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetBySlugRespMultiError, or
+// nil if none found.
+func (m *GetBySlugResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBySlugResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBlog()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBySlugRespValidationError{
+					field:  "Blog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBySlugRespValidationError{
+					field:  "Blog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBlog()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBySlugRespValidationError{
+				field:  "Blog",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RedirectSlug
+
+	if len(errors) > 0 {
+		return GetBySlugRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBySlugRespMultiError is an error wrapping multiple validation errors
+// returned by GetBySlugResp.ValidateAll() if the designated constraints
+// aren't met.
+type GetBySlugRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBySlugRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBySlugRespMultiError) AllErrors() []error { return m }
+
+// GetBySlugRespValidationError is the validation error returned by
+// GetBySlugResp.Validate if the designated constraints aren't met.
+type GetBySlugRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBySlugRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBySlugRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBySlugRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBySlugRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBySlugRespValidationError) ErrorName() string { return "GetBySlugRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetBySlugRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBySlugResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBySlugRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBySlugRespValidationError{}
+
+// Validate checks the field values on UpdateReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UpdateReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UpdateReqMultiError, or nil
+// if none found.
+func (m *UpdateReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateReqValidationError{
+				field:  "Id",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPublishAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateReqValidationError{
+					field:  "PublishAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateReqValidationError{
+					field:  "PublishAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPublishAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateReqValidationError{
+				field:  "PublishAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Editor
+
+	// no validation rules for Etag
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateReqValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateReqValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateReqValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Title != nil {
+		// no validation rules for Title
+	}
+
+	if m.Content != nil {
+		// no validation rules for Content
+	}
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if m.Slug != nil {
+		// no validation rules for Slug
+	}
+
+	if m.CommentPolicy != nil {
+		// no validation rules for CommentPolicy
+	}
+
+	if len(errors) > 0 {
+		return UpdateReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateReqMultiError is an error wrapping multiple validation errors returned
+// by UpdateReq.ValidateAll() if the designated constraints aren't met.
+type UpdateReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReqMultiError) AllErrors() []error { return m }
+
+// UpdateReqValidationError is the validation error returned by
+// UpdateReq.Validate if the designated constraints aren't met.
+type UpdateReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReqValidationError) ErrorName() string { return "UpdateReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReqValidationError{}
+
+// Validate checks the field values on DeleteReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeleteReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeleteReqMultiError, or nil
+// if none found.
+func (m *DeleteReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteReqValidationError{
+				field:  "Id",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Etag
+
+	if len(errors) > 0 {
+		return DeleteReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteReqMultiError is an error wrapping multiple validation errors returned
+// by DeleteReq.ValidateAll() if the designated constraints aren't met.
+type DeleteReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteReqMultiError) AllErrors() []error { return m }
+
+// DeleteReqValidationError is the validation error returned by
+// DeleteReq.Validate if the designated constraints aren't met.
+type DeleteReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReqValidationError) ErrorName() string { return "DeleteReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReqValidationError{}
+
+// Validate checks the field values on ListReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ListReqMultiError, or nil if none found.
+func (m *ListReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	// no validation rules for Status
+
+	// no validation rules for ShowDeleted
+
+	// no validation rules for Tag
+
+	if len(errors) > 0 {
+		return ListReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReqMultiError is an error wrapping multiple validation errors returned
+// by ListReq.ValidateAll() if the designated constraints aren't met.
+type ListReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReqMultiError) AllErrors() []error { return m }
+
+// ListReqValidationError is the validation error returned by ListReq.Validate
+// if the designated constraints aren't met.
+type ListReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReqValidationError) ErrorName() string { return "ListReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReqValidationError{}
+
+// Validate checks the field values on ListResp with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListRespMultiError, or nil
+// if none found.
+func (m *ListResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBlogs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRespValidationError{
+						field:  fmt.Sprintf("Blogs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRespValidationError{
+						field:  fmt.Sprintf("Blogs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRespValidationError{
+					field:  fmt.Sprintf("Blogs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRespMultiError is an error wrapping multiple validation errors returned
+// by ListResp.ValidateAll() if the designated constraints aren't met.
+type ListRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRespMultiError) AllErrors() []error { return m }
+
+// ListRespValidationError is the validation error returned by
+// ListResp.Validate if the designated constraints aren't met.
+type ListRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRespValidationError) ErrorName() string { return "ListRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRespValidationError{}
+
+// Validate checks the field values on BlogSummary with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BlogSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlogSummary with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BlogSummaryMultiError, or
+// nil if none found.
+func (m *BlogSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlogSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BlogSummaryValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BlogSummaryValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BlogSummaryValidationError{
+				field:  "Id",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Title
+
+	// no validation rules for CommentCount
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetDeletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BlogSummaryValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BlogSummaryValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BlogSummaryValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Slug
+
+	if len(errors) > 0 {
+		return BlogSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlogSummaryMultiError is an error wrapping multiple validation errors
+// returned by BlogSummary.ValidateAll() if the designated constraints aren't met.
+type BlogSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlogSummaryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlogSummaryMultiError) AllErrors() []error { return m }
+
+// BlogSummaryValidationError is the validation error returned by
+// BlogSummary.Validate if the designated constraints aren't met.
+type BlogSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlogSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlogSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlogSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlogSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlogSummaryValidationError) ErrorName() string { return "BlogSummaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlogSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlogSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlogSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlogSummaryValidationError{}
+
+// Validate checks the field values on ListTagsReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListTagsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTagsReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListTagsReqMultiError, or
+// nil if none found.
+func (m *ListTagsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTagsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return ListTagsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTagsReqMultiError is an error wrapping multiple validation errors
+// returned by ListTagsReq.ValidateAll() if the designated constraints aren't met.
+type ListTagsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTagsReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTagsReqMultiError) AllErrors() []error { return m }
+
+// ListTagsReqValidationError is the validation error returned by
+// ListTagsReq.Validate if the designated constraints aren't met.
+type ListTagsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTagsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTagsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTagsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTagsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTagsReqValidationError) ErrorName() string { return "ListTagsReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTagsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTagsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTagsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTagsReqValidationError{}
+
+// Validate checks the field values on TagCount with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TagCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TagCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TagCountMultiError, or nil
+// if none found.
+func (m *TagCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TagCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for PostCount
+
+	if len(errors) > 0 {
+		return TagCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// TagCountMultiError is an error wrapping multiple validation errors returned
+// by TagCount.ValidateAll() if the designated constraints aren't met.
+type TagCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TagCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TagCountMultiError) AllErrors() []error { return m }
+
+// TagCountValidationError is the validation error returned by
+// TagCount.Validate if the designated constraints aren't met.
+type TagCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TagCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TagCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TagCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TagCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TagCountValidationError) ErrorName() string { return "TagCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TagCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTagCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TagCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TagCountValidationError{}
+
+// Validate checks the field values on ListTagsResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListTagsResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTagsResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListTagsRespMultiError, or
+// nil if none found.
+func (m *ListTagsResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTagsResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTagsRespValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTagsRespValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTagsRespValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTagsRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTagsRespMultiError is an error wrapping multiple validation errors
+// returned by ListTagsResp.ValidateAll() if the designated constraints aren't met.
+type ListTagsRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTagsRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTagsRespMultiError) AllErrors() []error { return m }
+
+// ListTagsRespValidationError is the validation error returned by
+// ListTagsResp.Validate if the designated constraints aren't met.
+type ListTagsRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTagsRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTagsRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTagsRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTagsRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTagsRespValidationError) ErrorName() string { return "ListTagsRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTagsRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTagsResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTagsRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTagsRespValidationError{}
+
+// Validate checks the field values on SearchReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchReqMultiError, or nil
+// if none found.
+func (m *SearchReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Q
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	// no validation rules for IncludeComments
+
+	if len(errors) > 0 {
+		return SearchReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchReqMultiError is an error wrapping multiple validation errors returned
+// by SearchReq.ValidateAll() if the designated constraints aren't met.
+type SearchReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchReqMultiError) AllErrors() []error { return m }
+
+// SearchReqValidationError is the validation error returned by
+// SearchReq.Validate if the designated constraints aren't met.
+type SearchReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchReqValidationError) ErrorName() string { return "SearchReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchReqValidationError{}
+
+// Validate checks the field values on SearchResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchResultMultiError, or
+// nil if none found.
+func (m *SearchResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBlog()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchResultValidationError{
+					field:  "Blog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchResultValidationError{
+					field:  "Blog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBlog()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchResultValidationError{
+				field:  "Blog",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Snippet
+
+	// no validation rules for Rank
+
+	if len(errors) > 0 {
+		return SearchResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchResultMultiError is an error wrapping multiple validation errors
+// returned by SearchResult.ValidateAll() if the designated constraints aren't met.
+type SearchResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchResultMultiError) AllErrors() []error { return m }
+
+// SearchResultValidationError is the validation error returned by
+// SearchResult.Validate if the designated constraints aren't met.
+type SearchResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchResultValidationError) ErrorName() string { return "SearchResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchResultValidationError{}
+
+// Validate checks the field values on SearchResp with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchRespMultiError, or
+// nil if none found.
+func (m *SearchResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchRespValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchRespValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchRespValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return SearchRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchRespMultiError is an error wrapping multiple validation errors
+// returned by SearchResp.ValidateAll() if the designated constraints aren't met.
+type SearchRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchRespMultiError) AllErrors() []error { return m }
+
+// SearchRespValidationError is the validation error returned by
+// SearchResp.Validate if the designated constraints aren't met.
+type SearchRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchRespValidationError) ErrorName() string { return "SearchRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchRespValidationError{}
+
+// Validate checks the field values on AddCommentReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AddCommentReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddCommentReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AddCommentReqMultiError, or
+// nil if none found.
+func (m *AddCommentReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddCommentReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddCommentReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddCommentReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddCommentReqValidationError{
+				field:  "Id",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Content
+
+	// no validation rules for Author
+
+	if all {
+		switch v := interface{}(m.GetParentId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddCommentReqValidationError{
+					field:  "ParentId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddCommentReqValidationError{
+					field:  "ParentId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetParentId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddCommentReqValidationError{
+				field:  "ParentId",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAuthorId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddCommentReqValidationError{
+					field:  "AuthorId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddCommentReqValidationError{
+					field:  "AuthorId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthorId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddCommentReqValidationError{
+				field:  "AuthorId",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddCommentReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddCommentReqMultiError is an error wrapping multiple validation errors
+// returned by AddCommentReq.ValidateAll() if the designated constraints
+// aren't met.
+type AddCommentReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddCommentReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddCommentReqMultiError) AllErrors() []error { return m }
+
+// AddCommentReqValidationError is the validation error returned by
+// AddCommentReq.Validate if the designated constraints aren't met.
+type AddCommentReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddCommentReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddCommentReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddCommentReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddCommentReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddCommentReqValidationError) ErrorName() string { return "AddCommentReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddCommentReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddCommentReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddCommentReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddCommentReqValidationError{}
+
+// Validate checks the field values on AddCommentResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AddCommentResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddCommentResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AddCommentRespMultiError,
+// or nil if none found.
+func (m *AddCommentResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddCommentResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetComment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddCommentRespValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddCommentRespValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetComment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddCommentRespValidationError{
+				field:  "Comment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddCommentRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddCommentRespMultiError is an error wrapping multiple validation errors
+// returned by AddCommentResp.ValidateAll() if the designated constraints
+// aren't met.
+type AddCommentRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddCommentRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddCommentRespMultiError) AllErrors() []error { return m }
+
+// AddCommentRespValidationError is the validation error returned by
+// AddCommentResp.Validate if the designated constraints aren't met.
+type AddCommentRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddCommentRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddCommentRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddCommentRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddCommentRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddCommentRespValidationError) ErrorName() string { return "AddCommentRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddCommentRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddCommentResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddCommentRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddCommentRespValidationError{}
+
+// Validate checks the field values on GetCommentReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetCommentReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCommentReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetCommentReqMultiError, or
+// nil if none found.
+func (m *GetCommentReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCommentReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCommentReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCommentReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCommentReqValidationError{
+				field:  "Id",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCommentId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCommentReqValidationError{
+					field:  "CommentId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCommentReqValidationError{
+					field:  "CommentId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommentId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCommentReqValidationError{
+				field:  "CommentId",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCommentReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCommentReqMultiError is an error wrapping multiple validation errors
+// returned by GetCommentReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetCommentReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCommentReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCommentReqMultiError) AllErrors() []error { return m }
+
+// GetCommentReqValidationError is the validation error returned by
+// GetCommentReq.Validate if the designated constraints aren't met.
+type GetCommentReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCommentReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCommentReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCommentReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCommentReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCommentReqValidationError) ErrorName() string { return "GetCommentReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetCommentReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCommentReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCommentReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCommentReqValidationError{}
+
+// Validate checks the field values on GetCommentResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetCommentResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCommentResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetCommentRespMultiError,
+// or nil if none found.
+func (m *GetCommentResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCommentResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetComment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCommentRespValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCommentRespValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetComment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCommentRespValidationError{
+				field:  "Comment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCommentRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCommentRespMultiError is an error wrapping multiple validation errors
+// returned by GetCommentResp.ValidateAll() if the designated constraints
+// aren't met.
+type GetCommentRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCommentRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCommentRespMultiError) AllErrors() []error { return m }
+
+// GetCommentRespValidationError is the validation error returned by
+// GetCommentResp.Validate if the designated constraints aren't met.
+type GetCommentRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCommentRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCommentRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCommentRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCommentRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCommentRespValidationError) ErrorName() string { return "GetCommentRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetCommentRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCommentResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCommentRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCommentRespValidationError{}
+
+// Validate checks the field values on UpdateCommentReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCommentReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCommentReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCommentReqMultiError, or nil if none found.
+func (m *UpdateCommentReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCommentReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateCommentReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateCommentReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateCommentReqValidationError{
+				field:  "Id",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCommentId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateCommentReqValidationError{
+					field:  "CommentId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateCommentReqValidationError{
+					field:  "CommentId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommentId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateCommentReqValidationError{
+				field:  "CommentId",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return UpdateCommentReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCommentReqMultiError is an error wrapping multiple validation errors
+// returned by UpdateCommentReq.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateCommentReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCommentReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCommentReqMultiError) AllErrors() []error { return m }
+
+// UpdateCommentReqValidationError is the validation error returned by
+// UpdateCommentReq.Validate if the designated constraints aren't met.
+type UpdateCommentReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCommentReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCommentReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCommentReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCommentReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCommentReqValidationError) ErrorName() string { return "UpdateCommentReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateCommentReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCommentReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCommentReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCommentReqValidationError{}
+
+// Validate checks the field values on DeleteCommentReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCommentReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCommentReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCommentReqMultiError, or nil if none found.
+func (m *DeleteCommentReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCommentReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteCommentReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteCommentReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteCommentReqValidationError{
+				field:  "Id",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCommentId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteCommentReqValidationError{
+					field:  "CommentId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteCommentReqValidationError{
+					field:  "CommentId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommentId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteCommentReqValidationError{
+				field:  "CommentId",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteCommentReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCommentReqMultiError is an error wrapping multiple validation errors
+// returned by DeleteCommentReq.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteCommentReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCommentReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCommentReqMultiError) AllErrors() []error { return m }
+
+// DeleteCommentReqValidationError is the validation error returned by
+// DeleteCommentReq.Validate if the designated constraints aren't met.
+type DeleteCommentReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCommentReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCommentReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCommentReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCommentReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCommentReqValidationError) ErrorName() string { return "DeleteCommentReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteCommentReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCommentReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCommentReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCommentReqValidationError{}
+
+// Validate checks the field values on ListCommentsReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListCommentsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCommentsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCommentsReqMultiError, or nil if none found.
+func (m *ListCommentsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCommentsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListCommentsReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListCommentsReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListCommentsReqValidationError{
+				field:  "Id",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListCommentsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCommentsReqMultiError is an error wrapping multiple validation errors
+// returned by ListCommentsReq.ValidateAll() if the designated constraints
+// aren't met.
+type ListCommentsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCommentsReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCommentsReqMultiError) AllErrors() []error { return m }
+
+// ListCommentsReqValidationError is the validation error returned by
+// ListCommentsReq.Validate if the designated constraints aren't met.
+type ListCommentsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCommentsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCommentsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCommentsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCommentsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCommentsReqValidationError) ErrorName() string { return "ListCommentsReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListCommentsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCommentsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCommentsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCommentsReqValidationError{}
+
+// Validate checks the field values on ListCommentsResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListCommentsResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCommentsResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCommentsRespMultiError, or nil if none found.
+func (m *ListCommentsResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCommentsResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetComments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCommentsRespValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCommentsRespValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCommentsRespValidationError{
+					field:  fmt.Sprintf("Comments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListCommentsRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCommentsRespMultiError is an error wrapping multiple validation errors
+// returned by ListCommentsResp.ValidateAll() if the designated constraints
+// aren't met.
+type ListCommentsRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCommentsRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCommentsRespMultiError) AllErrors() []error { return m }
+
+// ListCommentsRespValidationError is the validation error returned by
+// ListCommentsResp.Validate if the designated constraints aren't met.
+type ListCommentsRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCommentsRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCommentsRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCommentsRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCommentsRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCommentsRespValidationError) ErrorName() string { return "ListCommentsRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListCommentsRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCommentsResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCommentsRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCommentsRespValidationError{}
+
+// Validate checks the field values on ListPendingCommentsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListPendingCommentsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPendingCommentsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPendingCommentsReqMultiError, or nil if none found.
+func (m *ListPendingCommentsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPendingCommentsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListPendingCommentsReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListPendingCommentsReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListPendingCommentsReqValidationError{
+				field:  "Id",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListPendingCommentsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPendingCommentsReqMultiError is an error wrapping multiple validation
+// errors returned by ListPendingCommentsReq.ValidateAll() if the designated
+// constraints aren't met.
+type ListPendingCommentsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPendingCommentsReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPendingCommentsReqMultiError) AllErrors() []error { return m }
+
+// ListPendingCommentsReqValidationError is the validation error returned by
+// ListPendingCommentsReq.Validate if the designated constraints aren't met.
+type ListPendingCommentsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPendingCommentsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPendingCommentsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPendingCommentsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPendingCommentsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPendingCommentsReqValidationError) ErrorName() string {
+	return "ListPendingCommentsReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPendingCommentsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPendingCommentsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPendingCommentsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPendingCommentsReqValidationError{}
+
+// Validate checks the field values on ListPendingCommentsResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListPendingCommentsResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPendingCommentsResp with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPendingCommentsRespMultiError, or nil if none found.
+func (m *ListPendingCommentsResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPendingCommentsResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetComments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPendingCommentsRespValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPendingCommentsRespValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPendingCommentsRespValidationError{
+					field:  fmt.Sprintf("Comments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListPendingCommentsRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPendingCommentsRespMultiError is an error wrapping multiple validation
+// errors returned by ListPendingCommentsResp.ValidateAll() if the designated
+// constraints aren't met.
+type ListPendingCommentsRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPendingCommentsRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPendingCommentsRespMultiError) AllErrors() []error { return m }
+
+// ListPendingCommentsRespValidationError is the validation error returned by
+// ListPendingCommentsResp.Validate if the designated constraints aren't met.
+type ListPendingCommentsRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPendingCommentsRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPendingCommentsRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPendingCommentsRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPendingCommentsRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPendingCommentsRespValidationError) ErrorName() string {
+	return "ListPendingCommentsRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPendingCommentsRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPendingCommentsResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPendingCommentsRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPendingCommentsRespValidationError{}
+
+// Validate checks the field values on ModerateCommentReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ModerateCommentReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModerateCommentReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ModerateCommentReqMultiError, or nil if none found.
+func (m *ModerateCommentReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModerateCommentReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModerateCommentReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModerateCommentReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModerateCommentReqValidationError{
+				field:  "Id",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCommentId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModerateCommentReqValidationError{
+					field:  "CommentId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModerateCommentReqValidationError{
+					field:  "CommentId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommentId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModerateCommentReqValidationError{
+				field:  "CommentId",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for State
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return ModerateCommentReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModerateCommentReqMultiError is an error wrapping multiple validation errors
+// returned by ModerateCommentReq.ValidateAll() if the designated constraints
+// aren't met.
+type ModerateCommentReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModerateCommentReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModerateCommentReqMultiError) AllErrors() []error { return m }
+
+// ModerateCommentReqValidationError is the validation error returned by
+// ModerateCommentReq.Validate if the designated constraints aren't met.
+type ModerateCommentReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModerateCommentReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModerateCommentReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModerateCommentReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModerateCommentReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModerateCommentReqValidationError) ErrorName() string {
+	return "ModerateCommentReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ModerateCommentReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModerateCommentReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModerateCommentReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModerateCommentReqValidationError{}
+
+// Validate checks the field values on CommentVerdict with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CommentVerdict) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommentVerdict with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CommentVerdictMultiError,
+// or nil if none found.
+func (m *CommentVerdict) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommentVerdict) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Classifier
+
+	// no validation rules for State
+
+	// no validation rules for Reason
+
+	// no validation rules for Score
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommentVerdictValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommentVerdictValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommentVerdictValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CommentVerdictMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommentVerdictMultiError is an error wrapping multiple validation errors
+// returned by CommentVerdict.ValidateAll() if the designated constraints
+// aren't met.
+type CommentVerdictMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommentVerdictMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommentVerdictMultiError) AllErrors() []error { return m }
+
+// CommentVerdictValidationError is the validation error returned by
+// CommentVerdict.Validate if the designated constraints aren't met.
+type CommentVerdictValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommentVerdictValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommentVerdictValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommentVerdictValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommentVerdictValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommentVerdictValidationError) ErrorName() string { return "CommentVerdictValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CommentVerdictValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommentVerdict.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommentVerdictValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommentVerdictValidationError{}
+
+// Validate checks the field values on ListCommentVerdictsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListCommentVerdictsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCommentVerdictsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCommentVerdictsReqMultiError, or nil if none found.
+func (m *ListCommentVerdictsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCommentVerdictsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListCommentVerdictsReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListCommentVerdictsReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListCommentVerdictsReqValidationError{
+				field:  "Id",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCommentId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListCommentVerdictsReqValidationError{
+					field:  "CommentId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListCommentVerdictsReqValidationError{
+					field:  "CommentId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommentId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListCommentVerdictsReqValidationError{
+				field:  "CommentId",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListCommentVerdictsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCommentVerdictsReqMultiError is an error wrapping multiple validation
+// errors returned by ListCommentVerdictsReq.ValidateAll() if the designated
+// constraints aren't met.
+type ListCommentVerdictsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCommentVerdictsReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCommentVerdictsReqMultiError) AllErrors() []error { return m }
+
+// ListCommentVerdictsReqValidationError is the validation error returned by
+// ListCommentVerdictsReq.Validate if the designated constraints aren't met.
+type ListCommentVerdictsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCommentVerdictsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCommentVerdictsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCommentVerdictsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCommentVerdictsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCommentVerdictsReqValidationError) ErrorName() string {
+	return "ListCommentVerdictsReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCommentVerdictsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCommentVerdictsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCommentVerdictsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCommentVerdictsReqValidationError{}
+
+// Validate checks the field values on ListCommentVerdictsResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListCommentVerdictsResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCommentVerdictsResp with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCommentVerdictsRespMultiError, or nil if none found.
+func (m *ListCommentVerdictsResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCommentVerdictsResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVerdicts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCommentVerdictsRespValidationError{
+						field:  fmt.Sprintf("Verdicts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCommentVerdictsRespValidationError{
+						field:  fmt.Sprintf("Verdicts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCommentVerdictsRespValidationError{
+					field:  fmt.Sprintf("Verdicts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListCommentVerdictsRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCommentVerdictsRespMultiError is an error wrapping multiple validation
+// errors returned by ListCommentVerdictsResp.ValidateAll() if the designated
+// constraints aren't met.
+type ListCommentVerdictsRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCommentVerdictsRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCommentVerdictsRespMultiError) AllErrors() []error { return m }
+
+// ListCommentVerdictsRespValidationError is the validation error returned by
+// ListCommentVerdictsResp.Validate if the designated constraints aren't met.
+type ListCommentVerdictsRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCommentVerdictsRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCommentVerdictsRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCommentVerdictsRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCommentVerdictsRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCommentVerdictsRespValidationError) ErrorName() string {
+	return "ListCommentVerdictsRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCommentVerdictsRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCommentVerdictsResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCommentVerdictsRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCommentVerdictsRespValidationError{}
+
+// Validate checks the field values on UndeleteReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UndeleteReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UndeleteReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UndeleteReqMultiError, or
+// nil if none found.
+func (m *UndeleteReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UndeleteReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UndeleteReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UndeleteReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UndeleteReqValidationError{
+				field:  "Id",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UndeleteReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// UndeleteReqMultiError is an error wrapping multiple validation errors
+// returned by UndeleteReq.ValidateAll() if the designated constraints aren't met.
+type UndeleteReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UndeleteReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UndeleteReqMultiError) AllErrors() []error { return m }
+
+// UndeleteReqValidationError is the validation error returned by
+// UndeleteReq.Validate if the designated constraints aren't met.
+type UndeleteReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UndeleteReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UndeleteReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UndeleteReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UndeleteReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UndeleteReqValidationError) ErrorName() string { return "UndeleteReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UndeleteReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UndeleteReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UndeleteReqValidationError{}
+
+// Validate checks the field values on ListDeletedReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListDeletedReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeletedReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListDeletedReqMultiError,
+// or nil if none found.
+func (m *ListDeletedReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeletedReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
-		return BlogMultiError(errors)
+		return ListDeletedReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// BlogMultiError is an error wrapping multiple validation errors returned by
-// Blog.ValidateAll() if the designated constraints aren't met.
-type BlogMultiError []error
+// ListDeletedReqMultiError is an error wrapping multiple validation errors
+// returned by ListDeletedReq.ValidateAll() if the designated constraints
+// aren't met.
+type ListDeletedReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BlogMultiError) Error() string {
+func (m ListDeletedReqMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -302,11 +5573,11 @@ func (m BlogMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BlogMultiError) AllErrors() []error { return m }
+func (m ListDeletedReqMultiError) AllErrors() []error { return m }
 
-// BlogValidationError is the validation error returned by Blog.Validate if the
-// designated constraints aren't met.
-type BlogValidationError struct {
+// ListDeletedReqValidationError is the validation error returned by
+// ListDeletedReq.Validate if the designated constraints aren't met.
+type ListDeletedReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -314,22 +5585,22 @@ type BlogValidationError struct {
 }
 
 // Field function returns field value.
-func (e BlogValidationError) Field() string { return e.field }
+func (e ListDeletedReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BlogValidationError) Reason() string { return e.reason }
+func (e ListDeletedReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BlogValidationError) Cause() error { return e.cause }
+func (e ListDeletedReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BlogValidationError) Key() bool { return e.key }
+func (e ListDeletedReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BlogValidationError) ErrorName() string { return "BlogValidationError" }
+func (e ListDeletedReqValidationError) ErrorName() string { return "ListDeletedReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e BlogValidationError) Error() string {
+func (e ListDeletedReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -341,14 +5612,14 @@ func (e BlogValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBlog.%s: %s%s",
+		"invalid %sListDeletedReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BlogValidationError{}
+var _ error = ListDeletedReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -356,85 +5627,189 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BlogValidationError{}
+} = ListDeletedReqValidationError{}
 
-// Validate checks the field values on Comment with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Comment) Validate() error {
+// Validate checks the field values on ListDeletedResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListDeletedResp) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Comment with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in CommentMultiError, or nil if none found.
-func (m *Comment) ValidateAll() error {
+// ValidateAll checks the field values on ListDeletedResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeletedRespMultiError, or nil if none found.
+func (m *ListDeletedResp) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Comment) validate(all bool) error {
+func (m *ListDeletedResp) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetId()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CommentValidationError{
-					field:  "Id",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetBlogs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeletedRespValidationError{
+						field:  fmt.Sprintf("Blogs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeletedRespValidationError{
+						field:  fmt.Sprintf("Blogs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CommentValidationError{
-					field:  "Id",
+				return ListDeletedRespValidationError{
+					field:  fmt.Sprintf("Blogs[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CommentValidationError{
-				field:  "Id",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
-	// no validation rules for Content
+	// no validation rules for NextPageToken
 
-	// no validation rules for Author
+	if len(errors) > 0 {
+		return ListDeletedRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeletedRespMultiError is an error wrapping multiple validation errors
+// returned by ListDeletedResp.ValidateAll() if the designated constraints
+// aren't met.
+type ListDeletedRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeletedRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeletedRespMultiError) AllErrors() []error { return m }
+
+// ListDeletedRespValidationError is the validation error returned by
+// ListDeletedResp.Validate if the designated constraints aren't met.
+type ListDeletedRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeletedRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeletedRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeletedRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeletedRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeletedRespValidationError) ErrorName() string { return "ListDeletedRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListDeletedRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeletedResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeletedRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeletedRespValidationError{}
+
+// Validate checks the field values on PublishReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PublishReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PublishReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PublishReqMultiError, or
+// nil if none found.
+func (m *PublishReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PublishReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
+		switch v := interface{}(m.GetId()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CommentValidationError{
-					field:  "CreatedAt",
+				errors = append(errors, PublishReqValidationError{
+					field:  "Id",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CommentValidationError{
-					field:  "CreatedAt",
+				errors = append(errors, PublishReqValidationError{
+					field:  "Id",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CommentValidationError{
-				field:  "CreatedAt",
+			return PublishReqValidationError{
+				field:  "Id",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -442,18 +5817,18 @@ func (m *Comment) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CommentMultiError(errors)
+		return PublishReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// CommentMultiError is an error wrapping multiple validation errors returned
-// by Comment.ValidateAll() if the designated constraints aren't met.
-type CommentMultiError []error
+// PublishReqMultiError is an error wrapping multiple validation errors
+// returned by PublishReq.ValidateAll() if the designated constraints aren't met.
+type PublishReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CommentMultiError) Error() string {
+func (m PublishReqMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -462,11 +5837,11 @@ func (m CommentMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CommentMultiError) AllErrors() []error { return m }
+func (m PublishReqMultiError) AllErrors() []error { return m }
 
-// CommentValidationError is the validation error returned by Comment.Validate
-// if the designated constraints aren't met.
-type CommentValidationError struct {
+// PublishReqValidationError is the validation error returned by
+// PublishReq.Validate if the designated constraints aren't met.
+type PublishReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -474,22 +5849,22 @@ type CommentValidationError struct {
 }
 
 // Field function returns field value.
-func (e CommentValidationError) Field() string { return e.field }
+func (e PublishReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CommentValidationError) Reason() string { return e.reason }
+func (e PublishReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CommentValidationError) Cause() error { return e.cause }
+func (e PublishReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CommentValidationError) Key() bool { return e.key }
+func (e PublishReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CommentValidationError) ErrorName() string { return "CommentValidationError" }
+func (e PublishReqValidationError) ErrorName() string { return "PublishReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CommentValidationError) Error() string {
+func (e PublishReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -501,14 +5876,14 @@ func (e CommentValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sComment.%s: %s%s",
+		"invalid %sPublishReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CommentValidationError{}
+var _ error = PublishReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -516,47 +5891,72 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CommentValidationError{}
+} = PublishReqValidationError{}
 
-// Validate checks the field values on CreateReq with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on UnpublishReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *CreateReq) Validate() error {
+func (m *UnpublishReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateReq with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CreateReqMultiError, or nil
-// if none found.
-func (m *CreateReq) ValidateAll() error {
+// ValidateAll checks the field values on UnpublishReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UnpublishReqMultiError, or
+// nil if none found.
+func (m *UnpublishReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateReq) validate(all bool) error {
+func (m *UnpublishReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Title
-
-	// no validation rules for Content
+	if all {
+		switch v := interface{}(m.GetId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UnpublishReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UnpublishReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UnpublishReqValidationError{
+				field:  "Id",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return CreateReqMultiError(errors)
+		return UnpublishReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateReqMultiError is an error wrapping multiple validation errors returned
-// by CreateReq.ValidateAll() if the designated constraints aren't met.
-type CreateReqMultiError []error
+// UnpublishReqMultiError is an error wrapping multiple validation errors
+// returned by UnpublishReq.ValidateAll() if the designated constraints aren't met.
+type UnpublishReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateReqMultiError) Error() string {
+func (m UnpublishReqMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -565,11 +5965,11 @@ func (m CreateReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateReqMultiError) AllErrors() []error { return m }
+func (m UnpublishReqMultiError) AllErrors() []error { return m }
 
-// CreateReqValidationError is the validation error returned by
-// CreateReq.Validate if the designated constraints aren't met.
-type CreateReqValidationError struct {
+// UnpublishReqValidationError is the validation error returned by
+// UnpublishReq.Validate if the designated constraints aren't met.
+type UnpublishReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -577,22 +5977,22 @@ type CreateReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateReqValidationError) Field() string { return e.field }
+func (e UnpublishReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateReqValidationError) Reason() string { return e.reason }
+func (e UnpublishReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateReqValidationError) Cause() error { return e.cause }
+func (e UnpublishReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateReqValidationError) Key() bool { return e.key }
+func (e UnpublishReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateReqValidationError) ErrorName() string { return "CreateReqValidationError" }
+func (e UnpublishReqValidationError) ErrorName() string { return "UnpublishReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateReqValidationError) Error() string {
+func (e UnpublishReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -604,14 +6004,14 @@ func (e CreateReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateReq.%s: %s%s",
+		"invalid %sUnpublishReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateReqValidationError{}
+var _ error = UnpublishReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -619,24 +6019,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateReqValidationError{}
+} = UnpublishReqValidationError{}
 
-// Validate checks the field values on CreateResp with the rules defined in the
+// Validate checks the field values on Revision with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *CreateResp) Validate() error {
+func (m *Revision) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateResp with the rules defined in
+// ValidateAll checks the field values on Revision with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CreateRespMultiError, or
-// nil if none found.
-func (m *CreateResp) ValidateAll() error {
+// result is a list of violation errors wrapped in RevisionMultiError, or nil
+// if none found.
+func (m *Revision) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateResp) validate(all bool) error {
+func (m *Revision) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -644,28 +6044,65 @@ func (m *CreateResp) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetId()).(type) {
+		switch v := interface{}(m.GetBlogId()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateRespValidationError{
-					field:  "Id",
+				errors = append(errors, RevisionValidationError{
+					field:  "BlogId",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateRespValidationError{
-					field:  "Id",
+				errors = append(errors, RevisionValidationError{
+					field:  "BlogId",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetBlogId()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateRespValidationError{
-				field:  "Id",
+			return RevisionValidationError{
+				field:  "BlogId",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Number
+
+	// no validation rules for Title
+
+	// no validation rules for Content
+
+	// no validation rules for Editor
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevisionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevisionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevisionValidationError{
+				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -673,18 +6110,18 @@ func (m *CreateResp) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateRespMultiError(errors)
+		return RevisionMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateRespMultiError is an error wrapping multiple validation errors
-// returned by CreateResp.ValidateAll() if the designated constraints aren't met.
-type CreateRespMultiError []error
+// RevisionMultiError is an error wrapping multiple validation errors returned
+// by Revision.ValidateAll() if the designated constraints aren't met.
+type RevisionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateRespMultiError) Error() string {
+func (m RevisionMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -693,11 +6130,11 @@ func (m CreateRespMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateRespMultiError) AllErrors() []error { return m }
+func (m RevisionMultiError) AllErrors() []error { return m }
 
-// CreateRespValidationError is the validation error returned by
-// CreateResp.Validate if the designated constraints aren't met.
-type CreateRespValidationError struct {
+// RevisionValidationError is the validation error returned by
+// Revision.Validate if the designated constraints aren't met.
+type RevisionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -705,22 +6142,22 @@ type CreateRespValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateRespValidationError) Field() string { return e.field }
+func (e RevisionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateRespValidationError) Reason() string { return e.reason }
+func (e RevisionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateRespValidationError) Cause() error { return e.cause }
+func (e RevisionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateRespValidationError) Key() bool { return e.key }
+func (e RevisionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateRespValidationError) ErrorName() string { return "CreateRespValidationError" }
+func (e RevisionValidationError) ErrorName() string { return "RevisionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateRespValidationError) Error() string {
+func (e RevisionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -732,14 +6169,14 @@ func (e CreateRespValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateResp.%s: %s%s",
+		"invalid %sRevision.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateRespValidationError{}
+var _ error = RevisionValidationError{}
 
 var _ interface {
 	Field() string
@@ -747,23 +6184,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateRespValidationError{}
+} = RevisionValidationError{}
 
-// Validate checks the field values on GetReq with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetReq) Validate() error {
+// Validate checks the field values on ListRevisionsReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRevisionsReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReq with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in GetReqMultiError, or nil if none found.
-func (m *GetReq) ValidateAll() error {
+// ValidateAll checks the field values on ListRevisionsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRevisionsReqMultiError, or nil if none found.
+func (m *ListRevisionsReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReq) validate(all bool) error {
+func (m *ListRevisionsReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -774,7 +6212,7 @@ func (m *GetReq) validate(all bool) error {
 		switch v := interface{}(m.GetId()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetReqValidationError{
+				errors = append(errors, ListRevisionsReqValidationError{
 					field:  "Id",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -782,7 +6220,7 @@ func (m *GetReq) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetReqValidationError{
+				errors = append(errors, ListRevisionsReqValidationError{
 					field:  "Id",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -791,7 +6229,7 @@ func (m *GetReq) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetReqValidationError{
+			return ListRevisionsReqValidationError{
 				field:  "Id",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -799,19 +6237,24 @@ func (m *GetReq) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
-		return GetReqMultiError(errors)
+		return ListRevisionsReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReqMultiError is an error wrapping multiple validation errors returned by
-// GetReq.ValidateAll() if the designated constraints aren't met.
-type GetReqMultiError []error
+// ListRevisionsReqMultiError is an error wrapping multiple validation errors
+// returned by ListRevisionsReq.ValidateAll() if the designated constraints
+// aren't met.
+type ListRevisionsReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReqMultiError) Error() string {
+func (m ListRevisionsReqMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -820,11 +6263,11 @@ func (m GetReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReqMultiError) AllErrors() []error { return m }
+func (m ListRevisionsReqMultiError) AllErrors() []error { return m }
 
-// GetReqValidationError is the validation error returned by GetReq.Validate if
-// the designated constraints aren't met.
-type GetReqValidationError struct {
+// ListRevisionsReqValidationError is the validation error returned by
+// ListRevisionsReq.Validate if the designated constraints aren't met.
+type ListRevisionsReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -832,22 +6275,22 @@ type GetReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReqValidationError) Field() string { return e.field }
+func (e ListRevisionsReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReqValidationError) Reason() string { return e.reason }
+func (e ListRevisionsReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReqValidationError) Cause() error { return e.cause }
+func (e ListRevisionsReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReqValidationError) Key() bool { return e.key }
+func (e ListRevisionsReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReqValidationError) ErrorName() string { return "GetReqValidationError" }
+func (e ListRevisionsReqValidationError) ErrorName() string { return "ListRevisionsReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetReqValidationError) Error() string {
+func (e ListRevisionsReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -859,14 +6302,14 @@ func (e GetReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReq.%s: %s%s",
+		"invalid %sListRevisionsReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReqValidationError{}
+var _ error = ListRevisionsReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -874,71 +6317,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReqValidationError{}
+} = ListRevisionsReqValidationError{}
 
-// Validate checks the field values on GetResp with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetResp) Validate() error {
+// Validate checks the field values on ListRevisionsResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRevisionsResp) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetResp with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in GetRespMultiError, or nil if none found.
-func (m *GetResp) ValidateAll() error {
+// ValidateAll checks the field values on ListRevisionsResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRevisionsRespMultiError, or nil if none found.
+func (m *ListRevisionsResp) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetResp) validate(all bool) error {
+func (m *ListRevisionsResp) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetBlog()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetRespValidationError{
-					field:  "Blog",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetRevisions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRevisionsRespValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRevisionsRespValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetRespValidationError{
-					field:  "Blog",
+				return ListRevisionsRespValidationError{
+					field:  fmt.Sprintf("Revisions[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetBlog()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetRespValidationError{
-				field:  "Blog",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return GetRespMultiError(errors)
+		return ListRevisionsRespMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRespMultiError is an error wrapping multiple validation errors returned
-// by GetResp.ValidateAll() if the designated constraints aren't met.
-type GetRespMultiError []error
+// ListRevisionsRespMultiError is an error wrapping multiple validation errors
+// returned by ListRevisionsResp.ValidateAll() if the designated constraints
+// aren't met.
+type ListRevisionsRespMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRespMultiError) Error() string {
+func (m ListRevisionsRespMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -947,11 +6399,11 @@ func (m GetRespMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRespMultiError) AllErrors() []error { return m }
+func (m ListRevisionsRespMultiError) AllErrors() []error { return m }
 
-// GetRespValidationError is the validation error returned by GetResp.Validate
-// if the designated constraints aren't met.
-type GetRespValidationError struct {
+// ListRevisionsRespValidationError is the validation error returned by
+// ListRevisionsResp.Validate if the designated constraints aren't met.
+type ListRevisionsRespValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -959,22 +6411,24 @@ type GetRespValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRespValidationError) Field() string { return e.field }
+func (e ListRevisionsRespValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRespValidationError) Reason() string { return e.reason }
+func (e ListRevisionsRespValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRespValidationError) Cause() error { return e.cause }
+func (e ListRevisionsRespValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRespValidationError) Key() bool { return e.key }
+func (e ListRevisionsRespValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRespValidationError) ErrorName() string { return "GetRespValidationError" }
+func (e ListRevisionsRespValidationError) ErrorName() string {
+	return "ListRevisionsRespValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetRespValidationError) Error() string {
+func (e ListRevisionsRespValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -986,14 +6440,14 @@ func (e GetRespValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetResp.%s: %s%s",
+		"invalid %sListRevisionsResp.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRespValidationError{}
+var _ error = ListRevisionsRespValidationError{}
 
 var _ interface {
 	Field() string
@@ -1001,24 +6455,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRespValidationError{}
+} = ListRevisionsRespValidationError{}
 
-// Validate checks the field values on UpdateReq with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on GetRevisionReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *UpdateReq) Validate() error {
+func (m *GetRevisionReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateReq with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in UpdateReqMultiError, or nil
-// if none found.
-func (m *UpdateReq) ValidateAll() error {
+// ValidateAll checks the field values on GetRevisionReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetRevisionReqMultiError,
+// or nil if none found.
+func (m *GetRevisionReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateReq) validate(all bool) error {
+func (m *GetRevisionReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1029,7 +6483,7 @@ func (m *UpdateReq) validate(all bool) error {
 		switch v := interface{}(m.GetId()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateReqValidationError{
+				errors = append(errors, GetRevisionReqValidationError{
 					field:  "Id",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1037,7 +6491,7 @@ func (m *UpdateReq) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateReqValidationError{
+				errors = append(errors, GetRevisionReqValidationError{
 					field:  "Id",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1046,7 +6500,7 @@ func (m *UpdateReq) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateReqValidationError{
+			return GetRevisionReqValidationError{
 				field:  "Id",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1054,27 +6508,22 @@ func (m *UpdateReq) validate(all bool) error {
 		}
 	}
 
-	if m.Title != nil {
-		// no validation rules for Title
-	}
-
-	if m.Content != nil {
-		// no validation rules for Content
-	}
+	// no validation rules for Revision
 
 	if len(errors) > 0 {
-		return UpdateReqMultiError(errors)
+		return GetRevisionReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateReqMultiError is an error wrapping multiple validation errors returned
-// by UpdateReq.ValidateAll() if the designated constraints aren't met.
-type UpdateReqMultiError []error
+// GetRevisionReqMultiError is an error wrapping multiple validation errors
+// returned by GetRevisionReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetRevisionReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateReqMultiError) Error() string {
+func (m GetRevisionReqMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1083,11 +6532,11 @@ func (m UpdateReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateReqMultiError) AllErrors() []error { return m }
+func (m GetRevisionReqMultiError) AllErrors() []error { return m }
 
-// UpdateReqValidationError is the validation error returned by
-// UpdateReq.Validate if the designated constraints aren't met.
-type UpdateReqValidationError struct {
+// GetRevisionReqValidationError is the validation error returned by
+// GetRevisionReq.Validate if the designated constraints aren't met.
+type GetRevisionReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1095,22 +6544,22 @@ type UpdateReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateReqValidationError) Field() string { return e.field }
+func (e GetRevisionReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateReqValidationError) Reason() string { return e.reason }
+func (e GetRevisionReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateReqValidationError) Cause() error { return e.cause }
+func (e GetRevisionReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateReqValidationError) Key() bool { return e.key }
+func (e GetRevisionReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateReqValidationError) ErrorName() string { return "UpdateReqValidationError" }
+func (e GetRevisionReqValidationError) ErrorName() string { return "GetRevisionReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateReqValidationError) Error() string {
+func (e GetRevisionReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1122,14 +6571,14 @@ func (e UpdateReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateReq.%s: %s%s",
+		"invalid %sGetRevisionReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateReqValidationError{}
+var _ error = GetRevisionReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -1137,24 +6586,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateReqValidationError{}
+} = GetRevisionReqValidationError{}
 
-// Validate checks the field values on DeleteReq with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DeleteReq) Validate() error {
+// Validate checks the field values on GetRevisionResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetRevisionResp) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteReq with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DeleteReqMultiError, or nil
-// if none found.
-func (m *DeleteReq) ValidateAll() error {
+// ValidateAll checks the field values on GetRevisionResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRevisionRespMultiError, or nil if none found.
+func (m *GetRevisionResp) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteReq) validate(all bool) error {
+func (m *GetRevisionResp) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1162,28 +6611,28 @@ func (m *DeleteReq) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetId()).(type) {
+		switch v := interface{}(m.GetRevision()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DeleteReqValidationError{
-					field:  "Id",
+				errors = append(errors, GetRevisionRespValidationError{
+					field:  "Revision",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DeleteReqValidationError{
-					field:  "Id",
+				errors = append(errors, GetRevisionRespValidationError{
+					field:  "Revision",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRevision()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DeleteReqValidationError{
-				field:  "Id",
+			return GetRevisionRespValidationError{
+				field:  "Revision",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1191,18 +6640,19 @@ func (m *DeleteReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DeleteReqMultiError(errors)
+		return GetRevisionRespMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteReqMultiError is an error wrapping multiple validation errors returned
-// by DeleteReq.ValidateAll() if the designated constraints aren't met.
-type DeleteReqMultiError []error
+// GetRevisionRespMultiError is an error wrapping multiple validation errors
+// returned by GetRevisionResp.ValidateAll() if the designated constraints
+// aren't met.
+type GetRevisionRespMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteReqMultiError) Error() string {
+func (m GetRevisionRespMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1211,11 +6661,11 @@ func (m DeleteReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteReqMultiError) AllErrors() []error { return m }
+func (m GetRevisionRespMultiError) AllErrors() []error { return m }
 
-// DeleteReqValidationError is the validation error returned by
-// DeleteReq.Validate if the designated constraints aren't met.
-type DeleteReqValidationError struct {
+// GetRevisionRespValidationError is the validation error returned by
+// GetRevisionResp.Validate if the designated constraints aren't met.
+type GetRevisionRespValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1223,22 +6673,22 @@ type DeleteReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteReqValidationError) Field() string { return e.field }
+func (e GetRevisionRespValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteReqValidationError) Reason() string { return e.reason }
+func (e GetRevisionRespValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteReqValidationError) Cause() error { return e.cause }
+func (e GetRevisionRespValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteReqValidationError) Key() bool { return e.key }
+func (e GetRevisionRespValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteReqValidationError) ErrorName() string { return "DeleteReqValidationError" }
+func (e GetRevisionRespValidationError) ErrorName() string { return "GetRevisionRespValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteReqValidationError) Error() string {
+func (e GetRevisionRespValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1250,14 +6700,14 @@ func (e DeleteReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteReq.%s: %s%s",
+		"invalid %sGetRevisionResp.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteReqValidationError{}
+var _ error = GetRevisionRespValidationError{}
 
 var _ interface {
 	Field() string
@@ -1265,46 +6715,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteReqValidationError{}
+} = GetRevisionRespValidationError{}
 
-// Validate checks the field values on ListReq with the rules defined in the
+// Validate checks the field values on DiffChunk with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *ListReq) Validate() error {
+func (m *DiffChunk) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListReq with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ListReqMultiError, or nil if none found.
-func (m *ListReq) ValidateAll() error {
+// ValidateAll checks the field values on DiffChunk with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DiffChunkMultiError, or nil
+// if none found.
+func (m *DiffChunk) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListReq) validate(all bool) error {
+func (m *DiffChunk) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PageSize
+	// no validation rules for Op
 
-	// no validation rules for PageToken
+	// no validation rules for Text
 
 	if len(errors) > 0 {
-		return ListReqMultiError(errors)
+		return DiffChunkMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListReqMultiError is an error wrapping multiple validation errors returned
-// by ListReq.ValidateAll() if the designated constraints aren't met.
-type ListReqMultiError []error
+// DiffChunkMultiError is an error wrapping multiple validation errors returned
+// by DiffChunk.ValidateAll() if the designated constraints aren't met.
+type DiffChunkMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListReqMultiError) Error() string {
+func (m DiffChunkMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1313,11 +6764,11 @@ func (m ListReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListReqMultiError) AllErrors() []error { return m }
+func (m DiffChunkMultiError) AllErrors() []error { return m }
 
-// ListReqValidationError is the validation error returned by ListReq.Validate
-// if the designated constraints aren't met.
-type ListReqValidationError struct {
+// DiffChunkValidationError is the validation error returned by
+// DiffChunk.Validate if the designated constraints aren't met.
+type DiffChunkValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1325,22 +6776,22 @@ type ListReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListReqValidationError) Field() string { return e.field }
+func (e DiffChunkValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListReqValidationError) Reason() string { return e.reason }
+func (e DiffChunkValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListReqValidationError) Cause() error { return e.cause }
+func (e DiffChunkValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListReqValidationError) Key() bool { return e.key }
+func (e DiffChunkValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListReqValidationError) ErrorName() string { return "ListReqValidationError" }
+func (e DiffChunkValidationError) ErrorName() string { return "DiffChunkValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListReqValidationError) Error() string {
+func (e DiffChunkValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1352,14 +6803,14 @@ func (e ListReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListReq.%s: %s%s",
+		"invalid %sDiffChunk.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListReqValidationError{}
+var _ error = DiffChunkValidationError{}
 
 var _ interface {
 	Field() string
@@ -1367,79 +6818,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListReqValidationError{}
+} = DiffChunkValidationError{}
 
-// Validate checks the field values on ListResp with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ListResp) Validate() error {
+// Validate checks the field values on DiffRevisionsReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DiffRevisionsReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListResp with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ListRespMultiError, or nil
-// if none found.
-func (m *ListResp) ValidateAll() error {
+// ValidateAll checks the field values on DiffRevisionsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffRevisionsReqMultiError, or nil if none found.
+func (m *DiffRevisionsReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListResp) validate(all bool) error {
+func (m *DiffRevisionsReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetBlogs() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListRespValidationError{
-						field:  fmt.Sprintf("Blogs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListRespValidationError{
-						field:  fmt.Sprintf("Blogs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiffRevisionsReqValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListRespValidationError{
-					field:  fmt.Sprintf("Blogs[%v]", idx),
+				errors = append(errors, DiffRevisionsReqValidationError{
+					field:  "Id",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiffRevisionsReqValidationError{
+				field:  "Id",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for NextPageToken
+	// no validation rules for FromRevision
+
+	// no validation rules for ToRevision
+
+	// no validation rules for Mode
 
 	if len(errors) > 0 {
-		return ListRespMultiError(errors)
+		return DiffRevisionsReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListRespMultiError is an error wrapping multiple validation errors returned
-// by ListResp.ValidateAll() if the designated constraints aren't met.
-type ListRespMultiError []error
+// DiffRevisionsReqMultiError is an error wrapping multiple validation errors
+// returned by DiffRevisionsReq.ValidateAll() if the designated constraints
+// aren't met.
+type DiffRevisionsReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListRespMultiError) Error() string {
+func (m DiffRevisionsReqMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1448,11 +6899,11 @@ func (m ListRespMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListRespMultiError) AllErrors() []error { return m }
+func (m DiffRevisionsReqMultiError) AllErrors() []error { return m }
 
-// ListRespValidationError is the validation error returned by
-// ListResp.Validate if the designated constraints aren't met.
-type ListRespValidationError struct {
+// DiffRevisionsReqValidationError is the validation error returned by
+// DiffRevisionsReq.Validate if the designated constraints aren't met.
+type DiffRevisionsReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1460,22 +6911,22 @@ type ListRespValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListRespValidationError) Field() string { return e.field }
+func (e DiffRevisionsReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListRespValidationError) Reason() string { return e.reason }
+func (e DiffRevisionsReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListRespValidationError) Cause() error { return e.cause }
+func (e DiffRevisionsReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListRespValidationError) Key() bool { return e.key }
+func (e DiffRevisionsReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListRespValidationError) ErrorName() string { return "ListRespValidationError" }
+func (e DiffRevisionsReqValidationError) ErrorName() string { return "DiffRevisionsReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListRespValidationError) Error() string {
+func (e DiffRevisionsReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1487,14 +6938,14 @@ func (e ListRespValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListResp.%s: %s%s",
+		"invalid %sDiffRevisionsReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListRespValidationError{}
+var _ error = DiffRevisionsReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -1502,76 +6953,112 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListRespValidationError{}
+} = DiffRevisionsReqValidationError{}
 
-// Validate checks the field values on BlogSummary with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *BlogSummary) Validate() error {
+// Validate checks the field values on DiffRevisionsResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DiffRevisionsResp) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BlogSummary with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in BlogSummaryMultiError, or
-// nil if none found.
-func (m *BlogSummary) ValidateAll() error {
+// ValidateAll checks the field values on DiffRevisionsResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffRevisionsRespMultiError, or nil if none found.
+func (m *DiffRevisionsResp) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BlogSummary) validate(all bool) error {
+func (m *DiffRevisionsResp) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetId()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, BlogSummaryValidationError{
-					field:  "Id",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetTitle() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiffRevisionsRespValidationError{
+						field:  fmt.Sprintf("Title[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiffRevisionsRespValidationError{
+						field:  fmt.Sprintf("Title[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, BlogSummaryValidationError{
-					field:  "Id",
+				return DiffRevisionsRespValidationError{
+					field:  fmt.Sprintf("Title[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return BlogSummaryValidationError{
-				field:  "Id",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
-	// no validation rules for Title
+	for idx, item := range m.GetContent() {
+		_, _ = idx, item
 
-	// no validation rules for CommentCount
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiffRevisionsRespValidationError{
+						field:  fmt.Sprintf("Content[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiffRevisionsRespValidationError{
+						field:  fmt.Sprintf("Content[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DiffRevisionsRespValidationError{
+					field:  fmt.Sprintf("Content[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return BlogSummaryMultiError(errors)
+		return DiffRevisionsRespMultiError(errors)
 	}
 
 	return nil
 }
 
-// BlogSummaryMultiError is an error wrapping multiple validation errors
-// returned by BlogSummary.ValidateAll() if the designated constraints aren't met.
-type BlogSummaryMultiError []error
+// DiffRevisionsRespMultiError is an error wrapping multiple validation errors
+// returned by DiffRevisionsResp.ValidateAll() if the designated constraints
+// aren't met.
+type DiffRevisionsRespMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BlogSummaryMultiError) Error() string {
+func (m DiffRevisionsRespMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1580,11 +7067,11 @@ func (m BlogSummaryMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BlogSummaryMultiError) AllErrors() []error { return m }
+func (m DiffRevisionsRespMultiError) AllErrors() []error { return m }
 
-// BlogSummaryValidationError is the validation error returned by
-// BlogSummary.Validate if the designated constraints aren't met.
-type BlogSummaryValidationError struct {
+// DiffRevisionsRespValidationError is the validation error returned by
+// DiffRevisionsResp.Validate if the designated constraints aren't met.
+type DiffRevisionsRespValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1592,22 +7079,24 @@ type BlogSummaryValidationError struct {
 }
 
 // Field function returns field value.
-func (e BlogSummaryValidationError) Field() string { return e.field }
+func (e DiffRevisionsRespValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BlogSummaryValidationError) Reason() string { return e.reason }
+func (e DiffRevisionsRespValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BlogSummaryValidationError) Cause() error { return e.cause }
+func (e DiffRevisionsRespValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BlogSummaryValidationError) Key() bool { return e.key }
+func (e DiffRevisionsRespValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BlogSummaryValidationError) ErrorName() string { return "BlogSummaryValidationError" }
+func (e DiffRevisionsRespValidationError) ErrorName() string {
+	return "DiffRevisionsRespValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e BlogSummaryValidationError) Error() string {
+func (e DiffRevisionsRespValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1619,14 +7108,14 @@ func (e BlogSummaryValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBlogSummary.%s: %s%s",
+		"invalid %sDiffRevisionsResp.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BlogSummaryValidationError{}
+var _ error = DiffRevisionsRespValidationError{}
 
 var _ interface {
 	Field() string
@@ -1634,24 +7123,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BlogSummaryValidationError{}
+} = DiffRevisionsRespValidationError{}
 
-// Validate checks the field values on AddCommentReq with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AddCommentReq) Validate() error {
+// Validate checks the field values on RestoreRevisionReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RestoreRevisionReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddCommentReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AddCommentReqMultiError, or
-// nil if none found.
-func (m *AddCommentReq) ValidateAll() error {
+// ValidateAll checks the field values on RestoreRevisionReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreRevisionReqMultiError, or nil if none found.
+func (m *RestoreRevisionReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddCommentReq) validate(all bool) error {
+func (m *RestoreRevisionReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1662,7 +7152,7 @@ func (m *AddCommentReq) validate(all bool) error {
 		switch v := interface{}(m.GetId()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AddCommentReqValidationError{
+				errors = append(errors, RestoreRevisionReqValidationError{
 					field:  "Id",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1670,7 +7160,7 @@ func (m *AddCommentReq) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AddCommentReqValidationError{
+				errors = append(errors, RestoreRevisionReqValidationError{
 					field:  "Id",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1679,7 +7169,7 @@ func (m *AddCommentReq) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AddCommentReqValidationError{
+			return RestoreRevisionReqValidationError{
 				field:  "Id",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1687,24 +7177,24 @@ func (m *AddCommentReq) validate(all bool) error {
 		}
 	}
 
-	// no validation rules for Content
+	// no validation rules for Revision
 
-	// no validation rules for Author
+	// no validation rules for Editor
 
 	if len(errors) > 0 {
-		return AddCommentReqMultiError(errors)
+		return RestoreRevisionReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddCommentReqMultiError is an error wrapping multiple validation errors
-// returned by AddCommentReq.ValidateAll() if the designated constraints
+// RestoreRevisionReqMultiError is an error wrapping multiple validation errors
+// returned by RestoreRevisionReq.ValidateAll() if the designated constraints
 // aren't met.
-type AddCommentReqMultiError []error
+type RestoreRevisionReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddCommentReqMultiError) Error() string {
+func (m RestoreRevisionReqMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1713,11 +7203,11 @@ func (m AddCommentReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddCommentReqMultiError) AllErrors() []error { return m }
+func (m RestoreRevisionReqMultiError) AllErrors() []error { return m }
 
-// AddCommentReqValidationError is the validation error returned by
-// AddCommentReq.Validate if the designated constraints aren't met.
-type AddCommentReqValidationError struct {
+// RestoreRevisionReqValidationError is the validation error returned by
+// RestoreRevisionReq.Validate if the designated constraints aren't met.
+type RestoreRevisionReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1725,22 +7215,24 @@ type AddCommentReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddCommentReqValidationError) Field() string { return e.field }
+func (e RestoreRevisionReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddCommentReqValidationError) Reason() string { return e.reason }
+func (e RestoreRevisionReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddCommentReqValidationError) Cause() error { return e.cause }
+func (e RestoreRevisionReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddCommentReqValidationError) Key() bool { return e.key }
+func (e RestoreRevisionReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddCommentReqValidationError) ErrorName() string { return "AddCommentReqValidationError" }
+func (e RestoreRevisionReqValidationError) ErrorName() string {
+	return "RestoreRevisionReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AddCommentReqValidationError) Error() string {
+func (e RestoreRevisionReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1752,14 +7244,14 @@ func (e AddCommentReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddCommentReq.%s: %s%s",
+		"invalid %sRestoreRevisionReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddCommentReqValidationError{}
+var _ error = RestoreRevisionReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -1767,4 +7259,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddCommentReqValidationError{}
+} = RestoreRevisionReqValidationError{}
@@ -20,12 +20,30 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Blogs_Create_FullMethodName     = "/blog.v1.Blogs/Create"
-	Blogs_Get_FullMethodName        = "/blog.v1.Blogs/Get"
-	Blogs_Update_FullMethodName     = "/blog.v1.Blogs/Update"
-	Blogs_Delete_FullMethodName     = "/blog.v1.Blogs/Delete"
-	Blogs_List_FullMethodName       = "/blog.v1.Blogs/List"
-	Blogs_AddComment_FullMethodName = "/blog.v1.Blogs/AddComment"
+	Blogs_Create_FullMethodName              = "/blog.v1.Blogs/Create"
+	Blogs_Get_FullMethodName                 = "/blog.v1.Blogs/Get"
+	Blogs_GetBySlug_FullMethodName           = "/blog.v1.Blogs/GetBySlug"
+	Blogs_Update_FullMethodName              = "/blog.v1.Blogs/Update"
+	Blogs_Delete_FullMethodName              = "/blog.v1.Blogs/Delete"
+	Blogs_List_FullMethodName                = "/blog.v1.Blogs/List"
+	Blogs_ListTags_FullMethodName            = "/blog.v1.Blogs/ListTags"
+	Blogs_Search_FullMethodName              = "/blog.v1.Blogs/Search"
+	Blogs_Undelete_FullMethodName            = "/blog.v1.Blogs/Undelete"
+	Blogs_ListDeleted_FullMethodName         = "/blog.v1.Blogs/ListDeleted"
+	Blogs_AddComment_FullMethodName          = "/blog.v1.Blogs/AddComment"
+	Blogs_GetComment_FullMethodName          = "/blog.v1.Blogs/GetComment"
+	Blogs_UpdateComment_FullMethodName       = "/blog.v1.Blogs/UpdateComment"
+	Blogs_DeleteComment_FullMethodName       = "/blog.v1.Blogs/DeleteComment"
+	Blogs_ListComments_FullMethodName        = "/blog.v1.Blogs/ListComments"
+	Blogs_ListPendingComments_FullMethodName = "/blog.v1.Blogs/ListPendingComments"
+	Blogs_ModerateComment_FullMethodName     = "/blog.v1.Blogs/ModerateComment"
+	Blogs_ListCommentVerdicts_FullMethodName = "/blog.v1.Blogs/ListCommentVerdicts"
+	Blogs_Publish_FullMethodName             = "/blog.v1.Blogs/Publish"
+	Blogs_Unpublish_FullMethodName           = "/blog.v1.Blogs/Unpublish"
+	Blogs_ListRevisions_FullMethodName       = "/blog.v1.Blogs/ListRevisions"
+	Blogs_GetRevision_FullMethodName         = "/blog.v1.Blogs/GetRevision"
+	Blogs_DiffRevisions_FullMethodName       = "/blog.v1.Blogs/DiffRevisions"
+	Blogs_RestoreRevision_FullMethodName     = "/blog.v1.Blogs/RestoreRevision"
 )
 
 // BlogsClient is the client API for Blogs service.
@@ -38,14 +56,54 @@ type BlogsClient interface {
 	Create(ctx context.Context, in *CreateReq, opts ...grpc.CallOption) (*CreateResp, error)
 	// Get retrieves a blog by ID
 	Get(ctx context.Context, in *GetReq, opts ...grpc.CallOption) (*GetResp, error)
+	// GetBySlug retrieves a blog by its current or a previous slug
+	GetBySlug(ctx context.Context, in *GetBySlugReq, opts ...grpc.CallOption) (*GetBySlugResp, error)
 	// Update updates an existing blog
 	Update(ctx context.Context, in *UpdateReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// Delete deletes a blog
+	// Delete moves a blog to the trash
 	Delete(ctx context.Context, in *DeleteReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// List lists blogs with pagination
 	List(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*ListResp, error)
+	// ListTags lists the tags of blogs with how many blogs have each
+	ListTags(ctx context.Context, in *ListTagsReq, opts ...grpc.CallOption) (*ListTagsResp, error)
+	// Search finds published blogs by the words they contain, best matches first
+	Search(ctx context.Context, in *SearchReq, opts ...grpc.CallOption) (*SearchResp, error)
+	// Undelete restores a blog from the trash
+	Undelete(ctx context.Context, in *UndeleteReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListDeleted lists the blogs in the trash
+	ListDeleted(ctx context.Context, in *ListDeletedReq, opts ...grpc.CallOption) (*ListDeletedResp, error)
 	// AddComment adds a comment to a blog
-	AddComment(ctx context.Context, in *AddCommentReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddComment(ctx context.Context, in *AddCommentReq, opts ...grpc.CallOption) (*AddCommentResp, error)
+	// GetComment retrieves a comment of a blog
+	GetComment(ctx context.Context, in *GetCommentReq, opts ...grpc.CallOption) (*GetCommentResp, error)
+	// UpdateComment edits the content of a comment
+	UpdateComment(ctx context.Context, in *UpdateCommentReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// DeleteComment deletes a comment along with its replies
+	DeleteComment(ctx context.Context, in *DeleteCommentReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListComments lists the comments of a blog, oldest first
+	ListComments(ctx context.Context, in *ListCommentsReq, opts ...grpc.CallOption) (*ListCommentsResp, error)
+	// ListPendingComments lists the comments waiting for a moderator, oldest
+	// first
+	ListPendingComments(ctx context.Context, in *ListPendingCommentsReq, opts ...grpc.CallOption) (*ListPendingCommentsResp, error)
+	// ModerateComment approves a comment or turns it down
+	ModerateComment(ctx context.Context, in *ModerateCommentReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListCommentVerdicts lists what the comment filters made of a comment
+	// when it was added
+	ListCommentVerdicts(ctx context.Context, in *ListCommentVerdictsReq, opts ...grpc.CallOption) (*ListCommentVerdictsResp, error)
+	// Publish makes a blog publicly listed
+	Publish(ctx context.Context, in *PublishReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Unpublish moves a published blog back to draft
+	Unpublish(ctx context.Context, in *UnpublishReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListRevisions lists the previous versions of a blog, newest first
+	ListRevisions(ctx context.Context, in *ListRevisionsReq, opts ...grpc.CallOption) (*ListRevisionsResp, error)
+	// GetRevision retrieves a previous version of a blog
+	GetRevision(ctx context.Context, in *GetRevisionReq, opts ...grpc.CallOption) (*GetRevisionResp, error)
+	// DiffRevisions compares a revision with a later revision or the current
+	// version of a blog
+	DiffRevisions(ctx context.Context, in *DiffRevisionsReq, opts ...grpc.CallOption) (*DiffRevisionsResp, error)
+	// RestoreRevision sets the title and content of a blog back to a revision,
+	// recording the replaced version as a new revision
+	RestoreRevision(ctx context.Context, in *RestoreRevisionReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type blogsClient struct {
@@ -76,6 +134,16 @@ func (c *blogsClient) Get(ctx context.Context, in *GetReq, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *blogsClient) GetBySlug(ctx context.Context, in *GetBySlugReq, opts ...grpc.CallOption) (*GetBySlugResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBySlugResp)
+	err := c.cc.Invoke(ctx, Blogs_GetBySlug_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blogsClient) Update(ctx context.Context, in *UpdateReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -106,9 +174,49 @@ func (c *blogsClient) List(ctx context.Context, in *ListReq, opts ...grpc.CallOp
 	return out, nil
 }
 
-func (c *blogsClient) AddComment(ctx context.Context, in *AddCommentReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *blogsClient) ListTags(ctx context.Context, in *ListTagsReq, opts ...grpc.CallOption) (*ListTagsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagsResp)
+	err := c.cc.Invoke(ctx, Blogs_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogsClient) Search(ctx context.Context, in *SearchReq, opts ...grpc.CallOption) (*SearchResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResp)
+	err := c.cc.Invoke(ctx, Blogs_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogsClient) Undelete(ctx context.Context, in *UndeleteReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Blogs_Undelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogsClient) ListDeleted(ctx context.Context, in *ListDeletedReq, opts ...grpc.CallOption) (*ListDeletedResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedResp)
+	err := c.cc.Invoke(ctx, Blogs_ListDeleted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogsClient) AddComment(ctx context.Context, in *AddCommentReq, opts ...grpc.CallOption) (*AddCommentResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddCommentResp)
 	err := c.cc.Invoke(ctx, Blogs_AddComment_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -116,6 +224,136 @@ func (c *blogsClient) AddComment(ctx context.Context, in *AddCommentReq, opts ..
 	return out, nil
 }
 
+func (c *blogsClient) GetComment(ctx context.Context, in *GetCommentReq, opts ...grpc.CallOption) (*GetCommentResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommentResp)
+	err := c.cc.Invoke(ctx, Blogs_GetComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogsClient) UpdateComment(ctx context.Context, in *UpdateCommentReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Blogs_UpdateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogsClient) DeleteComment(ctx context.Context, in *DeleteCommentReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Blogs_DeleteComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogsClient) ListComments(ctx context.Context, in *ListCommentsReq, opts ...grpc.CallOption) (*ListCommentsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommentsResp)
+	err := c.cc.Invoke(ctx, Blogs_ListComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogsClient) ListPendingComments(ctx context.Context, in *ListPendingCommentsReq, opts ...grpc.CallOption) (*ListPendingCommentsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingCommentsResp)
+	err := c.cc.Invoke(ctx, Blogs_ListPendingComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogsClient) ModerateComment(ctx context.Context, in *ModerateCommentReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Blogs_ModerateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogsClient) ListCommentVerdicts(ctx context.Context, in *ListCommentVerdictsReq, opts ...grpc.CallOption) (*ListCommentVerdictsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommentVerdictsResp)
+	err := c.cc.Invoke(ctx, Blogs_ListCommentVerdicts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogsClient) Publish(ctx context.Context, in *PublishReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Blogs_Publish_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogsClient) Unpublish(ctx context.Context, in *UnpublishReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Blogs_Unpublish_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogsClient) ListRevisions(ctx context.Context, in *ListRevisionsReq, opts ...grpc.CallOption) (*ListRevisionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRevisionsResp)
+	err := c.cc.Invoke(ctx, Blogs_ListRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogsClient) GetRevision(ctx context.Context, in *GetRevisionReq, opts ...grpc.CallOption) (*GetRevisionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRevisionResp)
+	err := c.cc.Invoke(ctx, Blogs_GetRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogsClient) DiffRevisions(ctx context.Context, in *DiffRevisionsReq, opts ...grpc.CallOption) (*DiffRevisionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffRevisionsResp)
+	err := c.cc.Invoke(ctx, Blogs_DiffRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogsClient) RestoreRevision(ctx context.Context, in *RestoreRevisionReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Blogs_RestoreRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BlogsServer is the server API for Blogs service.
 // All implementations must embed UnimplementedBlogsServer
 // for forward compatibility.
@@ -126,14 +364,54 @@ type BlogsServer interface {
 	Create(context.Context, *CreateReq) (*CreateResp, error)
 	// Get retrieves a blog by ID
 	Get(context.Context, *GetReq) (*GetResp, error)
+	// GetBySlug retrieves a blog by its current or a previous slug
+	GetBySlug(context.Context, *GetBySlugReq) (*GetBySlugResp, error)
 	// Update updates an existing blog
 	Update(context.Context, *UpdateReq) (*emptypb.Empty, error)
-	// Delete deletes a blog
+	// Delete moves a blog to the trash
 	Delete(context.Context, *DeleteReq) (*emptypb.Empty, error)
 	// List lists blogs with pagination
 	List(context.Context, *ListReq) (*ListResp, error)
+	// ListTags lists the tags of blogs with how many blogs have each
+	ListTags(context.Context, *ListTagsReq) (*ListTagsResp, error)
+	// Search finds published blogs by the words they contain, best matches first
+	Search(context.Context, *SearchReq) (*SearchResp, error)
+	// Undelete restores a blog from the trash
+	Undelete(context.Context, *UndeleteReq) (*emptypb.Empty, error)
+	// ListDeleted lists the blogs in the trash
+	ListDeleted(context.Context, *ListDeletedReq) (*ListDeletedResp, error)
 	// AddComment adds a comment to a blog
-	AddComment(context.Context, *AddCommentReq) (*emptypb.Empty, error)
+	AddComment(context.Context, *AddCommentReq) (*AddCommentResp, error)
+	// GetComment retrieves a comment of a blog
+	GetComment(context.Context, *GetCommentReq) (*GetCommentResp, error)
+	// UpdateComment edits the content of a comment
+	UpdateComment(context.Context, *UpdateCommentReq) (*emptypb.Empty, error)
+	// DeleteComment deletes a comment along with its replies
+	DeleteComment(context.Context, *DeleteCommentReq) (*emptypb.Empty, error)
+	// ListComments lists the comments of a blog, oldest first
+	ListComments(context.Context, *ListCommentsReq) (*ListCommentsResp, error)
+	// ListPendingComments lists the comments waiting for a moderator, oldest
+	// first
+	ListPendingComments(context.Context, *ListPendingCommentsReq) (*ListPendingCommentsResp, error)
+	// ModerateComment approves a comment or turns it down
+	ModerateComment(context.Context, *ModerateCommentReq) (*emptypb.Empty, error)
+	// ListCommentVerdicts lists what the comment filters made of a comment
+	// when it was added
+	ListCommentVerdicts(context.Context, *ListCommentVerdictsReq) (*ListCommentVerdictsResp, error)
+	// Publish makes a blog publicly listed
+	Publish(context.Context, *PublishReq) (*emptypb.Empty, error)
+	// Unpublish moves a published blog back to draft
+	Unpublish(context.Context, *UnpublishReq) (*emptypb.Empty, error)
+	// ListRevisions lists the previous versions of a blog, newest first
+	ListRevisions(context.Context, *ListRevisionsReq) (*ListRevisionsResp, error)
+	// GetRevision retrieves a previous version of a blog
+	GetRevision(context.Context, *GetRevisionReq) (*GetRevisionResp, error)
+	// DiffRevisions compares a revision with a later revision or the current
+	// version of a blog
+	DiffRevisions(context.Context, *DiffRevisionsReq) (*DiffRevisionsResp, error)
+	// RestoreRevision sets the title and content of a blog back to a revision,
+	// recording the replaced version as a new revision
+	RestoreRevision(context.Context, *RestoreRevisionReq) (*emptypb.Empty, error)
 	mustEmbedUnimplementedBlogsServer()
 }
 
@@ -150,6 +428,9 @@ func (UnimplementedBlogsServer) Create(context.Context, *CreateReq) (*CreateResp
 func (UnimplementedBlogsServer) Get(context.Context, *GetReq) (*GetResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
+func (UnimplementedBlogsServer) GetBySlug(context.Context, *GetBySlugReq) (*GetBySlugResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBySlug not implemented")
+}
 func (UnimplementedBlogsServer) Update(context.Context, *UpdateReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
@@ -159,9 +440,60 @@ func (UnimplementedBlogsServer) Delete(context.Context, *DeleteReq) (*emptypb.Em
 func (UnimplementedBlogsServer) List(context.Context, *ListReq) (*ListResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
-func (UnimplementedBlogsServer) AddComment(context.Context, *AddCommentReq) (*emptypb.Empty, error) {
+func (UnimplementedBlogsServer) ListTags(context.Context, *ListTagsReq) (*ListTagsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedBlogsServer) Search(context.Context, *SearchReq) (*SearchResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedBlogsServer) Undelete(context.Context, *UndeleteReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Undelete not implemented")
+}
+func (UnimplementedBlogsServer) ListDeleted(context.Context, *ListDeletedReq) (*ListDeletedResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeleted not implemented")
+}
+func (UnimplementedBlogsServer) AddComment(context.Context, *AddCommentReq) (*AddCommentResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddComment not implemented")
 }
+func (UnimplementedBlogsServer) GetComment(context.Context, *GetCommentReq) (*GetCommentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComment not implemented")
+}
+func (UnimplementedBlogsServer) UpdateComment(context.Context, *UpdateCommentReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
+}
+func (UnimplementedBlogsServer) DeleteComment(context.Context, *DeleteCommentReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedBlogsServer) ListComments(context.Context, *ListCommentsReq) (*ListCommentsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
+}
+func (UnimplementedBlogsServer) ListPendingComments(context.Context, *ListPendingCommentsReq) (*ListPendingCommentsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingComments not implemented")
+}
+func (UnimplementedBlogsServer) ModerateComment(context.Context, *ModerateCommentReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateComment not implemented")
+}
+func (UnimplementedBlogsServer) ListCommentVerdicts(context.Context, *ListCommentVerdictsReq) (*ListCommentVerdictsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommentVerdicts not implemented")
+}
+func (UnimplementedBlogsServer) Publish(context.Context, *PublishReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
+}
+func (UnimplementedBlogsServer) Unpublish(context.Context, *UnpublishReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unpublish not implemented")
+}
+func (UnimplementedBlogsServer) ListRevisions(context.Context, *ListRevisionsReq) (*ListRevisionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (UnimplementedBlogsServer) GetRevision(context.Context, *GetRevisionReq) (*GetRevisionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevision not implemented")
+}
+func (UnimplementedBlogsServer) DiffRevisions(context.Context, *DiffRevisionsReq) (*DiffRevisionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffRevisions not implemented")
+}
+func (UnimplementedBlogsServer) RestoreRevision(context.Context, *RestoreRevisionReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRevision not implemented")
+}
 func (UnimplementedBlogsServer) mustEmbedUnimplementedBlogsServer() {}
 func (UnimplementedBlogsServer) testEmbeddedByValue()               {}
 
@@ -219,6 +551,24 @@ func _Blogs_Get_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Blogs_GetBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBySlugReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogsServer).GetBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blogs_GetBySlug_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogsServer).GetBySlug(ctx, req.(*GetBySlugReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Blogs_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateReq)
 	if err := dec(in); err != nil {
@@ -273,6 +623,78 @@ func _Blogs_List_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Blogs_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogsServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blogs_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogsServer).ListTags(ctx, req.(*ListTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blogs_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogsServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blogs_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogsServer).Search(ctx, req.(*SearchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blogs_Undelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogsServer).Undelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blogs_Undelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogsServer).Undelete(ctx, req.(*UndeleteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blogs_ListDeleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogsServer).ListDeleted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blogs_ListDeleted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogsServer).ListDeleted(ctx, req.(*ListDeletedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Blogs_AddComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddCommentReq)
 	if err := dec(in); err != nil {
@@ -291,6 +713,240 @@ func _Blogs_AddComment_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Blogs_GetComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogsServer).GetComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blogs_GetComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogsServer).GetComment(ctx, req.(*GetCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blogs_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogsServer).UpdateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blogs_UpdateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogsServer).UpdateComment(ctx, req.(*UpdateCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blogs_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogsServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blogs_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogsServer).DeleteComment(ctx, req.(*DeleteCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blogs_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogsServer).ListComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blogs_ListComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogsServer).ListComments(ctx, req.(*ListCommentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blogs_ListPendingComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingCommentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogsServer).ListPendingComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blogs_ListPendingComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogsServer).ListPendingComments(ctx, req.(*ListPendingCommentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blogs_ModerateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogsServer).ModerateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blogs_ModerateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogsServer).ModerateComment(ctx, req.(*ModerateCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blogs_ListCommentVerdicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentVerdictsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogsServer).ListCommentVerdicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blogs_ListCommentVerdicts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogsServer).ListCommentVerdicts(ctx, req.(*ListCommentVerdictsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blogs_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogsServer).Publish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blogs_Publish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogsServer).Publish(ctx, req.(*PublishReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blogs_Unpublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpublishReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogsServer).Unpublish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blogs_Unpublish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogsServer).Unpublish(ctx, req.(*UnpublishReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blogs_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevisionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogsServer).ListRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blogs_ListRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogsServer).ListRevisions(ctx, req.(*ListRevisionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blogs_GetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogsServer).GetRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blogs_GetRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogsServer).GetRevision(ctx, req.(*GetRevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blogs_DiffRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRevisionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogsServer).DiffRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blogs_DiffRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogsServer).DiffRevisions(ctx, req.(*DiffRevisionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blogs_RestoreRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogsServer).RestoreRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blogs_RestoreRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogsServer).RestoreRevision(ctx, req.(*RestoreRevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Blogs_ServiceDesc is the grpc.ServiceDesc for Blogs service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -306,6 +962,10 @@ var Blogs_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _Blogs_Get_Handler,
 		},
+		{
+			MethodName: "GetBySlug",
+			Handler:    _Blogs_GetBySlug_Handler,
+		},
 		{
 			MethodName: "Update",
 			Handler:    _Blogs_Update_Handler,
@@ -318,10 +978,78 @@ var Blogs_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _Blogs_List_Handler,
 		},
+		{
+			MethodName: "ListTags",
+			Handler:    _Blogs_ListTags_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _Blogs_Search_Handler,
+		},
+		{
+			MethodName: "Undelete",
+			Handler:    _Blogs_Undelete_Handler,
+		},
+		{
+			MethodName: "ListDeleted",
+			Handler:    _Blogs_ListDeleted_Handler,
+		},
 		{
 			MethodName: "AddComment",
 			Handler:    _Blogs_AddComment_Handler,
 		},
+		{
+			MethodName: "GetComment",
+			Handler:    _Blogs_GetComment_Handler,
+		},
+		{
+			MethodName: "UpdateComment",
+			Handler:    _Blogs_UpdateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _Blogs_DeleteComment_Handler,
+		},
+		{
+			MethodName: "ListComments",
+			Handler:    _Blogs_ListComments_Handler,
+		},
+		{
+			MethodName: "ListPendingComments",
+			Handler:    _Blogs_ListPendingComments_Handler,
+		},
+		{
+			MethodName: "ModerateComment",
+			Handler:    _Blogs_ModerateComment_Handler,
+		},
+		{
+			MethodName: "ListCommentVerdicts",
+			Handler:    _Blogs_ListCommentVerdicts_Handler,
+		},
+		{
+			MethodName: "Publish",
+			Handler:    _Blogs_Publish_Handler,
+		},
+		{
+			MethodName: "Unpublish",
+			Handler:    _Blogs_Unpublish_Handler,
+		},
+		{
+			MethodName: "ListRevisions",
+			Handler:    _Blogs_ListRevisions_Handler,
+		},
+		{
+			MethodName: "GetRevision",
+			Handler:    _Blogs_GetRevision_Handler,
+		},
+		{
+			MethodName: "DiffRevisions",
+			Handler:    _Blogs_DiffRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreRevision",
+			Handler:    _Blogs_RestoreRevision_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/blog/v1/blog.proto",